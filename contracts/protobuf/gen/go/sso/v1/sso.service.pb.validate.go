@@ -342,40 +342,48 @@ var _ interface {
 	ErrorName() string
 } = RefreshTokenGrantValidationError{}
 
-// Validate checks the field values on GetJWKS with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJWKS) Validate() error {
+// Validate checks the field values on AuthorizationCodeGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizationCodeGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJWKS with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in GetJWKSMultiError, or nil if none found.
-func (m *GetJWKS) ValidateAll() error {
+// ValidateAll checks the field values on AuthorizationCodeGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizationCodeGrantMultiError, or nil if none found.
+func (m *AuthorizationCodeGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJWKS) validate(all bool) error {
+func (m *AuthorizationCodeGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for CodeVerifier
+
 	if len(errors) > 0 {
-		return GetJWKSMultiError(errors)
+		return AuthorizationCodeGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJWKSMultiError is an error wrapping multiple validation errors returned
-// by GetJWKS.ValidateAll() if the designated constraints aren't met.
-type GetJWKSMultiError []error
+// AuthorizationCodeGrantMultiError is an error wrapping multiple validation
+// errors returned by AuthorizationCodeGrant.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizationCodeGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJWKSMultiError) Error() string {
+func (m AuthorizationCodeGrantMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -384,11 +392,11 @@ func (m GetJWKSMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJWKSMultiError) AllErrors() []error { return m }
+func (m AuthorizationCodeGrantMultiError) AllErrors() []error { return m }
 
-// GetJWKSValidationError is the validation error returned by GetJWKS.Validate
-// if the designated constraints aren't met.
-type GetJWKSValidationError struct {
+// AuthorizationCodeGrantValidationError is the validation error returned by
+// AuthorizationCodeGrant.Validate if the designated constraints aren't met.
+type AuthorizationCodeGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -396,22 +404,24 @@ type GetJWKSValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJWKSValidationError) Field() string { return e.field }
+func (e AuthorizationCodeGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJWKSValidationError) Reason() string { return e.reason }
+func (e AuthorizationCodeGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJWKSValidationError) Cause() error { return e.cause }
+func (e AuthorizationCodeGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJWKSValidationError) Key() bool { return e.key }
+func (e AuthorizationCodeGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJWKSValidationError) ErrorName() string { return "GetJWKSValidationError" }
+func (e AuthorizationCodeGrantValidationError) ErrorName() string {
+	return "AuthorizationCodeGrantValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJWKSValidationError) Error() string {
+func (e AuthorizationCodeGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -423,14 +433,14 @@ func (e GetJWKSValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJWKS.%s: %s%s",
+		"invalid %sAuthorizationCodeGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJWKSValidationError{}
+var _ error = AuthorizationCodeGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -438,133 +448,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJWKSValidationError{}
+} = AuthorizationCodeGrantValidationError{}
 
-// Validate checks the field values on GetToken_Request with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetToken_Request) Validate() error {
+// Validate checks the field values on ClientCredentialsGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClientCredentialsGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetToken_Request with the rules
+// ValidateAll checks the field values on ClientCredentialsGrant with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetToken_RequestMultiError, or nil if none found.
-func (m *GetToken_Request) ValidateAll() error {
+// ClientCredentialsGrantMultiError, or nil if none found.
+func (m *ClientCredentialsGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetToken_Request) validate(all bool) error {
+func (m *ClientCredentialsGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ClientId
-
-	switch v := m.Grant.(type) {
-	case *GetToken_Request_PasswordGrant:
-		if v == nil {
-			err := GetToken_RequestValidationError{
-				field:  "Grant",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPasswordGrant()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetToken_RequestValidationError{
-						field:  "PasswordGrant",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetToken_RequestValidationError{
-						field:  "PasswordGrant",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPasswordGrant()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetToken_RequestValidationError{
-					field:  "PasswordGrant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *GetToken_Request_RefreshTokenGrant:
-		if v == nil {
-			err := GetToken_RequestValidationError{
-				field:  "Grant",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetRefreshTokenGrant()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetToken_RequestValidationError{
-						field:  "RefreshTokenGrant",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetToken_RequestValidationError{
-						field:  "RefreshTokenGrant",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRefreshTokenGrant()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetToken_RequestValidationError{
-					field:  "RefreshTokenGrant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return GetToken_RequestMultiError(errors)
+		return ClientCredentialsGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetToken_RequestMultiError is an error wrapping multiple validation errors
-// returned by GetToken_Request.ValidateAll() if the designated constraints
-// aren't met.
-type GetToken_RequestMultiError []error
+// ClientCredentialsGrantMultiError is an error wrapping multiple validation
+// errors returned by ClientCredentialsGrant.ValidateAll() if the designated
+// constraints aren't met.
+type ClientCredentialsGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetToken_RequestMultiError) Error() string {
+func (m ClientCredentialsGrantMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -573,11 +494,11 @@ func (m GetToken_RequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetToken_RequestMultiError) AllErrors() []error { return m }
+func (m ClientCredentialsGrantMultiError) AllErrors() []error { return m }
 
-// GetToken_RequestValidationError is the validation error returned by
-// GetToken_Request.Validate if the designated constraints aren't met.
-type GetToken_RequestValidationError struct {
+// ClientCredentialsGrantValidationError is the validation error returned by
+// ClientCredentialsGrant.Validate if the designated constraints aren't met.
+type ClientCredentialsGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -585,22 +506,24 @@ type GetToken_RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetToken_RequestValidationError) Field() string { return e.field }
+func (e ClientCredentialsGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetToken_RequestValidationError) Reason() string { return e.reason }
+func (e ClientCredentialsGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetToken_RequestValidationError) Cause() error { return e.cause }
+func (e ClientCredentialsGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetToken_RequestValidationError) Key() bool { return e.key }
+func (e ClientCredentialsGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetToken_RequestValidationError) ErrorName() string { return "GetToken_RequestValidationError" }
+func (e ClientCredentialsGrantValidationError) ErrorName() string {
+	return "ClientCredentialsGrantValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetToken_RequestValidationError) Error() string {
+func (e ClientCredentialsGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -612,14 +535,14 @@ func (e GetToken_RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetToken_Request.%s: %s%s",
+		"invalid %sClientCredentialsGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetToken_RequestValidationError{}
+var _ error = ClientCredentialsGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -627,73 +550,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetToken_RequestValidationError{}
+} = ClientCredentialsGrantValidationError{}
 
-// Validate checks the field values on GetToken_Response with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetToken_Response) Validate() error {
+// Validate checks the field values on MFAOTPGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MFAOTPGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetToken_Response with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetToken_ResponseMultiError, or nil if none found.
-func (m *GetToken_Response) ValidateAll() error {
+// ValidateAll checks the field values on MFAOTPGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MFAOTPGrantMultiError, or
+// nil if none found.
+func (m *MFAOTPGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetToken_Response) validate(all bool) error {
+func (m *MFAOTPGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetToken()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetToken_ResponseValidationError{
-					field:  "Token",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetToken_ResponseValidationError{
-					field:  "Token",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetToken()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetToken_ResponseValidationError{
-				field:  "Token",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for MfaToken
+
+	// no validation rules for Otp
 
 	if len(errors) > 0 {
-		return GetToken_ResponseMultiError(errors)
+		return MFAOTPGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetToken_ResponseMultiError is an error wrapping multiple validation errors
-// returned by GetToken_Response.ValidateAll() if the designated constraints
-// aren't met.
-type GetToken_ResponseMultiError []error
+// MFAOTPGrantMultiError is an error wrapping multiple validation errors
+// returned by MFAOTPGrant.ValidateAll() if the designated constraints aren't met.
+type MFAOTPGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetToken_ResponseMultiError) Error() string {
+func (m MFAOTPGrantMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -702,11 +599,11 @@ func (m GetToken_ResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetToken_ResponseMultiError) AllErrors() []error { return m }
+func (m MFAOTPGrantMultiError) AllErrors() []error { return m }
 
-// GetToken_ResponseValidationError is the validation error returned by
-// GetToken_Response.Validate if the designated constraints aren't met.
-type GetToken_ResponseValidationError struct {
+// MFAOTPGrantValidationError is the validation error returned by
+// MFAOTPGrant.Validate if the designated constraints aren't met.
+type MFAOTPGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -714,24 +611,22 @@ type GetToken_ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetToken_ResponseValidationError) Field() string { return e.field }
+func (e MFAOTPGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetToken_ResponseValidationError) Reason() string { return e.reason }
+func (e MFAOTPGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetToken_ResponseValidationError) Cause() error { return e.cause }
+func (e MFAOTPGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetToken_ResponseValidationError) Key() bool { return e.key }
+func (e MFAOTPGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetToken_ResponseValidationError) ErrorName() string {
-	return "GetToken_ResponseValidationError"
-}
+func (e MFAOTPGrantValidationError) ErrorName() string { return "MFAOTPGrantValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetToken_ResponseValidationError) Error() string {
+func (e MFAOTPGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -743,14 +638,14 @@ func (e GetToken_ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetToken_Response.%s: %s%s",
+		"invalid %sMFAOTPGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetToken_ResponseValidationError{}
+var _ error = MFAOTPGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -758,44 +653,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetToken_ResponseValidationError{}
+} = MFAOTPGrantValidationError{}
 
-// Validate checks the field values on GetJWKS_Request with the rules defined
+// Validate checks the field values on PasswordlessGrant with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetJWKS_Request) Validate() error {
+func (m *PasswordlessGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJWKS_Request with the rules
+// ValidateAll checks the field values on PasswordlessGrant with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJWKS_RequestMultiError, or nil if none found.
-func (m *GetJWKS_Request) ValidateAll() error {
+// PasswordlessGrantMultiError, or nil if none found.
+func (m *PasswordlessGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJWKS_Request) validate(all bool) error {
+func (m *PasswordlessGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PasswordlessToken
+
+	// no validation rules for Code
+
+	// no validation rules for LinkToken
+
 	if len(errors) > 0 {
-		return GetJWKS_RequestMultiError(errors)
+		return PasswordlessGrantMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJWKS_RequestMultiError is an error wrapping multiple validation errors
-// returned by GetJWKS_Request.ValidateAll() if the designated constraints
+// PasswordlessGrantMultiError is an error wrapping multiple validation errors
+// returned by PasswordlessGrant.ValidateAll() if the designated constraints
 // aren't met.
-type GetJWKS_RequestMultiError []error
+type PasswordlessGrantMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJWKS_RequestMultiError) Error() string {
+func (m PasswordlessGrantMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -804,11 +705,11 @@ func (m GetJWKS_RequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJWKS_RequestMultiError) AllErrors() []error { return m }
+func (m PasswordlessGrantMultiError) AllErrors() []error { return m }
 
-// GetJWKS_RequestValidationError is the validation error returned by
-// GetJWKS_Request.Validate if the designated constraints aren't met.
-type GetJWKS_RequestValidationError struct {
+// PasswordlessGrantValidationError is the validation error returned by
+// PasswordlessGrant.Validate if the designated constraints aren't met.
+type PasswordlessGrantValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -816,22 +717,24 @@ type GetJWKS_RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJWKS_RequestValidationError) Field() string { return e.field }
+func (e PasswordlessGrantValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJWKS_RequestValidationError) Reason() string { return e.reason }
+func (e PasswordlessGrantValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJWKS_RequestValidationError) Cause() error { return e.cause }
+func (e PasswordlessGrantValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJWKS_RequestValidationError) Key() bool { return e.key }
+func (e PasswordlessGrantValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJWKS_RequestValidationError) ErrorName() string { return "GetJWKS_RequestValidationError" }
+func (e PasswordlessGrantValidationError) ErrorName() string {
+	return "PasswordlessGrantValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJWKS_RequestValidationError) Error() string {
+func (e PasswordlessGrantValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -843,14 +746,14 @@ func (e GetJWKS_RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJWKS_Request.%s: %s%s",
+		"invalid %sPasswordlessGrant.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJWKS_RequestValidationError{}
+var _ error = PasswordlessGrantValidationError{}
 
 var _ interface {
 	Field() string
@@ -858,38 +761,12443 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJWKS_RequestValidationError{}
+} = PasswordlessGrantValidationError{}
 
-// Validate checks the field values on GetJWKS_Response with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJWKS_Response) Validate() error {
+// Validate checks the field values on TokenExchangeGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenExchangeGrant) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJWKS_Response with the rules
+// ValidateAll checks the field values on TokenExchangeGrant with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJWKS_ResponseMultiError, or nil if none found.
-func (m *GetJWKS_Response) ValidateAll() error {
+// TokenExchangeGrantMultiError, or nil if none found.
+func (m *TokenExchangeGrant) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJWKS_Response) validate(all bool) error {
+func (m *TokenExchangeGrant) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetKeys() {
-		_, _ = idx, item
+	// no validation rules for SubjectToken
 
-		if all {
+	// no validation rules for SubjectTokenType
+
+	// no validation rules for RequestedSubject
+
+	if len(errors) > 0 {
+		return TokenExchangeGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenExchangeGrantMultiError is an error wrapping multiple validation errors
+// returned by TokenExchangeGrant.ValidateAll() if the designated constraints
+// aren't met.
+type TokenExchangeGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenExchangeGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenExchangeGrantMultiError) AllErrors() []error { return m }
+
+// TokenExchangeGrantValidationError is the validation error returned by
+// TokenExchangeGrant.Validate if the designated constraints aren't met.
+type TokenExchangeGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenExchangeGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenExchangeGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenExchangeGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenExchangeGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenExchangeGrantValidationError) ErrorName() string {
+	return "TokenExchangeGrantValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenExchangeGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenExchangeGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenExchangeGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenExchangeGrantValidationError{}
+
+// Validate checks the field values on DeviceCodeGrant with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeviceCodeGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeviceCodeGrant with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeviceCodeGrantMultiError, or nil if none found.
+func (m *DeviceCodeGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeviceCodeGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceCode
+
+	if len(errors) > 0 {
+		return DeviceCodeGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeviceCodeGrantMultiError is an error wrapping multiple validation errors
+// returned by DeviceCodeGrant.ValidateAll() if the designated constraints
+// aren't met.
+type DeviceCodeGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeviceCodeGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeviceCodeGrantMultiError) AllErrors() []error { return m }
+
+// DeviceCodeGrantValidationError is the validation error returned by
+// DeviceCodeGrant.Validate if the designated constraints aren't met.
+type DeviceCodeGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceCodeGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceCodeGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceCodeGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceCodeGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceCodeGrantValidationError) ErrorName() string { return "DeviceCodeGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeviceCodeGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceCodeGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceCodeGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceCodeGrantValidationError{}
+
+// Validate checks the field values on WebAuthnGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebAuthnGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebAuthnGrant with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebAuthnGrantMultiError, or
+// nil if none found.
+func (m *WebAuthnGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebAuthnGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasskeyToken
+
+	// no validation rules for Credential
+
+	if len(errors) > 0 {
+		return WebAuthnGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebAuthnGrantMultiError is an error wrapping multiple validation errors
+// returned by WebAuthnGrant.ValidateAll() if the designated constraints
+// aren't met.
+type WebAuthnGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebAuthnGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebAuthnGrantMultiError) AllErrors() []error { return m }
+
+// WebAuthnGrantValidationError is the validation error returned by
+// WebAuthnGrant.Validate if the designated constraints aren't met.
+type WebAuthnGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebAuthnGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebAuthnGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebAuthnGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebAuthnGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebAuthnGrantValidationError) ErrorName() string { return "WebAuthnGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebAuthnGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebAuthnGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebAuthnGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebAuthnGrantValidationError{}
+
+// Validate checks the field values on APIKeyGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKeyGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKeyGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIKeyGrantMultiError, or
+// nil if none found.
+func (m *APIKeyGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKeyGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKey
+
+	if len(errors) > 0 {
+		return APIKeyGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIKeyGrantMultiError is an error wrapping multiple validation errors
+// returned by APIKeyGrant.ValidateAll() if the designated constraints aren't met.
+type APIKeyGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyGrantMultiError) AllErrors() []error { return m }
+
+// APIKeyGrantValidationError is the validation error returned by
+// APIKeyGrant.Validate if the designated constraints aren't met.
+type APIKeyGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyGrantValidationError) ErrorName() string { return "APIKeyGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyGrantValidationError{}
+
+// Validate checks the field values on StartPasswordless with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartPasswordless) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasswordless with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasswordlessMultiError, or nil if none found.
+func (m *StartPasswordless) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasswordless) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartPasswordlessMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasswordlessMultiError is an error wrapping multiple validation errors
+// returned by StartPasswordless.ValidateAll() if the designated constraints
+// aren't met.
+type StartPasswordlessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasswordlessMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasswordlessMultiError) AllErrors() []error { return m }
+
+// StartPasswordlessValidationError is the validation error returned by
+// StartPasswordless.Validate if the designated constraints aren't met.
+type StartPasswordlessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasswordlessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasswordlessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasswordlessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasswordlessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasswordlessValidationError) ErrorName() string {
+	return "StartPasswordlessValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasswordlessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasswordless.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasswordlessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasswordlessValidationError{}
+
+// Validate checks the field values on Authorize with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Authorize) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Authorize with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorizeMultiError, or nil
+// if none found.
+func (m *Authorize) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Authorize) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthorizeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeMultiError is an error wrapping multiple validation errors returned
+// by Authorize.ValidateAll() if the designated constraints aren't met.
+type AuthorizeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeMultiError) AllErrors() []error { return m }
+
+// AuthorizeValidationError is the validation error returned by
+// Authorize.Validate if the designated constraints aren't met.
+type AuthorizeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeValidationError) ErrorName() string { return "AuthorizeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorize.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeValidationError{}
+
+// Validate checks the field values on StartDeviceAuthorization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartDeviceAuthorization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDeviceAuthorization with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartDeviceAuthorizationMultiError, or nil if none found.
+func (m *StartDeviceAuthorization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDeviceAuthorization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartDeviceAuthorizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDeviceAuthorizationMultiError is an error wrapping multiple validation
+// errors returned by StartDeviceAuthorization.ValidateAll() if the designated
+// constraints aren't met.
+type StartDeviceAuthorizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDeviceAuthorizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDeviceAuthorizationMultiError) AllErrors() []error { return m }
+
+// StartDeviceAuthorizationValidationError is the validation error returned by
+// StartDeviceAuthorization.Validate if the designated constraints aren't met.
+type StartDeviceAuthorizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDeviceAuthorizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDeviceAuthorizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDeviceAuthorizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDeviceAuthorizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDeviceAuthorizationValidationError) ErrorName() string {
+	return "StartDeviceAuthorizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDeviceAuthorizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDeviceAuthorization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDeviceAuthorizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDeviceAuthorizationValidationError{}
+
+// Validate checks the field values on GetDeviceAuthorization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeviceAuthorization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeviceAuthorization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeviceAuthorizationMultiError, or nil if none found.
+func (m *GetDeviceAuthorization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeviceAuthorization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetDeviceAuthorizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeviceAuthorizationMultiError is an error wrapping multiple validation
+// errors returned by GetDeviceAuthorization.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeviceAuthorizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeviceAuthorizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeviceAuthorizationMultiError) AllErrors() []error { return m }
+
+// GetDeviceAuthorizationValidationError is the validation error returned by
+// GetDeviceAuthorization.Validate if the designated constraints aren't met.
+type GetDeviceAuthorizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeviceAuthorizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeviceAuthorizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeviceAuthorizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeviceAuthorizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeviceAuthorizationValidationError) ErrorName() string {
+	return "GetDeviceAuthorizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeviceAuthorizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeviceAuthorization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeviceAuthorizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeviceAuthorizationValidationError{}
+
+// Validate checks the field values on AuthorizeDevice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeDevice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeDevice with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeDeviceMultiError, or nil if none found.
+func (m *AuthorizeDevice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeDevice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthorizeDeviceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeDeviceMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeDevice.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorizeDeviceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeDeviceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeDeviceMultiError) AllErrors() []error { return m }
+
+// AuthorizeDeviceValidationError is the validation error returned by
+// AuthorizeDevice.Validate if the designated constraints aren't met.
+type AuthorizeDeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeDeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeDeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeDeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeDeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeDeviceValidationError) ErrorName() string { return "AuthorizeDeviceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeDeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeDevice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeDeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeDeviceValidationError{}
+
+// Validate checks the field values on StartPasskeyLogin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartPasskeyLogin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasskeyLogin with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasskeyLoginMultiError, or nil if none found.
+func (m *StartPasskeyLogin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasskeyLogin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartPasskeyLoginMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasskeyLoginMultiError is an error wrapping multiple validation errors
+// returned by StartPasskeyLogin.ValidateAll() if the designated constraints
+// aren't met.
+type StartPasskeyLoginMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasskeyLoginMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasskeyLoginMultiError) AllErrors() []error { return m }
+
+// StartPasskeyLoginValidationError is the validation error returned by
+// StartPasskeyLogin.Validate if the designated constraints aren't met.
+type StartPasskeyLoginValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasskeyLoginValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasskeyLoginValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasskeyLoginValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasskeyLoginValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasskeyLoginValidationError) ErrorName() string {
+	return "StartPasskeyLoginValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasskeyLoginValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasskeyLogin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasskeyLoginValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasskeyLoginValidationError{}
+
+// Validate checks the field values on GetJWKS with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJWKS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJWKS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GetJWKSMultiError, or nil if none found.
+func (m *GetJWKS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJWKS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetJWKSMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJWKSMultiError is an error wrapping multiple validation errors returned
+// by GetJWKS.ValidateAll() if the designated constraints aren't met.
+type GetJWKSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJWKSMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJWKSMultiError) AllErrors() []error { return m }
+
+// GetJWKSValidationError is the validation error returned by GetJWKS.Validate
+// if the designated constraints aren't met.
+type GetJWKSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJWKSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJWKSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJWKSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJWKSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJWKSValidationError) ErrorName() string { return "GetJWKSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJWKSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJWKS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJWKSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJWKSValidationError{}
+
+// Validate checks the field values on RevokeToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeTokenMultiError, or
+// nil if none found.
+func (m *RevokeToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTokenMultiError is an error wrapping multiple validation errors
+// returned by RevokeToken.ValidateAll() if the designated constraints aren't met.
+type RevokeTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTokenMultiError) AllErrors() []error { return m }
+
+// RevokeTokenValidationError is the validation error returned by
+// RevokeToken.Validate if the designated constraints aren't met.
+type RevokeTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTokenValidationError) ErrorName() string { return "RevokeTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTokenValidationError{}
+
+// Validate checks the field values on IntrospectToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IntrospectToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntrospectTokenMultiError, or nil if none found.
+func (m *IntrospectToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return IntrospectTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectTokenMultiError is an error wrapping multiple validation errors
+// returned by IntrospectToken.ValidateAll() if the designated constraints
+// aren't met.
+type IntrospectTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectTokenMultiError) AllErrors() []error { return m }
+
+// IntrospectTokenValidationError is the validation error returned by
+// IntrospectToken.Validate if the designated constraints aren't met.
+type IntrospectTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectTokenValidationError) ErrorName() string { return "IntrospectTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntrospectTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectTokenValidationError{}
+
+// Validate checks the field values on GetOpenIDConfiguration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOpenIDConfiguration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOpenIDConfiguration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOpenIDConfigurationMultiError, or nil if none found.
+func (m *GetOpenIDConfiguration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOpenIDConfiguration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetOpenIDConfigurationMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOpenIDConfigurationMultiError is an error wrapping multiple validation
+// errors returned by GetOpenIDConfiguration.ValidateAll() if the designated
+// constraints aren't met.
+type GetOpenIDConfigurationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOpenIDConfigurationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOpenIDConfigurationMultiError) AllErrors() []error { return m }
+
+// GetOpenIDConfigurationValidationError is the validation error returned by
+// GetOpenIDConfiguration.Validate if the designated constraints aren't met.
+type GetOpenIDConfigurationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOpenIDConfigurationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOpenIDConfigurationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOpenIDConfigurationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOpenIDConfigurationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOpenIDConfigurationValidationError) ErrorName() string {
+	return "GetOpenIDConfigurationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOpenIDConfigurationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOpenIDConfiguration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOpenIDConfigurationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOpenIDConfigurationValidationError{}
+
+// Validate checks the field values on GetUserInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserInfoMultiError, or
+// nil if none found.
+func (m *GetUserInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUserInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserInfoMultiError is an error wrapping multiple validation errors
+// returned by GetUserInfo.ValidateAll() if the designated constraints aren't met.
+type GetUserInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserInfoMultiError) AllErrors() []error { return m }
+
+// GetUserInfoValidationError is the validation error returned by
+// GetUserInfo.Validate if the designated constraints aren't met.
+type GetUserInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserInfoValidationError) ErrorName() string { return "GetUserInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserInfoValidationError{}
+
+// Validate checks the field values on CreateClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClient with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateClientMultiError, or
+// nil if none found.
+func (m *CreateClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClientMultiError is an error wrapping multiple validation errors
+// returned by CreateClient.ValidateAll() if the designated constraints aren't met.
+type CreateClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClientMultiError) AllErrors() []error { return m }
+
+// CreateClientValidationError is the validation error returned by
+// CreateClient.Validate if the designated constraints aren't met.
+type CreateClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClientValidationError) ErrorName() string { return "CreateClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClientValidationError{}
+
+// Validate checks the field values on ListClients with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListClients) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClients with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListClientsMultiError, or
+// nil if none found.
+func (m *ListClients) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClients) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListClientsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClientsMultiError is an error wrapping multiple validation errors
+// returned by ListClients.ValidateAll() if the designated constraints aren't met.
+type ListClientsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClientsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClientsMultiError) AllErrors() []error { return m }
+
+// ListClientsValidationError is the validation error returned by
+// ListClients.Validate if the designated constraints aren't met.
+type ListClientsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClientsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClientsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClientsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClientsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClientsValidationError) ErrorName() string { return "ListClientsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListClientsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClients.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClientsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClientsValidationError{}
+
+// Validate checks the field values on RotateClientSecret with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateClientSecret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateClientSecret with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateClientSecretMultiError, or nil if none found.
+func (m *RotateClientSecret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateClientSecret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RotateClientSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateClientSecretMultiError is an error wrapping multiple validation errors
+// returned by RotateClientSecret.ValidateAll() if the designated constraints
+// aren't met.
+type RotateClientSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateClientSecretMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateClientSecretMultiError) AllErrors() []error { return m }
+
+// RotateClientSecretValidationError is the validation error returned by
+// RotateClientSecret.Validate if the designated constraints aren't met.
+type RotateClientSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateClientSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateClientSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateClientSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateClientSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateClientSecretValidationError) ErrorName() string {
+	return "RotateClientSecretValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateClientSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateClientSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateClientSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateClientSecretValidationError{}
+
+// Validate checks the field values on DisableClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DisableClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableClient with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DisableClientMultiError, or
+// nil if none found.
+func (m *DisableClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableClientMultiError is an error wrapping multiple validation errors
+// returned by DisableClient.ValidateAll() if the designated constraints
+// aren't met.
+type DisableClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableClientMultiError) AllErrors() []error { return m }
+
+// DisableClientValidationError is the validation error returned by
+// DisableClient.Validate if the designated constraints aren't met.
+type DisableClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableClientValidationError) ErrorName() string { return "DisableClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DisableClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableClientValidationError{}
+
+// Validate checks the field values on EnrollTOTP with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollTOTPMultiError, or
+// nil if none found.
+func (m *EnrollTOTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnrollTOTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTP.ValidateAll() if the designated constraints aren't met.
+type EnrollTOTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPValidationError is the validation error returned by
+// EnrollTOTP.Validate if the designated constraints aren't met.
+type EnrollTOTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPValidationError) ErrorName() string { return "EnrollTOTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPValidationError{}
+
+// Validate checks the field values on ConfirmTOTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfirmTOTPMultiError, or
+// nil if none found.
+func (m *ConfirmTOTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTP.ValidateAll() if the designated constraints aren't met.
+type ConfirmTOTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPValidationError is the validation error returned by
+// ConfirmTOTP.Validate if the designated constraints aren't met.
+type ConfirmTOTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPValidationError) ErrorName() string { return "ConfirmTOTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPValidationError{}
+
+// Validate checks the field values on DisableTOTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DisableTOTPMultiError, or
+// nil if none found.
+func (m *DisableTOTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTOTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTPMultiError is an error wrapping multiple validation errors
+// returned by DisableTOTP.ValidateAll() if the designated constraints aren't met.
+type DisableTOTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTPMultiError) AllErrors() []error { return m }
+
+// DisableTOTPValidationError is the validation error returned by
+// DisableTOTP.Validate if the designated constraints aren't met.
+type DisableTOTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTPValidationError) ErrorName() string { return "DisableTOTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DisableTOTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTPValidationError{}
+
+// Validate checks the field values on RegenerateRecoveryCodes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegenerateRecoveryCodes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegenerateRecoveryCodes with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodesMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegenerateRecoveryCodes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RegenerateRecoveryCodesMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodesMultiError is an error wrapping multiple validation
+// errors returned by RegenerateRecoveryCodes.ValidateAll() if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegenerateRecoveryCodesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegenerateRecoveryCodesMultiError) AllErrors() []error { return m }
+
+// RegenerateRecoveryCodesValidationError is the validation error returned by
+// RegenerateRecoveryCodes.Validate if the designated constraints aren't met.
+type RegenerateRecoveryCodesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateRecoveryCodesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateRecoveryCodesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateRecoveryCodesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateRecoveryCodesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateRecoveryCodesValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateRecoveryCodesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateRecoveryCodes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateRecoveryCodesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateRecoveryCodesValidationError{}
+
+// Validate checks the field values on UnlockAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnlockAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnlockAccountMultiError, or
+// nil if none found.
+func (m *UnlockAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockAccountMultiError is an error wrapping multiple validation errors
+// returned by UnlockAccount.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockAccountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockAccountMultiError) AllErrors() []error { return m }
+
+// UnlockAccountValidationError is the validation error returned by
+// UnlockAccount.Validate if the designated constraints aren't met.
+type UnlockAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockAccountValidationError) ErrorName() string { return "UnlockAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnlockAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockAccountValidationError{}
+
+// Validate checks the field values on ListAuthEvents with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListAuthEvents) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthEvents with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListAuthEventsMultiError,
+// or nil if none found.
+func (m *ListAuthEvents) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthEvents) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAuthEventsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthEventsMultiError is an error wrapping multiple validation errors
+// returned by ListAuthEvents.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuthEventsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthEventsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthEventsMultiError) AllErrors() []error { return m }
+
+// ListAuthEventsValidationError is the validation error returned by
+// ListAuthEvents.Validate if the designated constraints aren't met.
+type ListAuthEventsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthEventsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthEventsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthEventsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthEventsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthEventsValidationError) ErrorName() string { return "ListAuthEventsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListAuthEventsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthEvents.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthEventsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthEventsValidationError{}
+
+// Validate checks the field values on ListSessions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListSessions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListSessionsMultiError, or
+// nil if none found.
+func (m *ListSessions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSessionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsMultiError is an error wrapping multiple validation errors
+// returned by ListSessions.ValidateAll() if the designated constraints aren't met.
+type ListSessionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsMultiError) AllErrors() []error { return m }
+
+// ListSessionsValidationError is the validation error returned by
+// ListSessions.Validate if the designated constraints aren't met.
+type ListSessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsValidationError) ErrorName() string { return "ListSessionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListSessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsValidationError{}
+
+// Validate checks the field values on RevokeSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeSessionMultiError, or
+// nil if none found.
+func (m *RevokeSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionMultiError is an error wrapping multiple validation errors
+// returned by RevokeSession.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionMultiError) AllErrors() []error { return m }
+
+// RevokeSessionValidationError is the validation error returned by
+// RevokeSession.Validate if the designated constraints aren't met.
+type RevokeSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionValidationError) ErrorName() string { return "RevokeSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionValidationError{}
+
+// Validate checks the field values on RevokeAllSessions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllSessions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAllSessions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllSessionsMultiError, or nil if none found.
+func (m *RevokeAllSessions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAllSessions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAllSessionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAllSessionsMultiError is an error wrapping multiple validation errors
+// returned by RevokeAllSessions.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeAllSessionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAllSessionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAllSessionsMultiError) AllErrors() []error { return m }
+
+// RevokeAllSessionsValidationError is the validation error returned by
+// RevokeAllSessions.Validate if the designated constraints aren't met.
+type RevokeAllSessionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAllSessionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAllSessionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAllSessionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAllSessionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAllSessionsValidationError) ErrorName() string {
+	return "RevokeAllSessionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAllSessionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAllSessions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAllSessionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAllSessionsValidationError{}
+
+// Validate checks the field values on RequestPasswordReset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordReset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordReset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetMultiError, or nil if none found.
+func (m *RequestPasswordReset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordReset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestPasswordResetMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetMultiError is an error wrapping multiple validation
+// errors returned by RequestPasswordReset.ValidateAll() if the designated
+// constraints aren't met.
+type RequestPasswordResetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetValidationError is the validation error returned by
+// RequestPasswordReset.Validate if the designated constraints aren't met.
+type RequestPasswordResetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetValidationError) ErrorName() string {
+	return "RequestPasswordResetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordReset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetValidationError{}
+
+// Validate checks the field values on ResetPassword with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResetPassword) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPassword with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResetPasswordMultiError, or
+// nil if none found.
+func (m *ResetPassword) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPassword) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetPasswordMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordMultiError is an error wrapping multiple validation errors
+// returned by ResetPassword.ValidateAll() if the designated constraints
+// aren't met.
+type ResetPasswordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordMultiError) AllErrors() []error { return m }
+
+// ResetPasswordValidationError is the validation error returned by
+// ResetPassword.Validate if the designated constraints aren't met.
+type ResetPasswordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordValidationError) ErrorName() string { return "ResetPasswordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPassword.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordValidationError{}
+
+// Validate checks the field values on SendVerificationEmail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendVerificationEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendVerificationEmail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendVerificationEmailMultiError, or nil if none found.
+func (m *SendVerificationEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendVerificationEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SendVerificationEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendVerificationEmailMultiError is an error wrapping multiple validation
+// errors returned by SendVerificationEmail.ValidateAll() if the designated
+// constraints aren't met.
+type SendVerificationEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendVerificationEmailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendVerificationEmailMultiError) AllErrors() []error { return m }
+
+// SendVerificationEmailValidationError is the validation error returned by
+// SendVerificationEmail.Validate if the designated constraints aren't met.
+type SendVerificationEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendVerificationEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendVerificationEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendVerificationEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendVerificationEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendVerificationEmailValidationError) ErrorName() string {
+	return "SendVerificationEmailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendVerificationEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendVerificationEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendVerificationEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendVerificationEmailValidationError{}
+
+// Validate checks the field values on VerifyEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyEmailMultiError, or
+// nil if none found.
+func (m *VerifyEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmailMultiError is an error wrapping multiple validation errors
+// returned by VerifyEmail.ValidateAll() if the designated constraints aren't met.
+type VerifyEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmailMultiError) AllErrors() []error { return m }
+
+// VerifyEmailValidationError is the validation error returned by
+// VerifyEmail.Validate if the designated constraints aren't met.
+type VerifyEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmailValidationError) ErrorName() string { return "VerifyEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmailValidationError{}
+
+// Validate checks the field values on ListIdentityProviders with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentityProviders) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIdentityProviders with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIdentityProvidersMultiError, or nil if none found.
+func (m *ListIdentityProviders) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIdentityProviders) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListIdentityProvidersMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIdentityProvidersMultiError is an error wrapping multiple validation
+// errors returned by ListIdentityProviders.ValidateAll() if the designated
+// constraints aren't met.
+type ListIdentityProvidersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIdentityProvidersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIdentityProvidersMultiError) AllErrors() []error { return m }
+
+// ListIdentityProvidersValidationError is the validation error returned by
+// ListIdentityProviders.Validate if the designated constraints aren't met.
+type ListIdentityProvidersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIdentityProvidersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIdentityProvidersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIdentityProvidersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIdentityProvidersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIdentityProvidersValidationError) ErrorName() string {
+	return "ListIdentityProvidersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIdentityProvidersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIdentityProviders.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIdentityProvidersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIdentityProvidersValidationError{}
+
+// Validate checks the field values on StartFederation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartFederation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartFederation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartFederationMultiError, or nil if none found.
+func (m *StartFederation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartFederation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartFederationMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartFederationMultiError is an error wrapping multiple validation errors
+// returned by StartFederation.ValidateAll() if the designated constraints
+// aren't met.
+type StartFederationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartFederationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartFederationMultiError) AllErrors() []error { return m }
+
+// StartFederationValidationError is the validation error returned by
+// StartFederation.Validate if the designated constraints aren't met.
+type StartFederationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartFederationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartFederationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartFederationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartFederationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartFederationValidationError) ErrorName() string { return "StartFederationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StartFederationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartFederation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartFederationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartFederationValidationError{}
+
+// Validate checks the field values on CompleteFederation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteFederation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteFederation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteFederationMultiError, or nil if none found.
+func (m *CompleteFederation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteFederation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CompleteFederationMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteFederationMultiError is an error wrapping multiple validation errors
+// returned by CompleteFederation.ValidateAll() if the designated constraints
+// aren't met.
+type CompleteFederationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteFederationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteFederationMultiError) AllErrors() []error { return m }
+
+// CompleteFederationValidationError is the validation error returned by
+// CompleteFederation.Validate if the designated constraints aren't met.
+type CompleteFederationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteFederationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteFederationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteFederationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteFederationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteFederationValidationError) ErrorName() string {
+	return "CompleteFederationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteFederationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteFederation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteFederationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteFederationValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BeginPasskeyRegistration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistration with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistrationMultiError, or nil if none found.
+func (m *BeginPasskeyRegistration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistrationMultiError is an error wrapping multiple validation
+// errors returned by BeginPasskeyRegistration.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyRegistrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistrationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistrationMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistrationValidationError is the validation error returned by
+// BeginPasskeyRegistration.Validate if the designated constraints aren't met.
+type BeginPasskeyRegistrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistrationValidationError) ErrorName() string {
+	return "BeginPasskeyRegistrationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistrationValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishPasskeyRegistration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistration with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistrationMultiError, or nil if none found.
+func (m *FinishPasskeyRegistration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistrationMultiError is an error wrapping multiple validation
+// errors returned by FinishPasskeyRegistration.ValidateAll() if the
+// designated constraints aren't met.
+type FinishPasskeyRegistrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistrationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistrationMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistrationValidationError is the validation error returned by
+// FinishPasskeyRegistration.Validate if the designated constraints aren't met.
+type FinishPasskeyRegistrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistrationValidationError) ErrorName() string {
+	return "FinishPasskeyRegistrationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistrationValidationError{}
+
+// Validate checks the field values on CreateAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateAPIKeyMultiError, or
+// nil if none found.
+func (m *CreateAPIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateAPIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyMultiError is an error wrapping multiple validation errors
+// returned by CreateAPIKey.ValidateAll() if the designated constraints aren't met.
+type CreateAPIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyValidationError is the validation error returned by
+// CreateAPIKey.Validate if the designated constraints aren't met.
+type CreateAPIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyValidationError) ErrorName() string { return "CreateAPIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyValidationError{}
+
+// Validate checks the field values on ListAPIKeys with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeys) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeys with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListAPIKeysMultiError, or
+// nil if none found.
+func (m *ListAPIKeys) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeys) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAPIKeysMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeys.ValidateAll() if the designated constraints aren't met.
+type ListAPIKeysMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysValidationError is the validation error returned by
+// ListAPIKeys.Validate if the designated constraints aren't met.
+type ListAPIKeysValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysValidationError) ErrorName() string { return "ListAPIKeysValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeys.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysValidationError{}
+
+// Validate checks the field values on RevokeAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeAPIKeyMultiError, or
+// nil if none found.
+func (m *RevokeAPIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyMultiError is an error wrapping multiple validation errors
+// returned by RevokeAPIKey.ValidateAll() if the designated constraints aren't met.
+type RevokeAPIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyValidationError is the validation error returned by
+// RevokeAPIKey.Validate if the designated constraints aren't met.
+type RevokeAPIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyValidationError) ErrorName() string { return "RevokeAPIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyValidationError{}
+
+// Validate checks the field values on GetToken_Request with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetToken_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetToken_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetToken_RequestMultiError, or nil if none found.
+func (m *GetToken_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetToken_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for Scope
+
+	switch v := m.Grant.(type) {
+	case *GetToken_Request_PasswordGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPasswordGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "PasswordGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "PasswordGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPasswordGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "PasswordGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_RefreshTokenGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRefreshTokenGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "RefreshTokenGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "RefreshTokenGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRefreshTokenGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "RefreshTokenGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_AuthorizationCodeGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAuthorizationCodeGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "AuthorizationCodeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "AuthorizationCodeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAuthorizationCodeGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "AuthorizationCodeGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_ClientCredentialsGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetClientCredentialsGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "ClientCredentialsGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "ClientCredentialsGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClientCredentialsGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "ClientCredentialsGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_MfaOtpGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMfaOtpGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "MfaOtpGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "MfaOtpGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMfaOtpGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "MfaOtpGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_PasswordlessGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPasswordlessGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "PasswordlessGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "PasswordlessGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPasswordlessGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "PasswordlessGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_TokenExchangeGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTokenExchangeGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "TokenExchangeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "TokenExchangeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTokenExchangeGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "TokenExchangeGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_DeviceCodeGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeviceCodeGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "DeviceCodeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "DeviceCodeGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeviceCodeGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "DeviceCodeGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_WebauthnGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetWebauthnGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "WebauthnGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "WebauthnGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebauthnGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "WebauthnGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetToken_Request_ApiKeyGrant:
+		if v == nil {
+			err := GetToken_RequestValidationError{
+				field:  "Grant",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetApiKeyGrant()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "ApiKeyGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetToken_RequestValidationError{
+						field:  "ApiKeyGrant",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApiKeyGrant()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetToken_RequestValidationError{
+					field:  "ApiKeyGrant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetToken_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetToken_RequestMultiError is an error wrapping multiple validation errors
+// returned by GetToken_Request.ValidateAll() if the designated constraints
+// aren't met.
+type GetToken_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetToken_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetToken_RequestMultiError) AllErrors() []error { return m }
+
+// GetToken_RequestValidationError is the validation error returned by
+// GetToken_Request.Validate if the designated constraints aren't met.
+type GetToken_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetToken_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetToken_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetToken_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetToken_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetToken_RequestValidationError) ErrorName() string { return "GetToken_RequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetToken_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetToken_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetToken_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetToken_RequestValidationError{}
+
+// Validate checks the field values on GetToken_Response with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetToken_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetToken_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetToken_ResponseMultiError, or nil if none found.
+func (m *GetToken_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetToken_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetToken_ResponseValidationError{
+					field:  "Token",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetToken_ResponseValidationError{
+					field:  "Token",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetToken_ResponseValidationError{
+				field:  "Token",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetToken_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetToken_ResponseMultiError is an error wrapping multiple validation errors
+// returned by GetToken_Response.ValidateAll() if the designated constraints
+// aren't met.
+type GetToken_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetToken_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetToken_ResponseMultiError) AllErrors() []error { return m }
+
+// GetToken_ResponseValidationError is the validation error returned by
+// GetToken_Response.Validate if the designated constraints aren't met.
+type GetToken_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetToken_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetToken_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetToken_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetToken_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetToken_ResponseValidationError) ErrorName() string {
+	return "GetToken_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetToken_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetToken_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetToken_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetToken_ResponseValidationError{}
+
+// Validate checks the field values on StartPasswordless_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPasswordless_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasswordless_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasswordless_RequestMultiError, or nil if none found.
+func (m *StartPasswordless_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasswordless_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return StartPasswordless_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasswordless_RequestMultiError is an error wrapping multiple validation
+// errors returned by StartPasswordless_Request.ValidateAll() if the
+// designated constraints aren't met.
+type StartPasswordless_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasswordless_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasswordless_RequestMultiError) AllErrors() []error { return m }
+
+// StartPasswordless_RequestValidationError is the validation error returned by
+// StartPasswordless_Request.Validate if the designated constraints aren't met.
+type StartPasswordless_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasswordless_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasswordless_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasswordless_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasswordless_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasswordless_RequestValidationError) ErrorName() string {
+	return "StartPasswordless_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasswordless_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasswordless_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasswordless_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasswordless_RequestValidationError{}
+
+// Validate checks the field values on StartPasswordless_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPasswordless_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasswordless_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasswordless_ResponseMultiError, or nil if none found.
+func (m *StartPasswordless_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasswordless_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasswordlessToken
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return StartPasswordless_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasswordless_ResponseMultiError is an error wrapping multiple
+// validation errors returned by StartPasswordless_Response.ValidateAll() if
+// the designated constraints aren't met.
+type StartPasswordless_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasswordless_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasswordless_ResponseMultiError) AllErrors() []error { return m }
+
+// StartPasswordless_ResponseValidationError is the validation error returned
+// by StartPasswordless_Response.Validate if the designated constraints aren't met.
+type StartPasswordless_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasswordless_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasswordless_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasswordless_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasswordless_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasswordless_ResponseValidationError) ErrorName() string {
+	return "StartPasswordless_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasswordless_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasswordless_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasswordless_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasswordless_ResponseValidationError{}
+
+// Validate checks the field values on Authorize_Request with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Authorize_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Authorize_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Authorize_RequestMultiError, or nil if none found.
+func (m *Authorize_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Authorize_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for ResponseType
+
+	// no validation rules for Scope
+
+	// no validation rules for State
+
+	// no validation rules for Nonce
+
+	// no validation rules for CodeChallenge
+
+	// no validation rules for CodeChallengeMethod
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Otp
+
+	if len(errors) > 0 {
+		return Authorize_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// Authorize_RequestMultiError is an error wrapping multiple validation errors
+// returned by Authorize_Request.ValidateAll() if the designated constraints
+// aren't met.
+type Authorize_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Authorize_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Authorize_RequestMultiError) AllErrors() []error { return m }
+
+// Authorize_RequestValidationError is the validation error returned by
+// Authorize_Request.Validate if the designated constraints aren't met.
+type Authorize_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Authorize_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Authorize_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Authorize_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Authorize_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Authorize_RequestValidationError) ErrorName() string {
+	return "Authorize_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Authorize_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorize_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Authorize_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Authorize_RequestValidationError{}
+
+// Validate checks the field values on Authorize_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Authorize_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Authorize_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Authorize_ResponseMultiError, or nil if none found.
+func (m *Authorize_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Authorize_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return Authorize_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// Authorize_ResponseMultiError is an error wrapping multiple validation errors
+// returned by Authorize_Response.ValidateAll() if the designated constraints
+// aren't met.
+type Authorize_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Authorize_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Authorize_ResponseMultiError) AllErrors() []error { return m }
+
+// Authorize_ResponseValidationError is the validation error returned by
+// Authorize_Response.Validate if the designated constraints aren't met.
+type Authorize_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Authorize_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Authorize_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Authorize_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Authorize_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Authorize_ResponseValidationError) ErrorName() string {
+	return "Authorize_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Authorize_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorize_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Authorize_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Authorize_ResponseValidationError{}
+
+// Validate checks the field values on StartDeviceAuthorization_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartDeviceAuthorization_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDeviceAuthorization_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartDeviceAuthorization_RequestMultiError, or nil if none found.
+func (m *StartDeviceAuthorization_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDeviceAuthorization_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return StartDeviceAuthorization_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDeviceAuthorization_RequestMultiError is an error wrapping multiple
+// validation errors returned by
+// StartDeviceAuthorization_Request.ValidateAll() if the designated
+// constraints aren't met.
+type StartDeviceAuthorization_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDeviceAuthorization_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDeviceAuthorization_RequestMultiError) AllErrors() []error { return m }
+
+// StartDeviceAuthorization_RequestValidationError is the validation error
+// returned by StartDeviceAuthorization_Request.Validate if the designated
+// constraints aren't met.
+type StartDeviceAuthorization_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDeviceAuthorization_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDeviceAuthorization_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDeviceAuthorization_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDeviceAuthorization_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDeviceAuthorization_RequestValidationError) ErrorName() string {
+	return "StartDeviceAuthorization_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDeviceAuthorization_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDeviceAuthorization_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDeviceAuthorization_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDeviceAuthorization_RequestValidationError{}
+
+// Validate checks the field values on StartDeviceAuthorization_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartDeviceAuthorization_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDeviceAuthorization_Response
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StartDeviceAuthorization_ResponseMultiError, or nil if none found.
+func (m *StartDeviceAuthorization_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDeviceAuthorization_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeviceCode
+
+	// no validation rules for UserCode
+
+	// no validation rules for VerificationUri
+
+	// no validation rules for VerificationUriComplete
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Interval
+
+	if len(errors) > 0 {
+		return StartDeviceAuthorization_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDeviceAuthorization_ResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// StartDeviceAuthorization_Response.ValidateAll() if the designated
+// constraints aren't met.
+type StartDeviceAuthorization_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDeviceAuthorization_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDeviceAuthorization_ResponseMultiError) AllErrors() []error { return m }
+
+// StartDeviceAuthorization_ResponseValidationError is the validation error
+// returned by StartDeviceAuthorization_Response.Validate if the designated
+// constraints aren't met.
+type StartDeviceAuthorization_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDeviceAuthorization_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDeviceAuthorization_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDeviceAuthorization_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDeviceAuthorization_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDeviceAuthorization_ResponseValidationError) ErrorName() string {
+	return "StartDeviceAuthorization_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDeviceAuthorization_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDeviceAuthorization_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDeviceAuthorization_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDeviceAuthorization_ResponseValidationError{}
+
+// Validate checks the field values on GetDeviceAuthorization_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeviceAuthorization_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeviceAuthorization_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDeviceAuthorization_RequestMultiError, or nil if none found.
+func (m *GetDeviceAuthorization_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeviceAuthorization_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserCode
+
+	if len(errors) > 0 {
+		return GetDeviceAuthorization_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeviceAuthorization_RequestMultiError is an error wrapping multiple
+// validation errors returned by GetDeviceAuthorization_Request.ValidateAll()
+// if the designated constraints aren't met.
+type GetDeviceAuthorization_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeviceAuthorization_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeviceAuthorization_RequestMultiError) AllErrors() []error { return m }
+
+// GetDeviceAuthorization_RequestValidationError is the validation error
+// returned by GetDeviceAuthorization_Request.Validate if the designated
+// constraints aren't met.
+type GetDeviceAuthorization_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeviceAuthorization_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeviceAuthorization_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeviceAuthorization_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeviceAuthorization_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeviceAuthorization_RequestValidationError) ErrorName() string {
+	return "GetDeviceAuthorization_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeviceAuthorization_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeviceAuthorization_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeviceAuthorization_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeviceAuthorization_RequestValidationError{}
+
+// Validate checks the field values on GetDeviceAuthorization_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeviceAuthorization_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeviceAuthorization_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDeviceAuthorization_ResponseMultiError, or nil if none found.
+func (m *GetDeviceAuthorization_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeviceAuthorization_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientName
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return GetDeviceAuthorization_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeviceAuthorization_ResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDeviceAuthorization_Response.ValidateAll()
+// if the designated constraints aren't met.
+type GetDeviceAuthorization_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeviceAuthorization_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeviceAuthorization_ResponseMultiError) AllErrors() []error { return m }
+
+// GetDeviceAuthorization_ResponseValidationError is the validation error
+// returned by GetDeviceAuthorization_Response.Validate if the designated
+// constraints aren't met.
+type GetDeviceAuthorization_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeviceAuthorization_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeviceAuthorization_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeviceAuthorization_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeviceAuthorization_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeviceAuthorization_ResponseValidationError) ErrorName() string {
+	return "GetDeviceAuthorization_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeviceAuthorization_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeviceAuthorization_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeviceAuthorization_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeviceAuthorization_ResponseValidationError{}
+
+// Validate checks the field values on AuthorizeDevice_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeDevice_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeDevice_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeDevice_RequestMultiError, or nil if none found.
+func (m *AuthorizeDevice_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeDevice_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserCode
+
+	// no validation rules for Approve
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Otp
+
+	if len(errors) > 0 {
+		return AuthorizeDevice_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeDevice_RequestMultiError is an error wrapping multiple validation
+// errors returned by AuthorizeDevice_Request.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizeDevice_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeDevice_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeDevice_RequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeDevice_RequestValidationError is the validation error returned by
+// AuthorizeDevice_Request.Validate if the designated constraints aren't met.
+type AuthorizeDevice_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeDevice_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeDevice_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeDevice_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeDevice_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeDevice_RequestValidationError) ErrorName() string {
+	return "AuthorizeDevice_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeDevice_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeDevice_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeDevice_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeDevice_RequestValidationError{}
+
+// Validate checks the field values on AuthorizeDevice_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeDevice_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeDevice_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorizeDevice_ResponseMultiError, or nil if none found.
+func (m *AuthorizeDevice_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeDevice_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientName
+
+	// no validation rules for Scope
+
+	if len(errors) > 0 {
+		return AuthorizeDevice_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeDevice_ResponseMultiError is an error wrapping multiple validation
+// errors returned by AuthorizeDevice_Response.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizeDevice_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeDevice_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeDevice_ResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeDevice_ResponseValidationError is the validation error returned by
+// AuthorizeDevice_Response.Validate if the designated constraints aren't met.
+type AuthorizeDevice_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeDevice_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeDevice_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeDevice_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeDevice_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeDevice_ResponseValidationError) ErrorName() string {
+	return "AuthorizeDevice_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeDevice_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeDevice_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeDevice_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeDevice_ResponseValidationError{}
+
+// Validate checks the field values on StartPasskeyLogin_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPasskeyLogin_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasskeyLogin_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasskeyLogin_RequestMultiError, or nil if none found.
+func (m *StartPasskeyLogin_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasskeyLogin_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return StartPasskeyLogin_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasskeyLogin_RequestMultiError is an error wrapping multiple validation
+// errors returned by StartPasskeyLogin_Request.ValidateAll() if the
+// designated constraints aren't met.
+type StartPasskeyLogin_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasskeyLogin_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasskeyLogin_RequestMultiError) AllErrors() []error { return m }
+
+// StartPasskeyLogin_RequestValidationError is the validation error returned by
+// StartPasskeyLogin_Request.Validate if the designated constraints aren't met.
+type StartPasskeyLogin_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasskeyLogin_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasskeyLogin_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasskeyLogin_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasskeyLogin_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasskeyLogin_RequestValidationError) ErrorName() string {
+	return "StartPasskeyLogin_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasskeyLogin_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasskeyLogin_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasskeyLogin_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasskeyLogin_RequestValidationError{}
+
+// Validate checks the field values on StartPasskeyLogin_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPasskeyLogin_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPasskeyLogin_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPasskeyLogin_ResponseMultiError, or nil if none found.
+func (m *StartPasskeyLogin_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPasskeyLogin_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasskeyToken
+
+	// no validation rules for Options
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return StartPasskeyLogin_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPasskeyLogin_ResponseMultiError is an error wrapping multiple
+// validation errors returned by StartPasskeyLogin_Response.ValidateAll() if
+// the designated constraints aren't met.
+type StartPasskeyLogin_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPasskeyLogin_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPasskeyLogin_ResponseMultiError) AllErrors() []error { return m }
+
+// StartPasskeyLogin_ResponseValidationError is the validation error returned
+// by StartPasskeyLogin_Response.Validate if the designated constraints aren't met.
+type StartPasskeyLogin_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPasskeyLogin_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPasskeyLogin_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPasskeyLogin_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPasskeyLogin_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPasskeyLogin_ResponseValidationError) ErrorName() string {
+	return "StartPasskeyLogin_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPasskeyLogin_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPasskeyLogin_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPasskeyLogin_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPasskeyLogin_ResponseValidationError{}
+
+// Validate checks the field values on GetJWKS_Request with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJWKS_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJWKS_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJWKS_RequestMultiError, or nil if none found.
+func (m *GetJWKS_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJWKS_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetJWKS_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJWKS_RequestMultiError is an error wrapping multiple validation errors
+// returned by GetJWKS_Request.ValidateAll() if the designated constraints
+// aren't met.
+type GetJWKS_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJWKS_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJWKS_RequestMultiError) AllErrors() []error { return m }
+
+// GetJWKS_RequestValidationError is the validation error returned by
+// GetJWKS_Request.Validate if the designated constraints aren't met.
+type GetJWKS_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJWKS_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJWKS_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJWKS_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJWKS_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJWKS_RequestValidationError) ErrorName() string { return "GetJWKS_RequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJWKS_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJWKS_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJWKS_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJWKS_RequestValidationError{}
+
+// Validate checks the field values on GetJWKS_Response with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJWKS_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJWKS_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJWKS_ResponseMultiError, or nil if none found.
+func (m *GetJWKS_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJWKS_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJWKS_ResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJWKS_ResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJWKS_ResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJWKS_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJWKS_ResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJWKS_Response.ValidateAll() if the designated constraints
+// aren't met.
+type GetJWKS_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJWKS_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJWKS_ResponseMultiError) AllErrors() []error { return m }
+
+// GetJWKS_ResponseValidationError is the validation error returned by
+// GetJWKS_Response.Validate if the designated constraints aren't met.
+type GetJWKS_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJWKS_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJWKS_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJWKS_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJWKS_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJWKS_ResponseValidationError) ErrorName() string { return "GetJWKS_ResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJWKS_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJWKS_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJWKS_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJWKS_ResponseValidationError{}
+
+// Validate checks the field values on RevokeToken_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeToken_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeToken_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeToken_RequestMultiError, or nil if none found.
+func (m *RevokeToken_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeToken_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	if m.TokenTypeHint != nil {
+		// no validation rules for TokenTypeHint
+	}
+
+	if len(errors) > 0 {
+		return RevokeToken_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeToken_RequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeToken_Request.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeToken_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeToken_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeToken_RequestMultiError) AllErrors() []error { return m }
+
+// RevokeToken_RequestValidationError is the validation error returned by
+// RevokeToken_Request.Validate if the designated constraints aren't met.
+type RevokeToken_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeToken_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeToken_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeToken_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeToken_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeToken_RequestValidationError) ErrorName() string {
+	return "RevokeToken_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeToken_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeToken_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeToken_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeToken_RequestValidationError{}
+
+// Validate checks the field values on RevokeToken_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeToken_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeToken_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeToken_ResponseMultiError, or nil if none found.
+func (m *RevokeToken_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeToken_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeToken_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeToken_ResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeToken_Response.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeToken_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeToken_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeToken_ResponseMultiError) AllErrors() []error { return m }
+
+// RevokeToken_ResponseValidationError is the validation error returned by
+// RevokeToken_Response.Validate if the designated constraints aren't met.
+type RevokeToken_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeToken_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeToken_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeToken_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeToken_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeToken_ResponseValidationError) ErrorName() string {
+	return "RevokeToken_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeToken_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeToken_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeToken_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeToken_ResponseValidationError{}
+
+// Validate checks the field values on IntrospectToken_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntrospectToken_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectToken_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntrospectToken_RequestMultiError, or nil if none found.
+func (m *IntrospectToken_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectToken_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	if m.TokenTypeHint != nil {
+		// no validation rules for TokenTypeHint
+	}
+
+	if len(errors) > 0 {
+		return IntrospectToken_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectToken_RequestMultiError is an error wrapping multiple validation
+// errors returned by IntrospectToken_Request.ValidateAll() if the designated
+// constraints aren't met.
+type IntrospectToken_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectToken_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectToken_RequestMultiError) AllErrors() []error { return m }
+
+// IntrospectToken_RequestValidationError is the validation error returned by
+// IntrospectToken_Request.Validate if the designated constraints aren't met.
+type IntrospectToken_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectToken_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectToken_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectToken_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectToken_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectToken_RequestValidationError) ErrorName() string {
+	return "IntrospectToken_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntrospectToken_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectToken_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectToken_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectToken_RequestValidationError{}
+
+// Validate checks the field values on IntrospectToken_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntrospectToken_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectToken_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntrospectToken_ResponseMultiError, or nil if none found.
+func (m *IntrospectToken_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectToken_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Active
+
+	if m.ClientId != nil {
+		// no validation rules for ClientId
+	}
+
+	if m.TokenType != nil {
+		// no validation rules for TokenType
+	}
+
+	if m.Sub != nil {
+		// no validation rules for Sub
+	}
+
+	if m.Iss != nil {
+		// no validation rules for Iss
+	}
+
+	if m.Jti != nil {
+		// no validation rules for Jti
+	}
+
+	if m.Exp != nil {
+		// no validation rules for Exp
+	}
+
+	if m.Iat != nil {
+		// no validation rules for Iat
+	}
+
+	if m.Scope != nil {
+		// no validation rules for Scope
+	}
+
+	if m.Role != nil {
+		// no validation rules for Role
+	}
+
+	if m.Tenant != nil {
+		// no validation rules for Tenant
+	}
+
+	if len(errors) > 0 {
+		return IntrospectToken_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectToken_ResponseMultiError is an error wrapping multiple validation
+// errors returned by IntrospectToken_Response.ValidateAll() if the designated
+// constraints aren't met.
+type IntrospectToken_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectToken_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectToken_ResponseMultiError) AllErrors() []error { return m }
+
+// IntrospectToken_ResponseValidationError is the validation error returned by
+// IntrospectToken_Response.Validate if the designated constraints aren't met.
+type IntrospectToken_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectToken_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectToken_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectToken_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectToken_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectToken_ResponseValidationError) ErrorName() string {
+	return "IntrospectToken_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntrospectToken_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectToken_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectToken_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectToken_ResponseValidationError{}
+
+// Validate checks the field values on GetOpenIDConfiguration_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOpenIDConfiguration_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOpenIDConfiguration_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetOpenIDConfiguration_RequestMultiError, or nil if none found.
+func (m *GetOpenIDConfiguration_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOpenIDConfiguration_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetOpenIDConfiguration_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOpenIDConfiguration_RequestMultiError is an error wrapping multiple
+// validation errors returned by GetOpenIDConfiguration_Request.ValidateAll()
+// if the designated constraints aren't met.
+type GetOpenIDConfiguration_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOpenIDConfiguration_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOpenIDConfiguration_RequestMultiError) AllErrors() []error { return m }
+
+// GetOpenIDConfiguration_RequestValidationError is the validation error
+// returned by GetOpenIDConfiguration_Request.Validate if the designated
+// constraints aren't met.
+type GetOpenIDConfiguration_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOpenIDConfiguration_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOpenIDConfiguration_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOpenIDConfiguration_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOpenIDConfiguration_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOpenIDConfiguration_RequestValidationError) ErrorName() string {
+	return "GetOpenIDConfiguration_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOpenIDConfiguration_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOpenIDConfiguration_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOpenIDConfiguration_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOpenIDConfiguration_RequestValidationError{}
+
+// Validate checks the field values on GetOpenIDConfiguration_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOpenIDConfiguration_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOpenIDConfiguration_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetOpenIDConfiguration_ResponseMultiError, or nil if none found.
+func (m *GetOpenIDConfiguration_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOpenIDConfiguration_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Issuer
+
+	// no validation rules for AuthorizationEndpoint
+
+	// no validation rules for TokenEndpoint
+
+	// no validation rules for UserinfoEndpoint
+
+	// no validation rules for JwksUri
+
+	// no validation rules for RevocationEndpoint
+
+	// no validation rules for IntrospectionEndpoint
+
+	// no validation rules for DeviceAuthorizationEndpoint
+
+	if len(errors) > 0 {
+		return GetOpenIDConfiguration_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOpenIDConfiguration_ResponseMultiError is an error wrapping multiple
+// validation errors returned by GetOpenIDConfiguration_Response.ValidateAll()
+// if the designated constraints aren't met.
+type GetOpenIDConfiguration_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOpenIDConfiguration_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOpenIDConfiguration_ResponseMultiError) AllErrors() []error { return m }
+
+// GetOpenIDConfiguration_ResponseValidationError is the validation error
+// returned by GetOpenIDConfiguration_Response.Validate if the designated
+// constraints aren't met.
+type GetOpenIDConfiguration_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOpenIDConfiguration_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOpenIDConfiguration_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOpenIDConfiguration_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOpenIDConfiguration_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOpenIDConfiguration_ResponseValidationError) ErrorName() string {
+	return "GetOpenIDConfiguration_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOpenIDConfiguration_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOpenIDConfiguration_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOpenIDConfiguration_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOpenIDConfiguration_ResponseValidationError{}
+
+// Validate checks the field values on GetUserInfo_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserInfo_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserInfo_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserInfo_RequestMultiError, or nil if none found.
+func (m *GetUserInfo_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserInfo_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUserInfo_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserInfo_RequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserInfo_Request.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserInfo_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserInfo_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserInfo_RequestMultiError) AllErrors() []error { return m }
+
+// GetUserInfo_RequestValidationError is the validation error returned by
+// GetUserInfo_Request.Validate if the designated constraints aren't met.
+type GetUserInfo_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserInfo_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserInfo_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserInfo_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserInfo_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserInfo_RequestValidationError) ErrorName() string {
+	return "GetUserInfo_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserInfo_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserInfo_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserInfo_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserInfo_RequestValidationError{}
+
+// Validate checks the field values on GetUserInfo_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserInfo_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserInfo_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserInfo_ResponseMultiError, or nil if none found.
+func (m *GetUserInfo_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserInfo_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sub
+
+	if m.Email != nil {
+		// no validation rules for Email
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.PreferredUsername != nil {
+		// no validation rules for PreferredUsername
+	}
+
+	if m.Role != nil {
+		// no validation rules for Role
+	}
+
+	if m.EmailVerified != nil {
+		// no validation rules for EmailVerified
+	}
+
+	if len(errors) > 0 {
+		return GetUserInfo_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserInfo_ResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserInfo_Response.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserInfo_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserInfo_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserInfo_ResponseMultiError) AllErrors() []error { return m }
+
+// GetUserInfo_ResponseValidationError is the validation error returned by
+// GetUserInfo_Response.Validate if the designated constraints aren't met.
+type GetUserInfo_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserInfo_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserInfo_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserInfo_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserInfo_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserInfo_ResponseValidationError) ErrorName() string {
+	return "GetUserInfo_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserInfo_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserInfo_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserInfo_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserInfo_ResponseValidationError{}
+
+// Validate checks the field values on CreateClient_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClient_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClient_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClient_RequestMultiError, or nil if none found.
+func (m *CreateClient_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClient_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Confidential
+
+	// no validation rules for AccessTokenTtlSeconds
+
+	// no validation rules for RefreshTokenTtlSeconds
+
+	if len(errors) > 0 {
+		return CreateClient_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClient_RequestMultiError is an error wrapping multiple validation
+// errors returned by CreateClient_Request.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClient_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClient_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClient_RequestMultiError) AllErrors() []error { return m }
+
+// CreateClient_RequestValidationError is the validation error returned by
+// CreateClient_Request.Validate if the designated constraints aren't met.
+type CreateClient_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClient_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClient_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClient_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClient_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClient_RequestValidationError) ErrorName() string {
+	return "CreateClient_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClient_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClient_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClient_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClient_RequestValidationError{}
+
+// Validate checks the field values on CreateClient_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClient_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClient_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClient_ResponseMultiError, or nil if none found.
+func (m *CreateClient_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClient_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClient_ResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClient_ResponseValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClient_ResponseValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ClientSecret != nil {
+		// no validation rules for ClientSecret
+	}
+
+	if len(errors) > 0 {
+		return CreateClient_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClient_ResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateClient_Response.ValidateAll() if the designated
+// constraints aren't met.
+type CreateClient_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClient_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClient_ResponseMultiError) AllErrors() []error { return m }
+
+// CreateClient_ResponseValidationError is the validation error returned by
+// CreateClient_Response.Validate if the designated constraints aren't met.
+type CreateClient_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClient_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClient_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClient_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClient_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClient_ResponseValidationError) ErrorName() string {
+	return "CreateClient_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClient_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClient_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClient_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClient_ResponseValidationError{}
+
+// Validate checks the field values on ListClients_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClients_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClients_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClients_RequestMultiError, or nil if none found.
+func (m *ListClients_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClients_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListClients_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClients_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListClients_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListClients_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClients_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClients_RequestMultiError) AllErrors() []error { return m }
+
+// ListClients_RequestValidationError is the validation error returned by
+// ListClients_Request.Validate if the designated constraints aren't met.
+type ListClients_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClients_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClients_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClients_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClients_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClients_RequestValidationError) ErrorName() string {
+	return "ListClients_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClients_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClients_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClients_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClients_RequestValidationError{}
+
+// Validate checks the field values on ListClients_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClients_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClients_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClients_ResponseMultiError, or nil if none found.
+func (m *ListClients_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClients_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClients_ResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClients_ResponseValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClients_ResponseValidationError{
+					field:  fmt.Sprintf("Clients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClients_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClients_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListClients_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListClients_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClients_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClients_ResponseMultiError) AllErrors() []error { return m }
+
+// ListClients_ResponseValidationError is the validation error returned by
+// ListClients_Response.Validate if the designated constraints aren't met.
+type ListClients_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClients_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClients_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClients_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClients_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClients_ResponseValidationError) ErrorName() string {
+	return "ListClients_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClients_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClients_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClients_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClients_ResponseValidationError{}
+
+// Validate checks the field values on RotateClientSecret_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateClientSecret_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateClientSecret_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateClientSecret_RequestMultiError, or nil if none found.
+func (m *RotateClientSecret_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateClientSecret_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	// no validation rules for GracePeriodSeconds
+
+	if len(errors) > 0 {
+		return RotateClientSecret_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateClientSecret_RequestMultiError is an error wrapping multiple
+// validation errors returned by RotateClientSecret_Request.ValidateAll() if
+// the designated constraints aren't met.
+type RotateClientSecret_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateClientSecret_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateClientSecret_RequestMultiError) AllErrors() []error { return m }
+
+// RotateClientSecret_RequestValidationError is the validation error returned
+// by RotateClientSecret_Request.Validate if the designated constraints aren't met.
+type RotateClientSecret_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateClientSecret_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateClientSecret_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateClientSecret_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateClientSecret_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateClientSecret_RequestValidationError) ErrorName() string {
+	return "RotateClientSecret_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateClientSecret_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateClientSecret_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateClientSecret_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateClientSecret_RequestValidationError{}
+
+// Validate checks the field values on RotateClientSecret_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateClientSecret_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateClientSecret_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateClientSecret_ResponseMultiError, or nil if none found.
+func (m *RotateClientSecret_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateClientSecret_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return RotateClientSecret_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateClientSecret_ResponseMultiError is an error wrapping multiple
+// validation errors returned by RotateClientSecret_Response.ValidateAll() if
+// the designated constraints aren't met.
+type RotateClientSecret_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateClientSecret_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateClientSecret_ResponseMultiError) AllErrors() []error { return m }
+
+// RotateClientSecret_ResponseValidationError is the validation error returned
+// by RotateClientSecret_Response.Validate if the designated constraints
+// aren't met.
+type RotateClientSecret_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateClientSecret_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateClientSecret_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateClientSecret_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateClientSecret_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateClientSecret_ResponseValidationError) ErrorName() string {
+	return "RotateClientSecret_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateClientSecret_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateClientSecret_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateClientSecret_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateClientSecret_ResponseValidationError{}
+
+// Validate checks the field values on DisableClient_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableClient_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableClient_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableClient_RequestMultiError, or nil if none found.
+func (m *DisableClient_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableClient_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientId
+
+	if len(errors) > 0 {
+		return DisableClient_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableClient_RequestMultiError is an error wrapping multiple validation
+// errors returned by DisableClient_Request.ValidateAll() if the designated
+// constraints aren't met.
+type DisableClient_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableClient_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableClient_RequestMultiError) AllErrors() []error { return m }
+
+// DisableClient_RequestValidationError is the validation error returned by
+// DisableClient_Request.Validate if the designated constraints aren't met.
+type DisableClient_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableClient_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableClient_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableClient_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableClient_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableClient_RequestValidationError) ErrorName() string {
+	return "DisableClient_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableClient_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableClient_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableClient_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableClient_RequestValidationError{}
+
+// Validate checks the field values on DisableClient_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableClient_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableClient_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableClient_ResponseMultiError, or nil if none found.
+func (m *DisableClient_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableClient_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableClient_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableClient_ResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableClient_Response.ValidateAll() if the designated
+// constraints aren't met.
+type DisableClient_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableClient_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableClient_ResponseMultiError) AllErrors() []error { return m }
+
+// DisableClient_ResponseValidationError is the validation error returned by
+// DisableClient_Response.Validate if the designated constraints aren't met.
+type DisableClient_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableClient_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableClient_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableClient_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableClient_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableClient_ResponseValidationError) ErrorName() string {
+	return "DisableClient_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableClient_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableClient_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableClient_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableClient_ResponseValidationError{}
+
+// Validate checks the field values on EnrollTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTP_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTP_RequestMultiError, or nil if none found.
+func (m *EnrollTOTP_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTP_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MfaToken != nil {
+		// no validation rules for MfaToken
+	}
+
+	if len(errors) > 0 {
+		return EnrollTOTP_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTP_RequestMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTP_Request.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTP_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTP_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTP_RequestMultiError) AllErrors() []error { return m }
+
+// EnrollTOTP_RequestValidationError is the validation error returned by
+// EnrollTOTP_Request.Validate if the designated constraints aren't met.
+type EnrollTOTP_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTP_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTP_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTP_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTP_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTP_RequestValidationError) ErrorName() string {
+	return "EnrollTOTP_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTP_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTP_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTP_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTP_RequestValidationError{}
+
+// Validate checks the field values on EnrollTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTP_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTP_ResponseMultiError, or nil if none found.
+func (m *EnrollTOTP_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTP_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for ProvisioningUri
+
+	// no validation rules for QrPayload
+
+	if len(errors) > 0 {
+		return EnrollTOTP_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTP_ResponseMultiError is an error wrapping multiple validation
+// errors returned by EnrollTOTP_Response.ValidateAll() if the designated
+// constraints aren't met.
+type EnrollTOTP_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTP_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTP_ResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTOTP_ResponseValidationError is the validation error returned by
+// EnrollTOTP_Response.Validate if the designated constraints aren't met.
+type EnrollTOTP_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTP_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTP_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTP_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTP_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTP_ResponseValidationError) ErrorName() string {
+	return "EnrollTOTP_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTP_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTP_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTP_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTP_ResponseValidationError{}
+
+// Validate checks the field values on ConfirmTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTP_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTP_RequestMultiError, or nil if none found.
+func (m *ConfirmTOTP_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTP_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if m.MfaToken != nil {
+		// no validation rules for MfaToken
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTP_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTP_RequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTP_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTP_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTP_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTP_RequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTP_RequestValidationError is the validation error returned by
+// ConfirmTOTP_Request.Validate if the designated constraints aren't met.
+type ConfirmTOTP_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTP_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTP_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTP_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTP_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTP_RequestValidationError) ErrorName() string {
+	return "ConfirmTOTP_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTP_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTP_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTP_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTP_RequestValidationError{}
+
+// Validate checks the field values on ConfirmTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTP_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTP_ResponseMultiError, or nil if none found.
+func (m *ConfirmTOTP_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTP_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTP_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTP_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTP_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTP_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTP_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTP_ResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTP_ResponseValidationError is the validation error returned by
+// ConfirmTOTP_Response.Validate if the designated constraints aren't met.
+type ConfirmTOTP_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTP_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTP_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTP_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTP_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTP_ResponseValidationError) ErrorName() string {
+	return "ConfirmTOTP_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTP_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTP_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTP_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTP_ResponseValidationError{}
+
+// Validate checks the field values on DisableTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTP_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTP_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTP_RequestMultiError, or nil if none found.
+func (m *DisableTOTP_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTP_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DisableTOTP_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTP_RequestMultiError is an error wrapping multiple validation
+// errors returned by DisableTOTP_Request.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTOTP_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTP_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTP_RequestMultiError) AllErrors() []error { return m }
+
+// DisableTOTP_RequestValidationError is the validation error returned by
+// DisableTOTP_Request.Validate if the designated constraints aren't met.
+type DisableTOTP_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTP_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTP_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTP_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTP_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTP_RequestValidationError) ErrorName() string {
+	return "DisableTOTP_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTP_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTP_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTP_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTP_RequestValidationError{}
+
+// Validate checks the field values on DisableTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTP_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTP_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTP_ResponseMultiError, or nil if none found.
+func (m *DisableTOTP_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTP_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTOTP_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTP_ResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableTOTP_Response.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTOTP_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTP_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTP_ResponseMultiError) AllErrors() []error { return m }
+
+// DisableTOTP_ResponseValidationError is the validation error returned by
+// DisableTOTP_Response.Validate if the designated constraints aren't met.
+type DisableTOTP_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTP_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTP_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTP_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTP_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTP_ResponseValidationError) ErrorName() string {
+	return "DisableTOTP_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTP_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTP_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTP_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTP_ResponseValidationError{}
+
+// Validate checks the field values on RegenerateRecoveryCodes_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegenerateRecoveryCodes_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegenerateRecoveryCodes_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodes_RequestMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodes_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegenerateRecoveryCodes_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return RegenerateRecoveryCodes_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes_RequestMultiError is an error wrapping multiple
+// validation errors returned by RegenerateRecoveryCodes_Request.ValidateAll()
+// if the designated constraints aren't met.
+type RegenerateRecoveryCodes_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegenerateRecoveryCodes_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegenerateRecoveryCodes_RequestMultiError) AllErrors() []error { return m }
+
+// RegenerateRecoveryCodes_RequestValidationError is the validation error
+// returned by RegenerateRecoveryCodes_Request.Validate if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodes_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateRecoveryCodes_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateRecoveryCodes_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateRecoveryCodes_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateRecoveryCodes_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateRecoveryCodes_RequestValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodes_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateRecoveryCodes_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateRecoveryCodes_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateRecoveryCodes_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateRecoveryCodes_RequestValidationError{}
+
+// Validate checks the field values on RegenerateRecoveryCodes_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RegenerateRecoveryCodes_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegenerateRecoveryCodes_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodes_ResponseMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodes_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegenerateRecoveryCodes_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RegenerateRecoveryCodes_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes_ResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RegenerateRecoveryCodes_Response.ValidateAll() if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodes_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegenerateRecoveryCodes_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegenerateRecoveryCodes_ResponseMultiError) AllErrors() []error { return m }
+
+// RegenerateRecoveryCodes_ResponseValidationError is the validation error
+// returned by RegenerateRecoveryCodes_Response.Validate if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodes_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateRecoveryCodes_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateRecoveryCodes_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateRecoveryCodes_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateRecoveryCodes_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateRecoveryCodes_ResponseValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodes_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateRecoveryCodes_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateRecoveryCodes_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateRecoveryCodes_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateRecoveryCodes_ResponseValidationError{}
+
+// Validate checks the field values on UnlockAccount_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockAccount_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockAccount_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockAccount_RequestMultiError, or nil if none found.
+func (m *UnlockAccount_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockAccount_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Ip != nil {
+		// no validation rules for Ip
+	}
+
+	if len(errors) > 0 {
+		return UnlockAccount_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockAccount_RequestMultiError is an error wrapping multiple validation
+// errors returned by UnlockAccount_Request.ValidateAll() if the designated
+// constraints aren't met.
+type UnlockAccount_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockAccount_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockAccount_RequestMultiError) AllErrors() []error { return m }
+
+// UnlockAccount_RequestValidationError is the validation error returned by
+// UnlockAccount_Request.Validate if the designated constraints aren't met.
+type UnlockAccount_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockAccount_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockAccount_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockAccount_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockAccount_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockAccount_RequestValidationError) ErrorName() string {
+	return "UnlockAccount_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockAccount_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockAccount_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockAccount_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockAccount_RequestValidationError{}
+
+// Validate checks the field values on UnlockAccount_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockAccount_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockAccount_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockAccount_ResponseMultiError, or nil if none found.
+func (m *UnlockAccount_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockAccount_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockAccount_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockAccount_ResponseMultiError is an error wrapping multiple validation
+// errors returned by UnlockAccount_Response.ValidateAll() if the designated
+// constraints aren't met.
+type UnlockAccount_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockAccount_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockAccount_ResponseMultiError) AllErrors() []error { return m }
+
+// UnlockAccount_ResponseValidationError is the validation error returned by
+// UnlockAccount_Response.Validate if the designated constraints aren't met.
+type UnlockAccount_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockAccount_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockAccount_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockAccount_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockAccount_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockAccount_ResponseValidationError) ErrorName() string {
+	return "UnlockAccount_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockAccount_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockAccount_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockAccount_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockAccount_ResponseValidationError{}
+
+// Validate checks the field values on ListAuthEvents_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthEvents_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthEvents_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthEvents_RequestMultiError, or nil if none found.
+func (m *ListAuthEvents_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthEvents_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuthEvents_RequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuthEvents_RequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuthEvents_RequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuthEvents_RequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuthEvents_RequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuthEvents_RequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.Subject != nil {
+		// no validation rules for Subject
+	}
+
+	if m.ClientId != nil {
+		// no validation rules for ClientId
+	}
+
+	if m.Ip != nil {
+		// no validation rules for Ip
+	}
+
+	if m.Outcome != nil {
+		// no validation rules for Outcome
+	}
+
+	if len(errors) > 0 {
+		return ListAuthEvents_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthEvents_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuthEvents_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuthEvents_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthEvents_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthEvents_RequestMultiError) AllErrors() []error { return m }
+
+// ListAuthEvents_RequestValidationError is the validation error returned by
+// ListAuthEvents_Request.Validate if the designated constraints aren't met.
+type ListAuthEvents_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthEvents_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthEvents_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthEvents_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthEvents_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthEvents_RequestValidationError) ErrorName() string {
+	return "ListAuthEvents_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthEvents_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthEvents_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthEvents_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthEvents_RequestValidationError{}
+
+// Validate checks the field values on ListAuthEvents_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthEvents_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthEvents_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthEvents_ResponseMultiError, or nil if none found.
+func (m *ListAuthEvents_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthEvents_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuthEvents_ResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuthEvents_ResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuthEvents_ResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuthEvents_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthEvents_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuthEvents_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuthEvents_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthEvents_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthEvents_ResponseMultiError) AllErrors() []error { return m }
+
+// ListAuthEvents_ResponseValidationError is the validation error returned by
+// ListAuthEvents_Response.Validate if the designated constraints aren't met.
+type ListAuthEvents_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthEvents_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthEvents_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthEvents_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthEvents_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthEvents_ResponseValidationError) ErrorName() string {
+	return "ListAuthEvents_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthEvents_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthEvents_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthEvents_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthEvents_ResponseValidationError{}
+
+// Validate checks the field values on ListSessions_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessions_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessions_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessions_RequestMultiError, or nil if none found.
+func (m *ListSessions_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessions_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if len(errors) > 0 {
+		return ListSessions_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessions_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessions_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessions_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessions_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessions_RequestMultiError) AllErrors() []error { return m }
+
+// ListSessions_RequestValidationError is the validation error returned by
+// ListSessions_Request.Validate if the designated constraints aren't met.
+type ListSessions_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessions_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessions_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessions_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessions_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessions_RequestValidationError) ErrorName() string {
+	return "ListSessions_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessions_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessions_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessions_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessions_RequestValidationError{}
+
+// Validate checks the field values on ListSessions_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessions_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessions_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessions_ResponseMultiError, or nil if none found.
+func (m *ListSessions_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessions_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessions_ResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessions_ResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessions_ResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessions_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessions_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessions_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessions_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessions_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessions_ResponseMultiError) AllErrors() []error { return m }
+
+// ListSessions_ResponseValidationError is the validation error returned by
+// ListSessions_Response.Validate if the designated constraints aren't met.
+type ListSessions_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessions_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessions_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessions_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessions_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessions_ResponseValidationError) ErrorName() string {
+	return "ListSessions_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessions_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessions_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessions_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessions_ResponseValidationError{}
+
+// Validate checks the field values on RevokeSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSession_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSession_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSession_RequestMultiError, or nil if none found.
+func (m *RevokeSession_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSession_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return RevokeSession_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSession_RequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSession_Request.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSession_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSession_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSession_RequestMultiError) AllErrors() []error { return m }
+
+// RevokeSession_RequestValidationError is the validation error returned by
+// RevokeSession_Request.Validate if the designated constraints aren't met.
+type RevokeSession_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSession_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSession_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSession_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSession_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSession_RequestValidationError) ErrorName() string {
+	return "RevokeSession_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSession_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSession_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSession_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSession_RequestValidationError{}
+
+// Validate checks the field values on RevokeSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSession_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSession_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSession_ResponseMultiError, or nil if none found.
+func (m *RevokeSession_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSession_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSession_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSession_ResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSession_Response.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSession_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSession_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSession_ResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSession_ResponseValidationError is the validation error returned by
+// RevokeSession_Response.Validate if the designated constraints aren't met.
+type RevokeSession_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSession_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSession_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSession_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSession_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSession_ResponseValidationError) ErrorName() string {
+	return "RevokeSession_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSession_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSession_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSession_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSession_ResponseValidationError{}
+
+// Validate checks the field values on RevokeAllSessions_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllSessions_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAllSessions_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllSessions_RequestMultiError, or nil if none found.
+func (m *RevokeAllSessions_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAllSessions_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeepCurrent
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if len(errors) > 0 {
+		return RevokeAllSessions_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAllSessions_RequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAllSessions_Request.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeAllSessions_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAllSessions_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAllSessions_RequestMultiError) AllErrors() []error { return m }
+
+// RevokeAllSessions_RequestValidationError is the validation error returned by
+// RevokeAllSessions_Request.Validate if the designated constraints aren't met.
+type RevokeAllSessions_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAllSessions_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAllSessions_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAllSessions_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAllSessions_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAllSessions_RequestValidationError) ErrorName() string {
+	return "RevokeAllSessions_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAllSessions_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAllSessions_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAllSessions_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAllSessions_RequestValidationError{}
+
+// Validate checks the field values on RevokeAllSessions_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllSessions_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAllSessions_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllSessions_ResponseMultiError, or nil if none found.
+func (m *RevokeAllSessions_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAllSessions_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return RevokeAllSessions_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAllSessions_ResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeAllSessions_Response.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeAllSessions_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAllSessions_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAllSessions_ResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAllSessions_ResponseValidationError is the validation error returned
+// by RevokeAllSessions_Response.Validate if the designated constraints aren't met.
+type RevokeAllSessions_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAllSessions_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAllSessions_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAllSessions_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAllSessions_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAllSessions_ResponseValidationError) ErrorName() string {
+	return "RevokeAllSessions_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAllSessions_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAllSessions_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAllSessions_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAllSessions_ResponseValidationError{}
+
+// Validate checks the field values on RequestPasswordReset_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordReset_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordReset_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordReset_RequestMultiError, or nil if none found.
+func (m *RequestPasswordReset_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordReset_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return RequestPasswordReset_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordReset_RequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordReset_Request.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordReset_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordReset_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordReset_RequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordReset_RequestValidationError is the validation error returned
+// by RequestPasswordReset_Request.Validate if the designated constraints
+// aren't met.
+type RequestPasswordReset_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordReset_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordReset_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordReset_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordReset_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordReset_RequestValidationError) ErrorName() string {
+	return "RequestPasswordReset_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordReset_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordReset_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordReset_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordReset_RequestValidationError{}
+
+// Validate checks the field values on RequestPasswordReset_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordReset_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordReset_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestPasswordReset_ResponseMultiError, or nil if none found.
+func (m *RequestPasswordReset_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordReset_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestPasswordReset_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordReset_ResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordReset_Response.ValidateAll()
+// if the designated constraints aren't met.
+type RequestPasswordReset_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordReset_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordReset_ResponseMultiError) AllErrors() []error { return m }
+
+// RequestPasswordReset_ResponseValidationError is the validation error
+// returned by RequestPasswordReset_Response.Validate if the designated
+// constraints aren't met.
+type RequestPasswordReset_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordReset_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordReset_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordReset_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordReset_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordReset_ResponseValidationError) ErrorName() string {
+	return "RequestPasswordReset_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordReset_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordReset_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordReset_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordReset_ResponseValidationError{}
+
+// Validate checks the field values on ResetPassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPassword_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPassword_RequestMultiError, or nil if none found.
+func (m *ResetPassword_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPassword_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for NewPassword
+
+	if len(errors) > 0 {
+		return ResetPassword_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPassword_RequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPassword_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPassword_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPassword_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPassword_RequestMultiError) AllErrors() []error { return m }
+
+// ResetPassword_RequestValidationError is the validation error returned by
+// ResetPassword_Request.Validate if the designated constraints aren't met.
+type ResetPassword_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPassword_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPassword_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPassword_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPassword_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPassword_RequestValidationError) ErrorName() string {
+	return "ResetPassword_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPassword_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPassword_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPassword_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPassword_RequestValidationError{}
+
+// Validate checks the field values on ResetPassword_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPassword_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPassword_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPassword_ResponseMultiError, or nil if none found.
+func (m *ResetPassword_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPassword_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetPassword_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPassword_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ResetPassword_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPassword_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPassword_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPassword_ResponseMultiError) AllErrors() []error { return m }
+
+// ResetPassword_ResponseValidationError is the validation error returned by
+// ResetPassword_Response.Validate if the designated constraints aren't met.
+type ResetPassword_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPassword_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPassword_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPassword_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPassword_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPassword_ResponseValidationError) ErrorName() string {
+	return "ResetPassword_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPassword_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPassword_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPassword_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPassword_ResponseValidationError{}
+
+// Validate checks the field values on SendVerificationEmail_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendVerificationEmail_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendVerificationEmail_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendVerificationEmail_RequestMultiError, or nil if none found.
+func (m *SendVerificationEmail_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendVerificationEmail_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SendVerificationEmail_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendVerificationEmail_RequestMultiError is an error wrapping multiple
+// validation errors returned by SendVerificationEmail_Request.ValidateAll()
+// if the designated constraints aren't met.
+type SendVerificationEmail_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendVerificationEmail_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendVerificationEmail_RequestMultiError) AllErrors() []error { return m }
+
+// SendVerificationEmail_RequestValidationError is the validation error
+// returned by SendVerificationEmail_Request.Validate if the designated
+// constraints aren't met.
+type SendVerificationEmail_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendVerificationEmail_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendVerificationEmail_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendVerificationEmail_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendVerificationEmail_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendVerificationEmail_RequestValidationError) ErrorName() string {
+	return "SendVerificationEmail_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendVerificationEmail_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendVerificationEmail_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendVerificationEmail_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendVerificationEmail_RequestValidationError{}
+
+// Validate checks the field values on SendVerificationEmail_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendVerificationEmail_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendVerificationEmail_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendVerificationEmail_ResponseMultiError, or nil if none found.
+func (m *SendVerificationEmail_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendVerificationEmail_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SendVerificationEmail_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendVerificationEmail_ResponseMultiError is an error wrapping multiple
+// validation errors returned by SendVerificationEmail_Response.ValidateAll()
+// if the designated constraints aren't met.
+type SendVerificationEmail_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendVerificationEmail_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendVerificationEmail_ResponseMultiError) AllErrors() []error { return m }
+
+// SendVerificationEmail_ResponseValidationError is the validation error
+// returned by SendVerificationEmail_Response.Validate if the designated
+// constraints aren't met.
+type SendVerificationEmail_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendVerificationEmail_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendVerificationEmail_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendVerificationEmail_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendVerificationEmail_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendVerificationEmail_ResponseValidationError) ErrorName() string {
+	return "SendVerificationEmail_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendVerificationEmail_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendVerificationEmail_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendVerificationEmail_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendVerificationEmail_ResponseValidationError{}
+
+// Validate checks the field values on VerifyEmail_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyEmail_RequestMultiError, or nil if none found.
+func (m *VerifyEmail_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return VerifyEmail_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmail_RequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyEmail_Request.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyEmail_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmail_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmail_RequestMultiError) AllErrors() []error { return m }
+
+// VerifyEmail_RequestValidationError is the validation error returned by
+// VerifyEmail_Request.Validate if the designated constraints aren't met.
+type VerifyEmail_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmail_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmail_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmail_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmail_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmail_RequestValidationError) ErrorName() string {
+	return "VerifyEmail_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyEmail_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmail_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmail_RequestValidationError{}
+
+// Validate checks the field values on VerifyEmail_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyEmail_ResponseMultiError, or nil if none found.
+func (m *VerifyEmail_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyEmail_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmail_ResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyEmail_Response.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyEmail_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmail_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmail_ResponseMultiError) AllErrors() []error { return m }
+
+// VerifyEmail_ResponseValidationError is the validation error returned by
+// VerifyEmail_Response.Validate if the designated constraints aren't met.
+type VerifyEmail_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmail_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmail_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmail_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmail_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmail_ResponseValidationError) ErrorName() string {
+	return "VerifyEmail_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyEmail_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmail_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmail_ResponseValidationError{}
+
+// Validate checks the field values on ListIdentityProviders_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentityProviders_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIdentityProviders_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIdentityProviders_RequestMultiError, or nil if none found.
+func (m *ListIdentityProviders_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIdentityProviders_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListIdentityProviders_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIdentityProviders_RequestMultiError is an error wrapping multiple
+// validation errors returned by ListIdentityProviders_Request.ValidateAll()
+// if the designated constraints aren't met.
+type ListIdentityProviders_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIdentityProviders_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIdentityProviders_RequestMultiError) AllErrors() []error { return m }
+
+// ListIdentityProviders_RequestValidationError is the validation error
+// returned by ListIdentityProviders_Request.Validate if the designated
+// constraints aren't met.
+type ListIdentityProviders_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIdentityProviders_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIdentityProviders_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIdentityProviders_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIdentityProviders_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIdentityProviders_RequestValidationError) ErrorName() string {
+	return "ListIdentityProviders_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIdentityProviders_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIdentityProviders_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIdentityProviders_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIdentityProviders_RequestValidationError{}
+
+// Validate checks the field values on ListIdentityProviders_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentityProviders_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIdentityProviders_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIdentityProviders_ResponseMultiError, or nil if none found.
+func (m *ListIdentityProviders_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIdentityProviders_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProviders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIdentityProviders_ResponseValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIdentityProviders_ResponseValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIdentityProviders_ResponseValidationError{
+					field:  fmt.Sprintf("Providers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIdentityProviders_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIdentityProviders_ResponseMultiError is an error wrapping multiple
+// validation errors returned by ListIdentityProviders_Response.ValidateAll()
+// if the designated constraints aren't met.
+type ListIdentityProviders_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIdentityProviders_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIdentityProviders_ResponseMultiError) AllErrors() []error { return m }
+
+// ListIdentityProviders_ResponseValidationError is the validation error
+// returned by ListIdentityProviders_Response.Validate if the designated
+// constraints aren't met.
+type ListIdentityProviders_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIdentityProviders_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIdentityProviders_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIdentityProviders_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIdentityProviders_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIdentityProviders_ResponseValidationError) ErrorName() string {
+	return "ListIdentityProviders_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIdentityProviders_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIdentityProviders_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIdentityProviders_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIdentityProviders_ResponseValidationError{}
+
+// Validate checks the field values on StartFederation_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartFederation_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartFederation_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartFederation_RequestMultiError, or nil if none found.
+func (m *StartFederation_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartFederation_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	// no validation rules for ClientId
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for ResponseType
+
+	// no validation rules for Scope
+
+	// no validation rules for State
+
+	// no validation rules for Nonce
+
+	// no validation rules for CodeChallenge
+
+	// no validation rules for CodeChallengeMethod
+
+	if len(errors) > 0 {
+		return StartFederation_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartFederation_RequestMultiError is an error wrapping multiple validation
+// errors returned by StartFederation_Request.ValidateAll() if the designated
+// constraints aren't met.
+type StartFederation_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartFederation_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartFederation_RequestMultiError) AllErrors() []error { return m }
+
+// StartFederation_RequestValidationError is the validation error returned by
+// StartFederation_Request.Validate if the designated constraints aren't met.
+type StartFederation_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartFederation_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartFederation_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartFederation_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartFederation_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartFederation_RequestValidationError) ErrorName() string {
+	return "StartFederation_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartFederation_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartFederation_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartFederation_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartFederation_RequestValidationError{}
+
+// Validate checks the field values on StartFederation_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartFederation_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartFederation_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartFederation_ResponseMultiError, or nil if none found.
+func (m *StartFederation_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartFederation_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthorizationUrl
+
+	if len(errors) > 0 {
+		return StartFederation_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartFederation_ResponseMultiError is an error wrapping multiple validation
+// errors returned by StartFederation_Response.ValidateAll() if the designated
+// constraints aren't met.
+type StartFederation_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartFederation_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartFederation_ResponseMultiError) AllErrors() []error { return m }
+
+// StartFederation_ResponseValidationError is the validation error returned by
+// StartFederation_Response.Validate if the designated constraints aren't met.
+type StartFederation_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartFederation_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartFederation_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartFederation_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartFederation_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartFederation_ResponseValidationError) ErrorName() string {
+	return "StartFederation_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartFederation_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartFederation_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartFederation_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartFederation_ResponseValidationError{}
+
+// Validate checks the field values on CompleteFederation_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteFederation_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteFederation_Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteFederation_RequestMultiError, or nil if none found.
+func (m *CompleteFederation_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteFederation_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return CompleteFederation_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteFederation_RequestMultiError is an error wrapping multiple
+// validation errors returned by CompleteFederation_Request.ValidateAll() if
+// the designated constraints aren't met.
+type CompleteFederation_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteFederation_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteFederation_RequestMultiError) AllErrors() []error { return m }
+
+// CompleteFederation_RequestValidationError is the validation error returned
+// by CompleteFederation_Request.Validate if the designated constraints aren't met.
+type CompleteFederation_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteFederation_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteFederation_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteFederation_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteFederation_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteFederation_RequestValidationError) ErrorName() string {
+	return "CompleteFederation_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteFederation_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteFederation_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteFederation_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteFederation_RequestValidationError{}
+
+// Validate checks the field values on CompleteFederation_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteFederation_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteFederation_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteFederation_ResponseMultiError, or nil if none found.
+func (m *CompleteFederation_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteFederation_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for RedirectUri
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return CompleteFederation_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteFederation_ResponseMultiError is an error wrapping multiple
+// validation errors returned by CompleteFederation_Response.ValidateAll() if
+// the designated constraints aren't met.
+type CompleteFederation_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteFederation_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteFederation_ResponseMultiError) AllErrors() []error { return m }
+
+// CompleteFederation_ResponseValidationError is the validation error returned
+// by CompleteFederation_Response.Validate if the designated constraints
+// aren't met.
+type CompleteFederation_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteFederation_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteFederation_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteFederation_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteFederation_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteFederation_ResponseValidationError) ErrorName() string {
+	return "CompleteFederation_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteFederation_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteFederation_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteFederation_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteFederation_ResponseValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistration_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BeginPasskeyRegistration_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistration_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistration_RequestMultiError, or nil if none found.
+func (m *BeginPasskeyRegistration_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistration_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistration_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistration_RequestMultiError is an error wrapping multiple
+// validation errors returned by
+// BeginPasskeyRegistration_Request.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyRegistration_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistration_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistration_RequestMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistration_RequestValidationError is the validation error
+// returned by BeginPasskeyRegistration_Request.Validate if the designated
+// constraints aren't met.
+type BeginPasskeyRegistration_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistration_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistration_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistration_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistration_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistration_RequestValidationError) ErrorName() string {
+	return "BeginPasskeyRegistration_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistration_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistration_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistration_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistration_RequestValidationError{}
+
+// Validate checks the field values on BeginPasskeyRegistration_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BeginPasskeyRegistration_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BeginPasskeyRegistration_Response
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// BeginPasskeyRegistration_ResponseMultiError, or nil if none found.
+func (m *BeginPasskeyRegistration_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BeginPasskeyRegistration_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasskeyToken
+
+	// no validation rules for Options
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return BeginPasskeyRegistration_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistration_ResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BeginPasskeyRegistration_Response.ValidateAll() if the designated
+// constraints aren't met.
+type BeginPasskeyRegistration_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BeginPasskeyRegistration_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BeginPasskeyRegistration_ResponseMultiError) AllErrors() []error { return m }
+
+// BeginPasskeyRegistration_ResponseValidationError is the validation error
+// returned by BeginPasskeyRegistration_Response.Validate if the designated
+// constraints aren't met.
+type BeginPasskeyRegistration_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginPasskeyRegistration_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginPasskeyRegistration_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginPasskeyRegistration_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginPasskeyRegistration_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginPasskeyRegistration_ResponseValidationError) ErrorName() string {
+	return "BeginPasskeyRegistration_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginPasskeyRegistration_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginPasskeyRegistration_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginPasskeyRegistration_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginPasskeyRegistration_ResponseValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistration_Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FinishPasskeyRegistration_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistration_Request
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistration_RequestMultiError, or nil if none found.
+func (m *FinishPasskeyRegistration_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistration_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasskeyToken
+
+	// no validation rules for Credential
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistration_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistration_RequestMultiError is an error wrapping multiple
+// validation errors returned by
+// FinishPasskeyRegistration_Request.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPasskeyRegistration_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistration_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistration_RequestMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistration_RequestValidationError is the validation error
+// returned by FinishPasskeyRegistration_Request.Validate if the designated
+// constraints aren't met.
+type FinishPasskeyRegistration_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistration_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistration_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistration_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistration_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistration_RequestValidationError) ErrorName() string {
+	return "FinishPasskeyRegistration_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistration_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistration_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistration_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistration_RequestValidationError{}
+
+// Validate checks the field values on FinishPasskeyRegistration_Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *FinishPasskeyRegistration_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishPasskeyRegistration_Response
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// FinishPasskeyRegistration_ResponseMultiError, or nil if none found.
+func (m *FinishPasskeyRegistration_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishPasskeyRegistration_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPasskey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishPasskeyRegistration_ResponseValidationError{
+					field:  "Passkey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishPasskeyRegistration_ResponseValidationError{
+					field:  "Passkey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasskey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishPasskeyRegistration_ResponseValidationError{
+				field:  "Passkey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishPasskeyRegistration_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishPasskeyRegistration_ResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// FinishPasskeyRegistration_Response.ValidateAll() if the designated
+// constraints aren't met.
+type FinishPasskeyRegistration_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishPasskeyRegistration_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishPasskeyRegistration_ResponseMultiError) AllErrors() []error { return m }
+
+// FinishPasskeyRegistration_ResponseValidationError is the validation error
+// returned by FinishPasskeyRegistration_Response.Validate if the designated
+// constraints aren't met.
+type FinishPasskeyRegistration_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishPasskeyRegistration_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishPasskeyRegistration_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishPasskeyRegistration_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishPasskeyRegistration_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishPasskeyRegistration_ResponseValidationError) ErrorName() string {
+	return "FinishPasskeyRegistration_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishPasskeyRegistration_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishPasskeyRegistration_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishPasskeyRegistration_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishPasskeyRegistration_ResponseValidationError{}
+
+// Validate checks the field values on CreateAPIKey_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKey_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKey_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKey_RequestMultiError, or nil if none found.
+func (m *CreateAPIKey_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKey_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ClientId
+
+	// no validation rules for TtlSeconds
+
+	// no validation rules for OwnedByClient
+
+	if len(errors) > 0 {
+		return CreateAPIKey_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKey_RequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKey_Request.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKey_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKey_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKey_RequestMultiError) AllErrors() []error { return m }
+
+// CreateAPIKey_RequestValidationError is the validation error returned by
+// CreateAPIKey_Request.Validate if the designated constraints aren't met.
+type CreateAPIKey_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKey_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKey_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKey_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKey_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKey_RequestValidationError) ErrorName() string {
+	return "CreateAPIKey_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKey_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKey_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKey_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKey_RequestValidationError{}
+
+// Validate checks the field values on CreateAPIKey_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKey_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKey_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKey_ResponseMultiError, or nil if none found.
+func (m *CreateAPIKey_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKey_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKey_ResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKey_ResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKey_ResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ApiKey
+
+	if len(errors) > 0 {
+		return CreateAPIKey_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKey_ResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKey_Response.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKey_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKey_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKey_ResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKey_ResponseValidationError is the validation error returned by
+// CreateAPIKey_Response.Validate if the designated constraints aren't met.
+type CreateAPIKey_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKey_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKey_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKey_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKey_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKey_ResponseValidationError) ErrorName() string {
+	return "CreateAPIKey_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKey_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKey_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKey_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKey_ResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeys_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeys_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeys_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeys_RequestMultiError, or nil if none found.
+func (m *ListAPIKeys_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeys_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.ClientId != nil {
+		// no validation rules for ClientId
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeys_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeys_RequestMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeys_Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIKeys_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeys_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeys_RequestMultiError) AllErrors() []error { return m }
+
+// ListAPIKeys_RequestValidationError is the validation error returned by
+// ListAPIKeys_Request.Validate if the designated constraints aren't met.
+type ListAPIKeys_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeys_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeys_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeys_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeys_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeys_RequestValidationError) ErrorName() string {
+	return "ListAPIKeys_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeys_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeys_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeys_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeys_RequestValidationError{}
+
+// Validate checks the field values on ListAPIKeys_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeys_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeys_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeys_ResponseMultiError, or nil if none found.
+func (m *ListAPIKeys_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeys_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJWKS_ResponseValidationError{
+					errors = append(errors, ListAPIKeys_ResponseValidationError{
 						field:  fmt.Sprintf("Keys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -897,7 +13205,7 @@ func (m *GetJWKS_Response) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJWKS_ResponseValidationError{
+					errors = append(errors, ListAPIKeys_ResponseValidationError{
 						field:  fmt.Sprintf("Keys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -906,7 +13214,7 @@ func (m *GetJWKS_Response) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetJWKS_ResponseValidationError{
+				return ListAPIKeys_ResponseValidationError{
 					field:  fmt.Sprintf("Keys[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -917,19 +13225,19 @@ func (m *GetJWKS_Response) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJWKS_ResponseMultiError(errors)
+		return ListAPIKeys_ResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJWKS_ResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJWKS_Response.ValidateAll() if the designated constraints
-// aren't met.
-type GetJWKS_ResponseMultiError []error
+// ListAPIKeys_ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeys_Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIKeys_ResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJWKS_ResponseMultiError) Error() string {
+func (m ListAPIKeys_ResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -938,11 +13246,11 @@ func (m GetJWKS_ResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJWKS_ResponseMultiError) AllErrors() []error { return m }
+func (m ListAPIKeys_ResponseMultiError) AllErrors() []error { return m }
 
-// GetJWKS_ResponseValidationError is the validation error returned by
-// GetJWKS_Response.Validate if the designated constraints aren't met.
-type GetJWKS_ResponseValidationError struct {
+// ListAPIKeys_ResponseValidationError is the validation error returned by
+// ListAPIKeys_Response.Validate if the designated constraints aren't met.
+type ListAPIKeys_ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -950,22 +13258,24 @@ type GetJWKS_ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJWKS_ResponseValidationError) Field() string { return e.field }
+func (e ListAPIKeys_ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJWKS_ResponseValidationError) Reason() string { return e.reason }
+func (e ListAPIKeys_ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJWKS_ResponseValidationError) Cause() error { return e.cause }
+func (e ListAPIKeys_ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJWKS_ResponseValidationError) Key() bool { return e.key }
+func (e ListAPIKeys_ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJWKS_ResponseValidationError) ErrorName() string { return "GetJWKS_ResponseValidationError" }
+func (e ListAPIKeys_ResponseValidationError) ErrorName() string {
+	return "ListAPIKeys_ResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJWKS_ResponseValidationError) Error() string {
+func (e ListAPIKeys_ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -977,14 +13287,14 @@ func (e GetJWKS_ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJWKS_Response.%s: %s%s",
+		"invalid %sListAPIKeys_Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJWKS_ResponseValidationError{}
+var _ error = ListAPIKeys_ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -992,4 +13302,210 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJWKS_ResponseValidationError{}
+} = ListAPIKeys_ResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKey_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKey_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKey_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKey_RequestMultiError, or nil if none found.
+func (m *RevokeAPIKey_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKey_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKeyId
+
+	if len(errors) > 0 {
+		return RevokeAPIKey_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKey_RequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKey_Request.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKey_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKey_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKey_RequestMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKey_RequestValidationError is the validation error returned by
+// RevokeAPIKey_Request.Validate if the designated constraints aren't met.
+type RevokeAPIKey_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKey_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKey_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKey_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKey_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKey_RequestValidationError) ErrorName() string {
+	return "RevokeAPIKey_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKey_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKey_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKey_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKey_RequestValidationError{}
+
+// Validate checks the field values on RevokeAPIKey_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKey_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKey_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKey_ResponseMultiError, or nil if none found.
+func (m *RevokeAPIKey_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKey_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKey_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKey_ResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKey_Response.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKey_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKey_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKey_ResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKey_ResponseValidationError is the validation error returned by
+// RevokeAPIKey_Response.Validate if the designated constraints aren't met.
+type RevokeAPIKey_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKey_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKey_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKey_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKey_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKey_ResponseValidationError) ErrorName() string {
+	return "RevokeAPIKey_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKey_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKey_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKey_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKey_ResponseValidationError{}
@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SSOService_GetToken_FullMethodName = "/sso.v1.SSOService/GetToken"
-	SSOService_GetJWKS_FullMethodName  = "/sso.v1.SSOService/GetJWKS"
+	SSOService_GetToken_FullMethodName                 = "/sso.v1.SSOService/GetToken"
+	SSOService_Authorize_FullMethodName                = "/sso.v1.SSOService/Authorize"
+	SSOService_StartPasswordless_FullMethodName        = "/sso.v1.SSOService/StartPasswordless"
+	SSOService_StartDeviceAuthorization_FullMethodName = "/sso.v1.SSOService/StartDeviceAuthorization"
+	SSOService_GetDeviceAuthorization_FullMethodName   = "/sso.v1.SSOService/GetDeviceAuthorization"
+	SSOService_AuthorizeDevice_FullMethodName          = "/sso.v1.SSOService/AuthorizeDevice"
+	SSOService_StartPasskeyLogin_FullMethodName        = "/sso.v1.SSOService/StartPasskeyLogin"
+	SSOService_GetJWKS_FullMethodName                  = "/sso.v1.SSOService/GetJWKS"
+	SSOService_GetOpenIDConfiguration_FullMethodName   = "/sso.v1.SSOService/GetOpenIDConfiguration"
+	SSOService_GetUserInfo_FullMethodName              = "/sso.v1.SSOService/GetUserInfo"
+	SSOService_RevokeToken_FullMethodName              = "/sso.v1.SSOService/RevokeToken"
+	SSOService_IntrospectToken_FullMethodName          = "/sso.v1.SSOService/IntrospectToken"
 )
 
 // SSOServiceClient is the client API for SSOService service.
@@ -28,7 +38,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SSOServiceClient interface {
 	GetToken(ctx context.Context, in *GetToken_Request, opts ...grpc.CallOption) (*GetToken_Response, error)
+	Authorize(ctx context.Context, in *Authorize_Request, opts ...grpc.CallOption) (*Authorize_Response, error)
+	StartPasswordless(ctx context.Context, in *StartPasswordless_Request, opts ...grpc.CallOption) (*StartPasswordless_Response, error)
+	StartDeviceAuthorization(ctx context.Context, in *StartDeviceAuthorization_Request, opts ...grpc.CallOption) (*StartDeviceAuthorization_Response, error)
+	GetDeviceAuthorization(ctx context.Context, in *GetDeviceAuthorization_Request, opts ...grpc.CallOption) (*GetDeviceAuthorization_Response, error)
+	AuthorizeDevice(ctx context.Context, in *AuthorizeDevice_Request, opts ...grpc.CallOption) (*AuthorizeDevice_Response, error)
+	StartPasskeyLogin(ctx context.Context, in *StartPasskeyLogin_Request, opts ...grpc.CallOption) (*StartPasskeyLogin_Response, error)
 	GetJWKS(ctx context.Context, in *GetJWKS_Request, opts ...grpc.CallOption) (*GetJWKS_Response, error)
+	GetOpenIDConfiguration(ctx context.Context, in *GetOpenIDConfiguration_Request, opts ...grpc.CallOption) (*GetOpenIDConfiguration_Response, error)
+	GetUserInfo(ctx context.Context, in *GetUserInfo_Request, opts ...grpc.CallOption) (*GetUserInfo_Response, error)
+	RevokeToken(ctx context.Context, in *RevokeToken_Request, opts ...grpc.CallOption) (*RevokeToken_Response, error)
+	IntrospectToken(ctx context.Context, in *IntrospectToken_Request, opts ...grpc.CallOption) (*IntrospectToken_Response, error)
 }
 
 type sSOServiceClient struct {
@@ -49,6 +69,66 @@ func (c *sSOServiceClient) GetToken(ctx context.Context, in *GetToken_Request, o
 	return out, nil
 }
 
+func (c *sSOServiceClient) Authorize(ctx context.Context, in *Authorize_Request, opts ...grpc.CallOption) (*Authorize_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Authorize_Response)
+	err := c.cc.Invoke(ctx, SSOService_Authorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) StartPasswordless(ctx context.Context, in *StartPasswordless_Request, opts ...grpc.CallOption) (*StartPasswordless_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartPasswordless_Response)
+	err := c.cc.Invoke(ctx, SSOService_StartPasswordless_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) StartDeviceAuthorization(ctx context.Context, in *StartDeviceAuthorization_Request, opts ...grpc.CallOption) (*StartDeviceAuthorization_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartDeviceAuthorization_Response)
+	err := c.cc.Invoke(ctx, SSOService_StartDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) GetDeviceAuthorization(ctx context.Context, in *GetDeviceAuthorization_Request, opts ...grpc.CallOption) (*GetDeviceAuthorization_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeviceAuthorization_Response)
+	err := c.cc.Invoke(ctx, SSOService_GetDeviceAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) AuthorizeDevice(ctx context.Context, in *AuthorizeDevice_Request, opts ...grpc.CallOption) (*AuthorizeDevice_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeDevice_Response)
+	err := c.cc.Invoke(ctx, SSOService_AuthorizeDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) StartPasskeyLogin(ctx context.Context, in *StartPasskeyLogin_Request, opts ...grpc.CallOption) (*StartPasskeyLogin_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartPasskeyLogin_Response)
+	err := c.cc.Invoke(ctx, SSOService_StartPasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOServiceClient) GetJWKS(ctx context.Context, in *GetJWKS_Request, opts ...grpc.CallOption) (*GetJWKS_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetJWKS_Response)
@@ -59,12 +139,62 @@ func (c *sSOServiceClient) GetJWKS(ctx context.Context, in *GetJWKS_Request, opt
 	return out, nil
 }
 
+func (c *sSOServiceClient) GetOpenIDConfiguration(ctx context.Context, in *GetOpenIDConfiguration_Request, opts ...grpc.CallOption) (*GetOpenIDConfiguration_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpenIDConfiguration_Response)
+	err := c.cc.Invoke(ctx, SSOService_GetOpenIDConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfo_Request, opts ...grpc.CallOption) (*GetUserInfo_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserInfo_Response)
+	err := c.cc.Invoke(ctx, SSOService_GetUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) RevokeToken(ctx context.Context, in *RevokeToken_Request, opts ...grpc.CallOption) (*RevokeToken_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeToken_Response)
+	err := c.cc.Invoke(ctx, SSOService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOServiceClient) IntrospectToken(ctx context.Context, in *IntrospectToken_Request, opts ...grpc.CallOption) (*IntrospectToken_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectToken_Response)
+	err := c.cc.Invoke(ctx, SSOService_IntrospectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SSOServiceServer is the server API for SSOService service.
 // All implementations must embed UnimplementedSSOServiceServer
 // for forward compatibility.
 type SSOServiceServer interface {
 	GetToken(context.Context, *GetToken_Request) (*GetToken_Response, error)
+	Authorize(context.Context, *Authorize_Request) (*Authorize_Response, error)
+	StartPasswordless(context.Context, *StartPasswordless_Request) (*StartPasswordless_Response, error)
+	StartDeviceAuthorization(context.Context, *StartDeviceAuthorization_Request) (*StartDeviceAuthorization_Response, error)
+	GetDeviceAuthorization(context.Context, *GetDeviceAuthorization_Request) (*GetDeviceAuthorization_Response, error)
+	AuthorizeDevice(context.Context, *AuthorizeDevice_Request) (*AuthorizeDevice_Response, error)
+	StartPasskeyLogin(context.Context, *StartPasskeyLogin_Request) (*StartPasskeyLogin_Response, error)
 	GetJWKS(context.Context, *GetJWKS_Request) (*GetJWKS_Response, error)
+	GetOpenIDConfiguration(context.Context, *GetOpenIDConfiguration_Request) (*GetOpenIDConfiguration_Response, error)
+	GetUserInfo(context.Context, *GetUserInfo_Request) (*GetUserInfo_Response, error)
+	RevokeToken(context.Context, *RevokeToken_Request) (*RevokeToken_Response, error)
+	IntrospectToken(context.Context, *IntrospectToken_Request) (*IntrospectToken_Response, error)
 	mustEmbedUnimplementedSSOServiceServer()
 }
 
@@ -78,9 +208,39 @@ type UnimplementedSSOServiceServer struct{}
 func (UnimplementedSSOServiceServer) GetToken(context.Context, *GetToken_Request) (*GetToken_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetToken not implemented")
 }
+func (UnimplementedSSOServiceServer) Authorize(context.Context, *Authorize_Request) (*Authorize_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedSSOServiceServer) StartPasswordless(context.Context, *StartPasswordless_Request) (*StartPasswordless_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPasswordless not implemented")
+}
+func (UnimplementedSSOServiceServer) StartDeviceAuthorization(context.Context, *StartDeviceAuthorization_Request) (*StartDeviceAuthorization_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartDeviceAuthorization not implemented")
+}
+func (UnimplementedSSOServiceServer) GetDeviceAuthorization(context.Context, *GetDeviceAuthorization_Request) (*GetDeviceAuthorization_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDeviceAuthorization not implemented")
+}
+func (UnimplementedSSOServiceServer) AuthorizeDevice(context.Context, *AuthorizeDevice_Request) (*AuthorizeDevice_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizeDevice not implemented")
+}
+func (UnimplementedSSOServiceServer) StartPasskeyLogin(context.Context, *StartPasskeyLogin_Request) (*StartPasskeyLogin_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartPasskeyLogin not implemented")
+}
 func (UnimplementedSSOServiceServer) GetJWKS(context.Context, *GetJWKS_Request) (*GetJWKS_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetJWKS not implemented")
 }
+func (UnimplementedSSOServiceServer) GetOpenIDConfiguration(context.Context, *GetOpenIDConfiguration_Request) (*GetOpenIDConfiguration_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOpenIDConfiguration not implemented")
+}
+func (UnimplementedSSOServiceServer) GetUserInfo(context.Context, *GetUserInfo_Request) (*GetUserInfo_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserInfo not implemented")
+}
+func (UnimplementedSSOServiceServer) RevokeToken(context.Context, *RevokeToken_Request) (*RevokeToken_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (UnimplementedSSOServiceServer) IntrospectToken(context.Context, *IntrospectToken_Request) (*IntrospectToken_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method IntrospectToken not implemented")
+}
 func (UnimplementedSSOServiceServer) mustEmbedUnimplementedSSOServiceServer() {}
 func (UnimplementedSSOServiceServer) testEmbeddedByValue()                    {}
 
@@ -120,6 +280,114 @@ func _SSOService_GetToken_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Authorize_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_Authorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).Authorize(ctx, req.(*Authorize_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_StartPasswordless_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPasswordless_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).StartPasswordless(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_StartPasswordless_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).StartPasswordless(ctx, req.(*StartPasswordless_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_StartDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDeviceAuthorization_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).StartDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_StartDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).StartDeviceAuthorization(ctx, req.(*StartDeviceAuthorization_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_GetDeviceAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceAuthorization_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).GetDeviceAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_GetDeviceAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).GetDeviceAuthorization(ctx, req.(*GetDeviceAuthorization_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_AuthorizeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeDevice_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).AuthorizeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_AuthorizeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).AuthorizeDevice(ctx, req.(*AuthorizeDevice_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_StartPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPasskeyLogin_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).StartPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_StartPasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).StartPasskeyLogin(ctx, req.(*StartPasskeyLogin_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetJWKS_Request)
 	if err := dec(in); err != nil {
@@ -138,6 +406,78 @@ func _SSOService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOService_GetOpenIDConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenIDConfiguration_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).GetOpenIDConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_GetOpenIDConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).GetOpenIDConfiguration(ctx, req.(*GetOpenIDConfiguration_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfo_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).GetUserInfo(ctx, req.(*GetUserInfo_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeToken_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).RevokeToken(ctx, req.(*RevokeToken_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOService_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectToken_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOServiceServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOService_IntrospectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOServiceServer).IntrospectToken(ctx, req.(*IntrospectToken_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SSOService_ServiceDesc is the grpc.ServiceDesc for SSOService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -149,10 +489,1512 @@ var SSOService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetToken",
 			Handler:    _SSOService_GetToken_Handler,
 		},
+		{
+			MethodName: "Authorize",
+			Handler:    _SSOService_Authorize_Handler,
+		},
+		{
+			MethodName: "StartPasswordless",
+			Handler:    _SSOService_StartPasswordless_Handler,
+		},
+		{
+			MethodName: "StartDeviceAuthorization",
+			Handler:    _SSOService_StartDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "GetDeviceAuthorization",
+			Handler:    _SSOService_GetDeviceAuthorization_Handler,
+		},
+		{
+			MethodName: "AuthorizeDevice",
+			Handler:    _SSOService_AuthorizeDevice_Handler,
+		},
+		{
+			MethodName: "StartPasskeyLogin",
+			Handler:    _SSOService_StartPasskeyLogin_Handler,
+		},
 		{
 			MethodName: "GetJWKS",
 			Handler:    _SSOService_GetJWKS_Handler,
 		},
+		{
+			MethodName: "GetOpenIDConfiguration",
+			Handler:    _SSOService_GetOpenIDConfiguration_Handler,
+		},
+		{
+			MethodName: "GetUserInfo",
+			Handler:    _SSOService_GetUserInfo_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _SSOService_RevokeToken_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _SSOService_IntrospectToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	ClientService_CreateClient_FullMethodName       = "/sso.v1.ClientService/CreateClient"
+	ClientService_ListClients_FullMethodName        = "/sso.v1.ClientService/ListClients"
+	ClientService_RotateClientSecret_FullMethodName = "/sso.v1.ClientService/RotateClientSecret"
+	ClientService_DisableClient_FullMethodName      = "/sso.v1.ClientService/DisableClient"
+)
+
+// ClientServiceClient is the client API for ClientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClientServiceClient interface {
+	CreateClient(ctx context.Context, in *CreateClient_Request, opts ...grpc.CallOption) (*CreateClient_Response, error)
+	ListClients(ctx context.Context, in *ListClients_Request, opts ...grpc.CallOption) (*ListClients_Response, error)
+	RotateClientSecret(ctx context.Context, in *RotateClientSecret_Request, opts ...grpc.CallOption) (*RotateClientSecret_Response, error)
+	DisableClient(ctx context.Context, in *DisableClient_Request, opts ...grpc.CallOption) (*DisableClient_Response, error)
+}
+
+type clientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientServiceClient(cc grpc.ClientConnInterface) ClientServiceClient {
+	return &clientServiceClient{cc}
+}
+
+func (c *clientServiceClient) CreateClient(ctx context.Context, in *CreateClient_Request, opts ...grpc.CallOption) (*CreateClient_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClient_Response)
+	err := c.cc.Invoke(ctx, ClientService_CreateClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) ListClients(ctx context.Context, in *ListClients_Request, opts ...grpc.CallOption) (*ListClients_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClients_Response)
+	err := c.cc.Invoke(ctx, ClientService_ListClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) RotateClientSecret(ctx context.Context, in *RotateClientSecret_Request, opts ...grpc.CallOption) (*RotateClientSecret_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateClientSecret_Response)
+	err := c.cc.Invoke(ctx, ClientService_RotateClientSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) DisableClient(ctx context.Context, in *DisableClient_Request, opts ...grpc.CallOption) (*DisableClient_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableClient_Response)
+	err := c.cc.Invoke(ctx, ClientService_DisableClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientServiceServer is the server API for ClientService service.
+// All implementations must embed UnimplementedClientServiceServer
+// for forward compatibility.
+type ClientServiceServer interface {
+	CreateClient(context.Context, *CreateClient_Request) (*CreateClient_Response, error)
+	ListClients(context.Context, *ListClients_Request) (*ListClients_Response, error)
+	RotateClientSecret(context.Context, *RotateClientSecret_Request) (*RotateClientSecret_Response, error)
+	DisableClient(context.Context, *DisableClient_Request) (*DisableClient_Response, error)
+	mustEmbedUnimplementedClientServiceServer()
+}
+
+// UnimplementedClientServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClientServiceServer struct{}
+
+func (UnimplementedClientServiceServer) CreateClient(context.Context, *CreateClient_Request) (*CreateClient_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateClient not implemented")
+}
+func (UnimplementedClientServiceServer) ListClients(context.Context, *ListClients_Request) (*ListClients_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedClientServiceServer) RotateClientSecret(context.Context, *RotateClientSecret_Request) (*RotateClientSecret_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateClientSecret not implemented")
+}
+func (UnimplementedClientServiceServer) DisableClient(context.Context, *DisableClient_Request) (*DisableClient_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableClient not implemented")
+}
+func (UnimplementedClientServiceServer) mustEmbedUnimplementedClientServiceServer() {}
+func (UnimplementedClientServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeClientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientServiceServer will
+// result in compilation errors.
+type UnsafeClientServiceServer interface {
+	mustEmbedUnimplementedClientServiceServer()
+}
+
+func RegisterClientServiceServer(s grpc.ServiceRegistrar, srv ClientServiceServer) {
+	// If the following call panics, it indicates UnimplementedClientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClientService_ServiceDesc, srv)
+}
+
+func _ClientService_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClient_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_CreateClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).CreateClient(ctx, req.(*CreateClient_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClients_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).ListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_ListClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).ListClients(ctx, req.(*ListClients_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_RotateClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateClientSecret_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).RotateClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_RotateClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).RotateClientSecret(ctx, req.(*RotateClientSecret_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_DisableClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableClient_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).DisableClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientService_DisableClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).DisableClient(ctx, req.(*DisableClient_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientService_ServiceDesc is the grpc.ServiceDesc for ClientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.ClientService",
+	HandlerType: (*ClientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClient",
+			Handler:    _ClientService_CreateClient_Handler,
+		},
+		{
+			MethodName: "ListClients",
+			Handler:    _ClientService_ListClients_Handler,
+		},
+		{
+			MethodName: "RotateClientSecret",
+			Handler:    _ClientService_RotateClientSecret_Handler,
+		},
+		{
+			MethodName: "DisableClient",
+			Handler:    _ClientService_DisableClient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	MFAService_EnrollTOTP_FullMethodName              = "/sso.v1.MFAService/EnrollTOTP"
+	MFAService_ConfirmTOTP_FullMethodName             = "/sso.v1.MFAService/ConfirmTOTP"
+	MFAService_DisableTOTP_FullMethodName             = "/sso.v1.MFAService/DisableTOTP"
+	MFAService_RegenerateRecoveryCodes_FullMethodName = "/sso.v1.MFAService/RegenerateRecoveryCodes"
+)
+
+// MFAServiceClient is the client API for MFAService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MFAServiceClient interface {
+	EnrollTOTP(ctx context.Context, in *EnrollTOTP_Request, opts ...grpc.CallOption) (*EnrollTOTP_Response, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTP_Request, opts ...grpc.CallOption) (*ConfirmTOTP_Response, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTP_Request, opts ...grpc.CallOption) (*DisableTOTP_Response, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodes_Request, opts ...grpc.CallOption) (*RegenerateRecoveryCodes_Response, error)
+}
+
+type mFAServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMFAServiceClient(cc grpc.ClientConnInterface) MFAServiceClient {
+	return &mFAServiceClient{cc}
+}
+
+func (c *mFAServiceClient) EnrollTOTP(ctx context.Context, in *EnrollTOTP_Request, opts ...grpc.CallOption) (*EnrollTOTP_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTP_Response)
+	err := c.cc.Invoke(ctx, MFAService_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTP_Request, opts ...grpc.CallOption) (*ConfirmTOTP_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTP_Response)
+	err := c.cc.Invoke(ctx, MFAService_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTP_Request, opts ...grpc.CallOption) (*DisableTOTP_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTP_Response)
+	err := c.cc.Invoke(ctx, MFAService_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mFAServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodes_Request, opts ...grpc.CallOption) (*RegenerateRecoveryCodes_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodes_Response)
+	err := c.cc.Invoke(ctx, MFAService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MFAServiceServer is the server API for MFAService service.
+// All implementations must embed UnimplementedMFAServiceServer
+// for forward compatibility.
+type MFAServiceServer interface {
+	EnrollTOTP(context.Context, *EnrollTOTP_Request) (*EnrollTOTP_Response, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTP_Request) (*ConfirmTOTP_Response, error)
+	DisableTOTP(context.Context, *DisableTOTP_Request) (*DisableTOTP_Response, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodes_Request) (*RegenerateRecoveryCodes_Response, error)
+	mustEmbedUnimplementedMFAServiceServer()
+}
+
+// UnimplementedMFAServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMFAServiceServer struct{}
+
+func (UnimplementedMFAServiceServer) EnrollTOTP(context.Context, *EnrollTOTP_Request) (*EnrollTOTP_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedMFAServiceServer) ConfirmTOTP(context.Context, *ConfirmTOTP_Request) (*ConfirmTOTP_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedMFAServiceServer) DisableTOTP(context.Context, *DisableTOTP_Request) (*DisableTOTP_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedMFAServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodes_Request) (*RegenerateRecoveryCodes_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedMFAServiceServer) mustEmbedUnimplementedMFAServiceServer() {}
+func (UnimplementedMFAServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeMFAServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MFAServiceServer will
+// result in compilation errors.
+type UnsafeMFAServiceServer interface {
+	mustEmbedUnimplementedMFAServiceServer()
+}
+
+func RegisterMFAServiceServer(s grpc.ServiceRegistrar, srv MFAServiceServer) {
+	// If the following call panics, it indicates UnimplementedMFAServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MFAService_ServiceDesc, srv)
+}
+
+func _MFAService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTP_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).EnrollTOTP(ctx, req.(*EnrollTOTP_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTP_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTP_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTP_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).DisableTOTP(ctx, req.(*DisableTOTP_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MFAService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodes_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MFAServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MFAService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MFAServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodes_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MFAService_ServiceDesc is the grpc.ServiceDesc for MFAService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MFAService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.MFAService",
+	HandlerType: (*MFAServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _MFAService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _MFAService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _MFAService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _MFAService_RegenerateRecoveryCodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	SessionService_ListSessions_FullMethodName      = "/sso.v1.SessionService/ListSessions"
+	SessionService_RevokeSession_FullMethodName     = "/sso.v1.SessionService/RevokeSession"
+	SessionService_RevokeAllSessions_FullMethodName = "/sso.v1.SessionService/RevokeAllSessions"
+)
+
+// SessionServiceClient is the client API for SessionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SessionServiceClient interface {
+	ListSessions(ctx context.Context, in *ListSessions_Request, opts ...grpc.CallOption) (*ListSessions_Response, error)
+	RevokeSession(ctx context.Context, in *RevokeSession_Request, opts ...grpc.CallOption) (*RevokeSession_Response, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessions_Request, opts ...grpc.CallOption) (*RevokeAllSessions_Response, error)
+}
+
+type sessionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionServiceClient(cc grpc.ClientConnInterface) SessionServiceClient {
+	return &sessionServiceClient{cc}
+}
+
+func (c *sessionServiceClient) ListSessions(ctx context.Context, in *ListSessions_Request, opts ...grpc.CallOption) (*ListSessions_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessions_Response)
+	err := c.cc.Invoke(ctx, SessionService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeSession(ctx context.Context, in *RevokeSession_Request, opts ...grpc.CallOption) (*RevokeSession_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSession_Response)
+	err := c.cc.Invoke(ctx, SessionService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessions_Request, opts ...grpc.CallOption) (*RevokeAllSessions_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessions_Response)
+	err := c.cc.Invoke(ctx, SessionService_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionServiceServer is the server API for SessionService service.
+// All implementations must embed UnimplementedSessionServiceServer
+// for forward compatibility.
+type SessionServiceServer interface {
+	ListSessions(context.Context, *ListSessions_Request) (*ListSessions_Response, error)
+	RevokeSession(context.Context, *RevokeSession_Request) (*RevokeSession_Response, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessions_Request) (*RevokeAllSessions_Response, error)
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+// UnimplementedSessionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSessionServiceServer struct{}
+
+func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSessions_Request) (*ListSessions_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) RevokeSession(context.Context, *RevokeSession_Request) (*RevokeSession_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSessionServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessions_Request) (*RevokeAllSessions_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
+func (UnimplementedSessionServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionServiceServer will
+// result in compilation errors.
+type UnsafeSessionServiceServer interface {
+	mustEmbedUnimplementedSessionServiceServer()
+}
+
+func RegisterSessionServiceServer(s grpc.ServiceRegistrar, srv SessionServiceServer) {
+	// If the following call panics, it indicates UnimplementedSessionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SessionService_ServiceDesc, srv)
+}
+
+func _SessionService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessions_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessions(ctx, req.(*ListSessions_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSession_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeSession(ctx, req.(*RevokeSession_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessions_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessions_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.SessionService",
+	HandlerType: (*SessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _SessionService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _SessionService_RevokeAllSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	AccountService_RequestPasswordReset_FullMethodName  = "/sso.v1.AccountService/RequestPasswordReset"
+	AccountService_ResetPassword_FullMethodName         = "/sso.v1.AccountService/ResetPassword"
+	AccountService_SendVerificationEmail_FullMethodName = "/sso.v1.AccountService/SendVerificationEmail"
+	AccountService_VerifyEmail_FullMethodName           = "/sso.v1.AccountService/VerifyEmail"
+)
+
+// AccountServiceClient is the client API for AccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccountServiceClient interface {
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordReset_Request, opts ...grpc.CallOption) (*RequestPasswordReset_Response, error)
+	ResetPassword(ctx context.Context, in *ResetPassword_Request, opts ...grpc.CallOption) (*ResetPassword_Response, error)
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmail_Request, opts ...grpc.CallOption) (*SendVerificationEmail_Response, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmail_Request, opts ...grpc.CallOption) (*VerifyEmail_Response, error)
+}
+
+type accountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountServiceClient(cc grpc.ClientConnInterface) AccountServiceClient {
+	return &accountServiceClient{cc}
+}
+
+func (c *accountServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordReset_Request, opts ...grpc.CallOption) (*RequestPasswordReset_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordReset_Response)
+	err := c.cc.Invoke(ctx, AccountService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ResetPassword(ctx context.Context, in *ResetPassword_Request, opts ...grpc.CallOption) (*ResetPassword_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPassword_Response)
+	err := c.cc.Invoke(ctx, AccountService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmail_Request, opts ...grpc.CallOption) (*SendVerificationEmail_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendVerificationEmail_Response)
+	err := c.cc.Invoke(ctx, AccountService_SendVerificationEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmail_Request, opts ...grpc.CallOption) (*VerifyEmail_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmail_Response)
+	err := c.cc.Invoke(ctx, AccountService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountServiceServer is the server API for AccountService service.
+// All implementations must embed UnimplementedAccountServiceServer
+// for forward compatibility.
+type AccountServiceServer interface {
+	RequestPasswordReset(context.Context, *RequestPasswordReset_Request) (*RequestPasswordReset_Response, error)
+	ResetPassword(context.Context, *ResetPassword_Request) (*ResetPassword_Response, error)
+	SendVerificationEmail(context.Context, *SendVerificationEmail_Request) (*SendVerificationEmail_Response, error)
+	VerifyEmail(context.Context, *VerifyEmail_Request) (*VerifyEmail_Response, error)
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+// UnimplementedAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountServiceServer struct{}
+
+func (UnimplementedAccountServiceServer) RequestPasswordReset(context.Context, *RequestPasswordReset_Request) (*RequestPasswordReset_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAccountServiceServer) ResetPassword(context.Context, *ResetPassword_Request) (*ResetPassword_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedAccountServiceServer) SendVerificationEmail(context.Context, *SendVerificationEmail_Request) (*SendVerificationEmail_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (UnimplementedAccountServiceServer) VerifyEmail(context.Context, *VerifyEmail_Request) (*VerifyEmail_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
+func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountServiceServer will
+// result in compilation errors.
+type UnsafeAccountServiceServer interface {
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+func RegisterAccountServiceServer(s grpc.ServiceRegistrar, srv AccountServiceServer) {
+	// If the following call panics, it indicates UnimplementedAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountService_ServiceDesc, srv)
+}
+
+func _AccountService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordReset_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordReset_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPassword_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ResetPassword(ctx, req.(*ResetPassword_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmail_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SendVerificationEmail(ctx, req.(*SendVerificationEmail_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmail_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).VerifyEmail(ctx, req.(*VerifyEmail_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.AccountService",
+	HandlerType: (*AccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AccountService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AccountService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _AccountService_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AccountService_VerifyEmail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	FederationService_ListIdentityProviders_FullMethodName = "/sso.v1.FederationService/ListIdentityProviders"
+	FederationService_StartFederation_FullMethodName       = "/sso.v1.FederationService/StartFederation"
+	FederationService_CompleteFederation_FullMethodName    = "/sso.v1.FederationService/CompleteFederation"
+)
+
+// FederationServiceClient is the client API for FederationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FederationServiceClient interface {
+	ListIdentityProviders(ctx context.Context, in *ListIdentityProviders_Request, opts ...grpc.CallOption) (*ListIdentityProviders_Response, error)
+	StartFederation(ctx context.Context, in *StartFederation_Request, opts ...grpc.CallOption) (*StartFederation_Response, error)
+	CompleteFederation(ctx context.Context, in *CompleteFederation_Request, opts ...grpc.CallOption) (*CompleteFederation_Response, error)
+}
+
+type federationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFederationServiceClient(cc grpc.ClientConnInterface) FederationServiceClient {
+	return &federationServiceClient{cc}
+}
+
+func (c *federationServiceClient) ListIdentityProviders(ctx context.Context, in *ListIdentityProviders_Request, opts ...grpc.CallOption) (*ListIdentityProviders_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentityProviders_Response)
+	err := c.cc.Invoke(ctx, FederationService_ListIdentityProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *federationServiceClient) StartFederation(ctx context.Context, in *StartFederation_Request, opts ...grpc.CallOption) (*StartFederation_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartFederation_Response)
+	err := c.cc.Invoke(ctx, FederationService_StartFederation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *federationServiceClient) CompleteFederation(ctx context.Context, in *CompleteFederation_Request, opts ...grpc.CallOption) (*CompleteFederation_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteFederation_Response)
+	err := c.cc.Invoke(ctx, FederationService_CompleteFederation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FederationServiceServer is the server API for FederationService service.
+// All implementations must embed UnimplementedFederationServiceServer
+// for forward compatibility.
+type FederationServiceServer interface {
+	ListIdentityProviders(context.Context, *ListIdentityProviders_Request) (*ListIdentityProviders_Response, error)
+	StartFederation(context.Context, *StartFederation_Request) (*StartFederation_Response, error)
+	CompleteFederation(context.Context, *CompleteFederation_Request) (*CompleteFederation_Response, error)
+	mustEmbedUnimplementedFederationServiceServer()
+}
+
+// UnimplementedFederationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFederationServiceServer struct{}
+
+func (UnimplementedFederationServiceServer) ListIdentityProviders(context.Context, *ListIdentityProviders_Request) (*ListIdentityProviders_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIdentityProviders not implemented")
+}
+func (UnimplementedFederationServiceServer) StartFederation(context.Context, *StartFederation_Request) (*StartFederation_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartFederation not implemented")
+}
+func (UnimplementedFederationServiceServer) CompleteFederation(context.Context, *CompleteFederation_Request) (*CompleteFederation_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteFederation not implemented")
+}
+func (UnimplementedFederationServiceServer) mustEmbedUnimplementedFederationServiceServer() {}
+func (UnimplementedFederationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeFederationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FederationServiceServer will
+// result in compilation errors.
+type UnsafeFederationServiceServer interface {
+	mustEmbedUnimplementedFederationServiceServer()
+}
+
+func RegisterFederationServiceServer(s grpc.ServiceRegistrar, srv FederationServiceServer) {
+	// If the following call panics, it indicates UnimplementedFederationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FederationService_ServiceDesc, srv)
+}
+
+func _FederationService_ListIdentityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentityProviders_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FederationServiceServer).ListIdentityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FederationService_ListIdentityProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FederationServiceServer).ListIdentityProviders(ctx, req.(*ListIdentityProviders_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FederationService_StartFederation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFederation_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FederationServiceServer).StartFederation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FederationService_StartFederation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FederationServiceServer).StartFederation(ctx, req.(*StartFederation_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FederationService_CompleteFederation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteFederation_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FederationServiceServer).CompleteFederation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FederationService_CompleteFederation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FederationServiceServer).CompleteFederation(ctx, req.(*CompleteFederation_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FederationService_ServiceDesc is the grpc.ServiceDesc for FederationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FederationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.FederationService",
+	HandlerType: (*FederationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListIdentityProviders",
+			Handler:    _FederationService_ListIdentityProviders_Handler,
+		},
+		{
+			MethodName: "StartFederation",
+			Handler:    _FederationService_StartFederation_Handler,
+		},
+		{
+			MethodName: "CompleteFederation",
+			Handler:    _FederationService_CompleteFederation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	PasskeyService_BeginPasskeyRegistration_FullMethodName  = "/sso.v1.PasskeyService/BeginPasskeyRegistration"
+	PasskeyService_FinishPasskeyRegistration_FullMethodName = "/sso.v1.PasskeyService/FinishPasskeyRegistration"
+)
+
+// PasskeyServiceClient is the client API for PasskeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PasskeyServiceClient interface {
+	BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistration_Request, opts ...grpc.CallOption) (*BeginPasskeyRegistration_Response, error)
+	FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistration_Request, opts ...grpc.CallOption) (*FinishPasskeyRegistration_Response, error)
+}
+
+type passkeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPasskeyServiceClient(cc grpc.ClientConnInterface) PasskeyServiceClient {
+	return &passkeyServiceClient{cc}
+}
+
+func (c *passkeyServiceClient) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistration_Request, opts ...grpc.CallOption) (*BeginPasskeyRegistration_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginPasskeyRegistration_Response)
+	err := c.cc.Invoke(ctx, PasskeyService_BeginPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passkeyServiceClient) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistration_Request, opts ...grpc.CallOption) (*FinishPasskeyRegistration_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishPasskeyRegistration_Response)
+	err := c.cc.Invoke(ctx, PasskeyService_FinishPasskeyRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PasskeyServiceServer is the server API for PasskeyService service.
+// All implementations must embed UnimplementedPasskeyServiceServer
+// for forward compatibility.
+type PasskeyServiceServer interface {
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistration_Request) (*BeginPasskeyRegistration_Response, error)
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistration_Request) (*FinishPasskeyRegistration_Response, error)
+	mustEmbedUnimplementedPasskeyServiceServer()
+}
+
+// UnimplementedPasskeyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPasskeyServiceServer struct{}
+
+func (UnimplementedPasskeyServiceServer) BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistration_Request) (*BeginPasskeyRegistration_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method BeginPasskeyRegistration not implemented")
+}
+func (UnimplementedPasskeyServiceServer) FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistration_Request) (*FinishPasskeyRegistration_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinishPasskeyRegistration not implemented")
+}
+func (UnimplementedPasskeyServiceServer) mustEmbedUnimplementedPasskeyServiceServer() {}
+func (UnimplementedPasskeyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePasskeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PasskeyServiceServer will
+// result in compilation errors.
+type UnsafePasskeyServiceServer interface {
+	mustEmbedUnimplementedPasskeyServiceServer()
+}
+
+func RegisterPasskeyServiceServer(s grpc.ServiceRegistrar, srv PasskeyServiceServer) {
+	// If the following call panics, it indicates UnimplementedPasskeyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PasskeyService_ServiceDesc, srv)
+}
+
+func _PasskeyService_BeginPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyRegistration_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeyServiceServer).BeginPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasskeyService_BeginPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeyServiceServer).BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistration_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PasskeyService_FinishPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyRegistration_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasskeyServiceServer).FinishPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PasskeyService_FinishPasskeyRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasskeyServiceServer).FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistration_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PasskeyService_ServiceDesc is the grpc.ServiceDesc for PasskeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PasskeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.PasskeyService",
+	HandlerType: (*PasskeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BeginPasskeyRegistration",
+			Handler:    _PasskeyService_BeginPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyRegistration",
+			Handler:    _PasskeyService_FinishPasskeyRegistration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	APIKeyService_CreateAPIKey_FullMethodName = "/sso.v1.APIKeyService/CreateAPIKey"
+	APIKeyService_ListAPIKeys_FullMethodName  = "/sso.v1.APIKeyService/ListAPIKeys"
+	APIKeyService_RevokeAPIKey_FullMethodName = "/sso.v1.APIKeyService/RevokeAPIKey"
+)
+
+// APIKeyServiceClient is the client API for APIKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APIKeyServiceClient interface {
+	CreateAPIKey(ctx context.Context, in *CreateAPIKey_Request, opts ...grpc.CallOption) (*CreateAPIKey_Response, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeys_Request, opts ...grpc.CallOption) (*ListAPIKeys_Response, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKey_Request, opts ...grpc.CallOption) (*RevokeAPIKey_Response, error)
+}
+
+type aPIKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyServiceClient(cc grpc.ClientConnInterface) APIKeyServiceClient {
+	return &aPIKeyServiceClient{cc}
+}
+
+func (c *aPIKeyServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKey_Request, opts ...grpc.CallOption) (*CreateAPIKey_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKey_Response)
+	err := c.cc.Invoke(ctx, APIKeyService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeys_Request, opts ...grpc.CallOption) (*ListAPIKeys_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeys_Response)
+	err := c.cc.Invoke(ctx, APIKeyService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKey_Request, opts ...grpc.CallOption) (*RevokeAPIKey_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKey_Response)
+	err := c.cc.Invoke(ctx, APIKeyService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyServiceServer is the server API for APIKeyService service.
+// All implementations must embed UnimplementedAPIKeyServiceServer
+// for forward compatibility.
+type APIKeyServiceServer interface {
+	CreateAPIKey(context.Context, *CreateAPIKey_Request) (*CreateAPIKey_Response, error)
+	ListAPIKeys(context.Context, *ListAPIKeys_Request) (*ListAPIKeys_Response, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKey_Request) (*RevokeAPIKey_Response, error)
+	mustEmbedUnimplementedAPIKeyServiceServer()
+}
+
+// UnimplementedAPIKeyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAPIKeyServiceServer struct{}
+
+func (UnimplementedAPIKeyServiceServer) CreateAPIKey(context.Context, *CreateAPIKey_Request) (*CreateAPIKey_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) ListAPIKeys(context.Context, *ListAPIKeys_Request) (*ListAPIKeys_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKey_Request) (*RevokeAPIKey_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAPIKeyServiceServer) mustEmbedUnimplementedAPIKeyServiceServer() {}
+func (UnimplementedAPIKeyServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAPIKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIKeyServiceServer will
+// result in compilation errors.
+type UnsafeAPIKeyServiceServer interface {
+	mustEmbedUnimplementedAPIKeyServiceServer()
+}
+
+func RegisterAPIKeyServiceServer(s grpc.ServiceRegistrar, srv APIKeyServiceServer) {
+	// If the following call panics, it indicates UnimplementedAPIKeyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&APIKeyService_ServiceDesc, srv)
+}
+
+func _APIKeyService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKey_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKey_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeys_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeys_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKey_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIKeyService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKey_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIKeyService_ServiceDesc is the grpc.ServiceDesc for APIKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APIKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.APIKeyService",
+	HandlerType: (*APIKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _APIKeyService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _APIKeyService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _APIKeyService_RevokeAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso/v1/sso.service.proto",
+}
+
+const (
+	AdminService_UnlockAccount_FullMethodName  = "/sso.v1.AdminService/UnlockAccount"
+	AdminService_ListAuthEvents_FullMethodName = "/sso.v1.AdminService/ListAuthEvents"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	UnlockAccount(ctx context.Context, in *UnlockAccount_Request, opts ...grpc.CallOption) (*UnlockAccount_Response, error)
+	ListAuthEvents(ctx context.Context, in *ListAuthEvents_Request, opts ...grpc.CallOption) (*ListAuthEvents_Response, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccount_Request, opts ...grpc.CallOption) (*UnlockAccount_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockAccount_Response)
+	err := c.cc.Invoke(ctx, AdminService_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuthEvents(ctx context.Context, in *ListAuthEvents_Request, opts ...grpc.CallOption) (*ListAuthEvents_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthEvents_Response)
+	err := c.cc.Invoke(ctx, AdminService_ListAuthEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	UnlockAccount(context.Context, *UnlockAccount_Request) (*UnlockAccount_Response, error)
+	ListAuthEvents(context.Context, *ListAuthEvents_Request) (*ListAuthEvents_Response, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) UnlockAccount(context.Context, *UnlockAccount_Request) (*UnlockAccount_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuthEvents(context.Context, *ListAuthEvents_Request) (*ListAuthEvents_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuthEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccount_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnlockAccount(ctx, req.(*UnlockAccount_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuthEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthEvents_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuthEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuthEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuthEvents(ctx, req.(*ListAuthEvents_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sso.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _AdminService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "ListAuthEvents",
+			Handler:    _AdminService_ListAuthEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sso/v1/sso.service.proto",
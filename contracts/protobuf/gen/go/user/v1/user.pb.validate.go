@@ -96,17 +96,6 @@ func (m *User) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 64 {
-		err := UserValidationError{
-			field:  "Password",
-			reason: "value length must be between 8 and 64 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if uri, err := url.Parse(m.GetAvatarUrl()); err != nil {
 		err = UserValidationError{
 			field:  "AvatarUrl",
@@ -219,6 +208,8 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for EmailVerified
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -334,6 +334,305 @@ var _ interface {
 	ErrorName() string
 } = DeleteUserValidationError{}
 
+// Validate checks the field values on VerifyPassword with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyPassword) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyPassword with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyPasswordMultiError,
+// or nil if none found.
+func (m *VerifyPassword) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyPassword) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyPasswordMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyPasswordMultiError is an error wrapping multiple validation errors
+// returned by VerifyPassword.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyPasswordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyPasswordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyPasswordMultiError) AllErrors() []error { return m }
+
+// VerifyPasswordValidationError is the validation error returned by
+// VerifyPassword.Validate if the designated constraints aren't met.
+type VerifyPasswordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPasswordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPasswordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPasswordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPasswordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPasswordValidationError) ErrorName() string { return "VerifyPasswordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyPasswordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPassword.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPasswordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPasswordValidationError{}
+
+// Validate checks the field values on UpdatePassword with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdatePassword) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePassword with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdatePasswordMultiError,
+// or nil if none found.
+func (m *UpdatePassword) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePassword) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdatePasswordMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePasswordMultiError is an error wrapping multiple validation errors
+// returned by UpdatePassword.ValidateAll() if the designated constraints
+// aren't met.
+type UpdatePasswordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePasswordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePasswordMultiError) AllErrors() []error { return m }
+
+// UpdatePasswordValidationError is the validation error returned by
+// UpdatePassword.Validate if the designated constraints aren't met.
+type UpdatePasswordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePasswordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePasswordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePasswordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePasswordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePasswordValidationError) ErrorName() string { return "UpdatePasswordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdatePasswordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePassword.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePasswordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePasswordValidationError{}
+
+// Validate checks the field values on VerifyEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyEmailMultiError, or
+// nil if none found.
+func (m *VerifyEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmailMultiError is an error wrapping multiple validation errors
+// returned by VerifyEmail.ValidateAll() if the designated constraints aren't met.
+type VerifyEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmailMultiError) AllErrors() []error { return m }
+
+// VerifyEmailValidationError is the validation error returned by
+// VerifyEmail.Validate if the designated constraints aren't met.
+type VerifyEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmailValidationError) ErrorName() string { return "VerifyEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmailValidationError{}
+
 // Validate checks the field values on CreateUser_Request with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1269,3 +1568,768 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteUser_ResponseValidationError{}
+
+// Validate checks the field values on VerifyPassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyPassword_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyPassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyPassword_RequestMultiError, or nil if none found.
+func (m *VerifyPassword_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyPassword_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = VerifyPassword_RequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 64 {
+		err := VerifyPassword_RequestValidationError{
+			field:  "Password",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyPassword_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyPassword_Request) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *VerifyPassword_Request) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// VerifyPassword_RequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyPassword_Request.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyPassword_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyPassword_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyPassword_RequestMultiError) AllErrors() []error { return m }
+
+// VerifyPassword_RequestValidationError is the validation error returned by
+// VerifyPassword_Request.Validate if the designated constraints aren't met.
+type VerifyPassword_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPassword_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPassword_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPassword_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPassword_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPassword_RequestValidationError) ErrorName() string {
+	return "VerifyPassword_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyPassword_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPassword_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPassword_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPassword_RequestValidationError{}
+
+// Validate checks the field values on VerifyPassword_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyPassword_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyPassword_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyPassword_ResponseMultiError, or nil if none found.
+func (m *VerifyPassword_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyPassword_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifyPassword_ResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifyPassword_ResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifyPassword_ResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VerifyPassword_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyPassword_ResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyPassword_Response.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyPassword_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyPassword_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyPassword_ResponseMultiError) AllErrors() []error { return m }
+
+// VerifyPassword_ResponseValidationError is the validation error returned by
+// VerifyPassword_Response.Validate if the designated constraints aren't met.
+type VerifyPassword_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPassword_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPassword_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPassword_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPassword_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPassword_ResponseValidationError) ErrorName() string {
+	return "VerifyPassword_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyPassword_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPassword_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPassword_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPassword_ResponseValidationError{}
+
+// Validate checks the field values on UpdatePassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePassword_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePassword_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePassword_RequestMultiError, or nil if none found.
+func (m *UpdatePassword_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePassword_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdatePassword_RequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 64 {
+		err := UpdatePassword_RequestValidationError{
+			field:  "Password",
+			reason: "value length must be between 8 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdatePassword_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePassword_Request) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePassword_RequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePassword_Request.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePassword_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePassword_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePassword_RequestMultiError) AllErrors() []error { return m }
+
+// UpdatePassword_RequestValidationError is the validation error returned by
+// UpdatePassword_Request.Validate if the designated constraints aren't met.
+type UpdatePassword_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePassword_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePassword_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePassword_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePassword_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePassword_RequestValidationError) ErrorName() string {
+	return "UpdatePassword_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePassword_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePassword_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePassword_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePassword_RequestValidationError{}
+
+// Validate checks the field values on UpdatePassword_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePassword_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePassword_Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePassword_ResponseMultiError, or nil if none found.
+func (m *UpdatePassword_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePassword_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdatePassword_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePassword_ResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePassword_Response.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePassword_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePassword_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePassword_ResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePassword_ResponseValidationError is the validation error returned by
+// UpdatePassword_Response.Validate if the designated constraints aren't met.
+type UpdatePassword_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePassword_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePassword_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePassword_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePassword_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePassword_ResponseValidationError) ErrorName() string {
+	return "UpdatePassword_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePassword_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePassword_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePassword_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePassword_ResponseValidationError{}
+
+// Validate checks the field values on VerifyEmail_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail_Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail_Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyEmail_RequestMultiError, or nil if none found.
+func (m *VerifyEmail_Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail_Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = VerifyEmail_RequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyEmail_RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyEmail_Request) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyEmail_RequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyEmail_Request.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyEmail_RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmail_RequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmail_RequestMultiError) AllErrors() []error { return m }
+
+// VerifyEmail_RequestValidationError is the validation error returned by
+// VerifyEmail_Request.Validate if the designated constraints aren't met.
+type VerifyEmail_RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmail_RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmail_RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmail_RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmail_RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmail_RequestValidationError) ErrorName() string {
+	return "VerifyEmail_RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyEmail_RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail_Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmail_RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmail_RequestValidationError{}
+
+// Validate checks the field values on VerifyEmail_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmail_Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmail_Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyEmail_ResponseMultiError, or nil if none found.
+func (m *VerifyEmail_Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmail_Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyEmail_ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmail_ResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyEmail_Response.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyEmail_ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmail_ResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmail_ResponseMultiError) AllErrors() []error { return m }
+
+// VerifyEmail_ResponseValidationError is the validation error returned by
+// VerifyEmail_Response.Validate if the designated constraints aren't met.
+type VerifyEmail_ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmail_ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmail_ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmail_ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmail_ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmail_ResponseValidationError) ErrorName() string {
+	return "VerifyEmail_ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyEmail_ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmail_Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmail_ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmail_ResponseValidationError{}
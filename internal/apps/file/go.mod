@@ -12,6 +12,7 @@ require (
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.3
 	github.com/jackc/pgx/v5 v5.8.0
 	github.com/kitanoyoru/kgym/contracts/protobuf v0.0.0-20260103131015-fe35aa05ab64
+	github.com/kitanoyoru/kgym/pkg/auth v0.0.0-00010101000000-000000000000
 	github.com/kitanoyoru/kgym/pkg/database v0.0.0-20260103131015-fe35aa05ab64
 	github.com/kitanoyoru/kgym/pkg/logging v0.0.0-20260103131623-9c57395a1221
 	github.com/kitanoyoru/kgym/pkg/metrics v0.0.0-20260103131015-fe35aa05ab64
@@ -20,6 +21,7 @@ require (
 	github.com/minio/minio-go/v7 v7.0.97
 	github.com/pkg/errors v0.9.1
 	github.com/pressly/goose/v3 v3.26.0
+	github.com/redis/go-redis/v9 v9.17.2
 	github.com/rs/zerolog v1.34.0
 	github.com/samber/lo v1.52.0
 	github.com/spf13/cobra v1.10.2
@@ -48,6 +50,7 @@ require (
 	github.com/containerd/platforms v0.2.1 // indirect
 	github.com/cpuguy83/dockercfg v0.3.2 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/distribution/reference v0.6.0 // indirect
 	github.com/docker/docker v28.5.1+incompatible // indirect
 	github.com/docker/go-connections v0.6.0 // indirect
@@ -64,6 +67,7 @@ require (
 	github.com/go-ole/go-ole v1.2.6 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.4 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
@@ -126,6 +130,7 @@ require (
 )
 
 replace (
+	github.com/kitanoyoru/kgym/pkg/auth => ../../../pkg/auth
 	github.com/kitanoyoru/kgym/pkg/database => ../../../pkg/database
 	github.com/kitanoyoru/kgym/pkg/logging => ../../../pkg/logging
 	github.com/kitanoyoru/kgym/pkg/metrics => ../../../pkg/metrics
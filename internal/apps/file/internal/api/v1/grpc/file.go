@@ -7,9 +7,12 @@ import (
 
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/file/v1"
 	"github.com/kitanoyoru/kgym/internal/apps/file/internal/service"
+	"github.com/kitanoyoru/kgym/pkg/auth"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -38,10 +41,17 @@ func (s *FileServiceServer) UploadUserAvatar(stream pb.FileService_UploadUserAva
 	ctx, span := s.tracer.Start(ctx, "UploadUserAvatar")
 	defer span.End()
 
+	// The avatar belongs to the caller.
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing access token")
+	}
+
 	uploadRequest, doneChan, err := s.streamToPipe(ctx, stream)
 	if err != nil {
 		return err
 	}
+	uploadRequest.UserID = claims.Subject
 
 	resp, err := s.service.Upload(ctx, uploadRequest)
 	if err != nil {
@@ -12,17 +12,22 @@ import (
 	fileminio "github.com/kitanoyoru/kgym/internal/apps/file/internal/repository/minio"
 	filepostgres "github.com/kitanoyoru/kgym/internal/apps/file/internal/repository/postgres"
 	fileservice "github.com/kitanoyoru/kgym/internal/apps/file/internal/service"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
 	pkgminio "github.com/kitanoyoru/kgym/pkg/database/minio"
 	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
+	pkgredis "github.com/kitanoyoru/kgym/pkg/database/redis"
 	pkglogging "github.com/kitanoyoru/kgym/pkg/logging"
 	pkgmetrics "github.com/kitanoyoru/kgym/pkg/metrics"
 	"github.com/minio/minio-go/v7"
+	"github.com/redis/go-redis/v9"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/multierr"
 	"google.golang.org/grpc"
 	pbhealth "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	pbreflection "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	pbreflectionalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
 const (
@@ -35,7 +40,9 @@ type App struct {
 
 	dbPool      *pgxpool.Pool
 	minioClient *minio.Client
+	rdb         *redis.ClusterClient
 	grpcServer  *grpc.Server
+	verifier    *pkgauth.Verifier
 
 	fileMinioRepository    fileminio.IRepository
 	filePostgresRepository filepostgres.IRepository
@@ -61,10 +68,18 @@ func New(ctx context.Context, cfg Config) (*App, error) {
 		return nil, err
 	}
 
+	rdb, err := pkgredis.New(ctx, pkgredis.Config{
+		Address: cfg.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	app := &App{
 		cfg:         cfg,
 		dbPool:      dbPool,
 		minioClient: minioClient,
+		rdb:         rdb,
 	}
 
 	err = multierr.Combine(
@@ -85,6 +100,8 @@ func (app *App) Run(ctx context.Context) error {
 		return err
 	}
 
+	go app.verifier.Run(ctx)
+
 	return app.grpcServer.Serve(listener)
 }
 
@@ -132,6 +149,26 @@ func (app *App) initGRPCServer(_ context.Context) error {
 		grpcprometheus.WithContextLabels(pkgmetrics.AllMetadataFields...),
 	)
 
+	app.verifier = pkgauth.NewVerifier(pkgauth.Config{
+		Issuer:    app.cfg.Auth.Issuer,
+		Audiences: app.cfg.Auth.Audiences,
+		JWKSURL:   app.cfg.Auth.JWKSURL,
+		// Proofs of possession are remembered in Redis, so that a replayed
+		// proof is refused by every replica.
+		ReplayCache: pkgauth.NewRedisReplayCache(app.rdb),
+	})
+
+	policies := pkgauth.Policies{
+		pbFile.FileService_UploadUserAvatar_FullMethodName: pkgauth.Authenticated(),
+		pbFile.FileService_GetFileURL_FullMethodName:       pkgauth.Authenticated(),
+		// Only admins delete files, whoever uploaded them.
+		pbFile.FileService_DeleteFile_FullMethodName: pkgauth.RequireRole(pkgauth.RoleAdmin),
+
+		"/" + pbhealth.Health_ServiceDesc.ServiceName:                    pkgauth.Public(),
+		"/" + pbreflection.ServerReflection_ServiceDesc.ServiceName:      pkgauth.Public(),
+		"/" + pbreflectionalpha.ServerReflection_ServiceDesc.ServiceName: pkgauth.Public(),
+	}
+
 	server := grpc.NewServer(
 		grpc.MaxRecvMsgSize(app.cfg.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(app.cfg.MaxSendMsgSize),
@@ -144,6 +181,7 @@ func (app *App) initGRPCServer(_ context.Context) error {
 			logging.UnaryServerInterceptor(
 				pkglogging.NewInterceptorLogger(Namespace, ServiceName),
 			),
+			pkgauth.UnaryServerInterceptor(app.verifier, policies),
 		),
 		grpc.ChainStreamInterceptor(
 			srvMetrics.StreamServerInterceptor(
@@ -152,6 +190,7 @@ func (app *App) initGRPCServer(_ context.Context) error {
 			logging.StreamServerInterceptor(
 				pkglogging.NewInterceptorLogger(Namespace, ServiceName),
 			),
+			pkgauth.StreamServerInterceptor(app.verifier, policies),
 		),
 		grpc.StatsHandler(
 			otelgrpc.NewServerHandler(),
@@ -4,6 +4,7 @@ import "time"
 
 type Config struct {
 	GRPC
+	Auth
 	Cache
 	Database
 	Static
@@ -20,6 +21,13 @@ type GRPC struct {
 	MaxConcurrentStreams uint32        `env:"KGYM_FILE_GRPC_MAX_CONCURRENT_STREAMS" envDefault:"1000"`
 }
 
+// Auth configures the verification of access tokens issued by SSO.
+type Auth struct {
+	Issuer    string   `env:"KGYM_FILE_AUTH_ISSUER" validate:"required,url"`
+	Audiences []string `env:"KGYM_FILE_AUTH_AUDIENCES" validate:"required,min=1"`
+	JWKSURL   string   `env:"KGYM_FILE_AUTH_JWKS_URL" validate:"required,url"`
+}
+
 type Cache struct {
 	Address string `env:"KGYM_FILE_CACHE_ADDRESS" validate:"required"`
 }
@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"fmt"
+
+	"github.com/kitanoyoru/kgym/internal/apps/sso/internal"
+	clientpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/postgres"
+	clientservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/client"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/env"
+	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "clients"
+	Short = "Manage OAuth clients"
+	Long  = "Manage OAuth clients directly in the database, e.g. to register the first client before any admin can sign in"
+)
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Use,
+		Short: Short,
+		Long:  Long,
+	}
+
+	cmd.AddCommand(createCommand())
+
+	return cmd
+}
+
+func createCommand() *cobra.Command {
+	var req clientservice.CreateRequest
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Register a new OAuth client",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var cfg internal.Database
+			if err := env.ParseAndValidate(ctx, &cfg); err != nil {
+				return err
+			}
+
+			db, err := pkgpostgres.New(ctx, pkgpostgres.Config{
+				URI: cfg.ConnectionString,
+			})
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			resp, err := clientservice.NewService(clientpostgres.New(db)).Create(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "client_id: %s\n", resp.Client.ID)
+			if resp.Secret != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "client_secret: %s\n", resp.Secret)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&req.Name, "name", "", "human readable client name")
+	cmd.Flags().BoolVar(&req.Confidential, "confidential", false, "issue a client secret")
+	cmd.Flags().StringSliceVar(&req.GrantTypes, "grant-type", nil, "allowed grant types")
+	cmd.Flags().StringSliceVar(&req.RedirectURIs, "redirect-uri", nil, "allowed redirect uris")
+	cmd.Flags().StringSliceVar(&req.Scopes, "scope", nil, "allowed scopes")
+	cmd.Flags().DurationVar(&req.AccessTokenTTL, "access-token-ttl", clientservice.DefaultAccessTokenTTL, "access token lifetime")
+	cmd.Flags().DurationVar(&req.RefreshTokenTTL, "refresh-token-ttl", clientservice.DefaultRefreshTokenTTL, "refresh token lifetime")
+
+	return cmd
+}
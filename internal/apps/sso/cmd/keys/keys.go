@@ -0,0 +1,241 @@
+package keys
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kitanoyoru/kgym/internal/apps/sso/internal"
+	autheventpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/postgres"
+	keyredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/redis"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	keyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/key"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/env"
+	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
+	pkgredis "github.com/kitanoyoru/kgym/pkg/database/redis"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "keys"
+	Short = "Manage JWT signing keys"
+	Long  = "Manage JWT signing keys: rotate, list, retire and import keys in the JWKS"
+)
+
+type config struct {
+	internal.Cache
+	internal.Database
+	internal.Keys
+}
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Use,
+		Short: Short,
+		Long:  Long,
+	}
+
+	cmd.AddCommand(
+		rotateCommand(),
+		listCommand(),
+		retireCommand(),
+		importCommand(),
+		reencryptCommand(),
+	)
+
+	return cmd
+}
+
+func rotateCommand() *cobra.Command {
+	var req keyservice.RotateRequest
+
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Create a new signing key",
+		Long:  "Create a new signing key. It is published right away and starts signing after the pre-publication window, unless --immediate is set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withService(cmd.Context(), func(ctx context.Context, keyService keyservice.IService) error {
+				key, err := keyService.Rotate(ctx, req)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "kid: %s\nstate: %s\nactivate_at: %s\n", key.ID, key.State, key.ActivateAt.Format(time.RFC3339))
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&req.Immediate, "immediate", false, "start signing with the new key right away")
+	cmd.Flags().StringVar(&req.Algorithm, "algorithm", "", "signing algorithm of the new key: RS256, ES256 or EdDSA, defaults to KGYM_SSO_KEYS_ALGORITHM")
+
+	return cmd
+}
+
+func listCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List signing keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withService(cmd.Context(), func(ctx context.Context, keyService keyservice.IService) error {
+				keys, err := keyService.List(ctx)
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "KID\tALG\tSTATE\tCREATED\tACTIVATE AT\tRETIRE AT")
+				for _, key := range keys {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", key.ID, key.Algorithm, key.State, formatTime(key.CreatedAt), formatTime(key.ActivateAt), formatTime(key.RetireAt))
+				}
+
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func retireCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retire KID",
+		Short: "Remove a key from the JWKS",
+		Long:  "Remove a key from the JWKS and drop its private half. Tokens it signed stop verifying",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withService(cmd.Context(), func(ctx context.Context, keyService keyservice.IService) error {
+				return keyService.Retire(ctx, args[0])
+			})
+		},
+	}
+}
+
+func importCommand() *cobra.Command {
+	var (
+		req  keyservice.ImportRequest
+		file string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a PEM encoded RSA, P-256 or Ed25519 private key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+
+			req.PrivateKey, err = parsePrivateKey(data)
+			if err != nil {
+				return err
+			}
+
+			return withService(cmd.Context(), func(ctx context.Context, keyService keyservice.IService) error {
+				key, err := keyService.Import(ctx, req)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "kid: %s\nstate: %s\n", key.ID, key.State)
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "path to the PEM encoded private key")
+	cmd.Flags().StringVar(&req.ID, "kid", "", "key id, derived from the current time when empty")
+	cmd.Flags().BoolVar(&req.Immediate, "immediate", false, "start signing with the imported key right away")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func reencryptCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Re-encrypt private keys under the current key-encryption key",
+		Long:  "Re-encrypt private keys under the current key-encryption key. Run it after putting a new KEK first in KGYM_SSO_KEYS_KEK and before removing the old one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withService(cmd.Context(), func(ctx context.Context, keyService keyservice.IService) error {
+				count, err := keyService.ReEncrypt(ctx)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "re-encrypted: %d\n", count)
+
+				return nil
+			})
+		},
+	}
+}
+
+func withService(ctx context.Context, fn func(ctx context.Context, keyService keyservice.IService) error) error {
+	var cfg config
+	if err := env.ParseAndValidate(ctx, &cfg); err != nil {
+		return err
+	}
+
+	rdb, err := pkgredis.New(ctx, pkgredis.Config{
+		Address: cfg.Address,
+	})
+	if err != nil {
+		return err
+	}
+	defer rdb.Close()
+
+	// Key changes made from the command line are audited like those of the
+	// scheduler.
+	db, err := pkgpostgres.New(ctx, pkgpostgres.Config{
+		URI: cfg.ConnectionString,
+	})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	kekProvider, err := cfg.Keys.KEKProvider()
+	if err != nil {
+		return err
+	}
+
+	keyRepository, err := keyredis.New(ctx, rdb, kekProvider)
+	if err != nil {
+		return err
+	}
+
+	auditService := auditservice.NewService(autheventpostgres.New(db))
+
+	return fn(ctx, keyservice.NewService(cfg.Keys.ServiceConfig(), keyRepository, auditService))
+}
+
+func parsePrivateKey(data []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
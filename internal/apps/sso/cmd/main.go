@@ -8,7 +8,10 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/kitanoyoru/kgym/internal/apps/sso/cmd/clients"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/cmd/keys"
 	"github.com/kitanoyoru/kgym/internal/apps/sso/cmd/run"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/cmd/tokens"
 	"github.com/kitanoyoru/kgym/pkg/tracing"
 )
 
@@ -52,6 +55,9 @@ var rootCmd = &cobra.Command{
 
 func main() {
 	rootCmd.AddCommand(run.Command())
+	rootCmd.AddCommand(clients.Command())
+	rootCmd.AddCommand(keys.Command())
+	rootCmd.AddCommand(tokens.Command())
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal().Err(err).Msg("failed to execute command")
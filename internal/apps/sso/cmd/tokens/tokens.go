@@ -0,0 +1,86 @@
+package tokens
+
+import (
+	"fmt"
+
+	"github.com/kitanoyoru/kgym/internal/apps/sso/internal"
+	lockredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock/redis"
+	tokenpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/postgres"
+	tokenpurgeservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/tokenpurge"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/env"
+	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
+	pkgredis "github.com/kitanoyoru/kgym/pkg/database/redis"
+	"github.com/spf13/cobra"
+)
+
+const (
+	Use   = "tokens"
+	Short = "Manage refresh tokens"
+	Long  = "Manage refresh tokens stored in the database"
+)
+
+type config struct {
+	internal.Cache
+	internal.Database
+	internal.Tokens
+}
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Use,
+		Short: Short,
+		Long:  Long,
+	}
+
+	cmd.AddCommand(purgeCommand())
+
+	return cmd
+}
+
+func purgeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge",
+		Short: "Delete expired and revoked refresh tokens",
+		Long:  "Delete refresh tokens that expired more than KGYM_SSO_TOKENS_EXPIRED_RETENTION ago or were revoked more than KGYM_SSO_TOKENS_REVOKED_RETENTION ago. It fails if a scheduled purge is running",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var cfg config
+			if err := env.ParseAndValidate(ctx, &cfg); err != nil {
+				return err
+			}
+
+			rdb, err := pkgredis.New(ctx, pkgredis.Config{
+				Address: cfg.Address,
+			})
+			if err != nil {
+				return err
+			}
+			defer rdb.Close()
+
+			db, err := pkgpostgres.New(ctx, pkgpostgres.Config{
+				URI: cfg.ConnectionString,
+			})
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			purgeService := tokenpurgeservice.NewService(
+				cfg.Tokens.PurgeConfig(),
+				tokenpostgres.New(db),
+				lockredis.New(rdb),
+				tokenpurgeservice.NewMetrics(internal.Namespace, internal.ServiceName),
+			)
+
+			resp, err := purgeService.Purge(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "expired: %d\nrevoked: %d\n", resp.Expired, resp.Revoked)
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	accountservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/account"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AccountServer struct {
+	pb.UnimplementedAccountServiceServer
+
+	tracer         trace.Tracer
+	accountService accountservice.IService
+	tokenService   tokenservice.IService
+	proofVerifier  *pkgauth.ProofVerifier
+}
+
+func NewAccountServer(accountService accountservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier) (*AccountServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &AccountServer{
+		accountService: accountService,
+		tokenService:   tokenService,
+		proofVerifier:  proofVerifier,
+		tracer:         tracer,
+	}, nil
+}
+
+func (s *AccountServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordReset_Request) (*pb.RequestPasswordReset_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RequestPasswordReset")
+	defer span.End()
+
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email required")
+	}
+
+	err := s.accountService.RequestPasswordReset(ctx, accountservice.RequestPasswordResetRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to request password reset")
+	}
+
+	return &pb.RequestPasswordReset_Response{}, nil
+}
+
+func (s *AccountServer) ResetPassword(ctx context.Context, req *pb.ResetPassword_Request) (*pb.ResetPassword_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ResetPassword")
+	defer span.End()
+
+	err := s.accountService.ResetPassword(ctx, accountservice.ResetPasswordRequest{
+		Token:    req.Token,
+		Password: req.NewPassword,
+	})
+	if err != nil {
+		return nil, accountErrorToStatus(err, "failed to reset password")
+	}
+
+	return &pb.ResetPassword_Response{}, nil
+}
+
+func (s *AccountServer) SendVerificationEmail(ctx context.Context, _ *pb.SendVerificationEmail_Request) (*pb.SendVerificationEmail_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "SendVerificationEmail")
+	defer span.End()
+
+	claims, err := authenticate(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.accountService.SendVerificationEmail(ctx, claims.Subject); err != nil {
+		return nil, accountErrorToStatus(err, "failed to send verification email")
+	}
+
+	return &pb.SendVerificationEmail_Response{}, nil
+}
+
+func (s *AccountServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmail_Request) (*pb.VerifyEmail_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	if err := s.accountService.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, accountErrorToStatus(err, "failed to verify email")
+	}
+
+	return &pb.VerifyEmail_Response{}, nil
+}
+
+func accountErrorToStatus(err error, msg string) error {
+	if errors.Is(err, accountservice.ErrInvalidToken) {
+		return status.Error(codes.InvalidArgument, "invalid or expired token")
+	}
+	if errors.Is(err, accountservice.ErrInvalidPassword) {
+		return status.Errorf(codes.InvalidArgument, "password must be %d to %d characters", accountservice.MinPasswordLength, accountservice.MaxPasswordLength)
+	}
+	if errors.Is(err, accountservice.ErrEmailAlreadyVerified) {
+		return status.Error(codes.FailedPrecondition, "email already verified")
+	}
+
+	return status.Error(codes.Internal, msg)
+}
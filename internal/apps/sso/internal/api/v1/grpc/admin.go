@@ -0,0 +1,203 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	autheventserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/authevent"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+
+	tracer          trace.Tracer
+	throttleService throttleservice.IService
+	tokenService    tokenservice.IService
+	proofVerifier   *pkgauth.ProofVerifier
+	oidcService     oidcservice.IService
+	auditService    auditservice.IService
+}
+
+func NewAdminServer(throttleService throttleservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService, auditService auditservice.IService) (*AdminServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &AdminServer{
+		throttleService: throttleService,
+		tokenService:    tokenService,
+		proofVerifier:   proofVerifier,
+		oidcService:     oidcService,
+		auditService:    auditService,
+		tracer:          tracer,
+	}, nil
+}
+
+func (s *AdminServer) UnlockAccount(ctx context.Context, req *pb.UnlockAccount_Request) (*pb.UnlockAccount_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "UnlockAccount")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	if req.GetUsername() == "" && req.GetIp() == "" {
+		return nil, status.Error(codes.InvalidArgument, "username or ip required")
+	}
+
+	err := s.throttleService.Unlock(ctx, throttleservice.UnlockRequest{
+		Email: req.GetUsername(),
+		IP:    req.GetIp(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to unlock account")
+	}
+
+	return &pb.UnlockAccount_Response{}, nil
+}
+
+func (s *AdminServer) ListAuthEvents(ctx context.Context, req *pb.ListAuthEvents_Request) (*pb.ListAuthEvents_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ListAuthEvents")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+
+	types := make([]authevententity.Type, 0, len(req.Types))
+	for _, eventType := range req.Types {
+		types = append(types, authevententity.Type(eventType))
+	}
+
+	var since, until time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+	if req.Until != nil {
+		until = req.Until.AsTime()
+	}
+
+	resp, err := s.auditService.List(ctx, auditservice.ListRequest{
+		Types:     types,
+		Subject:   req.GetSubject(),
+		ClientID:  req.GetClientId(),
+		IP:        req.GetIp(),
+		Outcome:   authevententity.Outcome(req.GetOutcome()),
+		Since:     since,
+		Until:     until,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, auditservice.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		return nil, status.Error(codes.Internal, "failed to list auth events")
+	}
+
+	pbEvents := make([]*pb.AuthEvent, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		pbEvents = append(pbEvents, autheventserializer.EntityToPb(event))
+	}
+
+	return &pb.ListAuthEvents_Response{
+		Events:        pbEvents,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// authenticate returns the claims of the access token the call carries.
+func authenticate(ctx context.Context, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier) (authservice.AccessTokenClaims, error) {
+	accessToken, err := accessTokenRequest(ctx, proofVerifier)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, err
+	}
+
+	claims, err := tokenService.VerifyAccessToken(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrInvalidToken) {
+			return authservice.AccessTokenClaims{}, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return authservice.AccessTokenClaims{}, status.Error(codes.Internal, "failed to verify access token")
+	}
+
+	return claims, nil
+}
+
+// authenticateOwner is authenticate for the methods that manage the
+// credentials, keys and sessions of the caller. Impersonation tokens are
+// refused there, since whatever an admin set up while acting as a member
+// would outlive the short-lived impersonation and escape its audit. So are
+// tokens of API keys, so that a leaked key cannot mint or revoke keys.
+func authenticateOwner(ctx context.Context, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier) (authservice.AccessTokenClaims, error) {
+	claims, err := authenticate(ctx, tokenService, proofVerifier)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, err
+	}
+
+	if claims.Actor != nil {
+		return authservice.AccessTokenClaims{}, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+	if claims.APIKeyID != "" {
+		return authservice.AccessTokenClaims{}, status.Error(codes.PermissionDenied, "not allowed with an api key")
+	}
+
+	return claims, nil
+}
+
+// authenticateAdmin is authenticate followed by requireAdmin.
+func authenticateAdmin(ctx context.Context, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService) (oidcservice.UserInfo, error) {
+	claims, err := authenticate(ctx, tokenService, proofVerifier)
+	if err != nil {
+		return oidcservice.UserInfo{}, err
+	}
+
+	return requireAdmin(ctx, claims, oidcService)
+}
+
+// requireAdmin lets the call through only when the verified claims were
+// issued to an admin with the admin scope, and the user still has the admin
+// role. It returns that user. Tokens of API keys are refused even if their
+// owner is an admin.
+func requireAdmin(ctx context.Context, claims authservice.AccessTokenClaims, oidcService oidcservice.IService) (oidcservice.UserInfo, error) {
+	if claims.APIKeyID != "" {
+		return oidcservice.UserInfo{}, status.Error(codes.PermissionDenied, "not allowed with an api key")
+	}
+
+	if claims.Role != string(usermodel.RoleAdmin) || !slices.Contains(claims.Scopes(), authservice.ScopeAdmin) {
+		return oidcservice.UserInfo{}, status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	userInfo, err := oidcService.GetUserInfoBySubject(ctx, claims.Subject)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return oidcservice.UserInfo{}, status.Error(codes.PermissionDenied, "admin role required")
+		}
+		return oidcservice.UserInfo{}, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	// The role may have been taken away since the token was issued.
+	if userInfo.Role != string(usermodel.RoleAdmin) {
+		return oidcservice.UserInfo{}, status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	return userInfo, nil
+}
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	apikeyserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/apikey"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type APIKeyServer struct {
+	pb.UnimplementedAPIKeyServiceServer
+
+	tracer        trace.Tracer
+	apiKeyService apikeyservice.IService
+	tokenService  tokenservice.IService
+	proofVerifier *pkgauth.ProofVerifier
+	oidcService   oidcservice.IService
+}
+
+func NewAPIKeyServer(apiKeyService apikeyservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService) (*APIKeyServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &APIKeyServer{
+		apiKeyService: apiKeyService,
+		tokenService:  tokenService,
+		proofVerifier: proofVerifier,
+		oidcService:   oidcService,
+		tracer:        tracer,
+	}, nil
+}
+
+func (s *APIKeyServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKey_Request) (*pb.CreateAPIKey_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "CreateAPIKey")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users create keys acting as themselves. Only admins create keys acting
+	// as a client.
+	owner := apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: claims.Subject}
+	if req.OwnedByClient {
+		if _, err := requireAdmin(ctx, claims, s.oidcService); err != nil {
+			return nil, err
+		}
+		owner = apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: req.ClientId}
+	}
+
+	resp, err := s.apiKeyService.Create(ctx, apikeyservice.CreateRequest{
+		Name:     req.Name,
+		Owner:    owner,
+		ClientID: req.ClientId,
+		Scopes:   req.Scopes,
+		TTL:      time.Duration(req.TtlSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, apiKeyErrorToStatus(err, "failed to create api key")
+	}
+
+	return &pb.CreateAPIKey_Response{
+		Key:    apikeyserializer.EntityToPb(resp.Key),
+		ApiKey: resp.APIKey,
+	}, nil
+}
+
+func (s *APIKeyServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeys_Request) (*pb.ListAPIKeys_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ListAPIKeys")
+	defer span.End()
+
+	claims, err := authenticate(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.APIKeyID != "" {
+		return nil, status.Error(codes.PermissionDenied, "not allowed with an api key")
+	}
+
+	// Only admins may list the keys of clients and other users.
+	owner := apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: claims.Subject}
+	switch {
+	case req.ClientId != nil:
+		owner = apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: req.GetClientId()}
+	case req.UserId != nil && req.GetUserId() != claims.Subject:
+		owner.ID = req.GetUserId()
+	}
+
+	if owner.Type != apikeyentity.OwnerTypeUser || owner.ID != claims.Subject {
+		if _, err := requireAdmin(ctx, claims, s.oidcService); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := s.apiKeyService.List(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	pbKeys := make([]*pb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, apikeyserializer.EntityToPb(key))
+	}
+
+	return &pb.ListAPIKeys_Response{
+		Keys: pbKeys,
+	}, nil
+}
+
+func (s *APIKeyServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKey_Request) (*pb.RevokeAPIKey_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RevokeAPIKey")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins may revoke any key, everyone else only their own.
+	owner := apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: claims.Subject}
+	if claims.Role == string(usermodel.RoleAdmin) {
+		if _, err := requireAdmin(ctx, claims, s.oidcService); err != nil {
+			return nil, err
+		}
+		owner = apikeyentity.Owner{}
+	}
+
+	err = s.apiKeyService.Revoke(ctx, apikeyservice.RevokeRequest{
+		ID:    req.ApiKeyId,
+		Owner: owner,
+	})
+	if err != nil {
+		return nil, apiKeyErrorToStatus(err, "failed to revoke api key")
+	}
+
+	return &pb.RevokeAPIKey_Response{}, nil
+}
+
+func apiKeyErrorToStatus(err error, msg string) error {
+	if errors.Is(err, apikeyservice.ErrInvalidKeyRequest) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, apikeyservice.ErrKeyNotFound) {
+		return status.Error(codes.NotFound, "api key not found")
+	}
+	return status.Error(codes.Internal, msg)
+}
@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	clientserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/client"
+	clientservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/client"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ClientServer struct {
+	pb.UnimplementedClientServiceServer
+
+	tracer        trace.Tracer
+	clientService clientservice.IService
+	tokenService  tokenservice.IService
+	proofVerifier *pkgauth.ProofVerifier
+	oidcService   oidcservice.IService
+}
+
+func NewClientServer(clientService clientservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService) (*ClientServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &ClientServer{
+		clientService: clientService,
+		tokenService:  tokenService,
+		proofVerifier: proofVerifier,
+		oidcService:   oidcService,
+		tracer:        tracer,
+	}, nil
+}
+
+func (s *ClientServer) CreateClient(ctx context.Context, req *pb.CreateClient_Request) (*pb.CreateClient_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "CreateClient")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.clientService.Create(ctx, clientservice.CreateRequest{
+		Name:            req.Name,
+		Confidential:    req.Confidential,
+		GrantTypes:      req.GrantTypes,
+		RedirectURIs:    req.RedirectUris,
+		Scopes:          req.Scopes,
+		AccessTokenTTL:  time.Duration(req.AccessTokenTtlSeconds) * time.Second,
+		RefreshTokenTTL: time.Duration(req.RefreshTokenTtlSeconds) * time.Second,
+	})
+	if err != nil {
+		if errors.Is(err, clientservice.ErrInvalidClientMetadata) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to create client")
+	}
+
+	pbResp := &pb.CreateClient_Response{
+		Client: clientserializer.EntityToPb(resp.Client),
+	}
+	if resp.Secret != "" {
+		pbResp.ClientSecret = &resp.Secret
+	}
+
+	return pbResp, nil
+}
+
+func (s *ClientServer) ListClients(ctx context.Context, req *pb.ListClients_Request) (*pb.ListClients_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ListClients")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	clients, err := s.clientService.List(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list clients")
+	}
+
+	pbClients := make([]*pb.Client, 0, len(clients))
+	for _, client := range clients {
+		pbClients = append(pbClients, clientserializer.EntityToPb(client))
+	}
+
+	return &pb.ListClients_Response{
+		Clients: pbClients,
+	}, nil
+}
+
+func (s *ClientServer) RotateClientSecret(ctx context.Context, req *pb.RotateClientSecret_Request) (*pb.RotateClientSecret_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RotateClientSecret")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.clientService.RotateSecret(ctx, clientservice.RotateSecretRequest{
+		ClientID:    req.ClientId,
+		GracePeriod: time.Duration(req.GracePeriodSeconds) * time.Second,
+	})
+	if err != nil {
+		if errors.Is(err, clientservice.ErrClientNotFound) {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		if errors.Is(err, clientservice.ErrPublicClient) {
+			return nil, status.Error(codes.FailedPrecondition, "public clients have no secret")
+		}
+		if errors.Is(err, clientservice.ErrInvalidClientMetadata) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to rotate client secret")
+	}
+
+	return &pb.RotateClientSecret_Response{
+		ClientSecret: resp.Secret,
+	}, nil
+}
+
+func (s *ClientServer) DisableClient(ctx context.Context, req *pb.DisableClient_Request) (*pb.DisableClient_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "DisableClient")
+	defer span.End()
+
+	if _, err := authenticateAdmin(ctx, s.tokenService, s.proofVerifier, s.oidcService); err != nil {
+		return nil, err
+	}
+
+	err := s.clientService.Disable(ctx, req.ClientId)
+	if err != nil {
+		if errors.Is(err, clientservice.ErrClientNotFound) {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to disable client")
+	}
+
+	return &pb.DisableClient_Response{}, nil
+}
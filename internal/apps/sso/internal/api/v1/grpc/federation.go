@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FederationServer struct {
+	pb.UnimplementedFederationServiceServer
+
+	tracer            trace.Tracer
+	authService       authservice.IService
+	federationService federationservice.IService
+}
+
+func NewFederationServer(authService authservice.IService, federationService federationservice.IService) (*FederationServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &FederationServer{
+		authService:       authService,
+		federationService: federationService,
+		tracer:            tracer,
+	}, nil
+}
+
+func (s *FederationServer) ListIdentityProviders(ctx context.Context, req *pb.ListIdentityProviders_Request) (*pb.ListIdentityProviders_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ListIdentityProviders")
+	defer span.End()
+
+	providers := s.federationService.ListProviders(ctx)
+
+	pbProviders := make([]*pb.IdentityProvider, 0, len(providers))
+	for _, provider := range providers {
+		pbProviders = append(pbProviders, &pb.IdentityProvider{
+			Id:   provider.ID,
+			Name: provider.Name,
+		})
+	}
+
+	return &pb.ListIdentityProviders_Response{
+		Providers: pbProviders,
+	}, nil
+}
+
+func (s *FederationServer) StartFederation(ctx context.Context, req *pb.StartFederation_Request) (*pb.StartFederation_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "StartFederation")
+	defer span.End()
+
+	resp, err := s.authService.StartFederation(ctx, authservice.StartFederationRequest{
+		Provider: req.Provider,
+		Authorization: authservice.AuthorizeRequest{
+			ClientID:            req.ClientId,
+			RedirectURI:         req.RedirectUri,
+			ResponseType:        req.ResponseType,
+			Scopes:              strings.Fields(req.Scope),
+			State:               req.State,
+			Nonce:               req.Nonce,
+			CodeChallenge:       req.CodeChallenge,
+			CodeChallengeMethod: req.CodeChallengeMethod,
+		},
+	})
+	if err != nil {
+		return nil, federationErrorToStatus(err, "failed to start federated sign in")
+	}
+
+	return &pb.StartFederation_Response{
+		AuthorizationUrl: resp.AuthorizationURL,
+	}, nil
+}
+
+func (s *FederationServer) CompleteFederation(ctx context.Context, req *pb.CompleteFederation_Request) (*pb.CompleteFederation_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "CompleteFederation")
+	defer span.End()
+
+	device := deviceFromContext(ctx)
+
+	resp, err := s.authService.CompleteFederation(ctx, authservice.CompleteFederationRequest{
+		State:     req.State,
+		Code:      req.Code,
+		IP:        device.IP,
+		UserAgent: device.Name,
+	})
+	if err != nil {
+		return nil, federationErrorToStatus(err, "failed to complete federated sign in")
+	}
+
+	return &pb.CompleteFederation_Response{
+		Code:        resp.Code,
+		RedirectUri: resp.RedirectURI,
+		State:       resp.State,
+	}, nil
+}
+
+func federationErrorToStatus(err error, msg string) error {
+	// As on the authorization endpoint, client errors describe a malformed
+	// request rather than bad credentials.
+	if errors.Is(err, authservice.ErrInvalidClient) {
+		return status.Error(codes.InvalidArgument, "invalid client")
+	}
+	if errors.Is(err, authservice.ErrUnauthorizedClient) {
+		return status.Error(codes.InvalidArgument, "unauthorized client")
+	}
+	if errors.Is(err, federationservice.ErrUnknownProvider) {
+		return status.Error(codes.NotFound, "unknown identity provider")
+	}
+	if errors.Is(err, federationservice.ErrInvalidState) {
+		return status.Error(codes.InvalidArgument, "invalid federation state")
+	}
+	if errors.Is(err, federationservice.ErrMissingEmail) {
+		return status.Error(codes.FailedPrecondition, "identity provider did not return an email address")
+	}
+	if errors.Is(err, federationservice.ErrAccountConflict) {
+		return status.Error(codes.FailedPrecondition, "account with the same email address exists")
+	}
+	if errors.Is(err, oidcclient.ErrDiscovery) || errors.Is(err, oidcclient.ErrIssuerMismatch) {
+		return status.Error(codes.Unavailable, "identity provider unavailable")
+	}
+	if errors.Is(err, oidcclient.ErrExchange) || errors.Is(err, oidcclient.ErrMissingIDToken) ||
+		errors.Is(err, oidcclient.ErrInvalidIDToken) || errors.Is(err, oidcclient.ErrUnknownKey) {
+		return status.Error(codes.Unauthenticated, "identity provider sign in failed")
+	}
+	return authErrorToStatus(err, msg)
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MFAServer struct {
+	pb.UnimplementedMFAServiceServer
+
+	tracer        trace.Tracer
+	mfaService    mfaservice.IService
+	tokenService  tokenservice.IService
+	proofVerifier *pkgauth.ProofVerifier
+	oidcService   oidcservice.IService
+}
+
+func NewMFAServer(mfaService mfaservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService) (*MFAServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &MFAServer{
+		mfaService:    mfaService,
+		tokenService:  tokenService,
+		proofVerifier: proofVerifier,
+		oidcService:   oidcService,
+		tracer:        tracer,
+	}, nil
+}
+
+func (s *MFAServer) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTP_Request) (*pb.EnrollTOTP_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "EnrollTOTP")
+	defer span.End()
+
+	userID, err := s.authenticate(ctx, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.mfaService.Enroll(ctx, mfaservice.EnrollRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, mfaErrorToStatus(err, "failed to enroll totp")
+	}
+
+	return &pb.EnrollTOTP_Response{
+		Secret:          resp.Secret,
+		ProvisioningUri: resp.ProvisioningURI,
+		QrPayload:       resp.QRPayload,
+	}, nil
+}
+
+func (s *MFAServer) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTP_Request) (*pb.ConfirmTOTP_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ConfirmTOTP")
+	defer span.End()
+
+	userID, err := s.authenticate(ctx, req.GetMfaToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.mfaService.Confirm(ctx, mfaservice.ConfirmRequest{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		return nil, mfaErrorToStatus(err, "failed to confirm totp")
+	}
+
+	return &pb.ConfirmTOTP_Response{
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+func (s *MFAServer) DisableTOTP(ctx context.Context, req *pb.DisableTOTP_Request) (*pb.DisableTOTP_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "DisableTOTP")
+	defer span.End()
+
+	userInfo, err := s.userInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.mfaService.Disable(ctx, mfaservice.DisableRequest{
+		UserID: userInfo.Subject,
+		Role:   usermodel.Role(userInfo.Role),
+		Code:   req.Code,
+	})
+	if err != nil {
+		return nil, mfaErrorToStatus(err, "failed to disable totp")
+	}
+
+	return &pb.DisableTOTP_Response{}, nil
+}
+
+func (s *MFAServer) RegenerateRecoveryCodes(ctx context.Context, req *pb.RegenerateRecoveryCodes_Request) (*pb.RegenerateRecoveryCodes_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RegenerateRecoveryCodes")
+	defer span.End()
+
+	userInfo, err := s.userInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.mfaService.RegenerateRecoveryCodes(ctx, mfaservice.RegenerateRecoveryCodesRequest{
+		UserID: userInfo.Subject,
+		Code:   req.Code,
+	})
+	if err != nil {
+		return nil, mfaErrorToStatus(err, "failed to regenerate recovery codes")
+	}
+
+	return &pb.RegenerateRecoveryCodes_Response{
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+// authenticate returns the user the call is made for. Users who have to
+// enroll before they can sign in present the token of their MFA challenge
+// instead of an access token.
+func (s *MFAServer) authenticate(ctx context.Context, mfaToken string) (string, error) {
+	if mfaToken == "" {
+		userInfo, err := s.userInfo(ctx)
+		if err != nil {
+			return "", err
+		}
+		return userInfo.Subject, nil
+	}
+
+	challenge, err := s.mfaService.GetChallenge(ctx, mfaToken)
+	if err != nil {
+		if errors.Is(err, mfaservice.ErrInvalidChallenge) {
+			return "", status.Error(codes.Unauthenticated, "invalid mfa token")
+		}
+		return "", status.Error(codes.Internal, "failed to get mfa challenge")
+	}
+
+	return challenge.UserID, nil
+}
+
+func (s *MFAServer) userInfo(ctx context.Context) (oidcservice.UserInfo, error) {
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return oidcservice.UserInfo{}, err
+	}
+
+	userInfo, err := s.oidcService.GetUserInfoBySubject(ctx, claims.Subject)
+	if err != nil {
+		return oidcservice.UserInfo{}, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	return userInfo, nil
+}
+
+func mfaErrorToStatus(err error, msg string) error {
+	if errors.Is(err, mfaservice.ErrAlreadyEnrolled) {
+		return status.Error(codes.AlreadyExists, "totp already enrolled")
+	}
+	if errors.Is(err, mfaservice.ErrNotEnrolled) {
+		return status.Error(codes.FailedPrecondition, "totp not enrolled")
+	}
+	if errors.Is(err, mfaservice.ErrInvalidCode) {
+		return status.Error(codes.Unauthenticated, "invalid one-time password")
+	}
+	if errors.Is(err, mfaservice.ErrMFAMandatory) {
+		return status.Error(codes.PermissionDenied, "mfa is mandatory for your role")
+	}
+	if errors.Is(err, mfaservice.ErrInvalidChallenge) {
+		return status.Error(codes.Unauthenticated, "invalid mfa token")
+	}
+	if errors.Is(err, mfaservice.ErrTooManyAttempts) {
+		return status.Error(codes.Unauthenticated, "too many mfa attempts")
+	}
+	return status.Error(codes.Internal, msg)
+}
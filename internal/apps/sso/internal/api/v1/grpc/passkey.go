@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	passkeyserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/passkey"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PasskeyServer struct {
+	pb.UnimplementedPasskeyServiceServer
+
+	tracer         trace.Tracer
+	passkeyService passkeyservice.IService
+	tokenService   tokenservice.IService
+	proofVerifier  *pkgauth.ProofVerifier
+}
+
+func NewPasskeyServer(passkeyService passkeyservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier) (*PasskeyServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &PasskeyServer{
+		passkeyService: passkeyService,
+		tokenService:   tokenService,
+		proofVerifier:  proofVerifier,
+		tracer:         tracer,
+	}, nil
+}
+
+func (s *PasskeyServer) BeginPasskeyRegistration(ctx context.Context, req *pb.BeginPasskeyRegistration_Request) (*pb.BeginPasskeyRegistration_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "BeginPasskeyRegistration")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.passkeyService.BeginRegistration(ctx, claims.Subject)
+	if err != nil {
+		return nil, passkeyErrorToStatus(err, "failed to begin passkey registration")
+	}
+
+	return &pb.BeginPasskeyRegistration_Response{
+		PasskeyToken: resp.Token,
+		Options:      string(resp.Options),
+		ExpiresIn:    int64(time.Until(resp.ExpiresAt).Round(time.Second).Seconds()),
+	}, nil
+}
+
+func (s *PasskeyServer) FinishPasskeyRegistration(ctx context.Context, req *pb.FinishPasskeyRegistration_Request) (*pb.FinishPasskeyRegistration_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "FinishPasskeyRegistration")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.passkeyService.FinishRegistration(ctx, passkeyservice.FinishRegistrationRequest{
+		Token:      req.PasskeyToken,
+		UserID:     claims.Subject,
+		Credential: []byte(req.Credential),
+	})
+	if err != nil {
+		return nil, passkeyErrorToStatus(err, "failed to finish passkey registration")
+	}
+
+	return &pb.FinishPasskeyRegistration_Response{
+		Passkey: passkeyserializer.EntityToPb(credential),
+	}, nil
+}
+
+func passkeyErrorToStatus(err error, msg string) error {
+	if errors.Is(err, passkeyservice.ErrInvalidCeremony) {
+		return status.Error(codes.InvalidArgument, "invalid passkey token")
+	}
+	if errors.Is(err, passkeyservice.ErrInvalidCredential) {
+		return status.Error(codes.InvalidArgument, "invalid passkey credential")
+	}
+	if errors.Is(err, passkeyservice.ErrCredentialExists) {
+		return status.Error(codes.AlreadyExists, "passkey already registered")
+	}
+	return status.Error(codes.Internal, msg)
+}
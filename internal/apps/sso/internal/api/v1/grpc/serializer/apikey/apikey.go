@@ -0,0 +1,27 @@
+package apikey
+
+import (
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EntityToPb(key apikeyentity.Key) *pb.APIKey {
+	pbKey := &pb.APIKey{
+		ApiKeyId:  key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		OwnerType: string(key.Owner.Type),
+		OwnerId:   key.Owner.ID,
+		ClientId:  key.ClientID,
+		Scopes:    key.Scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+		ExpiresAt: timestamppb.New(key.ExpiresAt),
+	}
+
+	if !key.LastUsedAt.IsZero() {
+		pbKey.LastUsedAt = timestamppb.New(key.LastUsedAt)
+	}
+
+	return pbKey
+}
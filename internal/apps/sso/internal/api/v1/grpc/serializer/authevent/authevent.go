@@ -0,0 +1,22 @@
+package authevent
+
+import (
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EntityToPb(event authevententity.Event) *pb.AuthEvent {
+	return &pb.AuthEvent{
+		Id:        event.ID,
+		Type:      string(event.Type),
+		Subject:   event.Subject,
+		Actor:     event.Actor,
+		ClientId:  event.ClientID,
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		Outcome:   string(event.Outcome),
+		Reason:    event.Reason,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
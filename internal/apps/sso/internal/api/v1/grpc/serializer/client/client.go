@@ -0,0 +1,24 @@
+package client
+
+import (
+	"time"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EntityToPb(client cliententity.Client) *pb.Client {
+	return &pb.Client{
+		ClientId:               client.ID,
+		Name:                   client.Name,
+		Confidential:           client.Confidential,
+		GrantTypes:             client.GrantTypes,
+		RedirectUris:           client.RedirectURIs,
+		Scopes:                 client.Scopes,
+		AccessTokenTtlSeconds:  int64(client.AccessTokenTTL / time.Second),
+		RefreshTokenTtlSeconds: int64(client.RefreshTokenTTL / time.Second),
+		Disabled:               client.Disabled,
+		CreatedAt:              timestamppb.New(client.CreatedAt),
+	}
+}
@@ -1,55 +1,81 @@
 package key
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
-	"crypto/x509"
-	"encoding/pem"
-	"errors"
+	"encoding/base64"
+	"math/big"
 
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	"github.com/pkg/errors"
 )
 
-func PbToEntity(pbKey *pb.Key) (*keyentity.Key, error) {
-	return &keyentity.Key{
-		ID:        pbKey.Kid,
-		Public:    pbKey.Public,
-		Algorithm: pbKey.Algorithm,
-		Active:    pbKey.Active,
-	}, nil
-}
+const (
+	KeyTypeRSA = "RSA"
+	KeyTypeEC  = "EC"
+	KeyTypeOKP = "OKP"
+
+	CurveP256    = "P-256"
+	CurveEd25519 = "Ed25519"
+
+	KeyUseSignature = "sig"
+)
+
+var (
+	ErrUnsupportedKeyType = errors.New("unsupported public key type")
+)
 
 func EntityToPb(entityKey *keyentity.Key) (*pb.Key, error) {
-	privateKey, ok := entityKey.Private.(*rsa.PrivateKey)
-	if !ok {
-		return nil, errors.New("private key is not RSA")
+	key := &pb.Key{
+		Kid: entityKey.ID,
+		Alg: entityKey.Algorithm,
+		Use: KeyUseSignature,
 	}
 
-	privatePEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
-	})
+	switch publicKey := entityKey.Public.(type) {
+	case *rsa.PublicKey:
+		n := encodeBigInt(publicKey.N)
+		e := encodeBigInt(big.NewInt(int64(publicKey.E)))
 
-	publicKey, ok := entityKey.Public.(*rsa.PublicKey)
-	if !ok {
-		return nil, errors.New("public key is not RSA")
-	}
+		key.Kty = KeyTypeRSA
+		key.N = &n
+		key.E = &e
+	case *ecdsa.PublicKey:
+		if publicKey.Curve != elliptic.P256() {
+			return nil, ErrUnsupportedKeyType
+		}
 
-	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
-	if err != nil {
-		return nil, err
+		// RFC 7518 requires the coordinates to be the full size of the curve,
+		// leading zeros included.
+		crv := CurveP256
+		x := encodeFixed(publicKey.X, 32)
+		y := encodeFixed(publicKey.Y, 32)
+
+		key.Kty = KeyTypeEC
+		key.Crv = &crv
+		key.X = &x
+		key.Y = &y
+	case ed25519.PublicKey:
+		crv := CurveEd25519
+		x := base64.RawURLEncoding.EncodeToString(publicKey)
+
+		key.Kty = KeyTypeOKP
+		key.Crv = &crv
+		key.X = &x
+	default:
+		return nil, ErrUnsupportedKeyType
 	}
 
-	publicPEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: publicDER,
-	})
-
-	return &pb.Key{
-		Kid:       entityKey.ID,
-		Private:   string(privatePEM),
-		Public:    string(publicPEM),
-		Algorithm: entityKey.Algorithm,
-		Active:    entityKey.Active,
-	}, nil
+	return key, nil
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func encodeFixed(i *big.Int, size int) string {
+	return base64.RawURLEncoding.EncodeToString(i.FillBytes(make([]byte, size)))
 }
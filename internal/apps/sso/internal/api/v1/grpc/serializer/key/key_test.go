@@ -0,0 +1,116 @@
+package key
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityToPb(t *testing.T) {
+	t.Run("should serialize RSA public key as JWK", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: "RS256",
+			State:     keyentity.StateActive,
+		}
+
+		pbKey, err := EntityToPb(&key)
+		require.NoError(t, err)
+		assert.Equal(t, "key-123", pbKey.Kid)
+		assert.Equal(t, "RS256", pbKey.Alg)
+		assert.Equal(t, KeyTypeRSA, pbKey.Kty)
+		assert.Equal(t, KeyUseSignature, pbKey.Use)
+
+		n, err := base64.RawURLEncoding.DecodeString(pbKey.GetN())
+		require.NoError(t, err)
+		assert.Equal(t, privateKey.N, new(big.Int).SetBytes(n))
+
+		e, err := base64.RawURLEncoding.DecodeString(pbKey.GetE())
+		require.NoError(t, err)
+		assert.Equal(t, int64(privateKey.E), new(big.Int).SetBytes(e).Int64())
+		assert.Equal(t, "AQAB", pbKey.GetE())
+	})
+
+	t.Run("should serialize P-256 public key as JWK", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: keyentity.AlgorithmES256,
+			State:     keyentity.StateActive,
+		}
+
+		pbKey, err := EntityToPb(&key)
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.AlgorithmES256, pbKey.Alg)
+		assert.Equal(t, KeyTypeEC, pbKey.Kty)
+		assert.Equal(t, CurveP256, pbKey.GetCrv())
+		assert.Nil(t, pbKey.N)
+		assert.Nil(t, pbKey.E)
+
+		x, err := base64.RawURLEncoding.DecodeString(pbKey.GetX())
+		require.NoError(t, err)
+		assert.Len(t, x, 32)
+		assert.Equal(t, privateKey.X, new(big.Int).SetBytes(x))
+
+		y, err := base64.RawURLEncoding.DecodeString(pbKey.GetY())
+		require.NoError(t, err)
+		assert.Len(t, y, 32)
+		assert.Equal(t, privateKey.Y, new(big.Int).SetBytes(y))
+	})
+
+	t.Run("should serialize Ed25519 public key as JWK", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Private:   privateKey,
+			Public:    publicKey,
+			Algorithm: keyentity.AlgorithmEdDSA,
+			State:     keyentity.StateActive,
+		}
+
+		pbKey, err := EntityToPb(&key)
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.AlgorithmEdDSA, pbKey.Alg)
+		assert.Equal(t, KeyTypeOKP, pbKey.Kty)
+		assert.Equal(t, CurveEd25519, pbKey.GetCrv())
+		assert.Nil(t, pbKey.Y)
+
+		x, err := base64.RawURLEncoding.DecodeString(pbKey.GetX())
+		require.NoError(t, err)
+		assert.Equal(t, []byte(publicKey), x)
+	})
+
+	t.Run("should return error for unsupported key type", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Public:    privateKey.Public(),
+			Algorithm: "ES384",
+		}
+
+		_, err = EntityToPb(&key)
+		assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+	})
+}
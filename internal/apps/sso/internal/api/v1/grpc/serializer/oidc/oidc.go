@@ -0,0 +1,55 @@
+package oidc
+
+import (
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+)
+
+func ConfigurationToPb(cfg oidcservice.Configuration) *pb.GetOpenIDConfiguration_Response {
+	return &pb.GetOpenIDConfiguration_Response{
+		Issuer:                            cfg.Issuer,
+		AuthorizationEndpoint:             cfg.AuthorizationEndpoint,
+		TokenEndpoint:                     cfg.TokenEndpoint,
+		UserinfoEndpoint:                  cfg.UserInfoEndpoint,
+		JwksUri:                           cfg.JWKSURI,
+		RevocationEndpoint:                cfg.RevocationEndpoint,
+		IntrospectionEndpoint:             cfg.IntrospectionEndpoint,
+		ScopesSupported:                   cfg.ScopesSupported,
+		ResponseTypesSupported:            cfg.ResponseTypesSupported,
+		GrantTypesSupported:               cfg.GrantTypesSupported,
+		SubjectTypesSupported:             cfg.SubjectTypesSupported,
+		IdTokenSigningAlgValuesSupported:  cfg.IDTokenSigningAlgValuesSupported,
+		TokenEndpointAuthMethodsSupported: cfg.TokenEndpointAuthMethodsSupported,
+		ClaimsSupported:                   cfg.ClaimsSupported,
+		CodeChallengeMethodsSupported:     cfg.CodeChallengeMethodsSupported,
+		DeviceAuthorizationEndpoint:       cfg.DeviceAuthorizationEndpoint,
+	}
+}
+
+func UserInfoToPb(userInfo oidcservice.UserInfo) *pb.GetUserInfo_Response {
+	return &pb.GetUserInfo_Response{
+		Sub:               userInfo.Subject,
+		Email:             optionalString(userInfo.Email),
+		Name:              optionalString(userInfo.Name),
+		PreferredUsername: optionalString(userInfo.PreferredUsername),
+		Role:              optionalString(userInfo.Role),
+		EmailVerified:     emailVerified(userInfo),
+	}
+}
+
+// emailVerified is only reported along with an email.
+func emailVerified(userInfo oidcservice.UserInfo) *bool {
+	if userInfo.Email == "" {
+		return nil
+	}
+
+	return &userInfo.EmailVerified
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
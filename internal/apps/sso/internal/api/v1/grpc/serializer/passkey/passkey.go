@@ -0,0 +1,18 @@
+package passkey
+
+import (
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EntityToPb(credential passkeyentity.Credential) *pb.Passkey {
+	return &pb.Passkey{
+		PasskeyId:      credential.ID,
+		Transports:     credential.Transports,
+		BackupEligible: credential.BackupEligible,
+		BackupState:    credential.BackupState,
+		CreatedAt:      timestamppb.New(credential.CreatedAt),
+		LastUsedAt:     timestamppb.New(credential.LastUsedAt),
+	}
+}
@@ -0,0 +1,21 @@
+package session
+
+import (
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EntityToPb(session sessionentity.Session, current bool) *pb.Session {
+	return &pb.Session{
+		SessionId:  session.ID,
+		ClientId:   session.ClientID,
+		Device:     session.Device.Name,
+		Platform:   session.Device.Platform,
+		AppVersion: session.Device.AppVersion,
+		Ip:         session.Device.IP,
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		LastUsedAt: timestamppb.New(session.LastUsedAt),
+		Current:    current,
+	}
+}
@@ -0,0 +1,49 @@
+package token
+
+import (
+	"strings"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+)
+
+func IntrospectResponseToPb(resp tokenservice.IntrospectResponse) *pb.IntrospectToken_Response {
+	// Inactive tokens must not disclose anything beyond their state (RFC 7662).
+	if !resp.Active {
+		return &pb.IntrospectToken_Response{
+			Active: false,
+		}
+	}
+
+	pbResp := &pb.IntrospectToken_Response{
+		Active:    true,
+		TokenType: optionalString(resp.TokenType),
+		ClientId:  optionalString(resp.ClientID),
+		Sub:       optionalString(resp.Subject),
+		Iss:       optionalString(resp.Issuer),
+		Jti:       optionalString(resp.JTI),
+		Aud:       resp.Audience,
+		Scope:     optionalString(strings.Join(resp.Scopes, " ")),
+		Role:      optionalString(resp.Role),
+		Tenant:    optionalString(resp.Tenant),
+	}
+
+	if !resp.ExpiresAt.IsZero() {
+		exp := resp.ExpiresAt.Unix()
+		pbResp.Exp = &exp
+	}
+	if !resp.IssuedAt.IsZero() {
+		iat := resp.IssuedAt.Unix()
+		pbResp.Iat = &iat
+	}
+
+	return pbResp
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	sessionserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/session"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SessionServer struct {
+	pb.UnimplementedSessionServiceServer
+
+	tracer         trace.Tracer
+	sessionService sessionservice.IService
+	tokenService   tokenservice.IService
+	proofVerifier  *pkgauth.ProofVerifier
+	oidcService    oidcservice.IService
+}
+
+func NewSessionServer(sessionService sessionservice.IService, tokenService tokenservice.IService, proofVerifier *pkgauth.ProofVerifier, oidcService oidcservice.IService) (*SessionServer, error) {
+	tracer := otel.Tracer(GRPCServerPrefix)
+
+	return &SessionServer{
+		sessionService: sessionService,
+		tokenService:   tokenService,
+		proofVerifier:  proofVerifier,
+		oidcService:    oidcService,
+		tracer:         tracer,
+	}, nil
+}
+
+func (s *SessionServer) ListSessions(ctx context.Context, req *pb.ListSessions_Request) (*pb.ListSessions_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "ListSessions")
+	defer span.End()
+
+	claims, err := authenticate(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.targetUser(ctx, claims, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionService.List(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	pbSessions := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		current := claims.SessionID != "" && session.ID == claims.SessionID
+		pbSessions = append(pbSessions, sessionserializer.EntityToPb(session, current))
+	}
+
+	return &pb.ListSessions_Response{
+		Sessions: pbSessions,
+	}, nil
+}
+
+func (s *SessionServer) RevokeSession(ctx context.Context, req *pb.RevokeSession_Request) (*pb.RevokeSession_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RevokeSession")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins may end the session of any user, everyone else only their own.
+	userID := claims.Subject
+	if claims.Role == string(usermodel.RoleAdmin) {
+		if _, err := requireAdmin(ctx, claims, s.oidcService); err != nil {
+			return nil, err
+		}
+		userID = ""
+	}
+
+	err = s.sessionService.Revoke(ctx, sessionservice.RevokeRequest{
+		ID:     req.SessionId,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, sessionservice.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	return &pb.RevokeSession_Response{}, nil
+}
+
+func (s *SessionServer) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessions_Request) (*pb.RevokeAllSessions_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RevokeAllSessions")
+	defer span.End()
+
+	claims, err := authenticateOwner(ctx, s.tokenService, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := s.targetUser(ctx, claims, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var exceptID string
+	if req.KeepCurrent && userID == claims.Subject {
+		exceptID = claims.SessionID
+	}
+
+	revoked, err := s.sessionService.RevokeAll(ctx, sessionservice.RevokeAllRequest{
+		UserID:   userID,
+		ExceptID: exceptID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke sessions")
+	}
+
+	return &pb.RevokeAllSessions_Response{
+		Revoked: int32(revoked),
+	}, nil
+}
+
+// targetUser returns the user whose sessions are managed. Only admins may
+// manage the sessions of other users.
+func (s *SessionServer) targetUser(ctx context.Context, claims authservice.AccessTokenClaims, userID string) (string, error) {
+	if userID == "" || userID == claims.Subject {
+		return claims.Subject, nil
+	}
+
+	if _, err := requireAdmin(ctx, claims, s.oidcService); err != nil {
+		return "", err
+	}
+
+	return userID, nil
+}
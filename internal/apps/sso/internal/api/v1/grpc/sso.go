@@ -2,36 +2,101 @@ package grpc
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
 	keyserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/key"
+	oidcserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/oidc"
+	tokenserializer "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc/serializer/token"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
 	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
 	keyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/key"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
 	GRPCServerPrefix = "kgym.sso.api.grpc"
+
+	HeaderAuthorization = "authorization"
+	HeaderCacheControl  = "cache-control"
+	HeaderETag          = "etag"
+	// HeaderClientIP carries the address of the user agent, as resolved by
+	// the gateway.
+	HeaderClientIP = "x-client-ip"
+	// HeaderDevice, HeaderPlatform and HeaderAppVersion describe the device a
+	// session is started from.
+	HeaderDevice     = "x-device"
+	HeaderPlatform   = "x-platform"
+	HeaderAppVersion = "x-app-version"
+
+	BasicPrefix  = "Basic "
+	BearerPrefix = "Bearer "
+	DPoPPrefix   = "DPoP "
+
+	JWKSCacheMaxAge = 5 * time.Minute
+
+	// ErrorReasonMFARequired is the reason of the error details returned when
+	// a grant needs a second factor. The details carry the mfa_token to
+	// complete the mfa_otp grant with.
+	ErrorReasonMFARequired = "mfa_required"
+	ErrorDomain            = "sso.kgym"
+
+	// ErrorReasonAuthorizationPending, ErrorReasonSlowDown,
+	// ErrorReasonAccessDenied and ErrorReasonExpiredToken are the reasons of
+	// the errors answering polls of the device code grant, named after the
+	// error codes of RFC 8628 section 3.5.
+	ErrorReasonAuthorizationPending = "authorization_pending"
+	ErrorReasonSlowDown             = "slow_down"
+	ErrorReasonAccessDenied         = "access_denied"
+	ErrorReasonExpiredToken         = "expired_token"
 )
 
 type SSOServer struct {
 	pb.UnimplementedSSOServiceServer
 
-	tracer      trace.Tracer
-	authService authservice.IService
-	keyService  keyservice.IService
+	tracer        trace.Tracer
+	authService   authservice.IService
+	keyService    keyservice.IService
+	tokenService  tokenservice.IService
+	oidcService   oidcservice.IService
+	proofVerifier *pkgauth.ProofVerifier
 }
 
-func NewSSOServer(authService authservice.IService, keyService keyservice.IService) (*SSOServer, error) {
+func NewSSOServer(authService authservice.IService, keyService keyservice.IService, tokenService tokenservice.IService, oidcService oidcservice.IService, proofVerifier *pkgauth.ProofVerifier) (*SSOServer, error) {
 	tracer := otel.Tracer(GRPCServerPrefix)
 
 	return &SSOServer{
-		authService: authService,
-		keyService:  keyService,
-		tracer:      tracer,
+		authService:   authService,
+		keyService:    keyService,
+		tokenService:  tokenService,
+		oidcService:   oidcService,
+		proofVerifier: proofVerifier,
+		tracer:        tracer,
 	}, nil
 }
 
@@ -39,24 +104,49 @@ func (s *SSOServer) GetToken(ctx context.Context, req *pb.GetToken_Request) (*pb
 	ctx, span := s.tracer.Start(ctx, "GetToken")
 	defer span.End()
 
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	// Tokens are bound to the key of the DPoP proof the request came with,
+	// except those of the token exchange and API key grants: the former are
+	// asked for by admin tools and the latter by the gateway on behalf of
+	// the key holder.
+	jkt, err := s.proofKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenType := pb.TokenType_TOKEN_TYPE_BEARER
+	if jkt != "" {
+		tokenType = pb.TokenType_TOKEN_TYPE_DPOP
+	}
+
 	switch req.Grant.(type) {
 	case *pb.GetToken_Request_PasswordGrant:
 		passwordGrant := req.GetPasswordGrant()
 
 		resp, err := s.authService.PasswordGrant(ctx, authservice.PasswordGrantRequest{
-			Email:    passwordGrant.Username,
-			Password: passwordGrant.Password,
-			ClientID: req.ClientId,
+			Email:        passwordGrant.Username,
+			Password:     passwordGrant.Password,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       strings.Fields(req.Scope),
 		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, "failed to grant password")
+			return nil, authErrorToStatus(err, "failed to grant password")
 		}
 
 		return &pb.GetToken_Response{
 			Token: &pb.Token{
 				AccessToken:  resp.AccessToken,
 				RefreshToken: resp.RefreshToken,
-				TokenType:    pb.TokenType_TOKEN_TYPE_BEARER,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
 			},
 		}, nil
 	case *pb.GetToken_Request_RefreshTokenGrant:
@@ -64,16 +154,228 @@ func (s *SSOServer) GetToken(ctx context.Context, req *pb.GetToken_Request) (*pb
 
 		resp, err := s.authService.RefreshTokenGrant(ctx, authservice.RefreshTokenGrantRequest{
 			RefreshToken: refreshTokenGrant.RefreshToken,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       strings.Fields(req.Scope),
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant refresh token")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:  resp.AccessToken,
+				RefreshToken: resp.RefreshToken,
+				TokenType:    tokenType,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_AuthorizationCodeGrant:
+		authorizationCodeGrant := req.GetAuthorizationCodeGrant()
+
+		resp, err := s.authService.AuthorizationCodeGrant(ctx, authservice.AuthorizationCodeGrantRequest{
+			Code:         authorizationCodeGrant.Code,
+			RedirectURI:  authorizationCodeGrant.RedirectUri,
+			CodeVerifier: authorizationCodeGrant.CodeVerifier,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant authorization code")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:  resp.AccessToken,
+				RefreshToken: resp.RefreshToken,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_MfaOtpGrant:
+		mfaOTPGrant := req.GetMfaOtpGrant()
+
+		resp, err := s.authService.MFAOTPGrant(ctx, authservice.MFAOTPGrantRequest{
+			MFAToken:     mfaOTPGrant.MfaToken,
+			OTP:          mfaOTPGrant.Otp,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant mfa otp")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:  resp.AccessToken,
+				RefreshToken: resp.RefreshToken,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_PasswordlessGrant:
+		passwordlessGrant := req.GetPasswordlessGrant()
+
+		resp, err := s.authService.PasswordlessGrant(ctx, authservice.PasswordlessGrantRequest{
+			Token:        passwordlessGrant.PasswordlessToken,
+			Code:         passwordlessGrant.Code,
+			Link:         passwordlessGrant.LinkToken,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant passwordless")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:  resp.AccessToken,
+				RefreshToken: resp.RefreshToken,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_ClientCredentialsGrant:
+		device := deviceFromContext(ctx)
+
+		resp, err := s.authService.ClientCredentialsGrant(ctx, authservice.ClientCredentialsGrantRequest{
+			IP:           device.IP,
+			UserAgent:    device.Name,
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       strings.Fields(req.Scope),
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant client credentials")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken: resp.AccessToken,
+				TokenType:   tokenType,
+				Scope:       strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_TokenExchangeGrant:
+		tokenExchangeGrant := req.GetTokenExchangeGrant()
+
+		// The subject token is not sent in a header, so a bound one is
+		// exchanged only if the proof of the request is signed by its key.
+		actor, err := s.tokenService.VerifyAccessToken(ctx, tokenservice.VerifyAccessTokenRequest{
+			Token: tokenExchangeGrant.SubjectToken,
+			JKT:   jkt,
+		})
+		if err != nil {
+			if errors.Is(err, tokenservice.ErrInvalidToken) {
+				return nil, status.Error(codes.Unauthenticated, "invalid subject token")
+			}
+			return nil, status.Error(codes.Internal, "failed to verify subject token")
+		}
+
+		device := deviceFromContext(ctx)
+
+		resp, err := s.authService.TokenExchangeGrant(ctx, authservice.TokenExchangeGrantRequest{
+			Actor:            actor,
+			SubjectTokenType: tokenExchangeGrant.SubjectTokenType,
+			RequestedSubject: tokenExchangeGrant.RequestedSubject,
+			IP:               device.IP,
+			UserAgent:        device.Name,
+			ClientID:         clientID,
+			ClientSecret:     clientSecret,
+			Scopes:           strings.Fields(req.Scope),
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant token exchange")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:     resp.AccessToken,
+				TokenType:       pb.TokenType_TOKEN_TYPE_BEARER,
+				Scope:           strings.Join(resp.Scopes, " "),
+				IssuedTokenType: resp.IssuedTokenType,
+			},
+		}, nil
+	case *pb.GetToken_Request_DeviceCodeGrant:
+		deviceCodeGrant := req.GetDeviceCodeGrant()
+
+		resp, err := s.authService.DeviceCodeGrant(ctx, authservice.DeviceCodeGrantRequest{
+			DeviceCode:   deviceCodeGrant.DeviceCode,
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant device code")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken:  resp.AccessToken,
+				RefreshToken: resp.RefreshToken,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_WebauthnGrant:
+		webAuthnGrant := req.GetWebauthnGrant()
+
+		resp, err := s.authService.WebAuthnGrant(ctx, authservice.WebAuthnGrantRequest{
+			Token:        webAuthnGrant.PasskeyToken,
+			Credential:   []byte(webAuthnGrant.Credential),
+			Device:       deviceFromContext(ctx),
+			JKT:          jkt,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       strings.Fields(req.Scope),
 		})
 		if err != nil {
-			return nil, status.Error(codes.Internal, "failed to grant refresh token")
+			return nil, authErrorToStatus(err, "failed to grant webauthn")
 		}
 
 		return &pb.GetToken_Response{
 			Token: &pb.Token{
 				AccessToken:  resp.AccessToken,
 				RefreshToken: resp.RefreshToken,
-				TokenType:    pb.TokenType_TOKEN_TYPE_BEARER,
+				TokenType:    tokenType,
+				IdToken:      resp.IDToken,
+				Scope:        strings.Join(resp.Scopes, " "),
+			},
+		}, nil
+	case *pb.GetToken_Request_ApiKeyGrant:
+		apiKeyGrant := req.GetApiKeyGrant()
+
+		device := deviceFromContext(ctx)
+
+		resp, err := s.authService.APIKeyGrant(ctx, authservice.APIKeyGrantRequest{
+			APIKey:    apiKeyGrant.ApiKey,
+			IP:        device.IP,
+			UserAgent: device.Name,
+			Scopes:    strings.Fields(req.Scope),
+		})
+		if err != nil {
+			return nil, authErrorToStatus(err, "failed to grant api key")
+		}
+
+		return &pb.GetToken_Response{
+			Token: &pb.Token{
+				AccessToken: resp.AccessToken,
+				TokenType:   pb.TokenType_TOKEN_TYPE_BEARER,
+				Scope:       strings.Join(resp.Scopes, " "),
 			},
 		}, nil
 	default:
@@ -81,6 +383,166 @@ func (s *SSOServer) GetToken(ctx context.Context, req *pb.GetToken_Request) (*pb
 	}
 }
 
+func (s *SSOServer) Authorize(ctx context.Context, req *pb.Authorize_Request) (*pb.Authorize_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "Authorize")
+	defer span.End()
+
+	device := deviceFromContext(ctx)
+
+	resp, err := s.authService.Authorize(ctx, authservice.AuthorizeRequest{
+		ClientID:            req.ClientId,
+		RedirectURI:         req.RedirectUri,
+		ResponseType:        req.ResponseType,
+		Scopes:              strings.Fields(req.Scope),
+		State:               req.State,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Email:               req.Username,
+		Password:            req.Password,
+		OTP:                 req.Otp,
+		IP:                  device.IP,
+		UserAgent:           device.Name,
+	})
+	if err != nil {
+		// The resource owner is authenticated here, not the client, so client
+		// errors describe a malformed request rather than bad credentials.
+		if errors.Is(err, authservice.ErrInvalidClient) {
+			return nil, status.Error(codes.InvalidArgument, "invalid client")
+		}
+		if errors.Is(err, authservice.ErrUnauthorizedClient) {
+			return nil, status.Error(codes.InvalidArgument, "unauthorized client")
+		}
+		return nil, authErrorToStatus(err, "failed to authorize")
+	}
+
+	return &pb.Authorize_Response{
+		Code:        resp.Code,
+		RedirectUri: resp.RedirectURI,
+		State:       resp.State,
+	}, nil
+}
+
+func (s *SSOServer) StartPasswordless(ctx context.Context, req *pb.StartPasswordless_Request) (*pb.StartPasswordless_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "StartPasswordless")
+	defer span.End()
+
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	resp, err := s.authService.StartPasswordless(ctx, authservice.StartPasswordlessRequest{
+		Email:        req.Email,
+		IP:           clientIPFromContext(ctx),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       strings.Fields(req.Scope),
+	})
+	if err != nil {
+		return nil, authErrorToStatus(err, "failed to start passwordless sign in")
+	}
+
+	return &pb.StartPasswordless_Response{
+		PasswordlessToken: resp.Token,
+		ExpiresIn:         int64(resp.ExpiresIn.Seconds()),
+	}, nil
+}
+
+func (s *SSOServer) StartDeviceAuthorization(ctx context.Context, req *pb.StartDeviceAuthorization_Request) (*pb.StartDeviceAuthorization_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "StartDeviceAuthorization")
+	defer span.End()
+
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	resp, err := s.authService.StartDeviceAuthorization(ctx, authservice.StartDeviceAuthorizationRequest{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       strings.Fields(req.Scope),
+	})
+	if err != nil {
+		return nil, authErrorToStatus(err, "failed to start device authorization")
+	}
+
+	return &pb.StartDeviceAuthorization_Response{
+		DeviceCode:              resp.DeviceCode,
+		UserCode:                resp.UserCode,
+		VerificationUri:         resp.VerificationURL,
+		VerificationUriComplete: resp.VerificationURLComplete,
+		ExpiresIn:               int64(resp.ExpiresIn.Seconds()),
+		Interval:                int64(resp.Interval.Seconds()),
+	}, nil
+}
+
+func (s *SSOServer) GetDeviceAuthorization(ctx context.Context, req *pb.GetDeviceAuthorization_Request) (*pb.GetDeviceAuthorization_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "GetDeviceAuthorization")
+	defer span.End()
+
+	resp, err := s.authService.GetDeviceAuthorization(ctx, req.UserCode)
+	if err != nil {
+		return nil, deviceAuthorizationErrorToStatus(err, "failed to get device authorization")
+	}
+
+	return &pb.GetDeviceAuthorization_Response{
+		ClientId:   resp.ClientID,
+		ClientName: resp.ClientName,
+		Scope:      strings.Join(resp.Scopes, " "),
+	}, nil
+}
+
+func (s *SSOServer) AuthorizeDevice(ctx context.Context, req *pb.AuthorizeDevice_Request) (*pb.AuthorizeDevice_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "AuthorizeDevice")
+	defer span.End()
+
+	device := deviceFromContext(ctx)
+
+	resp, err := s.authService.AuthorizeDevice(ctx, authservice.AuthorizeDeviceRequest{
+		UserCode:  req.UserCode,
+		Approve:   req.Approve,
+		Email:     req.Username,
+		Password:  req.Password,
+		OTP:       req.Otp,
+		IP:        device.IP,
+		UserAgent: device.Name,
+	})
+	if err != nil {
+		return nil, deviceAuthorizationErrorToStatus(err, "failed to authorize device")
+	}
+
+	return &pb.AuthorizeDevice_Response{
+		ClientId:   resp.ClientID,
+		ClientName: resp.ClientName,
+		Scope:      strings.Join(resp.Scopes, " "),
+	}, nil
+}
+
+func (s *SSOServer) StartPasskeyLogin(ctx context.Context, req *pb.StartPasskeyLogin_Request) (*pb.StartPasskeyLogin_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "StartPasskeyLogin")
+	defer span.End()
+
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	resp, err := s.authService.StartPasskeyLogin(ctx, authservice.StartPasskeyLoginRequest{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	})
+	if err != nil {
+		return nil, authErrorToStatus(err, "failed to start passkey sign in")
+	}
+
+	return &pb.StartPasskeyLogin_Response{
+		PasskeyToken: resp.Token,
+		Options:      string(resp.Options),
+		ExpiresIn:    int64(resp.ExpiresIn.Seconds()),
+	}, nil
+}
+
 func (s *SSOServer) GetJWKS(ctx context.Context, req *pb.GetJWKS_Request) (*pb.GetJWKS_Response, error) {
 	ctx, span := s.tracer.Start(ctx, "GetJWKS")
 	defer span.End()
@@ -100,7 +562,471 @@ func (s *SSOServer) GetJWKS(ctx context.Context, req *pb.GetJWKS_Request) (*pb.G
 		pbKeys = append(pbKeys, pbKey)
 	}
 
+	sort.Slice(pbKeys, func(i, j int) bool {
+		return pbKeys[i].Kid < pbKeys[j].Kid
+	})
+
+	etag, err := jwksETag(pbKeys)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to hash public keys")
+	}
+
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(JWKSCacheMaxAge.Seconds())),
+		HeaderETag, etag,
+	))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to set response headers")
+	}
+
 	return &pb.GetJWKS_Response{
 		Keys: pbKeys,
 	}, nil
 }
+
+func (s *SSOServer) GetOpenIDConfiguration(ctx context.Context, req *pb.GetOpenIDConfiguration_Request) (*pb.GetOpenIDConfiguration_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "GetOpenIDConfiguration")
+	defer span.End()
+
+	cfg, err := s.oidcService.GetConfiguration(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get openid configuration")
+	}
+
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(JWKSCacheMaxAge.Seconds())),
+	))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to set response headers")
+	}
+
+	return oidcserializer.ConfigurationToPb(cfg), nil
+}
+
+func (s *SSOServer) GetUserInfo(ctx context.Context, req *pb.GetUserInfo_Request) (*pb.GetUserInfo_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "GetUserInfo")
+	defer span.End()
+
+	accessToken, err := accessTokenRequest(ctx, s.proofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo, err := s.oidcService.GetUserInfo(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return nil, status.Error(codes.Internal, "failed to get user info")
+	}
+
+	return oidcserializer.UserInfoToPb(userInfo), nil
+}
+
+func (s *SSOServer) RevokeToken(ctx context.Context, req *pb.RevokeToken_Request) (*pb.RevokeToken_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "RevokeToken")
+	defer span.End()
+
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	client, err := s.authService.AuthenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, authErrorToStatus(err, "failed to authenticate client")
+	}
+
+	err = s.tokenService.Revoke(ctx, tokenservice.RevokeRequest{
+		Token:         req.Token,
+		TokenTypeHint: req.GetTokenTypeHint(),
+		ClientID:      client.ID,
+	})
+	if err != nil {
+		if errors.Is(err, tokenservice.ErrClientMismatch) {
+			return nil, status.Error(codes.PermissionDenied, "token was issued to another client")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke token")
+	}
+
+	return &pb.RevokeToken_Response{}, nil
+}
+
+func (s *SSOServer) IntrospectToken(ctx context.Context, req *pb.IntrospectToken_Request) (*pb.IntrospectToken_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "IntrospectToken")
+	defer span.End()
+
+	clientID, clientSecret := req.ClientId, req.ClientSecret
+	if id, secret, ok := basicCredentialsFromContext(ctx); ok {
+		clientID, clientSecret = id, secret
+	}
+
+	client, err := s.authService.AuthenticateClient(ctx, clientID, clientSecret)
+	if err != nil {
+		return nil, authErrorToStatus(err, "failed to authenticate client")
+	}
+
+	// Introspection is for resource servers, which can keep a secret. Anyone
+	// could call it as a public client and learn which tokens are valid.
+	if !client.Confidential {
+		return nil, status.Error(codes.PermissionDenied, "unauthorized client")
+	}
+
+	resp, err := s.tokenService.Introspect(ctx, tokenservice.IntrospectRequest{
+		Token:         req.Token,
+		TokenTypeHint: req.GetTokenTypeHint(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to introspect token")
+	}
+
+	return tokenserializer.IntrospectResponseToPb(resp), nil
+}
+
+// jwksETag hashes the serialized key set, so that a change to any member of
+// any key, whatever its type, changes the ETag.
+func jwksETag(keys []*pb.Key) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&pb.GetJWKS_Response{Keys: keys})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`, nil
+}
+
+// proofKey verifies the DPoP proof a token request came with, if any, and
+// returns the thumbprint of the key that signed it.
+func (s *SSOServer) proofKey(ctx context.Context) (string, error) {
+	proof, req, ok := pkgauth.ProofFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	verified, err := s.proofVerifier.Verify(ctx, proof, req)
+	if err != nil {
+		if errors.Is(err, pkgauth.ErrInvalidProof) {
+			return "", status.Error(codes.InvalidArgument, "invalid dpop proof")
+		}
+		return "", status.Error(codes.Internal, "failed to verify dpop proof")
+	}
+
+	return verified.JKT, nil
+}
+
+// accessTokenRequest returns the access token of the call as it was
+// presented. A token sent with the DPoP scheme has to come with a proof for
+// the request and the token, whose key the token is then checked against.
+func accessTokenRequest(ctx context.Context, proofVerifier *pkgauth.ProofVerifier) (tokenservice.VerifyAccessTokenRequest, error) {
+	accessToken, dpop, ok := accessTokenFromContext(ctx)
+	if !ok {
+		return tokenservice.VerifyAccessTokenRequest{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	req := tokenservice.VerifyAccessTokenRequest{
+		Token: accessToken,
+		DPoP:  dpop,
+	}
+	// Proofs that come with a bearer token are not looked at, so a bound
+	// token sent as one is refused.
+	if !dpop {
+		return req, nil
+	}
+
+	proof, proofReq, ok := pkgauth.ProofFromContext(ctx)
+	if !ok {
+		return tokenservice.VerifyAccessTokenRequest{}, status.Error(codes.Unauthenticated, "invalid dpop proof")
+	}
+	proofReq.AccessToken = accessToken
+
+	verified, err := proofVerifier.Verify(ctx, proof, proofReq)
+	if err != nil {
+		if errors.Is(err, pkgauth.ErrInvalidProof) {
+			return tokenservice.VerifyAccessTokenRequest{}, status.Error(codes.Unauthenticated, "invalid dpop proof")
+		}
+		return tokenservice.VerifyAccessTokenRequest{}, status.Error(codes.Internal, "failed to verify dpop proof")
+	}
+	req.JKT = verified.JKT
+
+	return req, nil
+}
+
+// accessTokenFromContext returns the access token of the call and whether it
+// was sent with the DPoP scheme rather than as a bearer token.
+func accessTokenFromContext(ctx context.Context) (string, bool, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false, false
+	}
+
+	for _, value := range md.Get(HeaderAuthorization) {
+		if len(value) > len(BearerPrefix) && strings.EqualFold(value[:len(BearerPrefix)], BearerPrefix) {
+			return value[len(BearerPrefix):], false, true
+		}
+		if len(value) > len(DPoPPrefix) && strings.EqualFold(value[:len(DPoPPrefix)], DPoPPrefix) {
+			return value[len(DPoPPrefix):], true, true
+		}
+	}
+
+	return "", false, false
+}
+
+func clientIPFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return lastValue(md, HeaderClientIP)
+}
+
+// deviceFromContext describes the device of the user agent from the metadata
+// the gateway forwards.
+func deviceFromContext(ctx context.Context) sessionentity.Device {
+	device := sessionentity.Device{
+		IP: clientIPFromContext(ctx),
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return device
+	}
+
+	device.Name = lastValue(md, HeaderDevice)
+	device.Platform = lastValue(md, HeaderPlatform)
+	device.AppVersion = lastValue(md, HeaderAppVersion)
+
+	return device
+}
+
+// lastValue returns the last value of key in md. grpc-gateway forwards the
+// Grpc-Metadata-* headers of the caller ahead of the metadata the gateway
+// sets itself, so only the last value can be trusted.
+func lastValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[len(values)-1]
+}
+
+// basicCredentialsFromContext extracts client credentials sent with HTTP Basic
+// authentication, as described in RFC 6749 section 2.3.1.
+func basicCredentialsFromContext(ctx context.Context) (string, string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", "", false
+	}
+
+	for _, value := range md.Get(HeaderAuthorization) {
+		if len(value) <= len(BasicPrefix) || !strings.EqualFold(value[:len(BasicPrefix)], BasicPrefix) {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(value[len(BasicPrefix):])
+		if err != nil {
+			return "", "", false
+		}
+
+		rawID, rawSecret, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return "", "", false
+		}
+
+		id, err := url.QueryUnescape(rawID)
+		if err != nil {
+			return "", "", false
+		}
+
+		secret, err := url.QueryUnescape(rawSecret)
+		if err != nil {
+			return "", "", false
+		}
+
+		return id, secret, true
+	}
+
+	return "", "", false
+}
+
+// mfaRequiredStatus reports a pending MFA challenge. The challenge token is
+// passed in the error details, as the response carries no token.
+func mfaRequiredStatus(mfaErr *authservice.MFARequiredError) error {
+	st, err := status.New(codes.PermissionDenied, ErrorReasonMFARequired).WithDetails(&errdetails.ErrorInfo{
+		Reason: ErrorReasonMFARequired,
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			"mfa_token":           mfaErr.Token,
+			"enrollment_required": strconv.FormatBool(mfaErr.EnrollmentRequired),
+		},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, "failed to build mfa challenge")
+	}
+
+	return st.Err()
+}
+
+// throttledStatus reports a rejected sign in attempt. The time to wait is
+// passed in the error details, so that the gateway can set Retry-After. A
+// locked out account looks like bad credentials, to not confirm that it
+// exists.
+func throttledStatus(throttledErr *throttleservice.ThrottledError) error {
+	code, msg := codes.ResourceExhausted, "too many attempts"
+	if throttledErr.Locked && throttledErr.Scope == throttleservice.ScopeAccount {
+		code, msg = codes.Unauthenticated, "invalid credentials"
+	}
+
+	st, err := status.New(code, msg).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(throttledErr.RetryAfter.Round(time.Second)),
+	})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+
+	return st.Err()
+}
+
+// deviceFlowStatus reports the state of a device authorization to a polling
+// device. The reason is passed in the error details, so that devices can
+// tell the states apart whatever the status code.
+func deviceFlowStatus(code codes.Code, reason, msg string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+
+	return st.Err()
+}
+
+// slowDownStatus tells a device that polls too fast to slow down. The interval
+// it has to keep from now on is passed in the error details, so that the
+// gateway can set Retry-After.
+func slowDownStatus(slowDownErr *deviceservice.SlowDownError) error {
+	code, msg := codes.ResourceExhausted, "slow down"
+
+	st, err := status.New(code, msg).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: ErrorReasonSlowDown,
+			Domain: ErrorDomain,
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(slowDownErr.Interval.Round(time.Second)),
+		},
+	)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+
+	return st.Err()
+}
+
+// deviceAuthorizationErrorToStatus maps the errors of the verification page,
+// where the user rather than the client is authenticated.
+func deviceAuthorizationErrorToStatus(err error, msg string) error {
+	// The code of a client disabled since the device asked is as good as
+	// unknown.
+	if errors.Is(err, deviceservice.ErrInvalidUserCode) || errors.Is(err, authservice.ErrInvalidClient) {
+		return status.Error(codes.NotFound, "invalid user code")
+	}
+	return authErrorToStatus(err, msg)
+}
+
+func authErrorToStatus(err error, msg string) error {
+	var mfaErr *authservice.MFARequiredError
+	if errors.As(err, &mfaErr) {
+		return mfaRequiredStatus(mfaErr)
+	}
+	var throttledErr *throttleservice.ThrottledError
+	if errors.As(err, &throttledErr) {
+		return throttledStatus(throttledErr)
+	}
+	var slowDownErr *deviceservice.SlowDownError
+	if errors.As(err, &slowDownErr) {
+		return slowDownStatus(slowDownErr)
+	}
+	if errors.Is(err, authservice.ErrMFARequired) {
+		return status.Error(codes.PermissionDenied, "mfa required")
+	}
+	if errors.Is(err, authservice.ErrMFAEnrollmentRequired) {
+		return status.Error(codes.FailedPrecondition, "mfa enrollment required")
+	}
+	if errors.Is(err, authservice.ErrInvalidCredentials) {
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	if errors.Is(err, authservice.ErrInvalidRefreshToken) {
+		return status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if errors.Is(err, authservice.ErrInvalidAuthorizationCode) {
+		return status.Error(codes.Unauthenticated, "invalid authorization code")
+	}
+	if errors.Is(err, authservice.ErrInvalidClient) {
+		return status.Error(codes.Unauthenticated, "invalid client")
+	}
+	if errors.Is(err, authservice.ErrUnauthorizedClient) {
+		return status.Error(codes.PermissionDenied, "unauthorized client")
+	}
+	if errors.Is(err, authservice.ErrInvalidScope) {
+		return status.Error(codes.InvalidArgument, "invalid scope")
+	}
+	if errors.Is(err, authservice.ErrUnsupportedResponseType) {
+		return status.Error(codes.InvalidArgument, "unsupported response type")
+	}
+	if errors.Is(err, authservice.ErrInvalidRedirectURI) {
+		return status.Error(codes.InvalidArgument, "invalid redirect uri")
+	}
+	if errors.Is(err, authservice.ErrInvalidCodeChallenge) {
+		return status.Error(codes.InvalidArgument, "invalid code challenge")
+	}
+	if errors.Is(err, authservice.ErrUnsupportedTokenType) {
+		return status.Error(codes.InvalidArgument, "unsupported token type")
+	}
+	if errors.Is(err, authservice.ErrImpersonationNotAllowed) {
+		return status.Error(codes.PermissionDenied, "impersonation not allowed")
+	}
+	if errors.Is(err, authservice.ErrInvalidRequestedSubject) {
+		return status.Error(codes.InvalidArgument, "invalid requested subject")
+	}
+	if errors.Is(err, authservice.ErrInvalidDPoPProof) {
+		return status.Error(codes.InvalidArgument, "invalid dpop proof")
+	}
+	if errors.Is(err, deviceservice.ErrAuthorizationPending) {
+		return deviceFlowStatus(codes.FailedPrecondition, ErrorReasonAuthorizationPending, "authorization pending")
+	}
+	if errors.Is(err, deviceservice.ErrSlowDown) {
+		return deviceFlowStatus(codes.ResourceExhausted, ErrorReasonSlowDown, "slow down")
+	}
+	if errors.Is(err, deviceservice.ErrAccessDenied) {
+		return deviceFlowStatus(codes.PermissionDenied, ErrorReasonAccessDenied, "access denied")
+	}
+	if errors.Is(err, deviceservice.ErrExpiredToken) {
+		return deviceFlowStatus(codes.Unauthenticated, ErrorReasonExpiredToken, "device code expired")
+	}
+	if errors.Is(err, deviceservice.ErrInvalidDeviceCode) {
+		return status.Error(codes.Unauthenticated, "invalid device code")
+	}
+	if errors.Is(err, apikeyservice.ErrInvalidKey) {
+		return status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	if errors.Is(err, passkeyservice.ErrInvalidCeremony) {
+		return status.Error(codes.Unauthenticated, "invalid passkey token")
+	}
+	if errors.Is(err, passkeyservice.ErrInvalidCredential) {
+		return status.Error(codes.Unauthenticated, "invalid passkey credential")
+	}
+	if errors.Is(err, passwordlessservice.ErrInvalidChallenge) {
+		return status.Error(codes.Unauthenticated, "invalid passwordless token")
+	}
+	if errors.Is(err, passwordlessservice.ErrInvalidCode) {
+		return status.Error(codes.Unauthenticated, "invalid code")
+	}
+	if errors.Is(err, passwordlessservice.ErrTooManyAttempts) {
+		return status.Error(codes.Unauthenticated, "too many passwordless attempts")
+	}
+	return mfaErrorToStatus(err, msg)
+}
@@ -2,26 +2,72 @@ package grpc
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
 	"errors"
 	"net"
+	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	actiontokenpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken/postgres"
+	apikeypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey/postgres"
+	authcoderedis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode/redis"
+	autheventpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/postgres"
+	clientpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/postgres"
+	denylistredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist/redis"
+	deviceredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device/redis"
+	federationredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation/redis"
+	identitypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity/postgres"
 	"github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/redis"
+	mfapostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa/postgres"
+	mfachallengeredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge/redis"
+	passkeypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey/postgres"
+	passkeyceremonyredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony/redis"
+	passwordlessredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless/redis"
+	sessionpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/postgres"
+	throttleredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle/redis"
 	tokenpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/postgres"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
 	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
 	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	accountservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/account"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
 	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	clientservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/client"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
 	keyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/key"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
 	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient/oidctest"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/totp"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/webauthntest"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
 	postgresdb "github.com/kitanoyoru/kgym/pkg/database/postgres"
 	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
 	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
@@ -31,8 +77,26 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testIssuer      = "https://sso.kgym.test"
+	testRedirectURI = "https://app.kgym.test/callback"
+
+	testFederationCallback = "https://gateway.kgym.test/authorize/federation/callback"
+
+	testDeviceVerificationURL = "https://gateway.kgym.test/device"
+	testDeviceInterval        = 100 * time.Millisecond
+
+	testPasskeyRPID   = "app.kgym.test"
+	testPasskeyOrigin = "https://app.kgym.test"
 )
 
 type SSOServiceTestSuite struct {
@@ -44,9 +108,57 @@ type SSOServiceTestSuite struct {
 	redisContainer     *rediscontainer.RedisContainer
 	ctrl               *gomock.Controller
 	userRepo           *usermocks.MockIRepository
+	clientService      clientservice.IService
+	keyService         keyservice.IService
+	keyRepo            *redis.Repository
 	ssoServer          *grpc.Server
 	ssoClient          pb.SSOServiceClient
+	clientClient       pb.ClientServiceClient
+	mfaClient          pb.MFAServiceClient
+	adminClient        pb.AdminServiceClient
+	sessionClient      pb.SessionServiceClient
+	accountClient      pb.AccountServiceClient
+	federationClient   pb.FederationServiceClient
+	passkeyClient      pb.PasskeyServiceClient
+	apiKeyClient       pb.APIKeyServiceClient
+	upstream           *oidctest.Server
+	mailer             *mailer.Memory
+	passwordlessSender *stubSender
 	ssoConn            *grpc.ClientConn
+
+	// resourceServer is a confidential client that introspects tokens.
+	resourceServer clientservice.CreateResponse
+}
+
+// stubSender keeps the passwordless messages it is asked to send.
+type stubSender struct {
+	mu       sync.Mutex
+	messages []passwordlessservice.Message
+}
+
+func (s *stubSender) Send(_ context.Context, msg passwordlessservice.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func (s *stubSender) Last() (passwordlessservice.Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.messages) == 0 {
+		return passwordlessservice.Message{}, false
+	}
+	return s.messages[len(s.messages)-1], true
+}
+
+func (s *stubSender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = nil
 }
 
 func (s *SSOServiceTestSuite) SetupSuite() {
@@ -84,18 +196,136 @@ func (s *SSOServiceTestSuite) SetupSuite() {
 	s.userRepo = usermocks.NewMockIRepository(s.ctrl)
 
 	tokenRepo := tokenpostgres.New(s.db)
-	keyRepo, err := redis.New(ctx, s.rdb)
+	kekProvider, err := kek.NewStatic(kek.Key{ID: "kek-1", Secret: make([]byte, kek.KeySize)})
+	require.NoError(s.T(), err, "failed to create kek provider")
+
+	keyRepo, err := redis.New(ctx, s.rdb, kekProvider)
 	require.NoError(s.T(), err, "failed to create key repository")
+	s.keyRepo = keyRepo
+
+	clientRepo := clientpostgres.New(s.db)
+
+	mfaService := mfaservice.NewService(mfaservice.Config{
+		Issuer:        "kgym",
+		RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+	}, mfapostgres.New(s.db, kekProvider), mfachallengeredis.New(s.rdb), s.userRepo)
+
+	sessionService := sessionservice.NewService(sessionpostgres.New(s.db), tokenRepo)
+
+	throttleService := throttleservice.NewService(throttleservice.Config{
+		Window:          15 * time.Minute,
+		LockoutDuration: 15 * time.Minute,
+		Account:         throttleservice.Limits{LockoutAfter: 3},
+	}, throttleredis.New(s.rdb))
+
+	s.passwordlessSender = &stubSender{}
+	passwordlessService := passwordlessservice.NewService(passwordlessservice.Config{
+		Secret:           []byte("0123456789abcdef0123456789abcdef"),
+		ChallengeTTL:     10 * time.Minute,
+		LinkURL:          "https://app.kgym.test/sign-in",
+		SendWindow:       time.Hour,
+		MaxSendsPerEmail: 3,
+	}, passwordlessredis.New(s.rdb), s.userRepo, s.passwordlessSender)
+
+	s.upstream = oidctest.NewServer(oidctest.Config{
+		ClientID:     "kgym",
+		ClientSecret: "upstream-secret",
+	})
+	federationService := federationservice.NewService(federationservice.Config{
+		Providers: []federationservice.ProviderConfig{{
+			ID:           "acme",
+			Name:         "Acme Corp",
+			Issuer:       s.upstream.Issuer(),
+			ClientID:     "kgym",
+			ClientSecret: "upstream-secret",
+			Scopes:       []string{"email", "profile"},
+		}},
+		RedirectURL: testFederationCallback,
+		RequestTTL:  10 * time.Minute,
+	}, federationredis.New(s.rdb), identitypostgres.New(s.db), s.userRepo)
+
+	deviceService := deviceservice.NewService(deviceservice.Config{
+		CodeTTL:         10 * time.Minute,
+		Interval:        testDeviceInterval,
+		VerificationURL: testDeviceVerificationURL,
+	}, deviceredis.New(s.rdb))
+
+	passkeyService := passkeyservice.NewService(passkeyservice.Config{
+		RPID:        testPasskeyRPID,
+		RPName:      "kgym",
+		Origins:     []string{testPasskeyOrigin},
+		CeremonyTTL: time.Minute,
+	}, passkeypostgres.New(s.db), passkeyceremonyredis.New(s.rdb), s.userRepo)
+
+	auditService := auditservice.NewService(autheventpostgres.New(s.db))
+
+	apiKeyService := apikeyservice.NewService(apikeypostgres.New(s.db), clientRepo)
+
+	authService := authservice.NewService(authservice.Config{
+		Issuer: testIssuer,
+	}, s.userRepo, tokenRepo, keyRepo, authcoderedis.New(s.rdb), clientRepo, mfaService, throttleService, sessionService, passwordlessService, federationService, deviceService, passkeyService, apiKeyService, auditService)
+
+	s.clientService = clientservice.NewService(clientRepo)
+
+	s.keyService = keyservice.NewService(keyservice.Config{
+		RotationInterval: 24 * time.Hour,
+		PrePublication:   time.Hour,
+		Overlap:          time.Hour,
+	}, keyRepo, auditService)
+
+	tokenService := tokenservice.NewService(tokenservice.Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistredis.New(s.rdb), sessionService)
+
+	oidcService := oidcservice.NewService(oidcservice.Config{Issuer: testIssuer}, tokenService, s.userRepo)
+
+	s.mailer = mailer.NewMemory()
+	accountService := accountservice.NewService(accountservice.Config{
+		PasswordResetTTL:     time.Hour,
+		EmailVerificationTTL: time.Hour,
+		PasswordResetURL:     "https://app.kgym.test/reset-password",
+		EmailVerificationURL: "https://app.kgym.test/verify-email",
+	}, actiontokenpostgres.New(s.db), tokenRepo, s.userRepo, sessionService, s.mailer)
+
+	proofVerifier := pkgauth.NewProofVerifier(pkgauth.ProofConfig{
+		ReplayCache: pkgauth.NewRedisReplayCache(s.rdb),
+	})
+
+	ssoServer, err := NewSSOServer(authService, s.keyService, tokenService, oidcService, proofVerifier)
+	require.NoError(s.T(), err, "failed to create SSO server")
 
-	authService := authservice.NewService(s.userRepo, tokenRepo, keyRepo)
+	clientServer, err := NewClientServer(s.clientService, tokenService, proofVerifier, oidcService)
+	require.NoError(s.T(), err, "failed to create client server")
 
-	keyService := keyservice.NewService(keyRepo)
+	mfaServer, err := NewMFAServer(mfaService, tokenService, proofVerifier, oidcService)
+	require.NoError(s.T(), err, "failed to create mfa server")
 
-	ssoServer, err := NewSSOServer(authService, keyService)
-	require.NoError(s.T(), err, "failed to create SSO server")
+	adminServer, err := NewAdminServer(throttleService, tokenService, proofVerifier, oidcService, auditService)
+	require.NoError(s.T(), err, "failed to create admin server")
+
+	sessionServer, err := NewSessionServer(sessionService, tokenService, proofVerifier, oidcService)
+	require.NoError(s.T(), err, "failed to create session server")
+
+	accountServer, err := NewAccountServer(accountService, tokenService, proofVerifier)
+	require.NoError(s.T(), err, "failed to create account server")
+
+	federationServer, err := NewFederationServer(authService, federationService)
+	require.NoError(s.T(), err, "failed to create federation server")
+
+	passkeyServer, err := NewPasskeyServer(passkeyService, tokenService, proofVerifier)
+	require.NoError(s.T(), err, "failed to create passkey server")
+
+	apiKeyServer, err := NewAPIKeyServer(apiKeyService, tokenService, proofVerifier, oidcService)
+	require.NoError(s.T(), err, "failed to create api key server")
 
 	s.ssoServer = grpc.NewServer()
 	pb.RegisterSSOServiceServer(s.ssoServer, ssoServer)
+	pb.RegisterClientServiceServer(s.ssoServer, clientServer)
+	pb.RegisterMFAServiceServer(s.ssoServer, mfaServer)
+	pb.RegisterAdminServiceServer(s.ssoServer, adminServer)
+	pb.RegisterSessionServiceServer(s.ssoServer, sessionServer)
+	pb.RegisterAccountServiceServer(s.ssoServer, accountServer)
+	pb.RegisterFederationServiceServer(s.ssoServer, federationServer)
+	pb.RegisterPasskeyServiceServer(s.ssoServer, passkeyServer)
+	pb.RegisterAPIKeyServiceServer(s.ssoServer, apiKeyServer)
 
 	ssoListener, err := net.Listen("tcp", "127.0.0.1:0")
 	require.NoError(s.T(), err, "failed to create SSO listener")
@@ -114,6 +344,14 @@ func (s *SSOServiceTestSuite) SetupSuite() {
 
 	s.ssoConn = ssoConn
 	s.ssoClient = pb.NewSSOServiceClient(ssoConn)
+	s.clientClient = pb.NewClientServiceClient(ssoConn)
+	s.mfaClient = pb.NewMFAServiceClient(ssoConn)
+	s.adminClient = pb.NewAdminServiceClient(ssoConn)
+	s.sessionClient = pb.NewSessionServiceClient(ssoConn)
+	s.accountClient = pb.NewAccountServiceClient(ssoConn)
+	s.federationClient = pb.NewFederationServiceClient(ssoConn)
+	s.passkeyClient = pb.NewPasskeyServiceClient(ssoConn)
+	s.apiKeyClient = pb.NewAPIKeyServiceClient(ssoConn)
 }
 
 func (s *SSOServiceTestSuite) TearDownSuite() {
@@ -126,6 +364,9 @@ func (s *SSOServiceTestSuite) TearDownSuite() {
 	if s.ssoServer != nil {
 		s.ssoServer.GracefulStop()
 	}
+	if s.upstream != nil {
+		s.upstream.Close()
+	}
 	if s.rdb != nil {
 		_ = s.rdb.Close()
 	}
@@ -146,10 +387,118 @@ func (s *SSOServiceTestSuite) SetupTest() {
 	_, err := s.db.Exec(ctx, "DELETE FROM tokens")
 	require.NoError(s.T(), err, "failed to clean tokens table")
 
+	_, err = s.db.Exec(ctx, "DELETE FROM oauth_clients")
+	require.NoError(s.T(), err, "failed to clean oauth_clients table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM mfa_recovery_codes")
+	require.NoError(s.T(), err, "failed to clean mfa_recovery_codes table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM mfa_totp")
+	require.NoError(s.T(), err, "failed to clean mfa_totp table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM sessions")
+	require.NoError(s.T(), err, "failed to clean sessions table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM action_tokens")
+	require.NoError(s.T(), err, "failed to clean action_tokens table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM identities")
+	require.NoError(s.T(), err, "failed to clean identities table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM auth_events")
+	require.NoError(s.T(), err, "failed to clean auth_events table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM passkeys")
+	require.NoError(s.T(), err, "failed to clean passkeys table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM api_keys")
+	require.NoError(s.T(), err, "failed to clean api_keys table")
+
+	s.mailer.Reset()
+	s.passwordlessSender.Reset()
+
 	keys, err := s.rdb.Keys(ctx, "jwks:*").Result()
 	if err == nil && len(keys) > 0 {
 		_ = s.rdb.Del(ctx, keys...).Err()
 	}
+
+	keys, err = s.rdb.Keys(ctx, denylistredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, authcoderedis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, mfachallengeredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, throttleredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, passwordlessredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, federationredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, deviceredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, passkeyceremonyredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	clientRepo := clientpostgres.New(s.db)
+	for _, clientID := range []string{"client-123", "client-456"} {
+		err = clientRepo.Create(ctx, cliententity.Client{
+			ID:   clientID,
+			Name: clientID,
+			GrantTypes: []string{
+				cliententity.GrantTypeAuthorizationCode,
+				cliententity.GrantTypePassword,
+				cliententity.GrantTypeRefreshToken,
+				cliententity.GrantTypePasswordless,
+				cliententity.GrantTypeTokenExchange,
+				cliententity.GrantTypeDeviceCode,
+				cliententity.GrantTypeWebAuthn,
+				cliententity.GrantTypeAPIKey,
+			},
+			RedirectURIs:    []string{testRedirectURI},
+			Scopes:          []string{"openid", "profile", "email"},
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+			CreatedAt:       time.Now(),
+		})
+		require.NoError(s.T(), err, "failed to create test client")
+	}
+
+	s.resourceServer, err = s.clientService.Create(ctx, clientservice.CreateRequest{
+		Name:         "resource-server",
+		Confidential: true,
+		GrantTypes:   []string{cliententity.GrantTypeClientCredentials},
+	})
+	require.NoError(s.T(), err, "failed to create resource server client")
+}
+
+// resourceServerContext authenticates calls as the resource server client.
+func (s *SSOServiceTestSuite) resourceServerContext(ctx context.Context) context.Context {
+	credentials := base64.StdEncoding.EncodeToString([]byte(s.resourceServer.Client.ID + ":" + s.resourceServer.Secret))
+
+	return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BasicPrefix+credentials)
 }
 
 func (s *SSOServiceTestSuite) TearDownTest() {
@@ -158,10 +507,80 @@ func (s *SSOServiceTestSuite) TearDownTest() {
 	_, err := s.db.Exec(ctx, "DELETE FROM tokens")
 	require.NoError(s.T(), err, "failed to clean tokens table")
 
+	_, err = s.db.Exec(ctx, "DELETE FROM oauth_clients")
+	require.NoError(s.T(), err, "failed to clean oauth_clients table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM mfa_recovery_codes")
+	require.NoError(s.T(), err, "failed to clean mfa_recovery_codes table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM mfa_totp")
+	require.NoError(s.T(), err, "failed to clean mfa_totp table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM sessions")
+	require.NoError(s.T(), err, "failed to clean sessions table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM action_tokens")
+	require.NoError(s.T(), err, "failed to clean action_tokens table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM identities")
+	require.NoError(s.T(), err, "failed to clean identities table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM auth_events")
+	require.NoError(s.T(), err, "failed to clean auth_events table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM passkeys")
+	require.NoError(s.T(), err, "failed to clean passkeys table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM api_keys")
+	require.NoError(s.T(), err, "failed to clean api_keys table")
+
+	s.mailer.Reset()
+	s.passwordlessSender.Reset()
+
 	keys, err := s.rdb.Keys(ctx, "jwks:*").Result()
 	if err == nil && len(keys) > 0 {
 		_ = s.rdb.Del(ctx, keys...).Err()
 	}
+
+	keys, err = s.rdb.Keys(ctx, denylistredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, authcoderedis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, mfachallengeredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, throttleredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, passwordlessredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, federationredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, deviceredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
+
+	keys, err = s.rdb.Keys(ctx, passkeyceremonyredis.KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(ctx, keys...).Err()
+	}
 }
 
 func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
@@ -175,19 +594,16 @@ func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
 		userID := uuid.New().String()
 
 		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
-			Role:     usermodel.RoleUser,
+			ID:    userID,
+			Email: email,
+			Role:  usermodel.RoleUser,
 		}
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
+			VerifyPassword(gomock.Any(), email, password).
 			Return(user, nil)
 
-		keyRepo, err := redis.New(ctx, s.rdb)
-		require.NoError(s.T(), err)
-		_, err = keyRepo.Rotate(ctx)
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
 		require.NoError(s.T(), err)
 
 		req := &pb.GetToken_Request{
@@ -215,7 +631,7 @@ func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
 		email := "notfound@example.com"
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
+			VerifyPassword(gomock.Any(), email, "password123").
 			Return(usermodel.User{}, errors.New("user not found"))
 
 		req := &pb.GetToken_Request{
@@ -238,20 +654,11 @@ func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
 		defer cancel()
 
 		email := "invalidpass@example.com"
-		password := "correct-password"
 		wrongPassword := "wrong-password"
-		userID := uuid.New().String()
-
-		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
-			Role:     usermodel.RoleUser,
-		}
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
-			Return(user, nil)
+			VerifyPassword(gomock.Any(), email, wrongPassword).
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
 
 		req := &pb.GetToken_Request{
 			Grant: &pb.GetToken_Request_PasswordGrant{
@@ -264,7 +671,7 @@ func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
 		}
 
 		resp, err := s.ssoClient.GetToken(ctx, req)
-		assert.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
 		assert.Nil(s.T(), resp)
 	})
 
@@ -282,14 +689,13 @@ func (s *SSOServiceTestSuite) TestGetToken_PasswordGrant() {
 		userID := uuid.New().String()
 
 		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
-			Role:     usermodel.RoleUser,
+			ID:    userID,
+			Email: email,
+			Role:  usermodel.RoleUser,
 		}
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
+			VerifyPassword(gomock.Any(), email, password).
 			Return(user, nil)
 
 		req := &pb.GetToken_Request{
@@ -319,19 +725,20 @@ func (s *SSOServiceTestSuite) TestGetToken_RefreshTokenGrant() {
 		userID := uuid.New().String()
 
 		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
-			Role:     usermodel.RoleUser,
+			ID:    userID,
+			Email: email,
+			Role:  usermodel.RoleUser,
 		}
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
+			VerifyPassword(gomock.Any(), email, password).
 			Return(user, nil)
 
-		keyRepo, err := redis.New(ctx, s.rdb)
-		require.NoError(s.T(), err)
-		_, err = keyRepo.Rotate(ctx)
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), userID).
+			Return(user, nil)
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
 		require.NoError(s.T(), err)
 
 		passwordReq := &pb.GetToken_Request{
@@ -349,13 +756,10 @@ func (s *SSOServiceTestSuite) TestGetToken_RefreshTokenGrant() {
 		require.NotNil(s.T(), passwordResp.Token)
 		require.NotEmpty(s.T(), passwordResp.Token.RefreshToken)
 
-		sum := sha256.Sum256([]byte(passwordResp.Token.RefreshToken))
-		tokenHash := hex.EncodeToString(sum[:])
-
 		refreshReq := &pb.GetToken_Request{
 			Grant: &pb.GetToken_Request_RefreshTokenGrant{
 				RefreshTokenGrant: &pb.RefreshTokenGrant{
-					RefreshToken: tokenHash,
+					RefreshToken: passwordResp.Token.RefreshToken,
 				},
 			},
 			ClientId: clientID,
@@ -370,6 +774,76 @@ func (s *SSOServiceTestSuite) TestGetToken_RefreshTokenGrant() {
 		assert.NotEqual(s.T(), passwordResp.Token.RefreshToken, refreshResp.Token.RefreshToken)
 	})
 
+	s.Run("should narrow scopes on refresh", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		email := "narrow@example.com"
+		password := "password123"
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, password).
+			Return(usermodel.User{ID: uuid.New().String(), Email: email, Role: usermodel.RoleUser}, nil)
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (usermodel.User, error) {
+				return usermodel.User{ID: id, Email: email, Role: usermodel.RoleUser}, nil
+			})
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		passwordResp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: email,
+					Password: password,
+				},
+			},
+			ClientId: "client-123",
+			Scope:    "profile email",
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "profile email", passwordResp.Token.Scope)
+
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: passwordResp.Token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+			Scope:    "profile openid",
+		})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+		refreshResp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: passwordResp.Token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+			Scope:    "profile",
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "profile", refreshResp.Token.Scope)
+
+		introspection, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: refreshResp.Token.AccessToken,
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "profile", introspection.GetScope())
+		assert.Equal(s.T(), string(usermodel.RoleUser), introspection.GetRole())
+
+		introspection, err = s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: refreshResp.Token.RefreshToken,
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "profile email", introspection.GetScope())
+	})
+
 	s.Run("should return error when refresh token is invalid", func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -398,19 +872,20 @@ func (s *SSOServiceTestSuite) TestGetToken_RefreshTokenGrant() {
 		userID := uuid.New().String()
 
 		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
-			Role:     usermodel.RoleUser,
+			ID:    userID,
+			Email: email,
+			Role:  usermodel.RoleUser,
 		}
 
 		s.userRepo.EXPECT().
-			GetByEmail(gomock.Any(), email).
+			VerifyPassword(gomock.Any(), email, password).
 			Return(user, nil)
 
-		keyRepo, err := redis.New(ctx, s.rdb)
-		require.NoError(s.T(), err)
-		_, err = keyRepo.Rotate(ctx)
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), userID).
+			Return(user, nil)
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
 		require.NoError(s.T(), err)
 
 		passwordReq := &pb.GetToken_Request{
@@ -427,23 +902,32 @@ func (s *SSOServiceTestSuite) TestGetToken_RefreshTokenGrant() {
 		require.NoError(s.T(), err)
 		refreshToken := passwordResp.Token.RefreshToken
 
-		sum := sha256.Sum256([]byte(refreshToken))
-		tokenHash := hex.EncodeToString(sum[:])
-
 		refreshReq := &pb.GetToken_Request{
 			Grant: &pb.GetToken_Request_RefreshTokenGrant{
 				RefreshTokenGrant: &pb.RefreshTokenGrant{
-					RefreshToken: tokenHash,
+					RefreshToken: refreshToken,
 				},
 			},
 			ClientId: clientID,
 		}
 
-		_, err = s.ssoClient.GetToken(ctx, refreshReq)
+		rotatedResp, err := s.ssoClient.GetToken(ctx, refreshReq)
 		require.NoError(s.T(), err)
 
 		_, err = s.ssoClient.GetToken(ctx, refreshReq)
-		assert.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		rotatedReq := &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: rotatedResp.Token.RefreshToken,
+				},
+			},
+			ClientId: clientID,
+		}
+
+		_, err = s.ssoClient.GetToken(ctx, rotatedReq)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err), "reuse must revoke the whole token family")
 	})
 }
 
@@ -452,19 +936,17 @@ func (s *SSOServiceTestSuite) TestGetJWKS() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
-		keyRepo, err := redis.New(ctx, s.rdb)
-		require.NoError(s.T(), err)
-
-		key1, err := keyRepo.Rotate(ctx)
+		key1, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
 		require.NoError(s.T(), err)
 
 		time.Sleep(time.Second)
-		key2, err := keyRepo.Rotate(ctx)
+		key2, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
 		require.NoError(s.T(), err)
 
 		req := &pb.GetJWKS_Request{}
 
-		resp, err := s.ssoClient.GetJWKS(ctx, req)
+		var header metadata.MD
+		resp, err := s.ssoClient.GetJWKS(ctx, req, grpc.Header(&header))
 		require.NoError(s.T(), err)
 		assert.NotNil(s.T(), resp.Keys)
 		assert.Len(s.T(), resp.Keys, 2)
@@ -473,13 +955,59 @@ func (s *SSOServiceTestSuite) TestGetJWKS() {
 		for _, key := range resp.Keys {
 			keyIDs[key.Kid] = true
 			assert.NotEmpty(s.T(), key.Kid)
-			assert.NotEmpty(s.T(), key.Public)
-			assert.Equal(s.T(), "RS256", key.Algorithm)
-			assert.True(s.T(), key.Active)
+			assert.Equal(s.T(), "RSA", key.Kty)
+			assert.Equal(s.T(), "sig", key.Use)
+			assert.Equal(s.T(), "RS256", key.Alg)
+			assert.NotEmpty(s.T(), key.GetN())
+			assert.NotEmpty(s.T(), key.GetE())
 		}
 
 		assert.True(s.T(), keyIDs[key1.ID])
 		assert.True(s.T(), keyIDs[key2.ID])
+
+		assert.Equal(s.T(), []string{"public, max-age=300"}, header.Get(HeaderCacheControl))
+		require.Len(s.T(), header.Get(HeaderETag), 1)
+		assert.NotEmpty(s.T(), header.Get(HeaderETag)[0])
+	})
+
+	s.Run("should return the same ETag while the key set is unchanged", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var first, second metadata.MD
+		_, err := s.ssoClient.GetJWKS(ctx, &pb.GetJWKS_Request{}, grpc.Header(&first))
+		require.NoError(s.T(), err)
+		_, err = s.ssoClient.GetJWKS(ctx, &pb.GetJWKS_Request{}, grpc.Header(&second))
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), first.Get(HeaderETag), second.Get(HeaderETag))
+	})
+
+	s.Run("should publish pending and previous keys alongside the active one", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		keys, err := s.keyService.List(ctx)
+		require.NoError(s.T(), err)
+
+		time.Sleep(time.Second)
+		pending, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), keyentity.StatePending, pending.State)
+
+		resp, err := s.ssoClient.GetJWKS(ctx, &pb.GetJWKS_Request{})
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), resp.Keys, len(keys)+1)
+
+		for _, key := range keys {
+			if key.State == keyentity.StateVerificationOnly {
+				require.NoError(s.T(), s.keyService.Retire(ctx, key.ID))
+			}
+		}
+
+		resp, err = s.ssoClient.GetJWKS(ctx, &pb.GetJWKS_Request{})
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), resp.Keys, 2)
 	})
 
 	s.Run("should return empty JWKS when no keys exist", func() {
@@ -500,21 +1028,2456 @@ func (s *SSOServiceTestSuite) TestGetJWKS() {
 	})
 }
 
-func (s *SSOServiceTestSuite) TestGetToken_InvalidGrant() {
-	s.Run("should return error when grant type is invalid", func() {
+func (s *SSOServiceTestSuite) TestGetToken_AuthorizationCodeGrant() {
+	// Test vector from RFC 7636 appendix B.
+	codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	authorize := func(ctx context.Context, email string) *pb.Authorize_Response {
+		password := "password123"
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, password).
+			Return(usermodel.User{
+				ID:    uuid.New().String(),
+				Email: email,
+				Role:  usermodel.RoleUser,
+			}, nil)
+
+		resp, err := s.ssoClient.Authorize(ctx, &pb.Authorize_Request{
+			ClientId:            "client-123",
+			RedirectUri:         testRedirectURI,
+			ResponseType:        authservice.ResponseTypeCode,
+			State:               "state-123",
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: authservice.CodeChallengeMethodS256,
+			Username:            email,
+			Password:            password,
+		})
+		require.NoError(s.T(), err)
+
+		return resp
+	}
+
+	s.Run("should exchange authorization code for tokens successfully", func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		authorizeResp := authorize(ctx, "code@example.com")
+		assert.NotEmpty(s.T(), authorizeResp.Code)
+		assert.Equal(s.T(), testRedirectURI, authorizeResp.RedirectUri)
+		assert.Equal(s.T(), "state-123", authorizeResp.State)
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (usermodel.User, error) {
+				return usermodel.User{ID: id, Email: "code@example.com", Role: usermodel.RoleUser}, nil
+			})
+
 		req := &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_AuthorizationCodeGrant{
+				AuthorizationCodeGrant: &pb.AuthorizationCodeGrant{
+					Code:         authorizeResp.Code,
+					RedirectUri:  testRedirectURI,
+					CodeVerifier: codeVerifier,
+				},
+			},
 			ClientId: "client-123",
 		}
 
 		resp, err := s.ssoClient.GetToken(ctx, req)
-		assert.Error(s.T(), err)
-		assert.Nil(s.T(), resp)
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.RefreshToken)
+		assert.Empty(s.T(), resp.Token.IdToken)
+		assert.Equal(s.T(), "profile email", resp.Token.Scope)
+
+		_, err = s.ssoClient.GetToken(ctx, req)
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error when code verifier is wrong", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		authorizeResp := authorize(ctx, "code-verifier@example.com")
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_AuthorizationCodeGrant{
+				AuthorizationCodeGrant: &pb.AuthorizationCodeGrant{
+					Code:         authorizeResp.Code,
+					RedirectUri:  testRedirectURI,
+					CodeVerifier: strings.Repeat("a", 43),
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should reject unregistered redirect uri", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.Authorize(ctx, &pb.Authorize_Request{
+			ClientId:            "client-123",
+			RedirectUri:         "https://evil.example.com/callback",
+			ResponseType:        authservice.ResponseTypeCode,
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: authservice.CodeChallengeMethodS256,
+			Username:            "code@example.com",
+			Password:            "password123",
+		})
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
 	})
 }
 
+func (s *SSOServiceTestSuite) TestGetToken_InvalidGrant() {
+	s.Run("should return error when grant type is invalid", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		req := &pb.GetToken_Request{
+			ClientId: "client-123",
+		}
+
+		resp, err := s.ssoClient.GetToken(ctx, req)
+		assert.Error(s.T(), err)
+		assert.Nil(s.T(), resp)
+	})
+}
+
+func (s *SSOServiceTestSuite) TestGetToken_ClientCredentialsGrant() {
+	createClient := func(ctx context.Context) *pb.CreateClient_Response {
+		resp, err := s.clientService.Create(ctx, clientservice.CreateRequest{
+			Name:         "billing-worker",
+			Confidential: true,
+			GrantTypes:   []string{cliententity.GrantTypeClientCredentials},
+			Scopes:       []string{"files:write"},
+		})
+		require.NoError(s.T(), err)
+
+		return &pb.CreateClient_Response{
+			Client:       &pb.Client{ClientId: resp.Client.ID},
+			ClientSecret: &resp.Secret,
+		}
+	}
+
+	s.Run("should get token with client credentials grant successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		client := createClient(ctx)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_ClientCredentialsGrant{
+				ClientCredentialsGrant: &pb.ClientCredentialsGrant{},
+			},
+			ClientId:     client.Client.ClientId,
+			ClientSecret: client.GetClientSecret(),
+			Scope:        "files:write",
+		})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.Empty(s.T(), resp.Token.RefreshToken)
+
+		introspection, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: resp.Token.AccessToken,
+		})
+		require.NoError(s.T(), err)
+		assert.True(s.T(), introspection.Active)
+		assert.Equal(s.T(), client.Client.ClientId, introspection.GetSub())
+	})
+
+	s.Run("should accept client credentials in basic authorization header", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		client := createClient(ctx)
+
+		credentials := base64.StdEncoding.EncodeToString([]byte(client.Client.ClientId + ":" + client.GetClientSecret()))
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BasicPrefix+credentials)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_ClientCredentialsGrant{
+				ClientCredentialsGrant: &pb.ClientCredentialsGrant{},
+			},
+		})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+	})
+
+	s.Run("should return error when client secret is wrong", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		client := createClient(ctx)
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_ClientCredentialsGrant{
+				ClientCredentialsGrant: &pb.ClientCredentialsGrant{},
+			},
+			ClientId:     client.Client.ClientId,
+			ClientSecret: "wrong-secret",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error for public client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_ClientCredentialsGrant{
+				ClientCredentialsGrant: &pb.ClientCredentialsGrant{},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should return error when client is unknown", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: "unknown-client@example.com",
+					Password: "password123",
+				},
+			},
+			ClientId: "client-unknown",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestGetToken_TokenExchangeGrant() {
+	s.Run("should return error when subject token is invalid", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_TokenExchangeGrant{
+				TokenExchangeGrant: &pb.TokenExchangeGrant{
+					SubjectToken:     "invalid-token",
+					SubjectTokenType: authservice.TokenTypeAccessToken,
+					RequestedSubject: uuid.New().String(),
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error when subject token is not an admin's", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "member@example.com", "client-123")
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_TokenExchangeGrant{
+				TokenExchangeGrant: &pb.TokenExchangeGrant{
+					SubjectToken:     token.AccessToken,
+					SubjectTokenType: authservice.TokenTypeAccessToken,
+					RequestedSubject: uuid.New().String(),
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestGetToken_DPoP() {
+	const tokenURL = "https://api.kgym.test/api/v1/sso/token"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(s.T(), err)
+
+	point, err := key.PublicKey.Bytes()
+	require.NoError(s.T(), err)
+
+	jwk := pkgauth.JSONWebKey{
+		KeyType: "EC",
+		Curve:   "P-256",
+		X:       base64.RawURLEncoding.EncodeToString(point[1:33]),
+		Y:       base64.RawURLEncoding.EncodeToString(point[33:]),
+	}
+
+	jkt, err := jwk.Thumbprint()
+	require.NoError(s.T(), err)
+
+	// withRequestProof adds a fresh proof of the request and the request the
+	// gateway forwards with it. The proof carries the hash of accessToken if
+	// it is set.
+	withRequestProof := func(ctx context.Context, method, url, accessToken string) context.Context {
+		claims := jwt.MapClaims{
+			"jti": uuid.NewString(),
+			"htm": method,
+			"htu": url,
+			"iat": time.Now().Unix(),
+		}
+		if accessToken != "" {
+			claims["ath"] = pkgauth.AccessTokenHash(accessToken)
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["typ"] = pkgauth.ProofType
+		token.Header["jwk"] = jwk
+
+		proof, err := token.SignedString(key)
+		require.NoError(s.T(), err)
+
+		return metadata.AppendToOutgoingContext(ctx,
+			pkgauth.HeaderDPoP, proof,
+			pkgauth.HeaderHTTPMethod, method,
+			pkgauth.HeaderHTTPURL, url,
+		)
+	}
+
+	withProof := func(ctx context.Context) context.Context {
+		return withRequestProof(ctx, http.MethodPost, tokenURL, "")
+	}
+
+	passwordGrant := func(email string) *pb.GetToken_Request {
+		return &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+		}
+	}
+
+	s.Run("should bind tokens to the key of the proof", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		user := usermodel.User{ID: uuid.New().String(), Email: "dpop@example.com", Role: usermodel.RoleUser}
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), user.ID).
+			Return(user, nil)
+
+		proofCtx := withProof(ctx)
+
+		resp, err := s.ssoClient.GetToken(proofCtx, passwordGrant(user.Email))
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), pb.TokenType_TOKEN_TYPE_DPOP, resp.Token.TokenType)
+
+		claims := authservice.AccessTokenClaims{}
+		_, _, err = jwt.NewParser().ParseUnverified(resp.Token.AccessToken, &claims)
+		require.NoError(s.T(), err)
+		require.NotNil(s.T(), claims.Confirmation)
+		assert.Equal(s.T(), jkt, claims.Confirmation.JKT)
+
+		refreshReq := &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: resp.Token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+		}
+
+		// The client is public, so its refresh token is only redeemed
+		// with a proof of the same key.
+		_, err = s.ssoClient.GetToken(ctx, refreshReq)
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+		proofCtx = withProof(ctx)
+
+		refreshResp, err := s.ssoClient.GetToken(proofCtx, refreshReq)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), pb.TokenType_TOKEN_TYPE_DPOP, refreshResp.Token.TokenType)
+	})
+
+	s.Run("should reject a replayed proof", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		user := usermodel.User{ID: uuid.New().String(), Email: "replay@example.com", Role: usermodel.RoleUser}
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		proofCtx := withProof(ctx)
+
+		_, err = s.ssoClient.GetToken(proofCtx, passwordGrant(user.Email))
+		require.NoError(s.T(), err)
+
+		_, err = s.ssoClient.GetToken(proofCtx, passwordGrant(user.Email))
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should issue bearer tokens without a proof", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "bearer@example.com", "client-123")
+		assert.Equal(s.T(), pb.TokenType_TOKEN_TYPE_BEARER, token.TokenType)
+	})
+
+	s.Run("should only accept bound tokens with a proof of their key", func() {
+		const userInfoURL = "https://api.kgym.test/api/v1/oauth/userinfo"
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		user := usermodel.User{ID: uuid.New().String(), Email: "bound@example.com", Role: usermodel.RoleUser}
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		resp, err := s.ssoClient.GetToken(withProof(ctx), passwordGrant(user.Email))
+		require.NoError(s.T(), err)
+		accessToken := resp.Token.AccessToken
+
+		// A bound token sent as a bearer token is refused, even when a
+		// valid proof comes along.
+		bearerCtx := metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+accessToken)
+
+		_, err = s.ssoClient.GetUserInfo(bearerCtx, &pb.GetUserInfo_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.ssoClient.GetUserInfo(withRequestProof(bearerCtx, http.MethodGet, userInfoURL, accessToken), &pb.GetUserInfo_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.sessionClient.ListSessions(bearerCtx, &pb.ListSessions_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		dpopCtx := metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, DPoPPrefix+accessToken)
+
+		_, err = s.ssoClient.GetUserInfo(dpopCtx, &pb.GetUserInfo_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err), "a proof is required")
+
+		_, err = s.ssoClient.GetUserInfo(withRequestProof(dpopCtx, http.MethodGet, userInfoURL, "another-token"), &pb.GetUserInfo_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err), "the proof has to carry the hash of the token")
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), user.ID).
+			Return(user, nil)
+
+		userInfo, err := s.ssoClient.GetUserInfo(withRequestProof(dpopCtx, http.MethodGet, userInfoURL, accessToken), &pb.GetUserInfo_Request{})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), user.ID, userInfo.Sub)
+
+		// The subject token of a token exchange is not sent in a header,
+		// so it is only accepted with a proof of the key it is bound to.
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_TokenExchangeGrant{
+				TokenExchangeGrant: &pb.TokenExchangeGrant{
+					SubjectToken:     accessToken,
+					SubjectTokenType: authservice.TokenTypeAccessToken,
+					RequestedSubject: uuid.New().String(),
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should refuse unbound tokens sent with the dpop scheme", func() {
+		const userInfoURL = "https://api.kgym.test/api/v1/oauth/userinfo"
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "unbound@example.com", "client-123")
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, DPoPPrefix+token.AccessToken)
+
+		_, err := s.ssoClient.GetUserInfo(withRequestProof(ctx, http.MethodGet, userInfoURL, token.AccessToken), &pb.GetUserInfo_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestClientService() {
+	// role is the role the admin has by the time the token is used.
+	adminContext := func(ctx context.Context, role usermodel.Role) context.Context {
+		accessToken := s.adminAccessToken(ctx, uuid.New().String())
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (usermodel.User, error) {
+				return usermodel.User{
+					ID:    id,
+					Email: "admin@example.com",
+					Role:  role,
+				}, nil
+			})
+
+		return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+accessToken)
+	}
+
+	s.Run("should create, rotate and disable client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		ctx = adminContext(ctx, usermodel.RoleAdmin)
+
+		created, err := s.clientClient.CreateClient(ctx, &pb.CreateClient_Request{
+			Name:         "reporting",
+			Confidential: true,
+			GrantTypes:   []string{cliententity.GrantTypeClientCredentials},
+		})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), created.GetClientSecret())
+		assert.Equal(s.T(), int64(clientservice.DefaultAccessTokenTTL.Seconds()), created.Client.AccessTokenTtlSeconds)
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			Return(usermodel.User{Role: usermodel.RoleAdmin}, nil).
+			Times(3)
+
+		rotated, err := s.clientClient.RotateClientSecret(ctx, &pb.RotateClientSecret_Request{
+			ClientId: created.Client.ClientId,
+		})
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), created.GetClientSecret(), rotated.ClientSecret)
+
+		_, err = s.clientClient.DisableClient(ctx, &pb.DisableClient_Request{
+			ClientId: created.Client.ClientId,
+		})
+		require.NoError(s.T(), err)
+
+		list, err := s.clientClient.ListClients(ctx, &pb.ListClients_Request{})
+		require.NoError(s.T(), err)
+
+		var found *pb.Client
+		for _, client := range list.Clients {
+			if client.ClientId == created.Client.ClientId {
+				found = client
+			}
+		}
+		require.NotNil(s.T(), found)
+		assert.True(s.T(), found.Disabled)
+	})
+
+	s.Run("should return error when caller is not admin", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "member@example.com", "client-123")
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+
+		_, err := s.clientClient.ListClients(ctx, &pb.ListClients_Request{})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should return error when admin role was taken away", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		ctx = adminContext(ctx, usermodel.RoleUser)
+
+		_, err := s.clientClient.ListClients(ctx, &pb.ListClients_Request{})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should return error when bearer token is missing", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.clientClient.ListClients(ctx, &pb.ListClients_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error when client metadata is invalid", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		ctx = adminContext(ctx, usermodel.RoleAdmin)
+
+		_, err := s.clientClient.CreateClient(ctx, &pb.CreateClient_Request{
+			Name:       "broken",
+			GrantTypes: []string{cliententity.GrantTypeClientCredentials},
+		})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should return error when rotating secret of public client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		ctx = adminContext(ctx, usermodel.RoleAdmin)
+
+		_, err := s.clientClient.RotateClientSecret(ctx, &pb.RotateClientSecret_Request{
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestMFA() {
+	admin := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "admin@example.com",
+		Role:  usermodel.RoleAdmin,
+	}
+
+	passwordGrant := func(ctx context.Context) error {
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), admin.Email, "password123").
+			Return(admin, nil)
+
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: admin.Email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+			Scope:    "openid",
+		})
+		return err
+	}
+
+	mfaChallenge := func(err error) *errdetails.ErrorInfo {
+		st, ok := status.FromError(err)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), codes.PermissionDenied, st.Code())
+
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == ErrorReasonMFARequired {
+				return info
+			}
+		}
+
+		require.FailNow(s.T(), "mfa_required error details are missing")
+		return nil
+	}
+
+	s.Run("should enroll admin and complete mfa challenge", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), admin.ID).
+			Return(admin, nil).
+			AnyTimes()
+
+		challenge := mfaChallenge(passwordGrant(ctx))
+		assert.Equal(s.T(), "true", challenge.Metadata["enrollment_required"])
+		mfaToken := challenge.Metadata["mfa_token"]
+		require.NotEmpty(s.T(), mfaToken)
+
+		enrolled, err := s.mfaClient.EnrollTOTP(ctx, &pb.EnrollTOTP_Request{MfaToken: &mfaToken})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), enrolled.ProvisioningUri, enrolled.QrPayload)
+
+		secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrolled.Secret)
+		require.NoError(s.T(), err)
+
+		confirmed, err := s.mfaClient.ConfirmTOTP(ctx, &pb.ConfirmTOTP_Request{
+			Code:     totp.Code(secret, totp.Step(time.Now())),
+			MfaToken: &mfaToken,
+		})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), confirmed.RecoveryCodes, mfaservice.RecoveryCodeCount)
+
+		challenge = mfaChallenge(passwordGrant(ctx))
+		assert.Equal(s.T(), "false", challenge.Metadata["enrollment_required"])
+
+		mfaOTPGrant := func(otp string) (*pb.GetToken_Response, error) {
+			return s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+				Grant: &pb.GetToken_Request_MfaOtpGrant{
+					MfaOtpGrant: &pb.MFAOTPGrant{
+						MfaToken: challenge.Metadata["mfa_token"],
+						Otp:      otp,
+					},
+				},
+				ClientId: "client-123",
+			})
+		}
+
+		_, err = mfaOTPGrant("000000")
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		// The code of the current step was spent on confirmation, so the
+		// recovery code is used to sign in.
+		resp, err := mfaOTPGrant(confirmed.RecoveryCodes[0])
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.RefreshToken)
+		assert.NotEmpty(s.T(), resp.Token.IdToken)
+
+		_, err = mfaOTPGrant(confirmed.RecoveryCodes[1])
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err), "challenge must be single use")
+	})
+
+	s.Run("should return error when mfa token is invalid", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		mfaToken := "invalid"
+		_, err := s.mfaClient.EnrollTOTP(ctx, &pb.EnrollTOTP_Request{MfaToken: &mfaToken})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) issueTokens(ctx context.Context, email, clientID string) *pb.Token {
+	password := "password123"
+
+	s.userRepo.EXPECT().
+		VerifyPassword(gomock.Any(), email, password).
+		Return(usermodel.User{
+			ID:    uuid.New().String(),
+			Email: email,
+			Role:  usermodel.RoleUser,
+		}, nil)
+
+	_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+	require.NoError(s.T(), err)
+
+	resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+		Grant: &pb.GetToken_Request_PasswordGrant{
+			PasswordGrant: &pb.PasswordGrant{
+				Username: email,
+				Password: password,
+			},
+		},
+		ClientId: clientID,
+	})
+	require.NoError(s.T(), err)
+
+	return resp.Token
+}
+
+// signAccessToken signs an access token with the current signing key, for
+// claims no grant the tests can reach would issue.
+func (s *SSOServiceTestSuite) signAccessToken(ctx context.Context, claims authservice.AccessTokenClaims) string {
+	_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+	require.NoError(s.T(), err)
+
+	key, err := s.keyRepo.GetCurrentSigningKey(ctx)
+	require.NoError(s.T(), err)
+
+	now := time.Now()
+	claims.Issuer = testIssuer
+	claims.ID = uuid.New().String()
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(time.Minute))
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	token.Header["typ"] = authservice.JWTTypeAccessToken
+
+	signed, err := token.SignedString(key.Private)
+	require.NoError(s.T(), err)
+
+	return signed
+}
+
+// adminAccessToken signs an access token of an admin with the admin scope,
+// which the clients of the tests are not registered for.
+func (s *SSOServiceTestSuite) adminAccessToken(ctx context.Context, subject string) string {
+	return s.signAccessToken(ctx, authservice.AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  subject,
+			Audience: jwt.ClaimStrings{"client-123"},
+		},
+		Scope: "openid " + authservice.ScopeAdmin,
+		Role:  string(usermodel.RoleAdmin),
+	})
+}
+
+func (s *SSOServiceTestSuite) TestImpersonation() {
+	impersonationContext := func(ctx context.Context) context.Context {
+		accessToken := s.signAccessToken(ctx, authservice.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:  uuid.New().String(),
+				Audience: jwt.ClaimStrings{"client-123"},
+			},
+			Scope: "openid profile email",
+			Role:  string(usermodel.RoleUser),
+			Actor: &authservice.ActorClaims{Subject: uuid.New().String()},
+		})
+
+		return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+accessToken)
+	}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "should refuse to register a passkey",
+			call: func(ctx context.Context) error {
+				_, err := s.passkeyClient.BeginPasskeyRegistration(ctx, &pb.BeginPasskeyRegistration_Request{})
+				return err
+			},
+		},
+		{
+			name: "should refuse to create an api key",
+			call: func(ctx context.Context) error {
+				_, err := s.apiKeyClient.CreateAPIKey(ctx, &pb.CreateAPIKey_Request{Name: "impersonated"})
+				return err
+			},
+		},
+		{
+			name: "should refuse to revoke an api key",
+			call: func(ctx context.Context) error {
+				_, err := s.apiKeyClient.RevokeAPIKey(ctx, &pb.RevokeAPIKey_Request{ApiKeyId: uuid.New().String()})
+				return err
+			},
+		},
+		{
+			name: "should refuse to enroll totp",
+			call: func(ctx context.Context) error {
+				_, err := s.mfaClient.EnrollTOTP(ctx, &pb.EnrollTOTP_Request{})
+				return err
+			},
+		},
+		{
+			name: "should refuse to disable totp",
+			call: func(ctx context.Context) error {
+				_, err := s.mfaClient.DisableTOTP(ctx, &pb.DisableTOTP_Request{Code: "000000"})
+				return err
+			},
+		},
+		{
+			name: "should refuse to revoke a session",
+			call: func(ctx context.Context) error {
+				_, err := s.sessionClient.RevokeSession(ctx, &pb.RevokeSession_Request{SessionId: uuid.New().String()})
+				return err
+			},
+		},
+		{
+			name: "should refuse to revoke all sessions",
+			call: func(ctx context.Context) error {
+				_, err := s.sessionClient.RevokeAllSessions(ctx, &pb.RevokeAllSessions_Request{})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			err := tt.call(impersonationContext(ctx))
+			assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+		})
+	}
+}
+
+func (s *SSOServiceTestSuite) TestThrottle() {
+	email := "throttled@example.com"
+
+	passwordGrant := func(ctx context.Context, password string) error {
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: email,
+					Password: password,
+				},
+			},
+			ClientId: "client-123",
+		})
+		return err
+	}
+
+	retryDelay := func(err error) time.Duration {
+		st, ok := status.FromError(err)
+		require.True(s.T(), ok)
+
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				return info.RetryDelay.AsDuration()
+			}
+		}
+
+		require.FailNow(s.T(), "retry info is missing")
+		return 0
+	}
+
+	s.Run("should lock out account and let admin unlock it", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials).
+			Times(3)
+
+		for range 3 {
+			err := passwordGrant(ctx, "wrong-password")
+			require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+		}
+
+		// The password is not checked while the account is locked out.
+		err = passwordGrant(ctx, "password123")
+		require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+		assert.InDelta(s.T(), 15*time.Minute, retryDelay(err), float64(time.Minute))
+
+		adminToken := s.adminAccessToken(ctx, uuid.New().String())
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			Return(usermodel.User{Role: usermodel.RoleAdmin}, nil)
+
+		adminCtx := metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+adminToken)
+		_, err = s.adminClient.UnlockAccount(adminCtx, &pb.UnlockAccount_Request{Username: &email})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, "password123").
+			Return(usermodel.User{ID: uuid.New().String(), Email: email, Role: usermodel.RoleUser}, nil)
+
+		err = passwordGrant(ctx, "password123")
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should reject unlock from non-admin", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "user@example.com", "client-123")
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+		_, err := s.adminClient.UnlockAccount(ctx, &pb.UnlockAccount_Request{Username: &email})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestAuthEvents() {
+	email := "audited@example.com"
+
+	adminContext := func(ctx context.Context) context.Context {
+		accessToken := s.adminAccessToken(ctx, uuid.New().String())
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			Return(usermodel.User{Role: usermodel.RoleAdmin}, nil)
+
+		return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+accessToken)
+	}
+
+	s.Run("should record failed login and list it to admins", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+
+		loginCtx := metadata.AppendToOutgoingContext(ctx, HeaderClientIP, "203.0.113.7", HeaderDevice, "Mozilla/5.0")
+		_, err = s.ssoClient.GetToken(loginCtx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: email,
+					Password: "wrong-password",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		outcome := string(authevententity.OutcomeFailure)
+		resp, err := s.adminClient.ListAuthEvents(adminContext(ctx), &pb.ListAuthEvents_Request{
+			Outcome: &outcome,
+		})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), resp.Events, 1)
+
+		event := resp.Events[0]
+		assert.Equal(s.T(), string(authevententity.TypeLogin), event.Type)
+		assert.Equal(s.T(), "client-123", event.ClientId)
+		assert.Equal(s.T(), "203.0.113.7", event.Ip)
+		assert.Equal(s.T(), "Mozilla/5.0", event.UserAgent)
+		assert.Equal(s.T(), "invalid credentials", event.Reason)
+		assert.Empty(s.T(), resp.NextPageToken)
+	})
+
+	s.Run("should record the address set by the gateway over one sent by the caller", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		since := timestamppb.Now()
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), email, "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+
+		// grpc-gateway puts Grpc-Metadata-X-Client-Ip headers of the caller
+		// ahead of the address it resolved.
+		loginCtx := metadata.AppendToOutgoingContext(ctx,
+			HeaderClientIP, "198.51.100.66",
+			HeaderClientIP, "203.0.113.8",
+		)
+		_, err := s.ssoClient.GetToken(loginCtx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: email,
+					Password: "wrong-password",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		outcome := string(authevententity.OutcomeFailure)
+		resp, err := s.adminClient.ListAuthEvents(adminContext(ctx), &pb.ListAuthEvents_Request{
+			Outcome: &outcome,
+			Since:   since,
+		})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), resp.Events, 1)
+		assert.Equal(s.T(), "203.0.113.8", resp.Events[0].Ip)
+	})
+
+	s.Run("should page through events", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Signing in as admin activates a key and records the login.
+		since := timestamppb.Now()
+		adminCtx := adminContext(ctx)
+
+		var ids []string
+		var pageToken string
+		for {
+			resp, err := s.adminClient.ListAuthEvents(adminCtx, &pb.ListAuthEvents_Request{
+				Types:     []string{string(authevententity.TypeLogin), string(authevententity.TypeKeyActivated)},
+				Since:     since,
+				PageSize:  1,
+				PageToken: pageToken,
+			})
+			require.NoError(s.T(), err)
+			require.LessOrEqual(s.T(), len(resp.Events), 1)
+
+			for _, event := range resp.Events {
+				ids = append(ids, event.Id)
+			}
+
+			pageToken = resp.NextPageToken
+			if pageToken == "" {
+				break
+			}
+		}
+
+		assert.Len(s.T(), ids, 2)
+		assert.NotEqual(s.T(), ids[0], ids[1])
+	})
+
+	s.Run("should reject invalid page token", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.adminClient.ListAuthEvents(adminContext(ctx), &pb.ListAuthEvents_Request{
+			PageToken: "not-a-token",
+		})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should reject non-admin", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "user@example.com", "client-123")
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+		_, err := s.adminClient.ListAuthEvents(ctx, &pb.ListAuthEvents_Request{})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestSessions() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "sessions@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	login := func(ctx context.Context, device string) *pb.Token {
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderDevice, device, HeaderPlatform, "ios", HeaderAppVersion, "1.2.3")
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: user.Email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		return resp.Token
+	}
+
+	refresh := func(ctx context.Context, token *pb.Token) error {
+		_, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+		})
+		return err
+	}
+
+	withToken := func(ctx context.Context, token *pb.Token) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+	}
+
+	s.Run("should list and revoke sessions of the caller", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		phone := login(ctx, "iPhone")
+		laptop := login(ctx, "MacBook")
+
+		resp, err := s.sessionClient.ListSessions(withToken(ctx, phone), &pb.ListSessions_Request{})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), resp.Sessions, 2)
+
+		var laptopSessionID string
+		for _, session := range resp.Sessions {
+			assert.Equal(s.T(), "client-123", session.ClientId)
+			assert.Equal(s.T(), "ios", session.Platform)
+			assert.Equal(s.T(), "1.2.3", session.AppVersion)
+			assert.Equal(s.T(), session.Device == "iPhone", session.Current)
+			if session.Device == "MacBook" {
+				laptopSessionID = session.SessionId
+			}
+		}
+		require.NotEmpty(s.T(), laptopSessionID)
+
+		_, err = s.sessionClient.RevokeSession(withToken(ctx, phone), &pb.RevokeSession_Request{SessionId: laptopSessionID})
+		require.NoError(s.T(), err)
+
+		err = refresh(ctx, laptop)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		err = refresh(ctx, phone)
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should revoke all other sessions", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		current := login(ctx, "iPhone")
+		other := login(ctx, "MacBook")
+
+		resp, err := s.sessionClient.RevokeAllSessions(withToken(ctx, current), &pb.RevokeAllSessions_Request{KeepCurrent: true})
+		require.NoError(s.T(), err)
+		assert.GreaterOrEqual(s.T(), resp.Revoked, int32(1))
+
+		err = refresh(ctx, other)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		list, err := s.sessionClient.ListSessions(withToken(ctx, current), &pb.ListSessions_Request{})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), list.Sessions, 1)
+		assert.True(s.T(), list.Sessions[0].Current)
+	})
+
+	s.Run("should not revoke session of another user", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		victim := login(ctx, "iPhone")
+		attacker := s.issueTokens(ctx, "attacker@example.com", "client-123")
+
+		list, err := s.sessionClient.ListSessions(withToken(ctx, victim), &pb.ListSessions_Request{})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), list.Sessions)
+
+		_, err = s.sessionClient.RevokeSession(withToken(ctx, attacker), &pb.RevokeSession_Request{SessionId: list.Sessions[0].SessionId})
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+
+		_, err = s.sessionClient.ListSessions(withToken(ctx, attacker), &pb.ListSessions_Request{UserId: &user.ID})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should require bearer token", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.sessionClient.ListSessions(ctx, &pb.ListSessions_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestAccount() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "account@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	login := func(ctx context.Context) *pb.Token {
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: user.Email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		return resp.Token
+	}
+
+	mailedToken := func() string {
+		msg, ok := s.mailer.Last(user.Email)
+		require.True(s.T(), ok, "no email sent")
+
+		for _, field := range strings.Fields(msg.Body) {
+			if u, err := url.Parse(field); err == nil && u.Query().Has("token") {
+				return u.Query().Get("token")
+			}
+		}
+
+		require.FailNow(s.T(), "link is missing")
+		return ""
+	}
+
+	s.Run("should reset password and sign out everywhere", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		token := login(ctx)
+
+		s.userRepo.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+
+		_, err = s.accountClient.RequestPasswordReset(ctx, &pb.RequestPasswordReset_Request{Email: user.Email})
+		require.NoError(s.T(), err)
+
+		resetToken := mailedToken()
+
+		s.userRepo.EXPECT().UpdatePassword(gomock.Any(), user.ID, "new-password123").Return(nil)
+
+		_, err = s.accountClient.ResetPassword(ctx, &pb.ResetPassword_Request{
+			Token:       resetToken,
+			NewPassword: "new-password123",
+		})
+		require.NoError(s.T(), err)
+
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.accountClient.ResetPassword(ctx, &pb.ResetPassword_Request{
+			Token:       resetToken,
+			NewPassword: "another-password123",
+		})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should not reveal unknown email", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		s.userRepo.EXPECT().
+			GetByEmail(gomock.Any(), "missing@example.com").
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+
+		_, err := s.accountClient.RequestPasswordReset(ctx, &pb.RequestPasswordReset_Request{Email: "missing@example.com"})
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), s.mailer.Messages())
+	})
+
+	s.Run("should verify email once", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		token := login(ctx)
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		authCtx := metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+		_, err = s.accountClient.SendVerificationEmail(authCtx, &pb.SendVerificationEmail_Request{})
+		require.NoError(s.T(), err)
+
+		verificationToken := mailedToken()
+
+		s.userRepo.EXPECT().MarkEmailVerified(gomock.Any(), user.ID).Return(nil)
+
+		_, err = s.accountClient.VerifyEmail(ctx, &pb.VerifyEmail_Request{Token: verificationToken})
+		require.NoError(s.T(), err)
+
+		_, err = s.accountClient.VerifyEmail(ctx, &pb.VerifyEmail_Request{Token: verificationToken})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should require bearer token to send verification email", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.accountClient.SendVerificationEmail(ctx, &pb.SendVerificationEmail_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestPasswordless() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "passwordless@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	start := func(ctx context.Context) string {
+		resp, err := s.ssoClient.StartPasswordless(ctx, &pb.StartPasswordless_Request{
+			Email:    user.Email,
+			ClientId: "client-123",
+			Scope:    "openid",
+		})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), resp.PasswordlessToken)
+		assert.Equal(s.T(), int64((10 * time.Minute).Seconds()), resp.ExpiresIn)
+
+		return resp.PasswordlessToken
+	}
+
+	s.Run("should sign in with code sent by email", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		token := start(ctx)
+
+		msg, ok := s.passwordlessSender.Last()
+		require.True(s.T(), ok, "no code sent")
+		assert.Equal(s.T(), user.Email, msg.To)
+
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordlessGrant{
+				PasswordlessGrant: &pb.PasswordlessGrant{
+					PasswordlessToken: token,
+					Code:              "not-a-code",
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordlessGrant{
+				PasswordlessGrant: &pb.PasswordlessGrant{
+					PasswordlessToken: token,
+					Code:              msg.Code,
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.RefreshToken)
+		assert.NotEmpty(s.T(), resp.Token.IdToken)
+
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordlessGrant{
+				PasswordlessGrant: &pb.PasswordlessGrant{
+					PasswordlessToken: token,
+					Code:              msg.Code,
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should sign in with magic link", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		s.userRepo.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(user, nil)
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		start(ctx)
+
+		msg, ok := s.passwordlessSender.Last()
+		require.True(s.T(), ok, "no code sent")
+
+		link, err := url.Parse(msg.Link)
+		require.NoError(s.T(), err)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordlessGrant{
+				PasswordlessGrant: &pb.PasswordlessGrant{
+					LinkToken: link.Query().Get("token"),
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+	})
+
+	s.Run("should rate limit codes per email", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		s.userRepo.EXPECT().GetByEmail(gomock.Any(), "limited@example.com").Return(usermodel.User{}, userrepo.ErrUserNotFound).Times(3)
+
+		for range 3 {
+			_, err := s.ssoClient.StartPasswordless(ctx, &pb.StartPasswordless_Request{
+				Email:    "limited@example.com",
+				ClientId: "client-123",
+			})
+			require.NoError(s.T(), err)
+		}
+
+		_, err := s.ssoClient.StartPasswordless(ctx, &pb.StartPasswordless_Request{
+			Email:    "limited@example.com",
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+
+		_, sent := s.passwordlessSender.Last()
+		assert.False(s.T(), sent)
+	})
+}
+
+func (s *SSOServiceTestSuite) TestDeviceAuthorization() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "device@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	start := func(ctx context.Context) *pb.StartDeviceAuthorization_Response {
+		resp, err := s.ssoClient.StartDeviceAuthorization(ctx, &pb.StartDeviceAuthorization_Request{
+			ClientId: "client-123",
+			Scope:    "openid profile",
+		})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), resp.DeviceCode)
+		require.NotEmpty(s.T(), resp.UserCode)
+		assert.Equal(s.T(), testDeviceVerificationURL, resp.VerificationUri)
+		assert.Equal(s.T(), testDeviceVerificationURL+"?user_code="+resp.UserCode, resp.VerificationUriComplete)
+		assert.Equal(s.T(), int64((10 * time.Minute).Seconds()), resp.ExpiresIn)
+
+		return resp
+	}
+
+	poll := func(ctx context.Context, deviceCode string) (*pb.GetToken_Response, error) {
+		return s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_DeviceCodeGrant{
+				DeviceCodeGrant: &pb.DeviceCodeGrant{
+					DeviceCode: deviceCode,
+				},
+			},
+			ClientId: "client-123",
+		})
+	}
+
+	authorize := func(ctx context.Context, userCode string, approve bool) error {
+		s.userRepo.EXPECT().VerifyPassword(gomock.Any(), user.Email, "password123").Return(user, nil)
+
+		_, err := s.ssoClient.AuthorizeDevice(ctx, &pb.AuthorizeDevice_Request{
+			UserCode: userCode,
+			Approve:  approve,
+			Username: user.Email,
+			Password: "password123",
+		})
+		return err
+	}
+
+	reason := func(err error, code codes.Code) string {
+		st, ok := status.FromError(err)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), code, st.Code())
+
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				return info.Reason
+			}
+		}
+
+		return ""
+	}
+
+	s.Run("should sign in device once the user approves it", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		started := start(ctx)
+
+		_, err = poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), ErrorReasonAuthorizationPending, reason(err, codes.FailedPrecondition))
+
+		authorization, err := s.ssoClient.GetDeviceAuthorization(ctx, &pb.GetDeviceAuthorization_Request{
+			UserCode: started.UserCode,
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "client-123", authorization.ClientId)
+		assert.Equal(s.T(), "openid profile", authorization.Scope)
+
+		require.NoError(s.T(), authorize(ctx, started.UserCode, true))
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		time.Sleep(testDeviceInterval)
+
+		resp, err := poll(ctx, started.DeviceCode)
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.RefreshToken)
+		assert.NotEmpty(s.T(), resp.Token.IdToken)
+		assert.Equal(s.T(), "openid profile", resp.Token.Scope)
+
+		_, err = poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.ssoClient.GetDeviceAuthorization(ctx, &pb.GetDeviceAuthorization_Request{
+			UserCode: started.UserCode,
+		})
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+	})
+
+	s.Run("should slow down device polling too fast", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		started := start(ctx)
+
+		_, err := poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), ErrorReasonAuthorizationPending, reason(err, codes.FailedPrecondition))
+
+		_, err = poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), ErrorReasonSlowDown, reason(err, codes.ResourceExhausted))
+
+		var retryDelay time.Duration
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				retryDelay = info.GetRetryDelay().AsDuration()
+			}
+		}
+		assert.Equal(s.T(), (testDeviceInterval + deviceservice.SlowDownIncrement).Round(time.Second), retryDelay)
+	})
+
+	s.Run("should tell device the user denied it", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		started := start(ctx)
+
+		require.NoError(s.T(), authorize(ctx, started.UserCode, false))
+
+		_, err := poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), ErrorReasonAccessDenied, reason(err, codes.PermissionDenied))
+
+		_, err = s.ssoClient.AuthorizeDevice(ctx, &pb.AuthorizeDevice_Request{
+			UserCode: started.UserCode,
+			Approve:  true,
+			Username: user.Email,
+			Password: "password123",
+		})
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+	})
+
+	s.Run("should reject invalid credentials and keep authorization pending", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		started := start(ctx)
+
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+
+		_, err := s.ssoClient.AuthorizeDevice(ctx, &pb.AuthorizeDevice_Request{
+			UserCode: started.UserCode,
+			Approve:  true,
+			Username: user.Email,
+			Password: "wrong-password",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = poll(ctx, started.DeviceCode)
+		assert.Equal(s.T(), ErrorReasonAuthorizationPending, reason(err, codes.FailedPrecondition))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestPasskeys() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "passkey@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	signIn := func(ctx context.Context) context.Context {
+		s.userRepo.EXPECT().VerifyPassword(gomock.Any(), user.Email, "password123").Return(user, nil)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: user.Email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+resp.Token.AccessToken)
+	}
+
+	register := func(ctx context.Context, authenticator *webauthntest.Authenticator) (*pb.Passkey, error) {
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		begun, err := s.passkeyClient.BeginPasskeyRegistration(ctx, &pb.BeginPasskeyRegistration_Request{})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), begun.PasskeyToken)
+		assert.Equal(s.T(), int64(time.Minute.Seconds()), begun.ExpiresIn)
+
+		credential, err := authenticator.Register([]byte(begun.Options))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.passkeyClient.FinishPasskeyRegistration(ctx, &pb.FinishPasskeyRegistration_Request{
+			PasskeyToken: begun.PasskeyToken,
+			Credential:   string(credential),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Passkey, nil
+	}
+
+	login := func(ctx context.Context, authenticator *webauthntest.Authenticator) (string, string) {
+		started, err := s.ssoClient.StartPasskeyLogin(ctx, &pb.StartPasskeyLogin_Request{
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), started.PasskeyToken)
+
+		credential, err := authenticator.Login([]byte(started.Options))
+		require.NoError(s.T(), err)
+
+		return started.PasskeyToken, string(credential)
+	}
+
+	grant := func(ctx context.Context, token, credential string) (*pb.GetToken_Response, error) {
+		return s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_WebauthnGrant{
+				WebauthnGrant: &pb.WebAuthnGrant{
+					PasskeyToken: token,
+					Credential:   credential,
+				},
+			},
+			ClientId: "client-123",
+			Scope:    "openid profile",
+		})
+	}
+
+	s.Run("should sign in with registered passkey", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		authenticator := webauthntest.New(webauthntest.Config{Origin: testPasskeyOrigin})
+
+		passkey, err := register(signIn(ctx), authenticator)
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), passkey.PasskeyId)
+		assert.ElementsMatch(s.T(), []string{"internal", "hybrid"}, passkey.Transports)
+
+		token, credential := login(ctx, authenticator)
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		resp, err := grant(ctx, token, credential)
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.RefreshToken)
+		assert.NotEmpty(s.T(), resp.Token.IdToken)
+		assert.Equal(s.T(), "openid profile", resp.Token.Scope)
+
+		_, err = grant(ctx, token, credential)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should not register the same authenticator twice", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		authenticator := webauthntest.New(webauthntest.Config{Origin: testPasskeyOrigin})
+
+		ctx = signIn(ctx)
+
+		_, err := register(ctx, authenticator)
+		require.NoError(s.T(), err)
+
+		_, err = register(ctx, authenticator)
+		assert.ErrorIs(s.T(), err, webauthntest.ErrExcluded)
+	})
+
+	s.Run("should reject assertion from another origin", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		authenticator := webauthntest.New(webauthntest.Config{Origin: testPasskeyOrigin})
+
+		_, err := register(signIn(ctx), authenticator)
+		require.NoError(s.T(), err)
+
+		phished := webauthntest.New(webauthntest.Config{Origin: "https://app.kgym.evil"})
+
+		_, err = register(signIn(ctx), phished)
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should require a bearer token to register passkey", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.passkeyClient.BeginPasskeyRegistration(ctx, &pb.BeginPasskeyRegistration_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestAPIKeys() {
+	user := usermodel.User{
+		ID:    uuid.New().String(),
+		Email: "apikeys@example.com",
+		Role:  usermodel.RoleUser,
+	}
+
+	withToken := func(ctx context.Context, token *pb.Token) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+	}
+
+	login := func(ctx context.Context) context.Context {
+		s.userRepo.EXPECT().
+			VerifyPassword(gomock.Any(), user.Email, "password123").
+			Return(user, nil)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_PasswordGrant{
+				PasswordGrant: &pb.PasswordGrant{
+					Username: user.Email,
+					Password: "password123",
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		return withToken(ctx, resp.Token)
+	}
+
+	create := func(ctx context.Context) *pb.CreateAPIKey_Response {
+		resp, err := s.apiKeyClient.CreateAPIKey(ctx, &pb.CreateAPIKey_Request{
+			Name:     "reporting",
+			ClientId: "client-123",
+			Scopes:   []string{"profile", "email"},
+		})
+		require.NoError(s.T(), err)
+
+		return resp
+	}
+
+	grant := func(ctx context.Context, apiKey, scope string) (*pb.GetToken_Response, error) {
+		return s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_ApiKeyGrant{
+				ApiKeyGrant: &pb.APIKeyGrant{
+					ApiKey: apiKey,
+				},
+			},
+			Scope: scope,
+		})
+	}
+
+	s.Run("should exchange api key for access token until revoked", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		authCtx := login(ctx)
+
+		created := create(authCtx)
+		require.True(s.T(), strings.HasPrefix(created.ApiKey, created.Key.Prefix+apikeyservice.Separator))
+		assert.Equal(s.T(), string(apikeyentity.OwnerTypeUser), created.Key.OwnerType)
+		assert.Equal(s.T(), user.ID, created.Key.OwnerId)
+		assert.Equal(s.T(), []string{"email", "profile"}, created.Key.Scopes)
+		assert.Nil(s.T(), created.Key.LastUsedAt)
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+		resp, err := grant(ctx, created.ApiKey, "")
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.Empty(s.T(), resp.Token.RefreshToken)
+		assert.Equal(s.T(), "email profile", resp.Token.Scope)
+
+		list, err := s.apiKeyClient.ListAPIKeys(authCtx, &pb.ListAPIKeys_Request{})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), list.Keys, 1)
+		assert.Equal(s.T(), created.Key.ApiKeyId, list.Keys[0].ApiKeyId)
+		assert.NotNil(s.T(), list.Keys[0].LastUsedAt)
+
+		_, err = s.apiKeyClient.RevokeAPIKey(authCtx, &pb.RevokeAPIKey_Request{ApiKeyId: created.Key.ApiKeyId})
+		require.NoError(s.T(), err)
+
+		_, err = grant(ctx, created.ApiKey, "")
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		list, err = s.apiKeyClient.ListAPIKeys(authCtx, &pb.ListAPIKeys_Request{})
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), list.Keys)
+	})
+
+	s.Run("should not widen scopes of api key", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		created := create(login(ctx))
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil).Times(2)
+
+		resp, err := grant(ctx, created.ApiKey, "email")
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "email", resp.Token.Scope)
+
+		_, err = grant(ctx, created.ApiKey, "openid email")
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should reject unknown api key", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		created := create(login(ctx))
+
+		prefix, _, _ := strings.Cut(created.ApiKey, apikeyservice.Separator)
+
+		_, err := grant(ctx, prefix+apikeyservice.Separator+"forged", "")
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should not manage api keys of another user", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		created := create(login(ctx))
+		attacker := withToken(ctx, s.issueTokens(ctx, "attacker@example.com", "client-123"))
+
+		_, err = s.apiKeyClient.RevokeAPIKey(attacker, &pb.RevokeAPIKey_Request{ApiKeyId: created.Key.ApiKeyId})
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+
+		_, err = s.apiKeyClient.ListAPIKeys(attacker, &pb.ListAPIKeys_Request{UserId: &user.ID})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+
+		_, err = s.apiKeyClient.CreateAPIKey(attacker, &pb.CreateAPIKey_Request{
+			Name:          "backdoor",
+			ClientId:      "client-123",
+			Scopes:        []string{"profile"},
+			OwnedByClient: true,
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should refuse tokens of api keys of admins in admin and key methods", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		admin := usermodel.User{
+			ID:    uuid.New().String(),
+			Email: "owner@example.com",
+			Role:  usermodel.RoleAdmin,
+		}
+
+		created := create(metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+s.adminAccessToken(ctx, admin.ID)))
+
+		s.userRepo.EXPECT().GetByID(gomock.Any(), admin.ID).Return(admin, nil)
+
+		resp, err := grant(ctx, created.ApiKey, "")
+		require.NoError(s.T(), err)
+
+		keyCtx := withToken(ctx, resp.Token)
+
+		_, err = s.clientClient.CreateClient(keyCtx, &pb.CreateClient_Request{
+			Name:         "backdoor",
+			Confidential: true,
+			GrantTypes:   []string{cliententity.GrantTypeClientCredentials},
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+
+		_, err = s.apiKeyClient.CreateAPIKey(keyCtx, &pb.CreateAPIKey_Request{
+			Name:     "backdoor",
+			ClientId: "client-123",
+			Scopes:   []string{"profile"},
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+
+		_, err = s.apiKeyClient.ListAPIKeys(keyCtx, &pb.ListAPIKeys_Request{})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should reject scopes not registered for the client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.apiKeyClient.CreateAPIKey(login(ctx), &pb.CreateAPIKey_Request{
+			Name:     "reporting",
+			ClientId: "client-123",
+			Scopes:   []string{"admin"},
+		})
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should require bearer token", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.apiKeyClient.ListAPIKeys(ctx, &pb.ListAPIKeys_Request{})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestFederation() {
+	// Test vector from RFC 7636 appendix B.
+	codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	identity := oidctest.Identity{
+		Subject:           uuid.New().String(),
+		Email:             "jane@corp.test",
+		EmailVerified:     true,
+		GivenName:         "Jane",
+		FamilyName:        "Doe",
+		PreferredUsername: "jane",
+	}
+
+	// signInUpstream starts a federated sign in and returns the callback
+	// parameters the upstream provider sent the user agent back with.
+	signInUpstream := func(ctx context.Context) *pb.CompleteFederation_Request {
+		s.upstream.SetIdentity(identity)
+
+		started, err := s.federationClient.StartFederation(ctx, &pb.StartFederation_Request{
+			Provider:            "acme",
+			ClientId:            "client-123",
+			RedirectUri:         testRedirectURI,
+			ResponseType:        authservice.ResponseTypeCode,
+			Scope:               "openid email",
+			State:               "state-123",
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: authservice.CodeChallengeMethodS256,
+		})
+		require.NoError(s.T(), err)
+
+		client := &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		resp, err := client.Get(started.AuthorizationUrl)
+		require.NoError(s.T(), err)
+		defer resp.Body.Close()
+		require.Equal(s.T(), http.StatusFound, resp.StatusCode)
+
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(s.T(), err)
+		require.True(s.T(), strings.HasPrefix(callback.String(), testFederationCallback))
+
+		return &pb.CompleteFederation_Request{
+			State: callback.Query().Get("state"),
+			Code:  callback.Query().Get("code"),
+		}
+	}
+
+	s.Run("should list identity providers", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := s.federationClient.ListIdentityProviders(ctx, &pb.ListIdentityProviders_Request{})
+		require.NoError(s.T(), err)
+		require.Len(s.T(), resp.Providers, 1)
+		assert.Equal(s.T(), "acme", resp.Providers[0].Id)
+		assert.Equal(s.T(), "Acme Corp", resp.Providers[0].Name)
+	})
+
+	s.Run("should provision user on first sign in and link identity", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, err := s.keyService.Rotate(ctx, keyservice.RotateRequest{Immediate: true})
+		require.NoError(s.T(), err)
+
+		user := usermodel.User{
+			ID:            uuid.New().String(),
+			Email:         identity.Email,
+			EmailVerified: true,
+			Role:          usermodel.RoleUser,
+		}
+
+		s.userRepo.EXPECT().
+			GetByEmail(gomock.Any(), identity.Email).
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+		s.userRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, created usermodel.User, _ string) (string, error) {
+				assert.Equal(s.T(), identity.Email, created.Email)
+				assert.Equal(s.T(), usermodel.RoleUser, created.Role)
+				return user.ID, nil
+			})
+		s.userRepo.EXPECT().
+			MarkEmailVerified(gomock.Any(), user.ID).
+			Return(nil)
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), user.ID).
+			Return(user, nil).
+			Times(2)
+
+		completed, err := s.federationClient.CompleteFederation(ctx, signInUpstream(ctx))
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), completed.Code)
+		assert.Equal(s.T(), testRedirectURI, completed.RedirectUri)
+		assert.Equal(s.T(), "state-123", completed.State)
+
+		resp, err := s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_AuthorizationCodeGrant{
+				AuthorizationCodeGrant: &pb.AuthorizationCodeGrant{
+					Code:         completed.Code,
+					RedirectUri:  testRedirectURI,
+					CodeVerifier: codeVerifier,
+				},
+			},
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Token.AccessToken)
+		assert.NotEmpty(s.T(), resp.Token.IdToken)
+
+		// The identity is linked now, so signing in again goes straight to
+		// the same user.
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), user.ID).
+			Return(user, nil)
+
+		completed, err = s.federationClient.CompleteFederation(ctx, signInUpstream(ctx))
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), completed.Code)
+	})
+
+	s.Run("should reject replayed callback", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			Return(usermodel.User{ID: uuid.New().String(), Email: identity.Email, Role: usermodel.RoleUser}, nil).
+			AnyTimes()
+
+		callback := signInUpstream(ctx)
+
+		_, err := s.federationClient.CompleteFederation(ctx, callback)
+		require.NoError(s.T(), err)
+
+		_, err = s.federationClient.CompleteFederation(ctx, callback)
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("should reject unknown provider", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.federationClient.StartFederation(ctx, &pb.StartFederation_Request{
+			Provider:            "unknown",
+			ClientId:            "client-123",
+			RedirectUri:         testRedirectURI,
+			ResponseType:        authservice.ResponseTypeCode,
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: authservice.CodeChallengeMethodS256,
+		})
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestRevokeToken() {
+	s.Run("should revoke access token successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "revoke-access@example.com", "client-123")
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:         token.AccessToken,
+			TokenTypeHint: ptr(tokenservice.TypeHintAccessToken),
+			ClientId:      "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		resp, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: token.AccessToken,
+		})
+		require.NoError(s.T(), err)
+		assert.False(s.T(), resp.Active)
+	})
+
+	s.Run("should revoke refresh token successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "revoke-refresh@example.com", "client-123")
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:    token.RefreshToken,
+			ClientId: "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		_, err = s.ssoClient.GetToken(ctx, &pb.GetToken_Request{
+			Grant: &pb.GetToken_Request_RefreshTokenGrant{
+				RefreshTokenGrant: &pb.RefreshTokenGrant{
+					RefreshToken: token.RefreshToken,
+				},
+			},
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error when token was issued to another client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "revoke-client@example.com", "client-123")
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:    token.RefreshToken,
+			ClientId: "client-456",
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+
+	s.Run("should succeed for unknown token", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:    "unknown-token",
+			ClientId: "client-123",
+		})
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should reject confidential client without its secret", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:    "unknown-token",
+			ClientId: s.resourceServer.Client.ID,
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:        "unknown-token",
+			ClientId:     s.resourceServer.Client.ID,
+			ClientSecret: "wrong-secret",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should reject unknown client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token: "unknown-token",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestIntrospectToken() {
+	s.Run("should introspect access token successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "introspect-access@example.com", "client-123")
+
+		resp, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token:         token.AccessToken,
+			TokenTypeHint: ptr(tokenservice.TypeHintAccessToken),
+		})
+		require.NoError(s.T(), err)
+		assert.True(s.T(), resp.Active)
+		assert.Equal(s.T(), tokenservice.TypeHintAccessToken, resp.GetTokenType())
+		assert.Equal(s.T(), "client-123", resp.GetClientId())
+		assert.NotEmpty(s.T(), resp.GetSub())
+		assert.NotEmpty(s.T(), resp.GetJti())
+		assert.NotZero(s.T(), resp.GetExp())
+	})
+
+	s.Run("should introspect refresh token successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "introspect-refresh@example.com", "client-123")
+
+		resp, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: token.RefreshToken,
+		})
+		require.NoError(s.T(), err)
+		assert.True(s.T(), resp.Active)
+		assert.Equal(s.T(), tokenservice.TypeHintRefreshToken, resp.GetTokenType())
+		assert.Equal(s.T(), "client-123", resp.GetClientId())
+	})
+
+	s.Run("should report unknown token as inactive", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := s.ssoClient.IntrospectToken(s.resourceServerContext(ctx), &pb.IntrospectToken_Request{
+			Token: "unknown-token",
+		})
+		require.NoError(s.T(), err)
+		assert.False(s.T(), resp.Active)
+		assert.Nil(s.T(), resp.Sub)
+	})
+
+	s.Run("should reject unauthenticated caller", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "introspect-anonymous@example.com", "client-123")
+
+		_, err := s.ssoClient.IntrospectToken(ctx, &pb.IntrospectToken_Request{
+			Token: token.AccessToken,
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.ssoClient.IntrospectToken(ctx, &pb.IntrospectToken_Request{
+			Token:        token.AccessToken,
+			ClientId:     s.resourceServer.Client.ID,
+			ClientSecret: "wrong-secret",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should reject public client", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "introspect-public@example.com", "client-123")
+
+		_, err := s.ssoClient.IntrospectToken(ctx, &pb.IntrospectToken_Request{
+			Token:    token.AccessToken,
+			ClientId: "client-123",
+		})
+		assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestGetOpenIDConfiguration() {
+	s.Run("should get openid configuration successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var header metadata.MD
+		resp, err := s.ssoClient.GetOpenIDConfiguration(ctx, &pb.GetOpenIDConfiguration_Request{}, grpc.Header(&header))
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), testIssuer, resp.Issuer)
+		assert.Equal(s.T(), testIssuer+oidcservice.TokenEndpointPath, resp.TokenEndpoint)
+		assert.Equal(s.T(), testIssuer+oidcservice.UserInfoEndpointPath, resp.UserinfoEndpoint)
+		assert.Equal(s.T(), testIssuer+oidcservice.JWKSPath, resp.JwksUri)
+		assert.Equal(s.T(), testIssuer+oidcservice.DeviceAuthorizationEndpointPath, resp.DeviceAuthorizationEndpoint)
+		assert.Contains(s.T(), resp.GrantTypesSupported, cliententity.GrantTypeDeviceCode)
+		assert.Contains(s.T(), resp.GrantTypesSupported, cliententity.GrantTypeWebAuthn)
+		assert.Contains(s.T(), resp.GrantTypesSupported, cliententity.GrantTypeAPIKey)
+		assert.Contains(s.T(), resp.ScopesSupported, authservice.ScopeOpenID)
+		assert.Contains(s.T(), resp.IdTokenSigningAlgValuesSupported, "RS256")
+		assert.NotEmpty(s.T(), header.Get(HeaderCacheControl))
+	})
+}
+
+func (s *SSOServiceTestSuite) TestGetUserInfo() {
+	s.Run("should get user info successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "userinfo@example.com", "client-123")
+
+		s.userRepo.EXPECT().
+			GetByID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string) (usermodel.User, error) {
+				return usermodel.User{
+					ID:        id,
+					Email:     "userinfo@example.com",
+					Role:      usermodel.RoleUser,
+					Username:  "userinfo",
+					FirstName: "User",
+					LastName:  "Info",
+				}, nil
+			})
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+
+		resp, err := s.ssoClient.GetUserInfo(ctx, &pb.GetUserInfo_Request{})
+		require.NoError(s.T(), err)
+		assert.NotEmpty(s.T(), resp.Sub)
+		assert.Equal(s.T(), "userinfo@example.com", resp.GetEmail())
+		assert.Equal(s.T(), "User Info", resp.GetName())
+		assert.Equal(s.T(), "userinfo", resp.GetPreferredUsername())
+		assert.Equal(s.T(), string(usermodel.RoleUser), resp.GetRole())
+	})
+
+	s.Run("should return error when bearer token is missing", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := s.ssoClient.GetUserInfo(ctx, &pb.GetUserInfo_Request{})
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+
+	s.Run("should return error when access token is revoked", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		token := s.issueTokens(ctx, "userinfo-revoked@example.com", "client-123")
+
+		_, err := s.ssoClient.RevokeToken(ctx, &pb.RevokeToken_Request{
+			Token:         token.AccessToken,
+			TokenTypeHint: ptr(tokenservice.TypeHintAccessToken),
+			ClientId:      "client-123",
+		})
+		require.NoError(s.T(), err)
+
+		ctx = metadata.AppendToOutgoingContext(ctx, HeaderAuthorization, BearerPrefix+token.AccessToken)
+
+		_, err = s.ssoClient.GetUserInfo(ctx, &pb.GetUserInfo_Request{})
+		require.Error(s.T(), err)
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestJWKSETag(t *testing.T) {
+	newKey := func(x string) *pb.Key {
+		return &pb.Key{Kid: "key-123", Alg: "ES256", Kty: "EC", Use: "sig", Crv: ptr("P-256"), X: ptr(x), Y: ptr("y")}
+	}
+
+	t.Run("should return the same ETag for the same key set", func(t *testing.T) {
+		first, err := jwksETag([]*pb.Key{newKey("x")})
+		require.NoError(t, err)
+
+		second, err := jwksETag([]*pb.Key{newKey("x")})
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should change the ETag when an EC coordinate changes", func(t *testing.T) {
+		first, err := jwksETag([]*pb.Key{newKey("x")})
+		require.NoError(t, err)
+
+		second, err := jwksETag([]*pb.Key{newKey("other-x")})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
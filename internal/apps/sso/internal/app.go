@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"net"
+	"time"
 
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -10,18 +11,69 @@ import (
 	pbsso "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
 	pbuser "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/user/v1"
 	apiv1grpc "github.com/kitanoyoru/kgym/internal/apps/sso/internal/api/v1/grpc"
+	actiontokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken"
+	actiontokenpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken/postgres"
+	apikeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey"
+	apikeypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey/postgres"
+	authcoderepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode"
+	authcoderedis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode/redis"
+	autheventrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent"
+	autheventpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/postgres"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	clientpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/postgres"
+	denylistrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist"
+	denylistredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist/redis"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	deviceredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device/redis"
+	federationrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation"
+	federationredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation/redis"
+	identityrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity"
+	identitypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity/postgres"
 	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
 	keyredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/redis"
+	lockrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock"
+	lockredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock/redis"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	mfapostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa/postgres"
+	mfachallengerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge"
+	mfachallengeredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge/redis"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	passkeypostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey/postgres"
+	passkeyceremonyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony"
+	passkeyceremonyredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony/redis"
+	passwordlessrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless"
+	passwordlessredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless/redis"
+	sessionrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session"
+	sessionpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/postgres"
+	throttlerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle"
+	throttleredis "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle/redis"
 	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
 	tokenpostgres "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/postgres"
 	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
 	usergrpc "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/grpc"
+	accountservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/account"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
 	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	clientservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/client"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
 	keyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/key"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	oidcservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/oidc"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	tokenpurgeservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/tokenpurge"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
 	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
 	pkgredis "github.com/kitanoyoru/kgym/pkg/database/redis"
 	pkglogging "github.com/kitanoyoru/kgym/pkg/logging"
 	pkgmetrics "github.com/kitanoyoru/kgym/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/multierr"
@@ -43,12 +95,48 @@ type App struct {
 	rdb        *redis.ClusterClient
 	grpcServer *grpc.Server
 
-	keyRepository   keyrepo.IRepository
-	tokenRepository tokenrepo.IRepository
-	userRepository  userrepo.IRepository
+	keyRepository          keyrepo.IRepository
+	tokenRepository        tokenrepo.IRepository
+	userRepository         userrepo.IRepository
+	denylistRepository     denylistrepo.IRepository
+	authCodeRepository     authcoderepo.IRepository
+	clientRepository       clientrepo.IRepository
+	lockRepository         lockrepo.IRepository
+	mfaRepository          mfarepo.IRepository
+	challengeRepository    mfachallengerepo.IRepository
+	throttleRepository     throttlerepo.IRepository
+	sessionRepository      sessionrepo.IRepository
+	actionTokenRepository  actiontokenrepo.IRepository
+	passwordlessRepository passwordlessrepo.IRepository
+	federationRepository   federationrepo.IRepository
+	deviceRepository       devicerepo.IRepository
+	passkeyRepository      passkeyrepo.IRepository
+	ceremonyRepository     passkeyceremonyrepo.IRepository
+	identityRepository     identityrepo.IRepository
+	authEventRepository    autheventrepo.IRepository
+	apiKeyRepository       apikeyrepo.IRepository
 
-	keyService  keyservice.IService
-	authService authservice.IService
+	keyService      keyservice.IService
+	keyScheduler    *keyservice.Scheduler
+	purgeService    tokenpurgeservice.IService
+	purgeScheduler  *tokenpurgeservice.Scheduler
+	authService     authservice.IService
+	tokenService    tokenservice.IService
+	oidcService     oidcservice.IService
+	clientService   clientservice.IService
+	mfaService      mfaservice.IService
+	throttleService throttleservice.IService
+	sessionService  sessionservice.IService
+	accountService  accountservice.IService
+
+	passwordlessService passwordlessservice.IService
+	federationService   federationservice.IService
+	deviceService       deviceservice.IService
+	passkeyService      passkeyservice.IService
+	auditService        auditservice.IService
+	apiKeyService       apikeyservice.IService
+
+	proofVerifier *pkgauth.ProofVerifier
 }
 
 func New(ctx context.Context, cfg Config) (*App, error) {
@@ -90,6 +178,14 @@ func (app *App) Run(ctx context.Context) error {
 		return err
 	}
 
+	if app.cfg.Keys.RotationEnabled {
+		go app.keyScheduler.Run(ctx)
+	}
+
+	if app.cfg.Tokens.PurgeEnabled {
+		go app.purgeScheduler.Run(ctx)
+	}
+
 	return app.grpcServer.Serve(listener)
 }
 
@@ -99,17 +195,48 @@ func (app *App) Shutdown(ctx context.Context) error {
 }
 
 func (app *App) initRepositories(ctx context.Context) error {
-	keyRepository, err := keyredis.New(ctx, app.rdb)
+	kekProvider, err := app.cfg.Keys.KEKProvider()
+	if err != nil {
+		return err
+	}
+
+	keyRepository, err := keyredis.New(ctx, app.rdb, kekProvider)
 	if err != nil {
 		return err
 	}
 	app.keyRepository = keyRepository
 
 	app.tokenRepository = tokenpostgres.New(app.dbPool)
+	app.denylistRepository = denylistredis.New(app.rdb)
+	app.authCodeRepository = authcoderedis.New(app.rdb)
+	app.clientRepository = clientpostgres.New(app.dbPool)
+	app.lockRepository = lockredis.New(app.rdb)
+	app.mfaRepository = mfapostgres.New(app.dbPool, kekProvider)
+	app.challengeRepository = mfachallengeredis.New(app.rdb)
+	app.throttleRepository = throttleredis.New(app.rdb)
+	app.sessionRepository = sessionpostgres.New(app.dbPool)
+	app.actionTokenRepository = actiontokenpostgres.New(app.dbPool)
+	app.passwordlessRepository = passwordlessredis.New(app.rdb)
+	app.federationRepository = federationredis.New(app.rdb)
+	app.deviceRepository = deviceredis.New(app.rdb)
+	app.passkeyRepository = passkeypostgres.New(app.dbPool)
+	app.ceremonyRepository = passkeyceremonyredis.New(app.rdb)
+	app.identityRepository = identitypostgres.New(app.dbPool)
+	app.authEventRepository = autheventpostgres.New(app.dbPool)
+	app.apiKeyRepository = apikeypostgres.New(app.dbPool)
+
+	// The user service only lets services call its internal methods, so SSO
+	// calls it with tokens it issues itself. The auth service is created
+	// after the repositories and only needed once the first call is made.
+	userCredentials := usergrpc.NewCredentials(func(ctx context.Context) (string, time.Time, error) {
+		resp, err := app.authService.ServiceToken(ctx, app.cfg.UserAudience)
+		return resp.AccessToken, resp.ExpiresAt, err
+	})
 
 	client, err := grpc.NewClient(
 		app.cfg.UserEndpoint,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(userCredentials),
 		grpc.WithStatsHandler(
 			otelgrpc.NewClientHandler(),
 		),
@@ -123,8 +250,49 @@ func (app *App) initRepositories(ctx context.Context) error {
 }
 
 func (app *App) initServices(_ context.Context) error {
-	app.keyService = keyservice.NewService(app.keyRepository)
-	app.authService = authservice.NewService(app.userRepository, app.tokenRepository, app.keyRepository)
+	mail := mailer.NewSMTP(app.cfg.SMTP.MailerConfig())
+
+	federationConfig, err := app.cfg.Federation.ServiceConfig()
+	if err != nil {
+		return err
+	}
+
+	app.auditService = auditservice.NewService(app.authEventRepository)
+	app.keyService = keyservice.NewService(app.cfg.Keys.ServiceConfig(), app.keyRepository, app.auditService)
+	app.keyScheduler = keyservice.NewScheduler(app.keyService, app.lockRepository, app.cfg.Keys.SchedulerInterval)
+
+	purgeMetrics := tokenpurgeservice.NewMetrics(Namespace, ServiceName)
+	if err := prometheus.Register(purgeMetrics); err != nil {
+		return err
+	}
+	app.purgeService = tokenpurgeservice.NewService(app.cfg.Tokens.PurgeConfig(), app.tokenRepository, app.lockRepository, purgeMetrics)
+	app.purgeScheduler = tokenpurgeservice.NewScheduler(app.purgeService, app.cfg.Tokens.PurgeInterval)
+
+	app.mfaService = mfaservice.NewService(app.cfg.MFA.ServiceConfig(), app.mfaRepository, app.challengeRepository, app.userRepository)
+	app.throttleService = throttleservice.NewService(app.cfg.Throttle.ServiceConfig(), app.throttleRepository)
+	app.sessionService = sessionservice.NewService(app.sessionRepository, app.tokenRepository)
+	app.passwordlessService = passwordlessservice.NewService(app.cfg.Passwordless.ServiceConfig(), app.passwordlessRepository, app.userRepository, passwordlessservice.NewMailSender(mail))
+	app.federationService = federationservice.NewService(federationConfig, app.federationRepository, app.identityRepository, app.userRepository)
+	app.deviceService = deviceservice.NewService(app.cfg.Device.ServiceConfig(), app.deviceRepository)
+	app.passkeyService = passkeyservice.NewService(app.cfg.Passkey.ServiceConfig(), app.passkeyRepository, app.ceremonyRepository, app.userRepository)
+	app.apiKeyService = apikeyservice.NewService(app.apiKeyRepository, app.clientRepository)
+	app.authService = authservice.NewService(authservice.Config{
+		Issuer: app.cfg.OIDC.Issuer,
+		Tenant: app.cfg.OIDC.Tenant,
+	}, app.userRepository, app.tokenRepository, app.keyRepository, app.authCodeRepository, app.clientRepository, app.mfaService, app.throttleService, app.sessionService, app.passwordlessService, app.federationService, app.deviceService, app.passkeyService, app.apiKeyService, app.auditService)
+	app.tokenService = tokenservice.NewService(tokenservice.Config{
+		Issuer: app.cfg.OIDC.Issuer,
+	}, app.tokenRepository, app.keyRepository, app.denylistRepository, app.sessionService)
+	app.oidcService = oidcservice.NewService(oidcservice.Config{
+		Issuer: app.cfg.OIDC.Issuer,
+	}, app.tokenService, app.userRepository)
+	app.clientService = clientservice.NewService(app.clientRepository)
+	app.accountService = accountservice.NewService(app.cfg.Account.ServiceConfig(), app.actionTokenRepository, app.tokenRepository, app.userRepository, app.sessionService, mail)
+
+	app.proofVerifier = pkgauth.NewProofVerifier(pkgauth.ProofConfig{
+		ReplayCache: pkgauth.NewRedisReplayCache(app.rdb),
+		MaxAge:      app.cfg.Tokens.DPoPProofMaxAge,
+	})
 
 	return nil
 }
@@ -170,12 +338,60 @@ func (app *App) initGRPCServer(_ context.Context) error {
 
 	srvMetrics.InitializeMetrics(server)
 
-	ssoServer, err := apiv1grpc.NewSSOServer(app.authService, app.keyService)
+	ssoServer, err := apiv1grpc.NewSSOServer(app.authService, app.keyService, app.tokenService, app.oidcService, app.proofVerifier)
+	if err != nil {
+		return err
+	}
+
+	clientServer, err := apiv1grpc.NewClientServer(app.clientService, app.tokenService, app.proofVerifier, app.oidcService)
+	if err != nil {
+		return err
+	}
+
+	mfaServer, err := apiv1grpc.NewMFAServer(app.mfaService, app.tokenService, app.proofVerifier, app.oidcService)
+	if err != nil {
+		return err
+	}
+
+	adminServer, err := apiv1grpc.NewAdminServer(app.throttleService, app.tokenService, app.proofVerifier, app.oidcService, app.auditService)
+	if err != nil {
+		return err
+	}
+
+	sessionServer, err := apiv1grpc.NewSessionServer(app.sessionService, app.tokenService, app.proofVerifier, app.oidcService)
+	if err != nil {
+		return err
+	}
+
+	accountServer, err := apiv1grpc.NewAccountServer(app.accountService, app.tokenService, app.proofVerifier)
+	if err != nil {
+		return err
+	}
+
+	federationServer, err := apiv1grpc.NewFederationServer(app.authService, app.federationService)
+	if err != nil {
+		return err
+	}
+
+	passkeyServer, err := apiv1grpc.NewPasskeyServer(app.passkeyService, app.tokenService, app.proofVerifier)
+	if err != nil {
+		return err
+	}
+
+	apiKeyServer, err := apiv1grpc.NewAPIKeyServer(app.apiKeyService, app.tokenService, app.proofVerifier, app.oidcService)
 	if err != nil {
 		return err
 	}
 
 	pbsso.RegisterSSOServiceServer(server, ssoServer)
+	pbsso.RegisterClientServiceServer(server, clientServer)
+	pbsso.RegisterMFAServiceServer(server, mfaServer)
+	pbsso.RegisterAdminServiceServer(server, adminServer)
+	pbsso.RegisterSessionServiceServer(server, sessionServer)
+	pbsso.RegisterAccountServiceServer(server, accountServer)
+	pbsso.RegisterFederationServiceServer(server, federationServer)
+	pbsso.RegisterPasskeyServiceServer(server, passkeyServer)
+	pbsso.RegisterAPIKeyServiceServer(server, apiKeyServer)
 	pbhealth.RegisterHealthServer(server, apiv1grpc.NewHealthzService())
 
 	reflection.Register(server)
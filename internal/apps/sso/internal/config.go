@@ -1,11 +1,41 @@
 package internal
 
-import "time"
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"time"
+
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	accountservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/account"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
+	keyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/key"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	tokenpurgeservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/tokenpurge"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+	"github.com/pkg/errors"
+)
 
 type Config struct {
 	GRPC
 	Cache
 	Database
+	OIDC
+	Keys
+	Tokens
+	MFA
+	Throttle
+	Account
+	Passwordless
+	Federation
+	Device
+	Passkey
+	SMTP
 
 	ShutdownTimeout time.Duration `env:"KGYM_SSO_SHUTDOWN_TIMEOUT" envDefault:"10s"`
 }
@@ -14,6 +44,10 @@ type GRPC struct {
 	Endpoint string `env:"KGYM_SSO_GRPC_ENDPOINT" validate:"required"`
 
 	UserEndpoint string `env:"KGYM_SSO_USER_ENDPOINT" validate:"required"`
+	// UserAudience is the audience of the access tokens SSO calls the user
+	// service with. It has to be one of the audiences the user service
+	// accepts.
+	UserAudience string `env:"KGYM_SSO_USER_AUDIENCE" validate:"required"`
 
 	MaxSendMsgSize       int           `env:"KGYM_SSO_GRPC_MAX_SEND_MSG_SIZE" envDefault:"1024"`
 	MaxRecvMsgSize       int           `env:"KGYM_SSO_GRPC_MAX_RECV_MSG_SIZE" envDefault:"1024"`
@@ -28,3 +62,280 @@ type Cache struct {
 type Database struct {
 	ConnectionString string `env:"KGYM_SSO_DATABASE_CONNECTION_STRING" validate:"required"`
 }
+
+type OIDC struct {
+	Issuer string `env:"KGYM_SSO_OIDC_ISSUER" validate:"required,url"`
+	// Tenant is put into the tenant claim of access tokens. Users do not
+	// belong to tenants yet, so it is the same for every token.
+	Tenant string `env:"KGYM_SSO_OIDC_TENANT" envDefault:"kgym"`
+}
+
+type Keys struct {
+	// RotationEnabled runs the key rotation scheduler in-process. Replicas
+	// coordinate through a lock in the cache, so it is safe to enable on all
+	// of them.
+	RotationEnabled   bool          `env:"KGYM_SSO_KEYS_ROTATION_ENABLED" envDefault:"false"`
+	Algorithm         string        `env:"KGYM_SSO_KEYS_ALGORITHM" envDefault:"RS256" validate:"oneof=RS256 ES256 EdDSA"`
+	RotationInterval  time.Duration `env:"KGYM_SSO_KEYS_ROTATION_INTERVAL" envDefault:"168h"`
+	PrePublication    time.Duration `env:"KGYM_SSO_KEYS_PRE_PUBLICATION" envDefault:"24h"`
+	Overlap           time.Duration `env:"KGYM_SSO_KEYS_OVERLAP" envDefault:"24h"`
+	SchedulerInterval time.Duration `env:"KGYM_SSO_KEYS_SCHEDULER_INTERVAL" envDefault:"1m" validate:"gt=0"`
+
+	// KEK holds the key-encryption keys for private signing keys as
+	// "<id>:<base64 secret>" entries, current key first. KEKFile takes
+	// precedence and points to a file in the same format, e.g. a mounted
+	// secret.
+	KEK     string `env:"KGYM_SSO_KEYS_KEK"`
+	KEKFile string `env:"KGYM_SSO_KEYS_KEK_FILE"`
+}
+
+func (k Keys) KEKProvider() (kek.Provider, error) {
+	if k.KEKFile != "" {
+		return kek.ReadStaticFile(k.KEKFile)
+	}
+
+	if k.KEK == "" {
+		return nil, errors.New("KGYM_SSO_KEYS_KEK or KGYM_SSO_KEYS_KEK_FILE must be set")
+	}
+
+	return kek.ParseStatic(k.KEK)
+}
+
+func (k Keys) ServiceConfig() keyservice.Config {
+	return keyservice.Config{
+		Algorithm:        k.Algorithm,
+		RotationInterval: k.RotationInterval,
+		PrePublication:   k.PrePublication,
+		Overlap:          k.Overlap,
+	}
+}
+
+type Tokens struct {
+	// PurgeEnabled runs the token purge in-process. Replicas coordinate
+	// through a lock in the cache, so it is safe to enable on all of them.
+	PurgeEnabled  bool          `env:"KGYM_SSO_TOKENS_PURGE_ENABLED" envDefault:"false"`
+	PurgeInterval time.Duration `env:"KGYM_SSO_TOKENS_PURGE_INTERVAL" envDefault:"1h" validate:"gt=0"`
+	// PurgeBatchSize bounds the rows deleted by one statement, so that a
+	// purge does not hold locks on the tokens table for long.
+	PurgeBatchSize   uint64        `env:"KGYM_SSO_TOKENS_PURGE_BATCH_SIZE" envDefault:"1000" validate:"gt=0"`
+	PurgeLockTTL     time.Duration `env:"KGYM_SSO_TOKENS_PURGE_LOCK_TTL" envDefault:"5m" validate:"gt=0"`
+	ExpiredRetention time.Duration `env:"KGYM_SSO_TOKENS_EXPIRED_RETENTION" envDefault:"24h"`
+	// RevokedRetention keeps used refresh tokens around so that replaying
+	// them is still detected as reuse.
+	RevokedRetention time.Duration `env:"KGYM_SSO_TOKENS_REVOKED_RETENTION" envDefault:"720h"`
+	// DPoPProofMaxAge is how long after it was issued a DPoP proof is
+	// accepted at the token endpoint.
+	DPoPProofMaxAge time.Duration `env:"KGYM_SSO_TOKENS_DPOP_PROOF_MAX_AGE" envDefault:"5m" validate:"gt=0"`
+}
+
+func (t Tokens) PurgeConfig() tokenpurgeservice.Config {
+	return tokenpurgeservice.Config{
+		ExpiredRetention: t.ExpiredRetention,
+		RevokedRetention: t.RevokedRetention,
+		BatchSize:        t.PurgeBatchSize,
+		LockTTL:          t.PurgeLockTTL,
+	}
+}
+
+type MFA struct {
+	// Issuer labels accounts in authenticator apps.
+	Issuer string `env:"KGYM_SSO_MFA_ISSUER" envDefault:"kgym"`
+	// RequiredRoles cannot sign in without a second factor.
+	RequiredRoles []string `env:"KGYM_SSO_MFA_REQUIRED_ROLES" envDefault:"admin" envSeparator:","`
+}
+
+func (m MFA) ServiceConfig() mfaservice.Config {
+	roles := make([]usermodel.Role, 0, len(m.RequiredRoles))
+	for _, role := range m.RequiredRoles {
+		roles = append(roles, usermodel.Role(role))
+	}
+
+	return mfaservice.Config{
+		Issuer:        m.Issuer,
+		RequiredRoles: roles,
+	}
+}
+
+type Throttle struct {
+	// Window is how long failed sign in attempts are remembered.
+	Window          time.Duration `env:"KGYM_SSO_THROTTLE_WINDOW" envDefault:"15m" validate:"gt=0"`
+	BackoffBase     time.Duration `env:"KGYM_SSO_THROTTLE_BACKOFF_BASE" envDefault:"1s"`
+	BackoffMax      time.Duration `env:"KGYM_SSO_THROTTLE_BACKOFF_MAX" envDefault:"5m"`
+	LockoutDuration time.Duration `env:"KGYM_SSO_THROTTLE_LOCKOUT_DURATION" envDefault:"15m"`
+
+	// Failures within the window after which attempts are delayed or locked
+	// out, per account, address and client. Zero disables the limit.
+	AccountBackoffAfter int64 `env:"KGYM_SSO_THROTTLE_ACCOUNT_BACKOFF_AFTER" envDefault:"3"`
+	AccountLockoutAfter int64 `env:"KGYM_SSO_THROTTLE_ACCOUNT_LOCKOUT_AFTER" envDefault:"10"`
+	IPBackoffAfter      int64 `env:"KGYM_SSO_THROTTLE_IP_BACKOFF_AFTER" envDefault:"10"`
+	IPLockoutAfter      int64 `env:"KGYM_SSO_THROTTLE_IP_LOCKOUT_AFTER" envDefault:"100"`
+	ClientBackoffAfter  int64 `env:"KGYM_SSO_THROTTLE_CLIENT_BACKOFF_AFTER" envDefault:"100"`
+	ClientLockoutAfter  int64 `env:"KGYM_SSO_THROTTLE_CLIENT_LOCKOUT_AFTER" envDefault:"0"`
+}
+
+func (t Throttle) ServiceConfig() throttleservice.Config {
+	return throttleservice.Config{
+		Window:          t.Window,
+		BackoffBase:     t.BackoffBase,
+		BackoffMax:      t.BackoffMax,
+		LockoutDuration: t.LockoutDuration,
+		Account: throttleservice.Limits{
+			BackoffAfter: t.AccountBackoffAfter,
+			LockoutAfter: t.AccountLockoutAfter,
+		},
+		IP: throttleservice.Limits{
+			BackoffAfter: t.IPBackoffAfter,
+			LockoutAfter: t.IPLockoutAfter,
+		},
+		Client: throttleservice.Limits{
+			BackoffAfter: t.ClientBackoffAfter,
+			LockoutAfter: t.ClientLockoutAfter,
+		},
+	}
+}
+
+type Account struct {
+	PasswordResetTTL     time.Duration `env:"KGYM_SSO_ACCOUNT_PASSWORD_RESET_TTL" envDefault:"1h" validate:"gt=0"`
+	EmailVerificationTTL time.Duration `env:"KGYM_SSO_ACCOUNT_EMAIL_VERIFICATION_TTL" envDefault:"24h" validate:"gt=0"`
+	// PasswordResetURL and EmailVerificationURL are the pages of the web app
+	// that mailed links open.
+	PasswordResetURL     string `env:"KGYM_SSO_ACCOUNT_PASSWORD_RESET_URL" validate:"required,url"`
+	EmailVerificationURL string `env:"KGYM_SSO_ACCOUNT_EMAIL_VERIFICATION_URL" validate:"required,url"`
+}
+
+func (a Account) ServiceConfig() accountservice.Config {
+	return accountservice.Config{
+		PasswordResetTTL:     a.PasswordResetTTL,
+		EmailVerificationTTL: a.EmailVerificationTTL,
+		PasswordResetURL:     a.PasswordResetURL,
+		EmailVerificationURL: a.EmailVerificationURL,
+	}
+}
+
+type Passwordless struct {
+	// Secret keys the hashes of codes and signs magic links. Changing it
+	// invalidates pending codes.
+	Secret       string        `env:"KGYM_SSO_PASSWORDLESS_SECRET" validate:"required,min=32"`
+	ChallengeTTL time.Duration `env:"KGYM_SSO_PASSWORDLESS_CHALLENGE_TTL" envDefault:"10m" validate:"gt=0"`
+	// LinkURL is the page of the web app that magic links open.
+	LinkURL string `env:"KGYM_SSO_PASSWORDLESS_LINK_URL" validate:"required,url"`
+
+	// Codes sent within the window per address and per client IP. Zero
+	// disables the limit.
+	SendWindow       time.Duration `env:"KGYM_SSO_PASSWORDLESS_SEND_WINDOW" envDefault:"1h" validate:"gt=0"`
+	MaxSendsPerEmail int64         `env:"KGYM_SSO_PASSWORDLESS_MAX_SENDS_PER_EMAIL" envDefault:"5"`
+	MaxSendsPerIP    int64         `env:"KGYM_SSO_PASSWORDLESS_MAX_SENDS_PER_IP" envDefault:"20"`
+}
+
+func (p Passwordless) ServiceConfig() passwordlessservice.Config {
+	return passwordlessservice.Config{
+		Secret:           []byte(p.Secret),
+		ChallengeTTL:     p.ChallengeTTL,
+		LinkURL:          p.LinkURL,
+		SendWindow:       p.SendWindow,
+		MaxSendsPerEmail: p.MaxSendsPerEmail,
+		MaxSendsPerIP:    p.MaxSendsPerIP,
+	}
+}
+
+type Federation struct {
+	// ProvidersFile is a JSON array of upstream OpenID providers, each with
+	// an id, name, issuer, client_id, client_secret and scopes. Federated
+	// sign in is disabled without it.
+	ProvidersFile string `env:"KGYM_SSO_FEDERATION_PROVIDERS_FILE"`
+	// RedirectURL is the callback of the gateway, registered with every
+	// provider.
+	RedirectURL string        `env:"KGYM_SSO_FEDERATION_REDIRECT_URL" validate:"required_with=ProvidersFile,omitempty,url"`
+	RequestTTL  time.Duration `env:"KGYM_SSO_FEDERATION_REQUEST_TTL" envDefault:"10m" validate:"gt=0"`
+}
+
+func (f Federation) ServiceConfig() (federationservice.Config, error) {
+	cfg := federationservice.Config{
+		RedirectURL: f.RedirectURL,
+		RequestTTL:  f.RequestTTL,
+	}
+
+	if f.ProvidersFile == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(f.ProvidersFile)
+	if err != nil {
+		return federationservice.Config{}, err
+	}
+
+	if err := json.Unmarshal(data, &cfg.Providers); err != nil {
+		return federationservice.Config{}, errors.Wrap(err, "failed to parse federation providers")
+	}
+
+	ids := make(map[string]struct{}, len(cfg.Providers))
+	for _, provider := range cfg.Providers {
+		if provider.ID == "" || provider.ClientID == "" {
+			return federationservice.Config{}, errors.New("federation providers need an id and a client_id")
+		}
+		if _, ok := ids[provider.ID]; ok {
+			return federationservice.Config{}, errors.Errorf("duplicate federation provider %q", provider.ID)
+		}
+		ids[provider.ID] = struct{}{}
+
+		if issuer, err := url.Parse(provider.Issuer); err != nil || issuer.Scheme != "https" && issuer.Hostname() != "localhost" {
+			return federationservice.Config{}, errors.Errorf("federation provider %q needs an https issuer", provider.ID)
+		}
+	}
+
+	return cfg, nil
+}
+
+type Device struct {
+	CodeTTL time.Duration `env:"KGYM_SSO_DEVICE_CODE_TTL" envDefault:"10m" validate:"gt=0"`
+	// Interval is the minimum time devices wait between polls.
+	Interval time.Duration `env:"KGYM_SSO_DEVICE_INTERVAL" envDefault:"5s" validate:"gt=0"`
+	// VerificationURL is the page of the gateway where users enter the code
+	// shown on the device.
+	VerificationURL string `env:"KGYM_SSO_DEVICE_VERIFICATION_URL" validate:"required,url"`
+}
+
+func (d Device) ServiceConfig() deviceservice.Config {
+	return deviceservice.Config{
+		CodeTTL:         d.CodeTTL,
+		Interval:        d.Interval,
+		VerificationURL: d.VerificationURL,
+	}
+}
+
+type Passkey struct {
+	// RPID is the domain passkeys are bound to, such as kgym.app.
+	RPID   string `env:"KGYM_SSO_PASSKEY_RP_ID" validate:"required"`
+	RPName string `env:"KGYM_SSO_PASSKEY_RP_NAME" envDefault:"kgym"`
+	// Origins are the web origins of the apps, and the
+	// android:apk-key-hash: origins of the Android apps.
+	Origins     []string      `env:"KGYM_SSO_PASSKEY_ORIGINS" envSeparator:"," validate:"required,min=1"`
+	CeremonyTTL time.Duration `env:"KGYM_SSO_PASSKEY_CEREMONY_TTL" envDefault:"5m" validate:"gt=0"`
+}
+
+func (p Passkey) ServiceConfig() passkeyservice.Config {
+	return passkeyservice.Config{
+		RPID:        p.RPID,
+		RPName:      p.RPName,
+		Origins:     p.Origins,
+		CeremonyTTL: p.CeremonyTTL,
+	}
+}
+
+type SMTP struct {
+	Host     string `env:"KGYM_SSO_SMTP_HOST" validate:"required"`
+	Port     int    `env:"KGYM_SSO_SMTP_PORT" envDefault:"587"`
+	Username string `env:"KGYM_SSO_SMTP_USERNAME"`
+	Password string `env:"KGYM_SSO_SMTP_PASSWORD"`
+	From     string `env:"KGYM_SSO_SMTP_FROM" validate:"required"`
+}
+
+func (s SMTP) MailerConfig() mailer.SMTPConfig {
+	return mailer.SMTPConfig{
+		Host:     s.Host,
+		Port:     s.Port,
+		Username: s.Username,
+		Password: s.Password,
+		From:     s.From,
+	}
+}
@@ -0,0 +1,30 @@
+package actiontoken
+
+import "time"
+
+// Purpose is what an action token may be used for. A token is only accepted
+// for the purpose it was issued for.
+type Purpose string
+
+const (
+	PurposePasswordReset     Purpose = "password_reset"
+	PurposeEmailVerification Purpose = "email_verification"
+)
+
+// ActionToken is a single-use token mailed to a user to confirm an action on
+// their account. Only its hash is stored.
+type ActionToken struct {
+	ID        string
+	UserID    string
+	Purpose   Purpose
+	TokenHash string
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// UsedAt is zero until the token is consumed or superseded.
+	UsedAt time.Time
+}
+
+func (t ActionToken) Used() bool {
+	return !t.UsedAt.IsZero()
+}
@@ -0,0 +1,58 @@
+package apikey
+
+import (
+	"crypto/subtle"
+	"time"
+
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+)
+
+type OwnerType string
+
+const (
+	OwnerTypeUser   OwnerType = "user"
+	OwnerTypeClient OwnerType = "client"
+)
+
+// Owner is the user or client an API key acts as.
+type Owner struct {
+	Type OwnerType
+	ID   string
+}
+
+// Key is a long-lived credential integrations exchange for short-lived
+// access tokens. It is shown once as its prefix and a secret, and only the
+// hash of the secret is stored.
+type Key struct {
+	ID   string
+	Name string
+	// Prefix identifies the key and is shown to tell keys apart.
+	Prefix     string
+	SecretHash string
+	Owner      Owner
+	// ClientID is the client the exchanged tokens are issued to. Keys owned
+	// by a client are issued to the client itself.
+	ClientID string
+	Scopes   []string
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// LastUsedAt is zero until the key is first exchanged.
+	LastUsedAt time.Time
+	// RevokedAt is zero while the key is active.
+	RevokedAt time.Time
+}
+
+func (k Key) Revoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// Active reports whether the key can still be exchanged at the given time.
+func (k Key) Active(now time.Time) bool {
+	return !k.Revoked() && now.Before(k.ExpiresAt)
+}
+
+func (k Key) VerifySecret(secret string) bool {
+	hash := []byte(tokenentity.Hash(secret))
+	return subtle.ConstantTimeCompare(hash, []byte(k.SecretHash)) == 1
+}
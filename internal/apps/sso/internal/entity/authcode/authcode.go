@@ -0,0 +1,15 @@
+package authcode
+
+import "time"
+
+type AuthorizationCode struct {
+	CodeHash            string
+	ClientID            string
+	Subject             string
+	RedirectURI         string
+	Scopes              []string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+}
@@ -0,0 +1,54 @@
+package authevent
+
+import "time"
+
+// Type is what happened. Sign ins and token grants are recorded along with
+// changes to the signing keys.
+type Type string
+
+const (
+	// TypeLogin is a user signing in, with a password, a one-time code, a
+	// passwordless challenge, a passkey or an upstream identity provider.
+	TypeLogin             Type = "login"
+	TypeCodeExchange      Type = "code_exchange"
+	TypeTokenRefresh      Type = "token_refresh"
+	TypeRefreshTokenReuse Type = "refresh_token_reuse"
+	TypeClientCredentials Type = "client_credentials"
+	TypeImpersonation     Type = "impersonation"
+	// TypeDeviceCode is a device redeeming the device code the user approved.
+	TypeDeviceCode Type = "device_code"
+	// TypeAPIKey is an integration exchanging an API key for an access
+	// token.
+	TypeAPIKey Type = "api_key"
+
+	TypeKeyCreated      Type = "key_created"
+	TypeKeyActivated    Type = "key_activated"
+	TypeKeyRetired      Type = "key_retired"
+	TypeKeysReEncrypted Type = "keys_reencrypted"
+)
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Event is an entry of the audit trail. Events are only ever appended.
+type Event struct {
+	ID   string
+	Type Type
+	// Subject is the user, client or signing key the event is about, if it
+	// is known.
+	Subject string
+	// Actor is the admin acting as the subject of an impersonation.
+	Actor     string
+	ClientID  string
+	IP        string
+	UserAgent string
+	Outcome   Outcome
+	// Reason tells why an attempt failed.
+	Reason string
+
+	CreatedAt time.Time
+}
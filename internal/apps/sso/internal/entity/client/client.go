@@ -0,0 +1,88 @@
+package client
+
+import (
+	"crypto/subtle"
+	"slices"
+	"time"
+
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypePassword          = "password"
+	GrantTypeRefreshToken      = "refresh_token"
+	// GrantTypePasswordless signs users in with a code or link sent to their
+	// email address.
+	GrantTypePasswordless = "passwordless"
+	// GrantTypeTokenExchange lets admins impersonate other users, as in
+	// RFC 8693.
+	GrantTypeTokenExchange = "token_exchange"
+	// GrantTypeDeviceCode signs in devices without a keyboard, such as gym
+	// TVs and check-in kiosks, as in RFC 8628.
+	GrantTypeDeviceCode = "device_code"
+	// GrantTypeWebAuthn signs users in with a passkey.
+	GrantTypeWebAuthn = "webauthn"
+	// GrantTypeAPIKey exchanges API keys of integrations for short-lived
+	// access tokens.
+	GrantTypeAPIKey = "api_key"
+)
+
+var GrantTypes = []string{
+	GrantTypeAuthorizationCode,
+	GrantTypeClientCredentials,
+	GrantTypePassword,
+	GrantTypeRefreshToken,
+	GrantTypePasswordless,
+	GrantTypeTokenExchange,
+	GrantTypeDeviceCode,
+	GrantTypeWebAuthn,
+	GrantTypeAPIKey,
+}
+
+type Client struct {
+	ID           string
+	Name         string
+	Confidential bool
+
+	SecretHash              string
+	PreviousSecretHash      string
+	PreviousSecretExpiresAt time.Time
+
+	GrantTypes   []string
+	RedirectURIs []string
+	Scopes       []string
+
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	Disabled  bool
+	CreatedAt time.Time
+}
+
+func (c Client) AllowsGrantType(grantType string) bool {
+	return slices.Contains(c.GrantTypes, grantType)
+}
+
+// AllowsRedirectURI reports whether uri is registered for the client. URIs are
+// compared as plain strings, without any normalization.
+func (c Client) AllowsRedirectURI(uri string) bool {
+	return slices.Contains(c.RedirectURIs, uri)
+}
+
+// VerifySecret checks secret against the current client secret and, while
+// its grace period lasts, against the one it replaced.
+func (c Client) VerifySecret(secret string, now time.Time) bool {
+	hash := []byte(tokenentity.Hash(secret))
+
+	if c.SecretHash != "" && subtle.ConstantTimeCompare(hash, []byte(c.SecretHash)) == 1 {
+		return true
+	}
+
+	if c.PreviousSecretHash != "" && now.Before(c.PreviousSecretExpiresAt) {
+		return subtle.ConstantTimeCompare(hash, []byte(c.PreviousSecretHash)) == 1
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+package device
+
+import "time"
+
+// Status tells whether the user decided on an authorization yet.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusDenied   Status = "denied"
+)
+
+// Authorization is requested by a device without a keyboard, such as a gym TV
+// or a check-in kiosk, as in RFC 8628. The user approves or denies it on
+// another device with the user code shown on screen, while the device polls
+// for tokens with the device code.
+type Authorization struct {
+	DeviceCodeHash string
+	UserCodeHash   string
+	ClientID       string
+	Scopes         []string
+	Status         Status
+	// UserID is the user who decided on the authorization.
+	UserID string
+	// Interval is how long the device has to wait between two polls once it
+	// was told to slow down. It is zero until then.
+	Interval  time.Duration
+	ExpiresAt time.Time
+}
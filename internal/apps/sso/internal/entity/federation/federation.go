@@ -0,0 +1,27 @@
+package federation
+
+import "time"
+
+// Authorization holds the parameters of the authorization request a user
+// left to sign in upstream, so it can be resumed when they come back.
+type Authorization struct {
+	ClientID            string
+	RedirectURI         string
+	Scopes              []string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// Request is a sign in at an upstream provider waiting for the callback. It
+// is stored under the hash of the state sent upstream, and holds the PKCE
+// verifier and nonce of the upstream request.
+type Request struct {
+	StateHash     string
+	Provider      string
+	CodeVerifier  string
+	Nonce         string
+	Authorization Authorization
+	ExpiresAt     time.Time
+}
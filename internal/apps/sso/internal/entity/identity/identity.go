@@ -0,0 +1,18 @@
+package identity
+
+import "time"
+
+// Identity links an account at an upstream identity provider to a user.
+// Subject is the sub claim of the provider, which is only unique within it.
+type Identity struct {
+	ID       string
+	Provider string
+	Subject  string
+	UserID   string
+	// Email is the address the provider reported when the identity was
+	// linked. It is informational only; users are never matched by it again.
+	Email string
+
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
@@ -0,0 +1,14 @@
+package key
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Algorithms lists the JWS algorithms keys can be created for.
+var Algorithms = []string{
+	AlgorithmRS256,
+	AlgorithmES256,
+	AlgorithmEdDSA,
+}
@@ -1,11 +1,26 @@
 package key
 
-import "crypto"
+import (
+	"crypto"
+	"time"
+)
 
 type Key struct {
 	ID        string            `json:"kid"`
 	Private   crypto.PrivateKey `json:"private"`
 	Public    crypto.PublicKey  `json:"public"`
 	Algorithm string            `json:"alg"`
-	Active    bool              `json:"active"`
+	State     State             `json:"state"`
+
+	CreatedAt time.Time `json:"created_at"`
+	// ActivateAt is when a pending key may start signing.
+	ActivateAt time.Time `json:"activate_at"`
+	// RetireAt is when a verification-only key may be removed from the JWKS.
+	RetireAt time.Time `json:"retire_at"`
+}
+
+// Published reports whether the key belongs in the JWKS, i.e. whether tokens
+// signed with it should verify.
+func (k Key) Published() bool {
+	return k.State == StatePending || k.State == StateActive || k.State == StateVerificationOnly
 }
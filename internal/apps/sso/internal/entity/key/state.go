@@ -0,0 +1,18 @@
+package key
+
+// State is a step in the lifecycle of a signing key. A key is published while
+// pending so that verifiers caching the JWKS learn it before it signs, signs
+// while active, keeps verifying the tokens it signed while verification-only
+// and is removed from the JWKS once retired.
+type State string
+
+const (
+	StatePending          State = "pending"
+	StateActive           State = "active"
+	StateVerificationOnly State = "verification_only"
+	StateRetired          State = "retired"
+)
+
+func (s State) String() string {
+	return string(s)
+}
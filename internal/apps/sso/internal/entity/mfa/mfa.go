@@ -0,0 +1,30 @@
+package mfa
+
+import "time"
+
+// TOTP is a user's authenticator app enrollment.
+type TOTP struct {
+	UserID string
+	Secret []byte
+	// ConfirmedAt is zero until the user has proven they can generate codes.
+	// Unconfirmed enrollments are not required at sign in.
+	ConfirmedAt time.Time
+	// LastUsedStep is the time step of the last accepted code. Codes for it
+	// and earlier steps are rejected so that they cannot be replayed.
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+func (t TOTP) Confirmed() bool {
+	return !t.ConfirmedAt.IsZero()
+}
+
+// Challenge is handed out instead of tokens when a user passed the first
+// factor and still has to present a one-time password.
+type Challenge struct {
+	TokenHash string
+	UserID    string
+	ClientID  string
+	Scopes    []string
+	ExpiresAt time.Time
+}
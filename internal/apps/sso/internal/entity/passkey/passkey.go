@@ -0,0 +1,48 @@
+package passkey
+
+import "time"
+
+// Credential is a WebAuthn public key credential a user registered to sign
+// in without a password.
+type Credential struct {
+	ID     string
+	UserID string
+	// CredentialID is the id the authenticator assigned to the credential.
+	CredentialID []byte
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	// SignCount is the signature counter of the last assertion. Synced
+	// passkeys always report zero.
+	SignCount  uint32
+	Transports []string
+	// BackupEligible never changes for a credential. BackupState tells
+	// whether it is currently synced to other devices.
+	BackupEligible bool
+	BackupState    bool
+	CreatedAt      time.Time
+	LastUsedAt     time.Time
+}
+
+type CeremonyType string
+
+const (
+	CeremonyTypeRegistration CeremonyType = "registration"
+	CeremonyTypeLogin        CeremonyType = "login"
+)
+
+// Ceremony is a registration or sign in waiting for the response of the
+// authenticator. It is stored under the hash of the token handed to the
+// app, and holds the challenge the authenticator has to sign.
+type Ceremony struct {
+	TokenHash string
+	Type      CeremonyType
+	// UserID is only set for registrations. Sign ins learn the user from
+	// the credential the authenticator picked.
+	UserID string
+	// ClientID is only set for sign ins, whose tokens are issued to it.
+	ClientID  string
+	Challenge string
+	ExpiresAt time.Time
+}
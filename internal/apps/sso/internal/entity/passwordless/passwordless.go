@@ -0,0 +1,17 @@
+package passwordless
+
+import "time"
+
+// Challenge is started when a user asks to sign in with a code sent to their
+// email address. It is completed with the code or the magic link from the
+// same email.
+type Challenge struct {
+	TokenHash string
+	UserID    string
+	ClientID  string
+	Scopes    []string
+	// CodeHash is the keyed hash of the one-time code, so that codes cannot
+	// be read back from the cache.
+	CodeHash  string
+	ExpiresAt time.Time
+}
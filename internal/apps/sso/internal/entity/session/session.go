@@ -0,0 +1,31 @@
+package session
+
+import "time"
+
+// Session is a sign in on a device. It lives as long as the refresh token
+// family issued at sign in, and shares its id.
+type Session struct {
+	ID       string
+	UserID   string
+	ClientID string
+	Device   Device
+
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	// RevokedAt is zero while the session is active.
+	RevokedAt time.Time
+}
+
+func (s Session) Revoked() bool {
+	return !s.RevokedAt.IsZero()
+}
+
+// Device describes where a session is used from, as reported by the client.
+type Device struct {
+	// Name is the device name sent by the app, or the user agent of a
+	// browser.
+	Name       string
+	Platform   string
+	AppVersion string
+	IP         string
+}
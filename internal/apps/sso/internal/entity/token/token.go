@@ -2,6 +2,8 @@ package token
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	pkgValidator "github.com/kitanoyoru/kgym/internal/apps/sso/pkg/validator"
@@ -13,6 +15,11 @@ type Token struct {
 	ClientID  string
 	TokenType Type
 	TokenHash string
+	FamilyID  string
+	ParentID  string
+	Scopes    []string
+	// JKT is the thumbprint of the DPoP key the token is bound to, if any.
+	JKT       string
 	ExpiresAt time.Time
 	Revoked   bool
 }
@@ -24,3 +31,10 @@ func (t Token) Validate(ctx context.Context) error {
 
 	return pkgValidator.Validate.StructCtx(ctx, t)
 }
+
+// Hash returns the value under which an opaque token is stored, so that a
+// database leak does not expose usable tokens.
+func Hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
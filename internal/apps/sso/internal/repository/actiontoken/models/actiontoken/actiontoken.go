@@ -0,0 +1,77 @@
+package actiontoken
+
+import (
+	"time"
+
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+)
+
+const (
+	Table = "action_tokens"
+)
+
+var Columns = []string{
+	"id",
+	"user_id",
+	"purpose",
+	"token_hash",
+	"created_at",
+	"expires_at",
+	"used_at",
+}
+
+func FromEntity(entity actiontokenentity.ActionToken) ActionToken {
+	var usedAt *time.Time
+	if entity.Used() {
+		usedAt = &entity.UsedAt
+	}
+
+	return ActionToken{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		Purpose:   string(entity.Purpose),
+		TokenHash: entity.TokenHash,
+		CreatedAt: entity.CreatedAt,
+		ExpiresAt: entity.ExpiresAt,
+		UsedAt:    usedAt,
+	}
+}
+
+type ActionToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	Purpose   string     `db:"purpose"`
+	TokenHash string     `db:"token_hash"`
+	CreatedAt time.Time  `db:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
+
+func (t ActionToken) Values() []any {
+	return []any{
+		t.ID,
+		t.UserID,
+		t.Purpose,
+		t.TokenHash,
+		t.CreatedAt,
+		t.ExpiresAt,
+		t.UsedAt,
+	}
+}
+
+func (t ActionToken) ToEntity() actiontokenentity.ActionToken {
+	entity := actiontokenentity.ActionToken{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		Purpose:   actiontokenentity.Purpose(t.Purpose),
+		TokenHash: t.TokenHash,
+		CreatedAt: t.CreatedAt,
+		ExpiresAt: t.ExpiresAt,
+	}
+
+	if t.UsedAt != nil {
+		entity.UsedAt = *t.UsedAt
+	}
+
+	return entity
+}
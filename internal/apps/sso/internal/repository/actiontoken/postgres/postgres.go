@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+	actiontokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken"
+	actiontokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken/models/actiontoken"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ actiontokenrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, token actiontokenentity.ActionToken) error {
+	model := actiontokenmodel.FromEntity(token)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(actiontokenmodel.Table).
+		Columns(actiontokenmodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Consume(ctx context.Context, purpose actiontokenentity.Purpose, tokenHash string, at time.Time) (actiontokenentity.ActionToken, error) {
+	// Checking and marking the token in one statement keeps concurrent
+	// requests from using it twice.
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(actiontokenmodel.Table).
+		Set("used_at", at).
+		Where(sq.Eq{
+			"token_hash": tokenHash,
+			"purpose":    string(purpose),
+			"used_at":    nil,
+		}).
+		Where(sq.Gt{"expires_at": at}).
+		Suffix("RETURNING " + strings.Join(actiontokenmodel.Columns, ", "))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return actiontokenentity.ActionToken{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return actiontokenentity.ActionToken{}, err
+	}
+	defer rows.Close()
+
+	token, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[actiontokenmodel.ActionToken])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return actiontokenentity.ActionToken{}, actiontokenrepo.ErrTokenNotFound
+		}
+		return actiontokenentity.ActionToken{}, err
+	}
+
+	return token.ToEntity(), nil
+}
+
+func (r *Repository) Invalidate(ctx context.Context, userID string, purpose actiontokenentity.Purpose, at time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(actiontokenmodel.Table).
+		Set("used_at", at).
+		Where(sq.Eq{
+			"user_id": userID,
+			"purpose": string(purpose),
+			"used_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
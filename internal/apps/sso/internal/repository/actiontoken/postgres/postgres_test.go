@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	actiontokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM action_tokens")
+	require.NoError(s.T(), err, "failed to clean action_tokens table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM action_tokens")
+	require.NoError(s.T(), err, "failed to clean action_tokens table")
+}
+
+func newActionToken(userID string, purpose actiontokenentity.Purpose, expiresAt time.Time) (actiontokenentity.ActionToken, string) {
+	value := uuid.New().String()
+
+	return actiontokenentity.ActionToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: tokenentity.Hash(value),
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}, value
+}
+
+func (s *RepositoryTestSuite) TestConsume() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should consume token only once", func() {
+		userID := uuid.New().String()
+		token, value := newActionToken(userID, actiontokenentity.PurposePasswordReset, time.Now().Add(time.Hour))
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		consumed, err := repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(value), time.Now())
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), token.ID, consumed.ID)
+		assert.Equal(s.T(), userID, consumed.UserID)
+		assert.True(s.T(), consumed.Used())
+
+		_, err = repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(value), time.Now())
+		assert.ErrorIs(s.T(), err, actiontokenrepo.ErrTokenNotFound)
+	})
+
+	s.Run("should consume token once under concurrent use", func() {
+		token, value := newActionToken(uuid.New().String(), actiontokenentity.PurposePasswordReset, time.Now().Add(time.Hour))
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		var (
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			successes int
+		)
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_, err := repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(value), time.Now())
+				if err == nil {
+					mu.Lock()
+					successes++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(s.T(), 1, successes)
+	})
+
+	s.Run("should not consume expired token", func() {
+		token, value := newActionToken(uuid.New().String(), actiontokenentity.PurposePasswordReset, time.Now().Add(-time.Minute))
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		_, err = repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(value), time.Now())
+		assert.ErrorIs(s.T(), err, actiontokenrepo.ErrTokenNotFound)
+	})
+
+	s.Run("should not consume token for another purpose", func() {
+		token, value := newActionToken(uuid.New().String(), actiontokenentity.PurposeEmailVerification, time.Now().Add(time.Hour))
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		_, err = repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(value), time.Now())
+		assert.ErrorIs(s.T(), err, actiontokenrepo.ErrTokenNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestInvalidate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should invalidate unused tokens of user for purpose", func() {
+		userID := uuid.New().String()
+
+		reset, resetValue := newActionToken(userID, actiontokenentity.PurposePasswordReset, time.Now().Add(time.Hour))
+		verification, verificationValue := newActionToken(userID, actiontokenentity.PurposeEmailVerification, time.Now().Add(time.Hour))
+
+		require.NoError(s.T(), repository.Create(ctx, reset))
+		require.NoError(s.T(), repository.Create(ctx, verification))
+
+		err := repository.Invalidate(ctx, userID, actiontokenentity.PurposePasswordReset, time.Now())
+		require.NoError(s.T(), err)
+
+		_, err = repository.Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash(resetValue), time.Now())
+		assert.ErrorIs(s.T(), err, actiontokenrepo.ErrTokenNotFound)
+
+		_, err = repository.Consume(ctx, actiontokenentity.PurposeEmailVerification, tokenentity.Hash(verificationValue), time.Now())
+		assert.NoError(s.T(), err)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,23 @@
+package actiontoken
+
+import (
+	"context"
+	"time"
+
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTokenNotFound = errors.New("action token not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, token actiontokenentity.ActionToken) error
+	// Consume marks the token with the given hash as used and returns it. It
+	// returns ErrTokenNotFound if there is no such token for purpose, or it
+	// is used or expired at the given time.
+	Consume(ctx context.Context, purpose actiontokenentity.Purpose, tokenHash string, at time.Time) (actiontokenentity.ActionToken, error)
+	// Invalidate marks all unused tokens of the user for purpose as used.
+	Invalidate(ctx context.Context, userID string, purpose actiontokenentity.Purpose, at time.Time) error
+}
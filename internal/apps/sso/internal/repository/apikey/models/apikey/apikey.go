@@ -0,0 +1,116 @@
+package apikey
+
+import (
+	"time"
+
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+)
+
+const (
+	Table = "api_keys"
+)
+
+var Columns = []string{
+	"id",
+	"name",
+	"prefix",
+	"secret_hash",
+	"owner_type",
+	"owner_id",
+	"client_id",
+	"scopes",
+	"created_at",
+	"expires_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+func FromEntity(entity apikeyentity.Key) Key {
+	scopes := entity.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	var lastUsedAt *time.Time
+	if !entity.LastUsedAt.IsZero() {
+		lastUsedAt = &entity.LastUsedAt
+	}
+
+	var revokedAt *time.Time
+	if entity.Revoked() {
+		revokedAt = &entity.RevokedAt
+	}
+
+	return Key{
+		ID:         entity.ID,
+		Name:       entity.Name,
+		Prefix:     entity.Prefix,
+		SecretHash: entity.SecretHash,
+		OwnerType:  string(entity.Owner.Type),
+		OwnerID:    entity.Owner.ID,
+		ClientID:   entity.ClientID,
+		Scopes:     scopes,
+		CreatedAt:  entity.CreatedAt,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: lastUsedAt,
+		RevokedAt:  revokedAt,
+	}
+}
+
+type Key struct {
+	ID         string     `db:"id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`
+	SecretHash string     `db:"secret_hash"`
+	OwnerType  string     `db:"owner_type"`
+	OwnerID    string     `db:"owner_id"`
+	ClientID   string     `db:"client_id"`
+	Scopes     []string   `db:"scopes"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+func (k Key) Values() []any {
+	return []any{
+		k.ID,
+		k.Name,
+		k.Prefix,
+		k.SecretHash,
+		k.OwnerType,
+		k.OwnerID,
+		k.ClientID,
+		k.Scopes,
+		k.CreatedAt,
+		k.ExpiresAt,
+		k.LastUsedAt,
+		k.RevokedAt,
+	}
+}
+
+func (k Key) ToEntity() apikeyentity.Key {
+	entity := apikeyentity.Key{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		SecretHash: k.SecretHash,
+		Owner: apikeyentity.Owner{
+			Type: apikeyentity.OwnerType(k.OwnerType),
+			ID:   k.OwnerID,
+		},
+		ClientID:  k.ClientID,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt,
+		ExpiresAt: k.ExpiresAt,
+	}
+
+	if k.LastUsedAt != nil {
+		entity.LastUsedAt = *k.LastUsedAt
+	}
+	if k.RevokedAt != nil {
+		entity.RevokedAt = *k.RevokedAt
+	}
+
+	return entity
+}
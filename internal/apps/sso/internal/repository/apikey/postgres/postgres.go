@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	apikeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey"
+	apikeymodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey/models/apikey"
+	"github.com/pkg/errors"
+)
+
+// uniqueViolation is the SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ apikeyrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, key apikeyentity.Key) error {
+	model := apikeymodel.FromEntity(key)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(apikeymodel.Table).
+		Columns(apikeymodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return apikeyrepo.ErrKeyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id string) (apikeyentity.Key, error) {
+	return r.get(ctx, sq.Eq{"id": id})
+}
+
+func (r *Repository) GetByPrefix(ctx context.Context, prefix string) (apikeyentity.Key, error) {
+	return r.get(ctx, sq.Eq{"prefix": prefix})
+}
+
+func (r *Repository) ListByOwner(ctx context.Context, owner apikeyentity.Owner) ([]apikeyentity.Key, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apikeymodel.Columns...).
+		From(apikeymodel.Table).
+		Where(sq.Eq{
+			"owner_type": string(owner.Type),
+			"owner_id":   owner.ID,
+			"revoked_at": nil,
+		}).
+		OrderBy("created_at DESC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[apikeymodel.Key])
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]apikeyentity.Key, 0, len(models))
+	for _, model := range models {
+		keys = append(keys, model.ToEntity())
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) RecordUse(ctx context.Context, id string, at time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(apikeymodel.Table).
+		Set("last_used_at", at).
+		Where(sq.Eq{"id": id})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) Revoke(ctx context.Context, id string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(apikeymodel.Table).
+		Set("revoked_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "revoked_at": nil})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) get(ctx context.Context, where sq.Eq) (apikeyentity.Key, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(apikeymodel.Columns...).
+		From(apikeymodel.Table).
+		Where(where)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return apikeyentity.Key{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return apikeyentity.Key{}, err
+	}
+	defer rows.Close()
+
+	key, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[apikeymodel.Key])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apikeyentity.Key{}, apikeyrepo.ErrKeyNotFound
+		}
+		return apikeyentity.Key{}, err
+	}
+
+	return key.ToEntity(), nil
+}
+
+func (r *Repository) exec(ctx context.Context, query sq.UpdateBuilder) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return apikeyrepo.ErrKeyNotFound
+	}
+
+	return nil
+}
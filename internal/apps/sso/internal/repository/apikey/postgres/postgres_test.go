@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	apikeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM api_keys")
+	require.NoError(s.T(), err, "failed to clean api_keys table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM api_keys")
+	require.NoError(s.T(), err, "failed to clean api_keys table")
+}
+
+func newKey(owner apikeyentity.Owner) apikeyentity.Key {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	return apikeyentity.Key{
+		ID:         uuid.New().String(),
+		Name:       "front desk",
+		Prefix:     "kgym_" + rand.Text(),
+		SecretHash: "hash",
+		Owner:      owner,
+		ClientID:   "client-123",
+		Scopes:     []string{"profile"},
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(24 * time.Hour),
+	}
+}
+
+func newUserOwner() apikeyentity.Owner {
+	return apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: uuid.New().String()}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create and get key", func() {
+		key := newKey(newUserOwner())
+
+		err := repository.Create(ctx, key)
+		require.NoError(s.T(), err)
+
+		got, err := repository.GetByPrefix(ctx, key.Prefix)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.ID, got.ID)
+		assert.Equal(s.T(), key.Name, got.Name)
+		assert.Equal(s.T(), key.SecretHash, got.SecretHash)
+		assert.Equal(s.T(), key.Owner, got.Owner)
+		assert.Equal(s.T(), key.ClientID, got.ClientID)
+		assert.Equal(s.T(), key.Scopes, got.Scopes)
+		assert.True(s.T(), key.ExpiresAt.Equal(got.ExpiresAt))
+		assert.True(s.T(), got.LastUsedAt.IsZero())
+		assert.False(s.T(), got.Revoked())
+
+		got, err = repository.GetByID(ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.Prefix, got.Prefix)
+	})
+
+	s.Run("should not reuse prefix", func() {
+		key := newKey(newUserOwner())
+
+		err := repository.Create(ctx, key)
+		require.NoError(s.T(), err)
+
+		other := newKey(newUserOwner())
+		other.Prefix = key.Prefix
+
+		err = repository.Create(ctx, other)
+		assert.ErrorIs(s.T(), err, apikeyrepo.ErrKeyExists)
+	})
+
+	s.Run("should return not found for unknown key", func() {
+		_, err := repository.GetByPrefix(ctx, "kgym_unknown")
+		assert.ErrorIs(s.T(), err, apikeyrepo.ErrKeyNotFound)
+
+		_, err = repository.GetByID(ctx, uuid.New().String())
+		assert.ErrorIs(s.T(), err, apikeyrepo.ErrKeyNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestListByOwner() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should list keys of owner that are not revoked", func() {
+		owner := newUserOwner()
+
+		first := newKey(owner)
+		second := newKey(owner)
+		second.CreatedAt = first.CreatedAt.Add(time.Minute)
+		revoked := newKey(owner)
+		client := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: owner.ID})
+
+		for _, key := range []apikeyentity.Key{first, second, revoked, client, newKey(newUserOwner())} {
+			require.NoError(s.T(), repository.Create(ctx, key))
+		}
+		require.NoError(s.T(), repository.Revoke(ctx, revoked.ID))
+
+		keys, err := repository.ListByOwner(ctx, owner)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), keys, 2)
+		assert.Equal(s.T(), second.ID, keys[0].ID)
+		assert.Equal(s.T(), first.ID, keys[1].ID)
+	})
+
+	s.Run("should return empty list for owner without keys", func() {
+		keys, err := repository.ListByOwner(ctx, newUserOwner())
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), keys)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRecordUse() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should record last use", func() {
+		key := newKey(newUserOwner())
+
+		err := repository.Create(ctx, key)
+		require.NoError(s.T(), err)
+
+		at := key.CreatedAt.Add(time.Hour)
+
+		err = repository.RecordUse(ctx, key.ID, at)
+		require.NoError(s.T(), err)
+
+		got, err := repository.GetByID(ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), at.Equal(got.LastUsedAt))
+	})
+
+	s.Run("should return not found for unknown key", func() {
+		err := repository.RecordUse(ctx, uuid.New().String(), time.Now())
+		assert.ErrorIs(s.T(), err, apikeyrepo.ErrKeyNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRevoke() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should revoke key once", func() {
+		key := newKey(newUserOwner())
+
+		err := repository.Create(ctx, key)
+		require.NoError(s.T(), err)
+
+		err = repository.Revoke(ctx, key.ID)
+		require.NoError(s.T(), err)
+
+		got, err := repository.GetByID(ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), got.Revoked())
+
+		err = repository.Revoke(ctx, key.ID)
+		assert.ErrorIs(s.T(), err, apikeyrepo.ErrKeyNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
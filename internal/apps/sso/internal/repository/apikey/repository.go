@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrKeyNotFound = errors.New("api key not found")
+	ErrKeyExists   = errors.New("api key already exists")
+)
+
+type IRepository interface {
+	// Create stores a new key. It returns ErrKeyExists if the prefix is
+	// already taken.
+	Create(ctx context.Context, key apikeyentity.Key) error
+	GetByID(ctx context.Context, id string) (apikeyentity.Key, error)
+	GetByPrefix(ctx context.Context, prefix string) (apikeyentity.Key, error)
+	// ListByOwner returns the keys of an owner that are not revoked, newest
+	// first.
+	ListByOwner(ctx context.Context, owner apikeyentity.Owner) ([]apikeyentity.Key, error)
+	// RecordUse records that a key was exchanged at the given time.
+	RecordUse(ctx context.Context, id string, at time.Time) error
+	// Revoke revokes a key. It fails with ErrKeyNotFound when there is no
+	// such key that is not revoked yet.
+	Revoke(ctx context.Context, id string) error
+}
@@ -0,0 +1,47 @@
+package authcode
+
+import (
+	"time"
+
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+)
+
+type AuthorizationCode struct {
+	CodeHash            string    `json:"code_hash"`
+	ClientID            string    `json:"client_id"`
+	Subject             string    `json:"sub"`
+	RedirectURI         string    `json:"redirect_uri"`
+	Scopes              []string  `json:"scopes,omitempty"`
+	Nonce               string    `json:"nonce,omitempty"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+func FromEntity(entity authcodeentity.AuthorizationCode) AuthorizationCode {
+	return AuthorizationCode{
+		CodeHash:            entity.CodeHash,
+		ClientID:            entity.ClientID,
+		Subject:             entity.Subject,
+		RedirectURI:         entity.RedirectURI,
+		Scopes:              entity.Scopes,
+		Nonce:               entity.Nonce,
+		CodeChallenge:       entity.CodeChallenge,
+		CodeChallengeMethod: entity.CodeChallengeMethod,
+		ExpiresAt:           entity.ExpiresAt,
+	}
+}
+
+func (c AuthorizationCode) ToEntity() authcodeentity.AuthorizationCode {
+	return authcodeentity.AuthorizationCode{
+		CodeHash:            c.CodeHash,
+		ClientID:            c.ClientID,
+		Subject:             c.Subject,
+		RedirectURI:         c.RedirectURI,
+		Scopes:              c.Scopes,
+		Nonce:               c.Nonce,
+		CodeChallenge:       c.CodeChallenge,
+		CodeChallengeMethod: c.CodeChallengeMethod,
+		ExpiresAt:           c.ExpiresAt,
+	}
+}
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+	authcoderepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode"
+	authcodemodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode/models/authcode"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "authcode:"
+)
+
+var _ authcoderepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, code authcodeentity.AuthorizationCode) error {
+	ttl := time.Until(code.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("authorization code is already expired")
+	}
+
+	data, err := json.Marshal(authcodemodel.FromEntity(code))
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, KeyPrefix+code.CodeHash, data, ttl).Err()
+}
+
+func (r *Repository) Consume(ctx context.Context, codeHash string) (authcodeentity.AuthorizationCode, error) {
+	data, err := r.rdb.GetDel(ctx, KeyPrefix+codeHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return authcodeentity.AuthorizationCode{}, authcoderepo.ErrAuthorizationCodeNotFound
+		}
+		return authcodeentity.AuthorizationCode{}, err
+	}
+
+	var model authcodemodel.AuthorizationCode
+	if err := json.Unmarshal(data, &model); err != nil {
+		return authcodeentity.AuthorizationCode{}, err
+	}
+
+	return model.ToEntity(), nil
+}
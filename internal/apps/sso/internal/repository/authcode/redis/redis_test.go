@@ -0,0 +1,131 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+	authcoderepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode"
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	s.Run("should store code until it expires", func() {
+		err := s.repository.Create(s.ctx, authcodeentity.AuthorizationCode{
+			CodeHash:  "hash-123",
+			ClientID:  "client-123",
+			Subject:   "user-123",
+			ExpiresAt: time.Now().Add(time.Minute),
+		})
+		require.NoError(s.T(), err)
+
+		ttl, err := s.rdb.TTL(s.ctx, KeyPrefix+"hash-123").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+	})
+
+	s.Run("should return error when code is already expired", func() {
+		err := s.repository.Create(s.ctx, authcodeentity.AuthorizationCode{
+			CodeHash:  "hash-expired",
+			ExpiresAt: time.Now().Add(-time.Second),
+		})
+		assert.Error(s.T(), err)
+	})
+}
+
+func (s *RepositoryTestSuite) TestConsume() {
+	s.Run("should return code only once", func() {
+		code := authcodeentity.AuthorizationCode{
+			CodeHash:            "hash-123",
+			ClientID:            "client-123",
+			Subject:             "user-123",
+			RedirectURI:         "https://app.example.com/callback",
+			Scopes:              []string{"openid", "email"},
+			Nonce:               "nonce-123",
+			CodeChallenge:       "challenge",
+			CodeChallengeMethod: "S256",
+			ExpiresAt:           time.Now().Add(time.Minute).UTC().Truncate(time.Second),
+		}
+
+		err := s.repository.Create(s.ctx, code)
+		require.NoError(s.T(), err)
+
+		consumed, err := s.repository.Consume(s.ctx, "hash-123")
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), code, consumed)
+
+		_, err = s.repository.Consume(s.ctx, "hash-123")
+		assert.ErrorIs(s.T(), err, authcoderepo.ErrAuthorizationCodeNotFound)
+	})
+
+	s.Run("should return error for unknown code", func() {
+		_, err := s.repository.Consume(s.ctx, "hash-unknown")
+		assert.ErrorIs(s.T(), err, authcoderepo.ErrAuthorizationCodeNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
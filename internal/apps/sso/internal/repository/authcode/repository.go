@@ -0,0 +1,19 @@
+package authcode
+
+import (
+	"context"
+
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAuthorizationCodeNotFound = errors.New("authorization code not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, code authcodeentity.AuthorizationCode) error
+	// Consume returns the code stored under codeHash and deletes it, so that
+	// every authorization code can be redeemed at most once.
+	Consume(ctx context.Context, codeHash string) (authcodeentity.AuthorizationCode, error)
+}
@@ -0,0 +1,82 @@
+package authevent
+
+import (
+	"time"
+
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+)
+
+const (
+	Table = "auth_events"
+)
+
+var Columns = []string{
+	"id",
+	"type",
+	"subject",
+	"actor",
+	"client_id",
+	"ip",
+	"user_agent",
+	"outcome",
+	"reason",
+	"created_at",
+}
+
+func FromEntity(entity authevententity.Event) Event {
+	return Event{
+		ID:        entity.ID,
+		Type:      string(entity.Type),
+		Subject:   entity.Subject,
+		Actor:     entity.Actor,
+		ClientID:  entity.ClientID,
+		IP:        entity.IP,
+		UserAgent: entity.UserAgent,
+		Outcome:   string(entity.Outcome),
+		Reason:    entity.Reason,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+type Event struct {
+	ID        string    `db:"id"`
+	Type      string    `db:"type"`
+	Subject   string    `db:"subject"`
+	Actor     string    `db:"actor"`
+	ClientID  string    `db:"client_id"`
+	IP        string    `db:"ip"`
+	UserAgent string    `db:"user_agent"`
+	Outcome   string    `db:"outcome"`
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (e Event) Values() []any {
+	return []any{
+		e.ID,
+		e.Type,
+		e.Subject,
+		e.Actor,
+		e.ClientID,
+		e.IP,
+		e.UserAgent,
+		e.Outcome,
+		e.Reason,
+		e.CreatedAt,
+	}
+}
+
+func (e Event) ToEntity() authevententity.Event {
+	return authevententity.Event{
+		ID:        e.ID,
+		Type:      authevententity.Type(e.Type),
+		Subject:   e.Subject,
+		Actor:     e.Actor,
+		ClientID:  e.ClientID,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		Outcome:   authevententity.Outcome(e.Outcome),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+}
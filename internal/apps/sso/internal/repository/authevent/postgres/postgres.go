@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	autheventrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent"
+	autheventmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/models/authevent"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ autheventrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, event authevententity.Event) error {
+	model := autheventmodel.FromEntity(event)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(autheventmodel.Table).
+		Columns(autheventmodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) List(ctx context.Context, filter autheventrepo.Filter, after *autheventrepo.Cursor, limit int) ([]authevententity.Event, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(autheventmodel.Columns...).
+		From(autheventmodel.Table).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(limit))
+
+	if len(filter.Types) > 0 {
+		types := make([]string, 0, len(filter.Types))
+		for _, t := range filter.Types {
+			types = append(types, string(t))
+		}
+		query = query.Where(sq.Eq{"type": types})
+	}
+	if filter.Subject != "" {
+		query = query.Where(sq.Eq{"subject": filter.Subject})
+	}
+	if filter.ClientID != "" {
+		query = query.Where(sq.Eq{"client_id": filter.ClientID})
+	}
+	if filter.IP != "" {
+		query = query.Where(sq.Eq{"ip": filter.IP})
+	}
+	if filter.Outcome != "" {
+		query = query.Where(sq.Eq{"outcome": string(filter.Outcome)})
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_at": filter.Since})
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where(sq.Lt{"created_at": filter.Until})
+	}
+	if after != nil {
+		query = query.Where(sq.Expr("(created_at, id) < (?, ?)", after.CreatedAt, after.ID))
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[autheventmodel.Event])
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]authevententity.Event, 0, len(models))
+	for _, model := range models {
+		events = append(events, model.ToEntity())
+	}
+
+	return events, nil
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	autheventrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM auth_events")
+	require.NoError(s.T(), err, "failed to clean auth_events table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM auth_events")
+	require.NoError(s.T(), err, "failed to clean auth_events table")
+}
+
+func newEvent(eventType authevententity.Type, subject string, createdAt time.Time) authevententity.Event {
+	return authevententity.Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		Subject:   subject,
+		ClientID:  "client-123",
+		IP:        "203.0.113.7",
+		UserAgent: "Mozilla/5.0",
+		Outcome:   authevententity.OutcomeSuccess,
+		CreatedAt: createdAt.UTC().Truncate(time.Microsecond),
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create and list event", func() {
+		event := newEvent(authevententity.TypeLogin, uuid.New().String(), time.Now())
+		event.Outcome = authevententity.OutcomeFailure
+		event.Reason = "invalid credentials"
+
+		err := repository.Create(ctx, event)
+		require.NoError(s.T(), err)
+
+		events, err := repository.List(ctx, autheventrepo.Filter{Subject: event.Subject}, nil, 10)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), events, 1)
+		assert.Equal(s.T(), event.ID, events[0].ID)
+		assert.Equal(s.T(), event.Type, events[0].Type)
+		assert.Equal(s.T(), event.ClientID, events[0].ClientID)
+		assert.Equal(s.T(), event.IP, events[0].IP)
+		assert.Equal(s.T(), event.UserAgent, events[0].UserAgent)
+		assert.Equal(s.T(), event.Outcome, events[0].Outcome)
+		assert.Equal(s.T(), event.Reason, events[0].Reason)
+		assert.True(s.T(), event.CreatedAt.Equal(events[0].CreatedAt))
+	})
+}
+
+func (s *RepositoryTestSuite) TestList() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	subject := uuid.New().String()
+	now := time.Now()
+
+	login := newEvent(authevententity.TypeLogin, subject, now.Add(-3*time.Minute))
+	refresh := newEvent(authevententity.TypeTokenRefresh, subject, now.Add(-2*time.Minute))
+	failed := newEvent(authevententity.TypeLogin, uuid.New().String(), now.Add(-time.Minute))
+	failed.Outcome = authevententity.OutcomeFailure
+
+	for _, event := range []authevententity.Event{login, refresh, failed} {
+		require.NoError(s.T(), repository.Create(ctx, event))
+	}
+
+	ids := func(events []authevententity.Event) []string {
+		result := make([]string, 0, len(events))
+		for _, event := range events {
+			result = append(result, event.ID)
+		}
+		return result
+	}
+
+	s.Run("should list newest first", func() {
+		events, err := repository.List(ctx, autheventrepo.Filter{}, nil, 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{failed.ID, refresh.ID, login.ID}, ids(events))
+	})
+
+	s.Run("should filter events", func() {
+		events, err := repository.List(ctx, autheventrepo.Filter{Subject: subject}, nil, 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{refresh.ID, login.ID}, ids(events))
+
+		events, err = repository.List(ctx, autheventrepo.Filter{Types: []authevententity.Type{authevententity.TypeLogin}}, nil, 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{failed.ID, login.ID}, ids(events))
+
+		events, err = repository.List(ctx, autheventrepo.Filter{Outcome: authevententity.OutcomeFailure}, nil, 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{failed.ID}, ids(events))
+
+		events, err = repository.List(ctx, autheventrepo.Filter{Since: refresh.CreatedAt, Until: failed.CreatedAt}, nil, 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{refresh.ID}, ids(events))
+	})
+
+	s.Run("should page with cursor", func() {
+		events, err := repository.List(ctx, autheventrepo.Filter{}, nil, 2)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), []string{failed.ID, refresh.ID}, ids(events))
+
+		last := events[len(events)-1]
+
+		events, err = repository.List(ctx, autheventrepo.Filter{}, &autheventrepo.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}, 2)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{login.ID}, ids(events))
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,36 @@
+package authevent
+
+import (
+	"context"
+	"time"
+
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+)
+
+// Filter narrows the events listed. Zero fields match every event.
+type Filter struct {
+	Types    []authevententity.Type
+	Subject  string
+	ClientID string
+	IP       string
+	Outcome  authevententity.Outcome
+	// Since and Until bound the creation time, inclusive and exclusive.
+	Since time.Time
+	Until time.Time
+}
+
+// Cursor is the position of the last event of a page. The next page starts
+// with the events listed after it.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// IRepository is append-only: events cannot be changed or removed through
+// it.
+type IRepository interface {
+	Create(ctx context.Context, event authevententity.Event) error
+	// List returns up to limit events matching filter, newest first. With a
+	// cursor, it starts after the event the cursor points to.
+	List(ctx context.Context, filter Filter, after *Cursor, limit int) ([]authevententity.Event, error)
+}
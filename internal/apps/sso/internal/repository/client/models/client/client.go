@@ -0,0 +1,127 @@
+package client
+
+import (
+	"time"
+
+	"github.com/dromara/carbon/v2"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+)
+
+const (
+	Table = "oauth_clients"
+)
+
+var Columns = []string{
+	"id",
+	"name",
+	"confidential",
+	"secret_hash",
+	"previous_secret_hash",
+	"previous_secret_expires_at",
+	"grant_types",
+	"redirect_uris",
+	"scopes",
+	"access_token_ttl_seconds",
+	"refresh_token_ttl_seconds",
+	"disabled",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+}
+
+func FromEntity(entity cliententity.Client) Client {
+	now := carbon.Now().StdTime()
+
+	var secretHash *string
+	if entity.SecretHash != "" {
+		secretHash = &entity.SecretHash
+	}
+
+	return Client{
+		ID:                     entity.ID,
+		Name:                   entity.Name,
+		Confidential:           entity.Confidential,
+		SecretHash:             secretHash,
+		GrantTypes:             nonNil(entity.GrantTypes),
+		RedirectURIs:           nonNil(entity.RedirectURIs),
+		Scopes:                 nonNil(entity.Scopes),
+		AccessTokenTTLSeconds:  int64(entity.AccessTokenTTL / time.Second),
+		RefreshTokenTTLSeconds: int64(entity.RefreshTokenTTL / time.Second),
+		Disabled:               entity.Disabled,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+}
+
+type Client struct {
+	ID                      string     `db:"id"`
+	Name                    string     `db:"name"`
+	Confidential            bool       `db:"confidential"`
+	SecretHash              *string    `db:"secret_hash"`
+	PreviousSecretHash      *string    `db:"previous_secret_hash"`
+	PreviousSecretExpiresAt *time.Time `db:"previous_secret_expires_at"`
+	GrantTypes              []string   `db:"grant_types"`
+	RedirectURIs            []string   `db:"redirect_uris"`
+	Scopes                  []string   `db:"scopes"`
+	AccessTokenTTLSeconds   int64      `db:"access_token_ttl_seconds"`
+	RefreshTokenTTLSeconds  int64      `db:"refresh_token_ttl_seconds"`
+	Disabled                bool       `db:"disabled"`
+	CreatedAt               time.Time  `db:"created_at"`
+	UpdatedAt               time.Time  `db:"updated_at"`
+	DeletedAt               *time.Time `db:"deleted_at"`
+}
+
+func (c Client) Values() []any {
+	return []any{
+		c.ID,
+		c.Name,
+		c.Confidential,
+		c.SecretHash,
+		c.PreviousSecretHash,
+		c.PreviousSecretExpiresAt,
+		c.GrantTypes,
+		c.RedirectURIs,
+		c.Scopes,
+		c.AccessTokenTTLSeconds,
+		c.RefreshTokenTTLSeconds,
+		c.Disabled,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.DeletedAt,
+	}
+}
+
+func (c Client) ToEntity() cliententity.Client {
+	entity := cliententity.Client{
+		ID:              c.ID,
+		Name:            c.Name,
+		Confidential:    c.Confidential,
+		GrantTypes:      c.GrantTypes,
+		RedirectURIs:    c.RedirectURIs,
+		Scopes:          c.Scopes,
+		AccessTokenTTL:  time.Duration(c.AccessTokenTTLSeconds) * time.Second,
+		RefreshTokenTTL: time.Duration(c.RefreshTokenTTLSeconds) * time.Second,
+		Disabled:        c.Disabled,
+		CreatedAt:       c.CreatedAt,
+	}
+
+	if c.SecretHash != nil {
+		entity.SecretHash = *c.SecretHash
+	}
+	if c.PreviousSecretHash != nil {
+		entity.PreviousSecretHash = *c.PreviousSecretHash
+	}
+	if c.PreviousSecretExpiresAt != nil {
+		entity.PreviousSecretExpiresAt = *c.PreviousSecretExpiresAt
+	}
+
+	return entity
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	clientmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/models/client"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ clientrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, client cliententity.Client) error {
+	model := clientmodel.FromEntity(client)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(clientmodel.Table).
+		Columns(clientmodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id string) (cliententity.Client, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(clientmodel.Columns...).
+		From(clientmodel.Table).
+		Where(sq.Eq{
+			"id":         id,
+			"deleted_at": nil,
+		}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return cliententity.Client{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return cliententity.Client{}, err
+	}
+	defer rows.Close()
+
+	client, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[clientmodel.Client])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return cliententity.Client{}, clientrepo.ErrClientNotFound
+		}
+		return cliententity.Client{}, err
+	}
+
+	return client.ToEntity(), nil
+}
+
+func (r *Repository) List(ctx context.Context) ([]cliententity.Client, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(clientmodel.Columns...).
+		From(clientmodel.Table).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("created_at ASC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[clientmodel.Client])
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]cliententity.Client, 0, len(models))
+	for _, model := range models {
+		clients = append(clients, model.ToEntity())
+	}
+
+	return clients, nil
+}
+
+func (r *Repository) UpdateSecret(ctx context.Context, id, secretHash string, previousSecretExpiresAt time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(clientmodel.Table).
+		Set("previous_secret_hash", sq.Expr("secret_hash")).
+		Set("previous_secret_expires_at", previousSecretExpiresAt).
+		Set("secret_hash", secretHash).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) Disable(ctx context.Context, id string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(clientmodel.Table).
+		Set("disabled", true).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) exec(ctx context.Context, query sq.UpdateBuilder) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return clientrepo.ErrClientNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM oauth_clients")
+	require.NoError(s.T(), err, "failed to clean oauth_clients table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM oauth_clients")
+	require.NoError(s.T(), err, "failed to clean oauth_clients table")
+}
+
+func newClient() cliententity.Client {
+	return cliententity.Client{
+		ID:              uuid.New().String(),
+		Name:            "test-client",
+		Confidential:    true,
+		SecretHash:      tokenentity.Hash("secret-123"),
+		GrantTypes:      []string{cliententity.GrantTypeClientCredentials},
+		RedirectURIs:    []string{"https://app.example.com/callback"},
+		Scopes:          []string{"files:read", "files:write"},
+		AccessTokenTTL:  5 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		CreatedAt:       time.Now(),
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create a client successfully", func() {
+		client := newClient()
+
+		err := repository.Create(ctx, client)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, client.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), client.ID, retrieved.ID)
+		assert.Equal(s.T(), client.Name, retrieved.Name)
+		assert.True(s.T(), retrieved.Confidential)
+		assert.Equal(s.T(), client.SecretHash, retrieved.SecretHash)
+		assert.Empty(s.T(), retrieved.PreviousSecretHash)
+		assert.Equal(s.T(), client.GrantTypes, retrieved.GrantTypes)
+		assert.Equal(s.T(), client.RedirectURIs, retrieved.RedirectURIs)
+		assert.Equal(s.T(), client.Scopes, retrieved.Scopes)
+		assert.Equal(s.T(), client.AccessTokenTTL, retrieved.AccessTokenTTL)
+		assert.Equal(s.T(), client.RefreshTokenTTL, retrieved.RefreshTokenTTL)
+		assert.False(s.T(), retrieved.Disabled)
+	})
+
+	s.Run("should create a public client without secret", func() {
+		client := newClient()
+		client.Confidential = false
+		client.SecretHash = ""
+		client.Scopes = nil
+
+		err := repository.Create(ctx, client)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, client.ID)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrieved.Confidential)
+		assert.Empty(s.T(), retrieved.SecretHash)
+		assert.Empty(s.T(), retrieved.Scopes)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGetByID() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should return error when client not found", func() {
+		_, err := repository.GetByID(ctx, uuid.New().String())
+		assert.ErrorIs(s.T(), err, clientrepo.ErrClientNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestList() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should list all clients", func() {
+		client1 := newClient()
+		client2 := newClient()
+
+		require.NoError(s.T(), repository.Create(ctx, client1))
+		require.NoError(s.T(), repository.Create(ctx, client2))
+
+		clients, err := repository.List(ctx)
+		require.NoError(s.T(), err)
+
+		ids := make([]string, 0, len(clients))
+		for _, client := range clients {
+			ids = append(ids, client.ID)
+		}
+		assert.ElementsMatch(s.T(), []string{client1.ID, client2.ID}, ids)
+	})
+}
+
+func (s *RepositoryTestSuite) TestUpdateSecret() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should keep previous secret until grace period ends", func() {
+		client := newClient()
+		require.NoError(s.T(), repository.Create(ctx, client))
+
+		expiresAt := time.Now().Add(time.Hour)
+		err := repository.UpdateSecret(ctx, client.ID, tokenentity.Hash("secret-456"), expiresAt)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, client.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), tokenentity.Hash("secret-456"), retrieved.SecretHash)
+		assert.Equal(s.T(), client.SecretHash, retrieved.PreviousSecretHash)
+		assert.WithinDuration(s.T(), expiresAt, retrieved.PreviousSecretExpiresAt, time.Second)
+
+		assert.True(s.T(), retrieved.VerifySecret("secret-123", time.Now()))
+		assert.True(s.T(), retrieved.VerifySecret("secret-456", time.Now()))
+		assert.False(s.T(), retrieved.VerifySecret("secret-123", expiresAt.Add(time.Second)))
+	})
+
+	s.Run("should return error when client not found", func() {
+		err := repository.UpdateSecret(ctx, uuid.New().String(), tokenentity.Hash("secret-456"), time.Now())
+		assert.ErrorIs(s.T(), err, clientrepo.ErrClientNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestDisable() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should disable client", func() {
+		client := newClient()
+		require.NoError(s.T(), repository.Create(ctx, client))
+
+		err := repository.Disable(ctx, client.ID)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, client.ID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrieved.Disabled)
+	})
+
+	s.Run("should return error when client not found", func() {
+		err := repository.Disable(ctx, uuid.New().String())
+		assert.ErrorIs(s.T(), err, clientrepo.ErrClientNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrClientNotFound = errors.New("client not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, client cliententity.Client) error
+	GetByID(ctx context.Context, id string) (cliententity.Client, error)
+	List(ctx context.Context) ([]cliententity.Client, error)
+	// UpdateSecret replaces the client secret. The replaced secret keeps
+	// working until previousSecretExpiresAt.
+	UpdateSecret(ctx context.Context, id, secretHash string, previousSecretExpiresAt time.Time) error
+	Disable(ctx context.Context, id string) error
+}
@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	denylistrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "denylist:jti:"
+)
+
+var _ denylistrepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return r.rdb.Set(ctx, KeyPrefix+jti, 1, ttl).Err()
+}
+
+func (r *Repository) Contains(ctx context.Context, jti string) (bool, error) {
+	n, err := r.rdb.Exists(ctx, KeyPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
@@ -0,0 +1,106 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func (s *RepositoryTestSuite) TestAdd() {
+	s.Run("should add jti with remaining lifetime as ttl", func() {
+		err := s.repository.Add(s.ctx, "jti-123", time.Minute)
+		require.NoError(s.T(), err)
+
+		contains, err := s.repository.Contains(s.ctx, "jti-123")
+		require.NoError(s.T(), err)
+		assert.True(s.T(), contains)
+
+		ttl, err := s.rdb.TTL(s.ctx, KeyPrefix+"jti-123").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+	})
+
+	s.Run("should skip jti that has already expired", func() {
+		err := s.repository.Add(s.ctx, "jti-expired", -time.Second)
+		require.NoError(s.T(), err)
+
+		contains, err := s.repository.Contains(s.ctx, "jti-expired")
+		require.NoError(s.T(), err)
+		assert.False(s.T(), contains)
+	})
+}
+
+func (s *RepositoryTestSuite) TestContains() {
+	s.Run("should return false for unknown jti", func() {
+		contains, err := s.repository.Contains(s.ctx, "jti-unknown")
+		require.NoError(s.T(), err)
+		assert.False(s.T(), contains)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,11 @@
+package denylist
+
+import (
+	"context"
+	"time"
+)
+
+type IRepository interface {
+	Add(ctx context.Context, jti string, ttl time.Duration) error
+	Contains(ctx context.Context, jti string) (bool, error)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/device/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/repository/device/repository.go -destination=internal/repository/device/mocks/repository_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	device "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRepository is a mock of IRepository interface.
+type MockIRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIRepositoryMockRecorder is the mock recorder for MockIRepository.
+type MockIRepositoryMockRecorder struct {
+	mock *MockIRepository
+}
+
+// NewMockIRepository creates a new mock instance.
+func NewMockIRepository(ctrl *gomock.Controller) *MockIRepository {
+	mock := &MockIRepository{ctrl: ctrl}
+	mock.recorder = &MockIRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRepository) EXPECT() *MockIRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockIRepository) Consume(ctx context.Context, authorization device.Authorization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, authorization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockIRepositoryMockRecorder) Consume(ctx, authorization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockIRepository)(nil).Consume), ctx, authorization)
+}
+
+// Create mocks base method.
+func (m *MockIRepository) Create(ctx context.Context, authorization device.Authorization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, authorization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIRepositoryMockRecorder) Create(ctx, authorization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIRepository)(nil).Create), ctx, authorization)
+}
+
+// Decide mocks base method.
+func (m *MockIRepository) Decide(ctx context.Context, deviceCodeHash string, status device.Status, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", ctx, deviceCodeHash, status, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MockIRepositoryMockRecorder) Decide(ctx, deviceCodeHash, status, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MockIRepository)(nil).Decide), ctx, deviceCodeHash, status, userID)
+}
+
+// GetByDeviceCode mocks base method.
+func (m *MockIRepository) GetByDeviceCode(ctx context.Context, deviceCodeHash string) (device.Authorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByDeviceCode", ctx, deviceCodeHash)
+	ret0, _ := ret[0].(device.Authorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByDeviceCode indicates an expected call of GetByDeviceCode.
+func (mr *MockIRepositoryMockRecorder) GetByDeviceCode(ctx, deviceCodeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDeviceCode", reflect.TypeOf((*MockIRepository)(nil).GetByDeviceCode), ctx, deviceCodeHash)
+}
+
+// GetByUserCode mocks base method.
+func (m *MockIRepository) GetByUserCode(ctx context.Context, userCodeHash string) (device.Authorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserCode", ctx, userCodeHash)
+	ret0, _ := ret[0].(device.Authorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserCode indicates an expected call of GetByUserCode.
+func (mr *MockIRepositoryMockRecorder) GetByUserCode(ctx, userCodeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserCode", reflect.TypeOf((*MockIRepository)(nil).GetByUserCode), ctx, userCodeHash)
+}
+
+// RecordPoll mocks base method.
+func (m *MockIRepository) RecordPoll(ctx context.Context, deviceCodeHash string, at time.Time) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPoll", ctx, deviceCodeHash, at)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordPoll indicates an expected call of RecordPoll.
+func (mr *MockIRepositoryMockRecorder) RecordPoll(ctx, deviceCodeHash, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPoll", reflect.TypeOf((*MockIRepository)(nil).RecordPoll), ctx, deviceCodeHash, at)
+}
+
+// SlowDown mocks base method.
+func (m *MockIRepository) SlowDown(ctx context.Context, deviceCodeHash string, interval, increment time.Duration) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlowDown", ctx, deviceCodeHash, interval, increment)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlowDown indicates an expected call of SlowDown.
+func (mr *MockIRepositoryMockRecorder) SlowDown(ctx, deviceCodeHash, interval, increment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlowDown", reflect.TypeOf((*MockIRepository)(nil).SlowDown), ctx, deviceCodeHash, interval, increment)
+}
@@ -0,0 +1,38 @@
+package authorization
+
+import (
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+)
+
+// Authorization holds the fields of an authorization that never change. The
+// decision of the user is stored next to it.
+type Authorization struct {
+	DeviceCodeHash string    `json:"device_code_hash"`
+	UserCodeHash   string    `json:"user_code_hash"`
+	ClientID       string    `json:"client_id"`
+	Scopes         []string  `json:"scopes,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+func FromEntity(entity deviceentity.Authorization) Authorization {
+	return Authorization{
+		DeviceCodeHash: entity.DeviceCodeHash,
+		UserCodeHash:   entity.UserCodeHash,
+		ClientID:       entity.ClientID,
+		Scopes:         entity.Scopes,
+		ExpiresAt:      entity.ExpiresAt,
+	}
+}
+
+func (a Authorization) ToEntity() deviceentity.Authorization {
+	return deviceentity.Authorization{
+		DeviceCodeHash: a.DeviceCodeHash,
+		UserCodeHash:   a.UserCodeHash,
+		ClientID:       a.ClientID,
+		Scopes:         a.Scopes,
+		Status:         deviceentity.StatusPending,
+		ExpiresAt:      a.ExpiresAt,
+	}
+}
@@ -0,0 +1,214 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	authorizationmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device/models/authorization"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "device:"
+	// AuthorizationPrefix holds the authorizations by the hash of their
+	// device code, as hashes of the fields below.
+	AuthorizationPrefix = KeyPrefix + "code:"
+	// UserCodePrefix maps the hash of a user code to the hash of the device
+	// code it was issued with.
+	UserCodePrefix = KeyPrefix + "user_code:"
+
+	// Fields of an authorization hash. The scripts use the same names.
+	FieldData     = "data"
+	FieldStatus   = "status"
+	FieldUserID   = "user_id"
+	FieldPolledAt = "polled_at"
+	FieldInterval = "interval"
+
+	// ExpiredRetention is how long authorizations are kept once they
+	// expired, so that devices still polling are told so instead of getting
+	// an unknown code.
+	ExpiredRetention = 10 * time.Minute // 10 minutes
+)
+
+var (
+	decideScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+if redis.call("HSETNX", KEYS[1], "status", ARGV[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], "user_id", ARGV[2])
+return 1
+`)
+
+	pollScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+local previous = redis.call("HGET", KEYS[1], "polled_at")
+redis.call("HSET", KEYS[1], "polled_at", ARGV[1])
+if previous then
+	return tonumber(previous)
+end
+return 0
+`)
+
+	slowDownScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+redis.call("HSETNX", KEYS[1], "interval", ARGV[1])
+return redis.call("HINCRBY", KEYS[1], "interval", ARGV[2])
+`)
+)
+
+var _ devicerepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, authorization deviceentity.Authorization) error {
+	ttl := time.Until(authorization.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("device authorization is already expired")
+	}
+
+	data, err := json.Marshal(authorizationmodel.FromEntity(authorization))
+	if err != nil {
+		return err
+	}
+
+	created, err := r.rdb.SetNX(ctx, UserCodePrefix+authorization.UserCodeHash, authorization.DeviceCodeHash, ttl).Result()
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		return devicerepo.ErrUserCodeTaken
+	}
+
+	key := AuthorizationPrefix + authorization.DeviceCodeHash
+
+	pipe := r.rdb.TxPipeline()
+	pipe.HSet(ctx, key, FieldData, data)
+	pipe.ExpireAt(ctx, key, authorization.ExpiresAt.Add(ExpiredRetention))
+
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+func (r *Repository) GetByDeviceCode(ctx context.Context, deviceCodeHash string) (deviceentity.Authorization, error) {
+	fields, err := r.rdb.HGetAll(ctx, AuthorizationPrefix+deviceCodeHash).Result()
+	if err != nil {
+		return deviceentity.Authorization{}, err
+	}
+
+	data, ok := fields[FieldData]
+	if !ok {
+		return deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound
+	}
+
+	var model authorizationmodel.Authorization
+	if err := json.Unmarshal([]byte(data), &model); err != nil {
+		return deviceentity.Authorization{}, err
+	}
+
+	authorization := model.ToEntity()
+	if status, ok := fields[FieldStatus]; ok {
+		authorization.Status = deviceentity.Status(status)
+		authorization.UserID = fields[FieldUserID]
+	}
+	if interval, ok := fields[FieldInterval]; ok {
+		milliseconds, err := strconv.ParseInt(interval, 10, 64)
+		if err != nil {
+			return deviceentity.Authorization{}, err
+		}
+		authorization.Interval = time.Duration(milliseconds) * time.Millisecond
+	}
+
+	return authorization, nil
+}
+
+func (r *Repository) GetByUserCode(ctx context.Context, userCodeHash string) (deviceentity.Authorization, error) {
+	deviceCodeHash, err := r.rdb.Get(ctx, UserCodePrefix+userCodeHash).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	return r.GetByDeviceCode(ctx, deviceCodeHash)
+}
+
+func (r *Repository) Decide(ctx context.Context, deviceCodeHash string, status deviceentity.Status, userID string) error {
+	decided, err := decideScript.Run(ctx, r.rdb, []string{AuthorizationPrefix + deviceCodeHash}, string(status), userID).Int()
+	if err != nil {
+		return err
+	}
+
+	switch decided {
+	case -1:
+		return devicerepo.ErrAuthorizationNotFound
+	case 0:
+		return devicerepo.ErrAlreadyDecided
+	}
+
+	return nil
+}
+
+func (r *Repository) RecordPoll(ctx context.Context, deviceCodeHash string, at time.Time) (time.Time, error) {
+	previous, err := pollScript.Run(ctx, r.rdb, []string{AuthorizationPrefix + deviceCodeHash}, at.UnixMilli()).Int64()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch previous {
+	case -1:
+		return time.Time{}, devicerepo.ErrAuthorizationNotFound
+	case 0:
+		return time.Time{}, nil
+	}
+
+	return time.UnixMilli(previous), nil
+}
+
+func (r *Repository) SlowDown(ctx context.Context, deviceCodeHash string, interval, increment time.Duration) (time.Duration, error) {
+	milliseconds, err := slowDownScript.Run(ctx, r.rdb, []string{AuthorizationPrefix + deviceCodeHash}, interval.Milliseconds(), increment.Milliseconds()).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	if milliseconds == -1 {
+		return 0, devicerepo.ErrAuthorizationNotFound
+	}
+
+	return time.Duration(milliseconds) * time.Millisecond, nil
+}
+
+func (r *Repository) Consume(ctx context.Context, authorization deviceentity.Authorization) error {
+	deleted, err := r.rdb.Del(ctx, AuthorizationPrefix+authorization.DeviceCodeHash).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return devicerepo.ErrAuthorizationNotFound
+	}
+
+	return r.rdb.Del(ctx, UserCodePrefix+authorization.UserCodeHash).Err()
+}
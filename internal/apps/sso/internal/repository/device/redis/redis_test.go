@@ -0,0 +1,244 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func newAuthorization(id string) deviceentity.Authorization {
+	return deviceentity.Authorization{
+		DeviceCodeHash: "device-hash-" + id,
+		UserCodeHash:   "user-hash-" + id,
+		ClientID:       "client-123",
+		Scopes:         []string{"openid"},
+		Status:         deviceentity.StatusPending,
+		ExpiresAt:      time.Now().Add(time.Minute).UTC().Truncate(time.Second),
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	s.Run("should store authorization past its expiry", func() {
+		authorization := newAuthorization("1")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		ttl, err := s.rdb.TTL(s.ctx, AuthorizationPrefix+authorization.DeviceCodeHash).Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, ExpiredRetention)
+		assert.LessOrEqual(s.T(), ttl, time.Minute+ExpiredRetention)
+
+		ttl, err = s.rdb.TTL(s.ctx, UserCodePrefix+authorization.UserCodeHash).Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+
+		retrieved, err := s.repository.GetByDeviceCode(s.ctx, authorization.DeviceCodeHash)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), authorization, retrieved)
+
+		retrieved, err = s.repository.GetByUserCode(s.ctx, authorization.UserCodeHash)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), authorization, retrieved)
+	})
+
+	s.Run("should reject a user code that is taken", func() {
+		authorization := newAuthorization("2")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		other := newAuthorization("3")
+		other.UserCodeHash = authorization.UserCodeHash
+
+		err = s.repository.Create(s.ctx, other)
+		assert.ErrorIs(s.T(), err, devicerepo.ErrUserCodeTaken)
+
+		_, err = s.repository.GetByDeviceCode(s.ctx, other.DeviceCodeHash)
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+	})
+
+	s.Run("should return error when authorization is already expired", func() {
+		authorization := newAuthorization("4")
+		authorization.ExpiresAt = time.Now().Add(-time.Second)
+
+		err := s.repository.Create(s.ctx, authorization)
+		assert.Error(s.T(), err)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGet() {
+	s.Run("should return error for unknown codes", func() {
+		_, err := s.repository.GetByDeviceCode(s.ctx, "device-hash-unknown")
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+
+		_, err = s.repository.GetByUserCode(s.ctx, "user-hash-unknown")
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestDecide() {
+	s.Run("should decide authorization only once", func() {
+		authorization := newAuthorization("1")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		err = s.repository.Decide(s.ctx, authorization.DeviceCodeHash, deviceentity.StatusApproved, "user-123")
+		require.NoError(s.T(), err)
+
+		err = s.repository.Decide(s.ctx, authorization.DeviceCodeHash, deviceentity.StatusDenied, "user-456")
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAlreadyDecided)
+
+		retrieved, err := s.repository.GetByDeviceCode(s.ctx, authorization.DeviceCodeHash)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), deviceentity.StatusApproved, retrieved.Status)
+		assert.Equal(s.T(), "user-123", retrieved.UserID)
+	})
+
+	s.Run("should not recreate unknown authorization", func() {
+		err := s.repository.Decide(s.ctx, "device-hash-unknown", deviceentity.StatusApproved, "user-123")
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+
+		exists, err := s.rdb.Exists(s.ctx, AuthorizationPrefix+"device-hash-unknown").Result()
+		require.NoError(s.T(), err)
+		assert.Zero(s.T(), exists)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRecordPoll() {
+	s.Run("should return the time of the previous poll", func() {
+		authorization := newAuthorization("1")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		first := time.Now().Truncate(time.Millisecond)
+
+		previous, err := s.repository.RecordPoll(s.ctx, authorization.DeviceCodeHash, first)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), previous.IsZero())
+
+		previous, err = s.repository.RecordPoll(s.ctx, authorization.DeviceCodeHash, first.Add(5*time.Second))
+		require.NoError(s.T(), err)
+		assert.True(s.T(), first.Equal(previous))
+	})
+
+	s.Run("should not recreate unknown authorization", func() {
+		_, err := s.repository.RecordPoll(s.ctx, "device-hash-unknown", time.Now())
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestSlowDown() {
+	s.Run("should grow the interval of the device", func() {
+		authorization := newAuthorization("1")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		interval, err := s.repository.SlowDown(s.ctx, authorization.DeviceCodeHash, 5*time.Second, 5*time.Second)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 10*time.Second, interval)
+
+		interval, err = s.repository.SlowDown(s.ctx, authorization.DeviceCodeHash, 5*time.Second, 5*time.Second)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 15*time.Second, interval)
+
+		stored, err := s.repository.GetByDeviceCode(s.ctx, authorization.DeviceCodeHash)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 15*time.Second, stored.Interval)
+	})
+
+	s.Run("should not recreate unknown authorization", func() {
+		_, err := s.repository.SlowDown(s.ctx, "device-hash-unknown", 5*time.Second, 5*time.Second)
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestConsume() {
+	s.Run("should consume authorization only once", func() {
+		authorization := newAuthorization("1")
+
+		err := s.repository.Create(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		err = s.repository.Consume(s.ctx, authorization)
+		require.NoError(s.T(), err)
+
+		_, err = s.repository.GetByUserCode(s.ctx, authorization.UserCodeHash)
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+
+		err = s.repository.Consume(s.ctx, authorization)
+		assert.ErrorIs(s.T(), err, devicerepo.ErrAuthorizationNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,36 @@
+package device
+
+import (
+	"context"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAuthorizationNotFound = errors.New("device authorization not found")
+	ErrUserCodeTaken         = errors.New("user code already taken")
+	ErrAlreadyDecided        = errors.New("device authorization already decided")
+)
+
+type IRepository interface {
+	// Create stores a pending authorization. It fails with ErrUserCodeTaken
+	// if another authorization still has the same user code.
+	Create(ctx context.Context, authorization deviceentity.Authorization) error
+	GetByDeviceCode(ctx context.Context, deviceCodeHash string) (deviceentity.Authorization, error)
+	GetByUserCode(ctx context.Context, userCodeHash string) (deviceentity.Authorization, error)
+	// Decide approves or denies a pending authorization on behalf of the
+	// user. It fails with ErrAlreadyDecided if it was decided before.
+	Decide(ctx context.Context, deviceCodeHash string, status deviceentity.Status, userID string) error
+	// RecordPoll records a poll of the device at the given time and returns
+	// the time of the previous one, or the zero time for the first.
+	RecordPoll(ctx context.Context, deviceCodeHash string, at time.Time) (time.Time, error)
+	// SlowDown increases the interval of the device by increment, starting
+	// from interval if it was never increased, and returns the new one.
+	SlowDown(ctx context.Context, deviceCodeHash string, interval, increment time.Duration) (time.Duration, error)
+	// Consume deletes the authorization, so that tokens are issued for it at
+	// most once. It fails with ErrAuthorizationNotFound if it is already
+	// gone.
+	Consume(ctx context.Context, authorization deviceentity.Authorization) error
+}
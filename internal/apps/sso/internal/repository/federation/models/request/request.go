@@ -0,0 +1,64 @@
+package request
+
+import (
+	"time"
+
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+)
+
+type Request struct {
+	StateHash     string        `json:"state_hash"`
+	Provider      string        `json:"provider"`
+	CodeVerifier  string        `json:"code_verifier"`
+	Nonce         string        `json:"nonce"`
+	Authorization Authorization `json:"authorization"`
+	ExpiresAt     time.Time     `json:"expires_at"`
+}
+
+type Authorization struct {
+	ClientID            string   `json:"client_id"`
+	RedirectURI         string   `json:"redirect_uri"`
+	Scopes              []string `json:"scopes,omitempty"`
+	State               string   `json:"state,omitempty"`
+	Nonce               string   `json:"nonce,omitempty"`
+	CodeChallenge       string   `json:"code_challenge"`
+	CodeChallengeMethod string   `json:"code_challenge_method"`
+}
+
+func FromEntity(entity federationentity.Request) Request {
+	return Request{
+		StateHash:    entity.StateHash,
+		Provider:     entity.Provider,
+		CodeVerifier: entity.CodeVerifier,
+		Nonce:        entity.Nonce,
+		Authorization: Authorization{
+			ClientID:            entity.Authorization.ClientID,
+			RedirectURI:         entity.Authorization.RedirectURI,
+			Scopes:              entity.Authorization.Scopes,
+			State:               entity.Authorization.State,
+			Nonce:               entity.Authorization.Nonce,
+			CodeChallenge:       entity.Authorization.CodeChallenge,
+			CodeChallengeMethod: entity.Authorization.CodeChallengeMethod,
+		},
+		ExpiresAt: entity.ExpiresAt,
+	}
+}
+
+func (r Request) ToEntity() federationentity.Request {
+	return federationentity.Request{
+		StateHash:    r.StateHash,
+		Provider:     r.Provider,
+		CodeVerifier: r.CodeVerifier,
+		Nonce:        r.Nonce,
+		Authorization: federationentity.Authorization{
+			ClientID:            r.Authorization.ClientID,
+			RedirectURI:         r.Authorization.RedirectURI,
+			Scopes:              r.Authorization.Scopes,
+			State:               r.Authorization.State,
+			Nonce:               r.Authorization.Nonce,
+			CodeChallenge:       r.Authorization.CodeChallenge,
+			CodeChallengeMethod: r.Authorization.CodeChallengeMethod,
+		},
+		ExpiresAt: r.ExpiresAt,
+	}
+}
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	federationrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation"
+	requestmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation/models/request"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "federation:"
+)
+
+var _ federationrepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, request federationentity.Request) error {
+	ttl := time.Until(request.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("federation request is already expired")
+	}
+
+	data, err := json.Marshal(requestmodel.FromEntity(request))
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, KeyPrefix+request.StateHash, data, ttl).Err()
+}
+
+func (r *Repository) Consume(ctx context.Context, stateHash string) (federationentity.Request, error) {
+	data, err := r.rdb.GetDel(ctx, KeyPrefix+stateHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return federationentity.Request{}, federationrepo.ErrRequestNotFound
+		}
+		return federationentity.Request{}, err
+	}
+
+	var model requestmodel.Request
+	if err := json.Unmarshal(data, &model); err != nil {
+		return federationentity.Request{}, err
+	}
+
+	return model.ToEntity(), nil
+}
@@ -0,0 +1,19 @@
+package federation
+
+import (
+	"context"
+
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrRequestNotFound = errors.New("federation request not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, request federationentity.Request) error
+	// Consume returns the request stored under stateHash and deletes it, so
+	// that every callback is accepted at most once.
+	Consume(ctx context.Context, stateHash string) (federationentity.Request, error)
+}
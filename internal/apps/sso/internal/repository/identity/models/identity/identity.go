@@ -0,0 +1,67 @@
+package identity
+
+import (
+	"time"
+
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+)
+
+const (
+	Table = "identities"
+)
+
+var Columns = []string{
+	"id",
+	"provider",
+	"subject",
+	"user_id",
+	"email",
+	"created_at",
+	"last_login_at",
+}
+
+func FromEntity(entity identityentity.Identity) Identity {
+	return Identity{
+		ID:          entity.ID,
+		Provider:    entity.Provider,
+		Subject:     entity.Subject,
+		UserID:      entity.UserID,
+		Email:       entity.Email,
+		CreatedAt:   entity.CreatedAt,
+		LastLoginAt: entity.LastLoginAt,
+	}
+}
+
+type Identity struct {
+	ID          string    `db:"id"`
+	Provider    string    `db:"provider"`
+	Subject     string    `db:"subject"`
+	UserID      string    `db:"user_id"`
+	Email       string    `db:"email"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastLoginAt time.Time `db:"last_login_at"`
+}
+
+func (i Identity) Values() []any {
+	return []any{
+		i.ID,
+		i.Provider,
+		i.Subject,
+		i.UserID,
+		i.Email,
+		i.CreatedAt,
+		i.LastLoginAt,
+	}
+}
+
+func (i Identity) ToEntity() identityentity.Identity {
+	return identityentity.Identity{
+		ID:          i.ID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		UserID:      i.UserID,
+		Email:       i.Email,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+	identityrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity"
+	identitymodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity/models/identity"
+	"github.com/pkg/errors"
+)
+
+// uniqueViolation is the SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ identityrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, identity identityentity.Identity) error {
+	model := identitymodel.FromEntity(identity)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(identitymodel.Table).
+		Columns(identitymodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return identityrepo.ErrIdentityExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, provider, subject string) (identityentity.Identity, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(identitymodel.Columns...).
+		From(identitymodel.Table).
+		Where(sq.Eq{
+			"provider": provider,
+			"subject":  subject,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return identityentity.Identity{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return identityentity.Identity{}, err
+	}
+	defer rows.Close()
+
+	identity, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[identitymodel.Identity])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return identityentity.Identity{}, identityrepo.ErrIdentityNotFound
+		}
+		return identityentity.Identity{}, err
+	}
+
+	return identity.ToEntity(), nil
+}
+
+func (r *Repository) Touch(ctx context.Context, id string, at time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(identitymodel.Table).
+		Set("last_login_at", at).
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return identityrepo.ErrIdentityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+	identityrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM identities")
+	require.NoError(s.T(), err, "failed to clean identities table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM identities")
+	require.NoError(s.T(), err, "failed to clean identities table")
+}
+
+func newIdentity(provider string) identityentity.Identity {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	return identityentity.Identity{
+		ID:          uuid.New().String(),
+		Provider:    provider,
+		Subject:     uuid.New().String(),
+		UserID:      uuid.New().String(),
+		Email:       "jane@corp.test",
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create and get identity", func() {
+		identity := newIdentity("acme")
+
+		err := repository.Create(ctx, identity)
+		require.NoError(s.T(), err)
+
+		got, err := repository.Get(ctx, identity.Provider, identity.Subject)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), identity.ID, got.ID)
+		assert.Equal(s.T(), identity.UserID, got.UserID)
+		assert.Equal(s.T(), identity.Email, got.Email)
+	})
+
+	s.Run("should not link provider subject twice", func() {
+		identity := newIdentity("acme")
+
+		err := repository.Create(ctx, identity)
+		require.NoError(s.T(), err)
+
+		other := newIdentity("acme")
+		other.Subject = identity.Subject
+
+		err = repository.Create(ctx, other)
+		assert.ErrorIs(s.T(), err, identityrepo.ErrIdentityExists)
+	})
+
+	s.Run("should scope subjects to their provider", func() {
+		identity := newIdentity("acme")
+
+		err := repository.Create(ctx, identity)
+		require.NoError(s.T(), err)
+
+		_, err = repository.Get(ctx, "other", identity.Subject)
+		assert.ErrorIs(s.T(), err, identityrepo.ErrIdentityNotFound)
+
+		other := newIdentity("other")
+		other.Subject = identity.Subject
+
+		err = repository.Create(ctx, other)
+		assert.NoError(s.T(), err)
+	})
+}
+
+func (s *RepositoryTestSuite) TestTouch() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should record last login", func() {
+		identity := newIdentity("acme")
+
+		err := repository.Create(ctx, identity)
+		require.NoError(s.T(), err)
+
+		at := identity.LastLoginAt.Add(time.Hour)
+
+		err = repository.Touch(ctx, identity.ID, at)
+		require.NoError(s.T(), err)
+
+		got, err := repository.Get(ctx, identity.Provider, identity.Subject)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), at.Equal(got.LastLoginAt))
+	})
+
+	s.Run("should return not found for unknown identity", func() {
+		err := repository.Touch(ctx, uuid.New().String(), time.Now())
+		assert.ErrorIs(s.T(), err, identityrepo.ErrIdentityNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,23 @@
+package identity
+
+import (
+	"context"
+	"time"
+
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrIdentityNotFound = errors.New("identity not found")
+	ErrIdentityExists   = errors.New("identity already exists")
+)
+
+type IRepository interface {
+	// Create links a new identity. It returns ErrIdentityExists if the
+	// provider subject is already linked.
+	Create(ctx context.Context, identity identityentity.Identity) error
+	Get(ctx context.Context, provider, subject string) (identityentity.Identity, error)
+	// Touch records a sign in with the identity.
+	Touch(ctx context.Context, id string, at time.Time) error
+}
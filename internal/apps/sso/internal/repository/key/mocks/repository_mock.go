@@ -41,6 +41,21 @@ func (m *MockIRepository) EXPECT() *MockIRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Get mocks base method.
+func (m *MockIRepository) Get(ctx context.Context, kid string) (key.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, kid)
+	ret0, _ := ret[0].(key.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIRepositoryMockRecorder) Get(ctx, kid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIRepository)(nil).Get), ctx, kid)
+}
+
 // GetCurrentSigningKey mocks base method.
 func (m *MockIRepository) GetCurrentSigningKey(ctx context.Context) (key.Key, error) {
 	m.ctrl.T.Helper()
@@ -71,17 +86,51 @@ func (mr *MockIRepositoryMockRecorder) GetPublicKeys(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicKeys", reflect.TypeOf((*MockIRepository)(nil).GetPublicKeys), ctx)
 }
 
-// Rotate mocks base method.
-func (m *MockIRepository) Rotate(ctx context.Context) (key.Key, error) {
+// List mocks base method.
+func (m *MockIRepository) List(ctx context.Context) ([]key.Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Rotate", ctx)
-	ret0, _ := ret[0].(key.Key)
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]key.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Rotate indicates an expected call of Rotate.
-func (mr *MockIRepositoryMockRecorder) Rotate(ctx any) *gomock.Call {
+// List indicates an expected call of List.
+func (mr *MockIRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRepository)(nil).List), ctx)
+}
+
+// ReEncrypt mocks base method.
+func (m *MockIRepository) ReEncrypt(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReEncrypt", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReEncrypt indicates an expected call of ReEncrypt.
+func (mr *MockIRepositoryMockRecorder) ReEncrypt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReEncrypt", reflect.TypeOf((*MockIRepository)(nil).ReEncrypt), ctx)
+}
+
+// Save mocks base method.
+func (m *MockIRepository) Save(ctx context.Context, keys ...key.Key) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Save", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRepositoryMockRecorder) Save(ctx any, keys ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockIRepository)(nil).Rotate), ctx)
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRepository)(nil).Save), varargs...)
 }
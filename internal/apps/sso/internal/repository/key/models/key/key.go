@@ -1,83 +1,172 @@
 package key
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"time"
 
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+)
+
+const (
+	PEMTypeRSAPrivateKey = "RSA PRIVATE KEY"
+	PEMTypePrivateKey    = "PRIVATE KEY"
+	PEMTypePublicKey     = "PUBLIC KEY"
 )
 
 type Key struct {
-	ID        string `json:"kid"`
-	Private   string `json:"private"`
-	Public    string `json:"public"`
-	Algorithm string `json:"alg"`
-	Active    bool   `json:"active"`
+	ID string `json:"kid"`
+	// Private is the PEM encoded private key. It is only stored in plaintext
+	// by versions that predate EncryptedPrivate.
+	Private          string        `json:"private,omitempty"`
+	EncryptedPrivate *kek.Envelope `json:"encrypted_private,omitempty"`
+	Public           string        `json:"public"`
+	Algorithm        string        `json:"alg"`
+	State            string        `json:"state,omitempty"`
+	// Active is only set on keys stored before keys had states.
+	Active bool `json:"active,omitempty"`
+
+	CreatedAt  time.Time `json:"created_at"`
+	ActivateAt time.Time `json:"activate_at"`
+	RetireAt   time.Time `json:"retire_at"`
 }
 
 func FromEntity(entity keyentity.Key) (Key, error) {
-	privateKey, ok := entity.Private.(*rsa.PrivateKey)
-	if !ok {
-		return Key{}, errors.New("private key is not RSA")
-	}
-
-	privatePEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
-	})
+	// Retired keys never sign again, so their private half is not kept.
+	var privatePEM []byte
+	if entity.Private != nil {
+		block, err := encodePrivateKey(entity.Private)
+		if err != nil {
+			return Key{}, err
+		}
 
-	publicKey, ok := entity.Public.(*rsa.PublicKey)
-	if !ok {
-		return Key{}, errors.New("public key is not RSA")
+		privatePEM = pem.EncodeToMemory(block)
 	}
 
-	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	publicDER, err := x509.MarshalPKIXPublicKey(entity.Public)
 	if err != nil {
 		return Key{}, err
 	}
 
 	publicPEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "PUBLIC KEY",
+		Type:  PEMTypePublicKey,
 		Bytes: publicDER,
 	})
 
 	return Key{
-		ID:        entity.ID,
-		Private:   string(privatePEM),
-		Public:    string(publicPEM),
-		Algorithm: entity.Algorithm,
-		Active:    entity.Active,
+		ID:         entity.ID,
+		Private:    string(privatePEM),
+		Public:     string(publicPEM),
+		Algorithm:  entity.Algorithm,
+		State:      entity.State.String(),
+		CreatedAt:  entity.CreatedAt,
+		ActivateAt: entity.ActivateAt,
+		RetireAt:   entity.RetireAt,
 	}, nil
 }
 
 func (k Key) ToEntity() (keyentity.Key, error) {
+	key, err := k.ToPublicEntity()
+	if err != nil {
+		return keyentity.Key{}, err
+	}
+
+	if k.Private == "" {
+		return key, nil
+	}
+
 	privateBlock, _ := pem.Decode([]byte(k.Private))
 	if privateBlock == nil {
 		return keyentity.Key{}, errors.New("failed to decode private key PEM")
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(privateBlock.Bytes)
+	privateKey, err := decodePrivateKey(privateBlock)
 	if err != nil {
 		return keyentity.Key{}, err
 	}
 
-	publicBlock, _ := pem.Decode([]byte(k.Public))
-	if publicBlock == nil {
-		return keyentity.Key{}, err
-	}
+	key.Private = privateKey
+
+	return key, nil
+}
 
-	publicKey, err := x509.ParsePKIXPublicKey(publicBlock.Bytes)
+func (k Key) ToPublicEntity() (keyentity.Key, error) {
+	publicKey, err := k.parsePublicKey()
 	if err != nil {
 		return keyentity.Key{}, err
 	}
 
 	return keyentity.Key{
-		ID:        k.ID,
-		Private:   privateKey,
-		Public:    publicKey,
-		Algorithm: k.Algorithm,
-		Active:    k.Active,
+		ID:         k.ID,
+		Public:     publicKey,
+		Algorithm:  k.Algorithm,
+		State:      k.state(),
+		CreatedAt:  k.CreatedAt,
+		ActivateAt: k.ActivateAt,
+		RetireAt:   k.RetireAt,
 	}, nil
 }
+
+// state maps keys stored before keys had states: active ones were published
+// and are left for the next rotation to demote, inactive ones were not.
+func (k Key) state() keyentity.State {
+	if k.State != "" {
+		return keyentity.State(k.State)
+	}
+
+	if k.Active {
+		return keyentity.StateActive
+	}
+
+	return keyentity.StateRetired
+}
+
+func (k Key) parsePublicKey() (crypto.PublicKey, error) {
+	publicBlock, _ := pem.Decode([]byte(k.Public))
+	if publicBlock == nil {
+		return nil, errors.New("failed to decode public key PEM")
+	}
+
+	return x509.ParsePKIXPublicKey(publicBlock.Bytes)
+}
+
+// encodePrivateKey keeps RSA keys in PKCS #1, which is how they were stored
+// before other key types were supported, and uses PKCS #8 for the rest.
+func encodePrivateKey(privateKey crypto.PrivateKey) (*pem.Block, error) {
+	switch privateKey := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return &pem.Block{
+			Type:  PEMTypeRSAPrivateKey,
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		}, nil
+	case *ecdsa.PrivateKey, ed25519.PrivateKey:
+		der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pem.Block{
+			Type:  PEMTypePrivateKey,
+			Bytes: der,
+		}, nil
+	default:
+		return nil, errors.New("unsupported private key type")
+	}
+}
+
+func decodePrivateKey(block *pem.Block) (crypto.PrivateKey, error) {
+	switch block.Type {
+	case PEMTypeRSAPrivateKey:
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case PEMTypePrivateKey:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, errors.New("unsupported private key PEM type")
+	}
+}
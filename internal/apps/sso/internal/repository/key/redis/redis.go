@@ -2,51 +2,111 @@ package redis
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
 	"encoding/json"
-	"time"
 
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
 	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
 	keymodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/models/key"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+	"github.com/pkg/errors"
 	redis "github.com/redis/go-redis/v9"
-	"go.uber.org/multierr"
 )
 
+const (
+	KeyPrefix = "jwks:key:"
+	// ActiveKey holds the id of the key that signs new tokens.
+	ActiveKey = "jwks:active"
+	// PublicSet holds the ids of the keys published in the JWKS.
+	PublicSet = "jwks:public"
+	// KeySet holds the ids of every stored key, retired ones included.
+	KeySet = "jwks:keys"
+)
+
+// compareAndSetScript overwrites a key only if it still holds the value it
+// was read with, so re-encryption cannot undo a concurrent state change.
+var compareAndSetScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
 var _ keyrepo.IRepository = (*Repository)(nil)
 
 type Repository struct {
-	rdb redis.Cmdable
+	rdb         redis.Cmdable
+	kekProvider kek.Provider
 }
 
-func New(ctx context.Context, rdb redis.Cmdable) (*Repository, error) {
+func New(ctx context.Context, rdb redis.Cmdable, kekProvider kek.Provider) (*Repository, error) {
 	return &Repository{
-		rdb: rdb,
+		rdb:         rdb,
+		kekProvider: kekProvider,
 	}, nil
 }
 
 func (r *Repository) GetCurrentSigningKey(ctx context.Context) (keyentity.Key, error) {
-	kid, err := r.rdb.Get(ctx, "jwks:active").Result()
+	kid, err := r.rdb.Get(ctx, ActiveKey).Result()
 	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return keyentity.Key{}, keyrepo.ErrKeyNotFound
+		}
 		return keyentity.Key{}, err
 	}
 
-	data, err := r.rdb.Get(ctx, "jwks:key:"+kid).Bytes()
+	return r.Get(ctx, kid)
+}
+
+func (r *Repository) GetPublicKeys(ctx context.Context) ([]keyentity.Key, error) {
+	kids, err := r.rdb.SMembers(ctx, PublicSet).Result()
 	if err != nil {
-		return keyentity.Key{}, err
+		return nil, err
 	}
 
-	var model keymodel.Key
-	if err := json.Unmarshal(data, &model); err != nil {
+	keys := make([]keyentity.Key, 0, len(kids))
+
+	for _, kid := range kids {
+		model, err := r.get(ctx, kid)
+		if err != nil {
+			continue
+		}
+
+		key, err := model.ToPublicEntity()
+		if err != nil {
+			continue
+		}
+
+		if !key.Published() {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) Get(ctx context.Context, kid string) (keyentity.Key, error) {
+	model, err := r.get(ctx, kid)
+	if err != nil {
 		return keyentity.Key{}, err
 	}
 
+	if model.EncryptedPrivate != nil {
+		privatePEM, err := kek.Open(ctx, r.kekProvider, *model.EncryptedPrivate, []byte(model.ID))
+		if err != nil {
+			return keyentity.Key{}, err
+		}
+
+		model.Private = string(privatePEM)
+	}
+
 	return model.ToEntity()
 }
 
-func (r *Repository) GetPublicKeys(ctx context.Context) ([]keyentity.Key, error) {
-	kids, err := r.rdb.SMembers(ctx, "jwks:public").Result()
+func (r *Repository) List(ctx context.Context) ([]keyentity.Key, error) {
+	kids, err := r.ids(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -54,77 +114,206 @@ func (r *Repository) GetPublicKeys(ctx context.Context) ([]keyentity.Key, error)
 	keys := make([]keyentity.Key, 0, len(kids))
 
 	for _, kid := range kids {
-		data, err := r.rdb.Get(ctx, "jwks:key:"+kid).Bytes()
+		model, err := r.get(ctx, kid)
 		if err != nil {
-			continue
+			if errors.Is(err, keyrepo.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		key, err := model.ToPublicEntity()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Save stores the keys. The keys live in different slots, and Redis Cluster
+// refuses transactions across slots, so they are written in an order that
+// leaves a consistent state if SSO stops halfway: the keys first, then the
+// sets, then the active key, and only then are keys unpublished. A key is
+// thus published before it signs and stays published until it no longer
+// does. Saving the keys again completes an interrupted save.
+func (r *Repository) Save(ctx context.Context, keys ...keyentity.Key) error {
+	for _, key := range keys {
+		model, err := keymodel.FromEntity(key)
+		if err != nil {
+			return err
+		}
+
+		if err := r.seal(ctx, &model); err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(model)
+		if err != nil {
+			return err
+		}
+
+		if err := r.rdb.Set(ctx, KeyPrefix+key.ID, data, 0).Err(); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		if err := r.rdb.SAdd(ctx, KeySet, key.ID).Err(); err != nil {
+			return err
+		}
+
+		if key.Published() {
+			if err := r.rdb.SAdd(ctx, PublicSet, key.ID).Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if key.State == keyentity.StateActive {
+			if err := r.rdb.Set(ctx, ActiveKey, key.ID, 0).Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if !key.Published() {
+			if err := r.rdb.SRem(ctx, PublicSet, key.ID).Err(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ReEncrypt(ctx context.Context) (int, error) {
+	current, err := r.kekProvider.CurrentKeyID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	kids, err := r.ids(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rewritten := 0
+
+	for _, kid := range kids {
+		data, err := r.rdb.Get(ctx, KeyPrefix+kid).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			return rewritten, err
 		}
 
 		var model keymodel.Key
 		if err := json.Unmarshal(data, &model); err != nil {
-			continue
+			return rewritten, err
 		}
 
-		if !model.Active {
+		switch {
+		case model.EncryptedPrivate != nil && model.EncryptedPrivate.KeyID != current:
+			envelope, err := kek.Rewrap(ctx, r.kekProvider, *model.EncryptedPrivate)
+			if err != nil {
+				return rewritten, err
+			}
+
+			model.EncryptedPrivate = &envelope
+		case model.Private != "":
+			if err := r.seal(ctx, &model); err != nil {
+				return rewritten, err
+			}
+		default:
 			continue
 		}
 
-		key, err := model.ToEntity()
+		updated, err := json.Marshal(model)
 		if err != nil {
+			return rewritten, err
+		}
+
+		ok, err := compareAndSetScript.Run(ctx, r.rdb, []string{KeyPrefix + kid}, data, updated).Bool()
+		if err != nil {
+			return rewritten, err
+		}
+		if !ok {
+			// The key was saved in the meantime, and Save always uses the
+			// current KEK.
 			continue
 		}
 
-		keys = append(keys, key)
+		rewritten++
 	}
 
-	return keys, nil
+	return rewritten, nil
 }
 
-// TODO: Create a k8s job to rotate the key every week
-func (r *Repository) Rotate(ctx context.Context) (keyentity.Key, error) {
-	privateKey, err := r.generateRSAKey()
+// seal moves the plaintext private key of model into an envelope.
+func (r *Repository) seal(ctx context.Context, model *keymodel.Key) error {
+	if model.Private == "" {
+		return nil
+	}
+
+	envelope, err := kek.Seal(ctx, r.kekProvider, []byte(model.Private), []byte(model.ID))
 	if err != nil {
-		return keyentity.Key{}, err
+		return err
 	}
 
-	kid := time.Now().UTC().Format("20060102T150405")
+	model.Private = ""
+	model.EncryptedPrivate = &envelope
 
-	key := keyentity.Key{
-		ID:        kid,
-		Private:   privateKey,
-		Public:    privateKey.Public(),
-		Algorithm: "RS256",
-		Active:    true,
-	}
+	return nil
+}
 
-	model, err := keymodel.FromEntity(key)
+// ids returns the ids of every stored key. KeySet and PublicSet hash to
+// different slots, and Redis Cluster refuses SUNION across slots, so the sets
+// are read one at a time.
+func (r *Repository) ids(ctx context.Context) ([]string, error) {
+	kids, err := r.rdb.SMembers(ctx, KeySet).Result()
 	if err != nil {
-		return keyentity.Key{}, err
+		return nil, err
 	}
 
-	data, err := json.Marshal(model)
+	// Keys stored before KeySet existed are only found in PublicSet.
+	public, err := r.rdb.SMembers(ctx, PublicSet).Result()
 	if err != nil {
-		return keyentity.Key{}, err
+		return nil, err
 	}
 
-	pipe := r.rdb.TxPipeline()
+	seen := make(map[string]struct{}, len(kids))
+	for _, kid := range kids {
+		seen[kid] = struct{}{}
+	}
 
-	err = multierr.Combine(
-		pipe.Set(ctx, "jwks:key:"+kid, data, 0).Err(),
-		pipe.Set(ctx, "jwks:active", kid, 0).Err(),
-		pipe.SAdd(ctx, "jwks:public", kid).Err(),
-	)
-	if err != nil {
-		return keyentity.Key{}, err
+	for _, kid := range public {
+		if _, ok := seen[kid]; !ok {
+			kids = append(kids, kid)
+		}
 	}
 
-	_, err = pipe.Exec(ctx)
+	return kids, nil
+}
+
+func (r *Repository) get(ctx context.Context, kid string) (keymodel.Key, error) {
+	data, err := r.rdb.Get(ctx, KeyPrefix+kid).Bytes()
 	if err != nil {
-		return keyentity.Key{}, err
+		if errors.Is(err, redis.Nil) {
+			return keymodel.Key{}, keyrepo.ErrKeyNotFound
+		}
+		return keymodel.Key{}, err
 	}
 
-	return key, nil
-}
+	var model keymodel.Key
+	if err := json.Unmarshal(data, &model); err != nil {
+		return keymodel.Key{}, err
+	}
 
-func (r *Repository) generateRSAKey() (*rsa.PrivateKey, error) {
-	return rsa.GenerateKey(rand.Reader, 2048)
+	return model, nil
 }
@@ -2,6 +2,11 @@ package redis
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/json"
 	"net/url"
 	"strings"
@@ -9,6 +14,9 @@ import (
 	"time"
 
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
+	keymodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/models/key"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
 	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
@@ -23,9 +31,20 @@ type RepositoryTestSuite struct {
 	rdb        *redis.Client
 	container  *rediscontainer.RedisContainer
 	repository *Repository
+	kekKeys    []kek.Key
 	ctx        context.Context
 }
 
+func newKEK(t *testing.T, id string) kek.Key {
+	t.Helper()
+
+	secret := make([]byte, kek.KeySize)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	return kek.Key{ID: id, Secret: secret}
+}
+
 func (s *RepositoryTestSuite) SetupSuite() {
 	ctx := context.Background()
 	s.ctx = ctx
@@ -48,7 +67,12 @@ func (s *RepositoryTestSuite) SetupSuite() {
 		Addr: address,
 	})
 
-	repository, err := New(ctx, s.rdb)
+	s.kekKeys = []kek.Key{newKEK(s.T(), "kek-1")}
+
+	kekProvider, err := kek.NewStatic(s.kekKeys...)
+	require.NoError(s.T(), err)
+
+	repository, err := New(ctx, s.rdb, kekProvider)
 	require.NoError(s.T(), err, "failed to create repository")
 
 	s.repository = repository
@@ -90,84 +114,181 @@ func (s *RepositoryTestSuite) cleanupKeys(ctx context.Context) {
 	}
 }
 
-func (s *RepositoryTestSuite) TestRotate() {
-	s.Run("should rotate key successfully", func() {
-		key, err := s.repository.Rotate(s.ctx)
+func (s *RepositoryTestSuite) newKey(kid string, state keyentity.State) keyentity.Key {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(s.T(), err)
+
+	now := time.Now().Truncate(time.Second)
+
+	return keyentity.Key{
+		ID:         kid,
+		Private:    privateKey,
+		Public:     privateKey.Public(),
+		Algorithm:  "RS256",
+		State:      state,
+		CreatedAt:  now,
+		ActivateAt: now,
+	}
+}
+
+func (s *RepositoryTestSuite) TestSave() {
+	s.Run("should save active key successfully", func() {
+		key := s.newKey("key-1", keyentity.StateActive)
+
+		err := s.repository.Save(s.ctx, key)
 		require.NoError(s.T(), err)
-		assert.NotEmpty(s.T(), key.ID)
-		assert.NotNil(s.T(), key.Private)
-		assert.NotNil(s.T(), key.Public)
-		assert.Equal(s.T(), "RS256", key.Algorithm)
-		assert.True(s.T(), key.Active)
 
-		// Verify key is stored in Redis
-		activeKid, err := s.rdb.Get(s.ctx, "jwks:active").Result()
+		activeKid, err := s.rdb.Get(s.ctx, ActiveKey).Result()
 		require.NoError(s.T(), err)
 		assert.Equal(s.T(), key.ID, activeKid)
 
-		// Verify key data is stored
-		data, err := s.rdb.Get(s.ctx, "jwks:key:"+key.ID).Bytes()
+		data, err := s.rdb.Get(s.ctx, KeyPrefix+key.ID).Bytes()
 		require.NoError(s.T(), err)
 		assert.NotEmpty(s.T(), data)
 
-		// Verify key is in public set
-		isMember, err := s.rdb.SIsMember(s.ctx, "jwks:public", key.ID).Result()
+		isMember, err := s.rdb.SIsMember(s.ctx, PublicSet, key.ID).Result()
+		require.NoError(s.T(), err)
+		assert.True(s.T(), isMember)
+
+		isMember, err = s.rdb.SIsMember(s.ctx, KeySet, key.ID).Result()
 		require.NoError(s.T(), err)
 		assert.True(s.T(), isMember)
 	})
 
-	s.Run("should rotate key multiple times", func() {
+	s.Run("should publish pending key without activating it", func() {
 		s.cleanupKeys(s.ctx)
-		key1, err := s.repository.Rotate(s.ctx)
+
+		active := s.newKey("key-1", keyentity.StateActive)
+		pending := s.newKey("key-2", keyentity.StatePending)
+
+		err := s.repository.Save(s.ctx, active, pending)
+		require.NoError(s.T(), err)
+
+		activeKid, err := s.rdb.Get(s.ctx, ActiveKey).Result()
 		require.NoError(s.T(), err)
+		assert.Equal(s.T(), active.ID, activeKid)
 
-		time.Sleep(time.Second)
-		key2, err := s.repository.Rotate(s.ctx)
+		isMember, err := s.rdb.SIsMember(s.ctx, PublicSet, pending.ID).Result()
 		require.NoError(s.T(), err)
+		assert.True(s.T(), isMember)
+	})
+
+	s.Run("should unpublish retired key and drop its private half", func() {
+		s.cleanupKeys(s.ctx)
 
-		assert.NotEqual(s.T(), key1.ID, key2.ID)
+		key := s.newKey("key-1", keyentity.StateVerificationOnly)
+		err := s.repository.Save(s.ctx, key)
+		require.NoError(s.T(), err)
 
-		// Verify latest key is active
-		activeKid, err := s.rdb.Get(s.ctx, "jwks:active").Result()
+		key.State = keyentity.StateRetired
+		key.Private = nil
+		err = s.repository.Save(s.ctx, key)
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), key2.ID, activeKid)
 
-		// Verify both keys are in public set
-		isMember1, err := s.rdb.SIsMember(s.ctx, "jwks:public", key1.ID).Result()
+		isMember, err := s.rdb.SIsMember(s.ctx, PublicSet, key.ID).Result()
 		require.NoError(s.T(), err)
-		assert.True(s.T(), isMember1)
+		assert.False(s.T(), isMember)
 
-		isMember2, err := s.rdb.SIsMember(s.ctx, "jwks:public", key2.ID).Result()
+		stored, err := s.repository.Get(s.ctx, key.ID)
 		require.NoError(s.T(), err)
-		assert.True(s.T(), isMember2)
+		assert.Equal(s.T(), keyentity.StateRetired, stored.State)
+		assert.Nil(s.T(), stored.Private)
+		assert.NotNil(s.T(), stored.Public)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGet() {
+	s.Run("should get key with private half", func() {
+		key := s.newKey("key-1", keyentity.StatePending)
+		key.ActivateAt = key.CreatedAt.Add(time.Hour)
+
+		err := s.repository.Save(s.ctx, key)
+		require.NoError(s.T(), err)
+
+		stored, err := s.repository.Get(s.ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.ID, stored.ID)
+		assert.Equal(s.T(), keyentity.StatePending, stored.State)
+		assert.True(s.T(), key.ActivateAt.Equal(stored.ActivateAt))
+		assert.NotNil(s.T(), stored.Private)
+	})
+
+	s.Run("should round-trip ES256 and EdDSA keys", func() {
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(s.T(), err)
+
+		ed25519Public, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(s.T(), err)
+
+		keys := []keyentity.Key{
+			{ID: "key-es256", Private: ecdsaKey, Public: ecdsaKey.Public(), Algorithm: keyentity.AlgorithmES256, State: keyentity.StatePending},
+			{ID: "key-eddsa", Private: ed25519Key, Public: ed25519Public, Algorithm: keyentity.AlgorithmEdDSA, State: keyentity.StatePending},
+		}
+
+		err = s.repository.Save(s.ctx, keys...)
+		require.NoError(s.T(), err)
+
+		for _, key := range keys {
+			stored, err := s.repository.Get(s.ctx, key.ID)
+			require.NoError(s.T(), err)
+			assert.Equal(s.T(), key.Algorithm, stored.Algorithm)
+			assert.Equal(s.T(), key.Private, stored.Private)
+			assert.Equal(s.T(), key.Public, stored.Public)
+		}
+	})
+
+	s.Run("should return ErrKeyNotFound for unknown key", func() {
+		_, err := s.repository.Get(s.ctx, "missing-key-id")
+		assert.ErrorIs(s.T(), err, keyrepo.ErrKeyNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestList() {
+	s.Run("should list keys in every state without private halves", func() {
+		s.cleanupKeys(s.ctx)
+
+		err := s.repository.Save(s.ctx,
+			s.newKey("key-1", keyentity.StateRetired),
+			s.newKey("key-2", keyentity.StateVerificationOnly),
+			s.newKey("key-3", keyentity.StateActive),
+			s.newKey("key-4", keyentity.StatePending),
+		)
+		require.NoError(s.T(), err)
+
+		keys, err := s.repository.List(s.ctx)
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), keys, 4)
+
+		for _, k := range keys {
+			assert.Nil(s.T(), k.Private)
+			assert.NotNil(s.T(), k.Public)
+		}
 	})
 }
 
 func (s *RepositoryTestSuite) TestGetCurrentSigningKey() {
 	s.Run("should get current signing key successfully", func() {
-		// First rotate to create a key
-		rotatedKey, err := s.repository.Rotate(s.ctx)
+		saved := s.newKey("key-1", keyentity.StateActive)
+		err := s.repository.Save(s.ctx, saved)
 		require.NoError(s.T(), err)
 
-		// Get the current signing key
 		key, err := s.repository.GetCurrentSigningKey(s.ctx)
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), rotatedKey.ID, key.ID)
-		assert.Equal(s.T(), rotatedKey.Algorithm, key.Algorithm)
-		assert.True(s.T(), key.Active)
+		assert.Equal(s.T(), saved.ID, key.ID)
+		assert.Equal(s.T(), saved.Algorithm, key.Algorithm)
+		assert.Equal(s.T(), keyentity.StateActive, key.State)
 		assert.NotNil(s.T(), key.Private)
 		assert.NotNil(s.T(), key.Public)
 	})
 
-	s.Run("should return error when no active key exists", func() {
+	s.Run("should return ErrKeyNotFound when no active key exists", func() {
 		s.cleanupKeys(s.ctx)
 		_, err := s.repository.GetCurrentSigningKey(s.ctx)
-		assert.Error(s.T(), err)
+		assert.ErrorIs(s.T(), err, keyrepo.ErrKeyNotFound)
 	})
 
 	s.Run("should return error when active key ID exists but key data is missing", func() {
-		// Set active key ID but don't set the key data
-		err := s.rdb.Set(s.ctx, "jwks:active", "missing-key-id", 0).Err()
+		err := s.rdb.Set(s.ctx, ActiveKey, "missing-key-id", 0).Err()
 		require.NoError(s.T(), err)
 
 		_, err = s.repository.GetCurrentSigningKey(s.ctx)
@@ -176,31 +297,32 @@ func (s *RepositoryTestSuite) TestGetCurrentSigningKey() {
 }
 
 func (s *RepositoryTestSuite) TestGetPublicKeys() {
-	s.Run("should get public keys successfully", func() {
+	s.Run("should get published keys successfully", func() {
 		s.cleanupKeys(s.ctx)
-		// Rotate multiple keys
-		key1, err := s.repository.Rotate(s.ctx)
-		require.NoError(s.T(), err)
 
-		time.Sleep(time.Second)
-		key2, err := s.repository.Rotate(s.ctx)
+		active := s.newKey("key-1", keyentity.StateActive)
+		pending := s.newKey("key-2", keyentity.StatePending)
+		previous := s.newKey("key-3", keyentity.StateVerificationOnly)
+		retired := s.newKey("key-4", keyentity.StateRetired)
+
+		err := s.repository.Save(s.ctx, active, pending, previous, retired)
 		require.NoError(s.T(), err)
 
-		// Get public keys
 		keys, err := s.repository.GetPublicKeys(s.ctx)
 		require.NoError(s.T(), err)
-		assert.Len(s.T(), keys, 2)
+		assert.Len(s.T(), keys, 3)
 
 		keyIDs := make(map[string]bool)
 		for _, k := range keys {
 			keyIDs[k.ID] = true
-			assert.True(s.T(), k.Active)
 			assert.Equal(s.T(), "RS256", k.Algorithm)
 			assert.NotNil(s.T(), k.Public)
+			assert.Nil(s.T(), k.Private)
 		}
 
-		assert.True(s.T(), keyIDs[key1.ID])
-		assert.True(s.T(), keyIDs[key2.ID])
+		assert.True(s.T(), keyIDs[active.ID])
+		assert.True(s.T(), keyIDs[pending.ID])
+		assert.True(s.T(), keyIDs[previous.ID])
 	})
 
 	s.Run("should return empty list when no public keys exist", func() {
@@ -210,48 +332,202 @@ func (s *RepositoryTestSuite) TestGetPublicKeys() {
 		assert.Empty(s.T(), keys)
 	})
 
-	s.Run("should only return active keys", func() {
+	s.Run("should read keys stored with the legacy active flag", func() {
 		s.cleanupKeys(s.ctx)
-		// Rotate a key
-		key, err := s.repository.Rotate(s.ctx)
+
+		key := s.newKey("key-1", keyentity.StateActive)
+		err := s.repository.Save(s.ctx, key)
 		require.NoError(s.T(), err)
 
-		// Manually add an inactive key to the public set
-		// Get existing key and modify it to be inactive
-		var existingKey keyentity.Key
-		data, err := s.rdb.Get(s.ctx, "jwks:key:"+key.ID).Bytes()
+		// Store a copy of the key the way older versions stored it
+		var legacy map[string]any
+		data, err := s.rdb.Get(s.ctx, KeyPrefix+key.ID).Bytes()
 		require.NoError(s.T(), err)
-		err = json.Unmarshal(data, &existingKey)
+		err = json.Unmarshal(data, &legacy)
 		require.NoError(s.T(), err)
-		existingKey.Active = false
-		existingKey.ID = "inactive-key-id"
-		// Marshal the inactive key
-		inactiveData, err := json.Marshal(existingKey)
+		delete(legacy, "state")
+		legacy["kid"] = "legacy-inactive-key"
+		legacy["active"] = false
+		legacyData, err := json.Marshal(legacy)
 		require.NoError(s.T(), err)
-		_ = s.rdb.Set(s.ctx, "jwks:key:inactive-key-id", inactiveData, 0).Err()
-		_ = s.rdb.SAdd(s.ctx, "jwks:public", "inactive-key-id").Err()
+		_ = s.rdb.Set(s.ctx, KeyPrefix+"legacy-inactive-key", legacyData, 0).Err()
+		_ = s.rdb.SAdd(s.ctx, PublicSet, "legacy-inactive-key").Err()
 
-		// Get public keys - should only return active ones
 		keys, err := s.repository.GetPublicKeys(s.ctx)
 		require.NoError(s.T(), err)
 		assert.Len(s.T(), keys, 1)
 		assert.Equal(s.T(), key.ID, keys[0].ID)
-		assert.True(s.T(), keys[0].Active)
+		assert.Equal(s.T(), keyentity.StateActive, keys[0].State)
 	})
 
 	s.Run("should skip keys with invalid JSON", func() {
 		s.cleanupKeys(s.ctx)
-		// Add invalid JSON to a key
-		_ = s.rdb.Set(s.ctx, "jwks:key:invalid-json", "invalid json data", 0).Err()
-		_ = s.rdb.SAdd(s.ctx, "jwks:public", "invalid-json").Err()
+		_ = s.rdb.Set(s.ctx, KeyPrefix+"invalid-json", "invalid json data", 0).Err()
+		_ = s.rdb.SAdd(s.ctx, PublicSet, "invalid-json").Err()
 
-		// Get public keys - should skip invalid JSON
 		keys, err := s.repository.GetPublicKeys(s.ctx)
 		require.NoError(s.T(), err)
 		assert.Empty(s.T(), keys)
 	})
 }
 
+func (s *RepositoryTestSuite) TestEncryption() {
+	s.Run("should not store private keys in plaintext", func() {
+		s.cleanupKeys(s.ctx)
+
+		key := s.newKey("key-1", keyentity.StateActive)
+		err := s.repository.Save(s.ctx, key)
+		require.NoError(s.T(), err)
+
+		var model keymodel.Key
+		data, err := s.rdb.Get(s.ctx, KeyPrefix+key.ID).Bytes()
+		require.NoError(s.T(), err)
+		err = json.Unmarshal(data, &model)
+		require.NoError(s.T(), err)
+
+		assert.Empty(s.T(), model.Private)
+		require.NotNil(s.T(), model.EncryptedPrivate)
+		assert.Equal(s.T(), "kek-1", model.EncryptedPrivate.KeyID)
+		assert.NotContains(s.T(), string(data), "PRIVATE KEY")
+
+		stored, err := s.repository.GetCurrentSigningKey(s.ctx)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.Private, stored.Private)
+	})
+
+	s.Run("should re-encrypt keys under the new kek", func() {
+		s.cleanupKeys(s.ctx)
+
+		key := s.newKey("key-1", keyentity.StateActive)
+		err := s.repository.Save(s.ctx, key)
+		require.NoError(s.T(), err)
+
+		rotated, err := kek.NewStatic(append([]kek.Key{newKEK(s.T(), "kek-2")}, s.kekKeys...)...)
+		require.NoError(s.T(), err)
+
+		repository, err := New(s.ctx, s.rdb, rotated)
+		require.NoError(s.T(), err)
+
+		count, err := repository.ReEncrypt(s.ctx)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 1, count)
+
+		count, err = repository.ReEncrypt(s.ctx)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 0, count)
+
+		_, err = s.repository.Get(s.ctx, key.ID)
+		assert.ErrorIs(s.T(), err, kek.ErrUnknownKey)
+
+		stored, err := repository.Get(s.ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.Private, stored.Private)
+	})
+
+	s.Run("should encrypt keys stored in plaintext", func() {
+		s.cleanupKeys(s.ctx)
+
+		key := s.newKey("key-1", keyentity.StateActive)
+
+		model, err := keymodel.FromEntity(key)
+		require.NoError(s.T(), err)
+		data, err := json.Marshal(model)
+		require.NoError(s.T(), err)
+		_ = s.rdb.Set(s.ctx, KeyPrefix+key.ID, data, 0).Err()
+		_ = s.rdb.SAdd(s.ctx, PublicSet, key.ID).Err()
+
+		stored, err := s.repository.Get(s.ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.Private, stored.Private)
+
+		count, err := s.repository.ReEncrypt(s.ctx)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), 1, count)
+
+		data, err = s.rdb.Get(s.ctx, KeyPrefix+key.ID).Bytes()
+		require.NoError(s.T(), err)
+		assert.NotContains(s.T(), string(data), "PRIVATE KEY")
+
+		stored, err = s.repository.Get(s.ctx, key.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), key.Private, stored.Private)
+	})
+}
+
+func TestRepository_Cluster(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := rediscontainer.SetupTestClusterContainer(ctx)
+	require.NoError(t, err, "failed to setup test container")
+	defer func() { _ = container.Terminate(ctx) }()
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+
+	// The node announces its address inside the container network, so every
+	// slot is routed to the mapped port instead.
+	rdb := redis.NewClusterClient(&redis.ClusterOptions{
+		ClusterSlots: func(context.Context) ([]redis.ClusterSlot, error) {
+			return []redis.ClusterSlot{
+				{Start: 0, End: 16383, Nodes: []redis.ClusterNode{{Addr: address}}},
+			}, nil
+		},
+	})
+	defer func() { _ = rdb.Close() }()
+
+	kekKeys := []kek.Key{newKEK(t, "kek-1")}
+
+	kekProvider, err := kek.NewStatic(kekKeys...)
+	require.NoError(t, err)
+
+	repository, err := New(ctx, rdb, kekProvider)
+	require.NoError(t, err)
+
+	var keys []keyentity.Key
+	for _, state := range []keyentity.State{keyentity.StateActive, keyentity.StatePending} {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		keys = append(keys, keyentity.Key{
+			ID:        "key-" + string(state),
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: keyentity.AlgorithmES256,
+			State:     state,
+		})
+	}
+
+	err = repository.Save(ctx, keys...)
+	require.NoError(t, err)
+
+	t.Run("should save keys", func(t *testing.T) {
+		current, err := repository.GetCurrentSigningKey(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "key-"+string(keyentity.StateActive), current.ID)
+
+		public, err := repository.GetPublicKeys(ctx)
+		require.NoError(t, err)
+		assert.Len(t, public, len(keys))
+	})
+
+	t.Run("should list keys", func(t *testing.T) {
+		listed, err := repository.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, listed, len(keys))
+	})
+
+	t.Run("should re-encrypt keys", func(t *testing.T) {
+		rotated, err := kek.NewStatic(append([]kek.Key{newKEK(t, "kek-2")}, kekKeys...)...)
+		require.NoError(t, err)
+
+		repository, err := New(ctx, rdb, rotated)
+		require.NoError(t, err)
+
+		count, err := repository.ReEncrypt(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, len(keys), count)
+	})
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
@@ -4,10 +4,25 @@ import (
 	"context"
 
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrKeyNotFound = errors.New("key not found")
 )
 
 type IRepository interface {
 	GetCurrentSigningKey(ctx context.Context) (keyentity.Key, error)
 	GetPublicKeys(ctx context.Context) ([]keyentity.Key, error)
-	Rotate(ctx context.Context) (keyentity.Key, error)
+	Get(ctx context.Context, kid string) (keyentity.Key, error)
+	// List returns every stored key, retired ones included, without private
+	// key material.
+	List(ctx context.Context) ([]keyentity.Key, error)
+	// Save stores keys. The JWKS and the current signing key are updated to
+	// match the states of the saved keys. A save that failed halfway is
+	// completed by saving the keys again.
+	Save(ctx context.Context, keys ...keyentity.Key) error
+	// ReEncrypt re-wraps the private key of every stored key under the
+	// current key-encryption key and reports how many keys were rewritten.
+	ReEncrypt(ctx context.Context) (int, error)
 }
@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	lockrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "lock:"
+)
+
+var (
+	acquireScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+var _ lockrepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Acquire(ctx context.Context, name, owner string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireScript.Run(ctx, r.rdb, []string{KeyPrefix + name}, owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return acquired == 1, nil
+}
+
+func (r *Repository) Release(ctx context.Context, name, owner string) error {
+	return releaseScript.Run(ctx, r.rdb, []string{KeyPrefix + name}, owner).Err()
+}
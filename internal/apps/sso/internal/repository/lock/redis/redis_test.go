@@ -0,0 +1,137 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func (s *RepositoryTestSuite) TestAcquire() {
+	s.Run("should acquire free lock", func() {
+		acquired, err := s.repository.Acquire(s.ctx, "acquire-free", "owner-1", time.Minute)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), acquired)
+
+		ttl, err := s.rdb.PTTL(s.ctx, KeyPrefix+"acquire-free").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+	})
+
+	s.Run("should not acquire lock held by another owner", func() {
+		acquired, err := s.repository.Acquire(s.ctx, "acquire-held", "owner-1", time.Minute)
+		require.NoError(s.T(), err)
+		require.True(s.T(), acquired)
+
+		acquired, err = s.repository.Acquire(s.ctx, "acquire-held", "owner-2", time.Minute)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), acquired)
+	})
+
+	s.Run("should extend lock held by the same owner", func() {
+		acquired, err := s.repository.Acquire(s.ctx, "acquire-extend", "owner-1", time.Second)
+		require.NoError(s.T(), err)
+		require.True(s.T(), acquired)
+
+		acquired, err = s.repository.Acquire(s.ctx, "acquire-extend", "owner-1", time.Minute)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), acquired)
+
+		ttl, err := s.rdb.PTTL(s.ctx, KeyPrefix+"acquire-extend").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Second)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRelease() {
+	s.Run("should release lock held by owner", func() {
+		acquired, err := s.repository.Acquire(s.ctx, "release-own", "owner-1", time.Minute)
+		require.NoError(s.T(), err)
+		require.True(s.T(), acquired)
+
+		err = s.repository.Release(s.ctx, "release-own", "owner-1")
+		require.NoError(s.T(), err)
+
+		acquired, err = s.repository.Acquire(s.ctx, "release-own", "owner-2", time.Minute)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), acquired)
+	})
+
+	s.Run("should not release lock held by another owner", func() {
+		acquired, err := s.repository.Acquire(s.ctx, "release-other", "owner-1", time.Minute)
+		require.NoError(s.T(), err)
+		require.True(s.T(), acquired)
+
+		err = s.repository.Release(s.ctx, "release-other", "owner-2")
+		require.NoError(s.T(), err)
+
+		acquired, err = s.repository.Acquire(s.ctx, "release-other", "owner-2", time.Minute)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), acquired)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
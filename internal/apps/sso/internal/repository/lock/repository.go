@@ -0,0 +1,15 @@
+package lock
+
+import (
+	"context"
+	"time"
+)
+
+type IRepository interface {
+	// Acquire takes the named lock for owner, or extends it when owner already
+	// holds it. It reports false when the lock is held by someone else. The
+	// lock is released automatically after ttl.
+	Acquire(ctx context.Context, name, owner string, ttl time.Duration) (bool, error)
+	// Release gives up the named lock if owner holds it.
+	Release(ctx context.Context, name, owner string) error
+}
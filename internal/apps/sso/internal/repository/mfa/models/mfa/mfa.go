@@ -0,0 +1,48 @@
+package mfa
+
+import (
+	"time"
+
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+)
+
+const (
+	TOTPTable          = "mfa_totp"
+	RecoveryCodesTable = "mfa_recovery_codes"
+)
+
+var TOTPColumns = []string{
+	"user_id",
+	"secret",
+	"confirmed_at",
+	"last_used_step",
+	"created_at",
+	"updated_at",
+}
+
+var RecoveryCodeColumns = []string{
+	"user_id",
+	"code_hash",
+}
+
+// TOTP keeps the secret sealed under the key-encryption key: anyone holding
+// it can generate codes.
+type TOTP struct {
+	UserID       string       `db:"user_id"`
+	Secret       kek.Envelope `db:"secret"`
+	ConfirmedAt  *time.Time   `db:"confirmed_at"`
+	LastUsedStep int64        `db:"last_used_step"`
+	CreatedAt    time.Time    `db:"created_at"`
+	UpdatedAt    time.Time    `db:"updated_at"`
+}
+
+func (t TOTP) Values() []any {
+	return []any{
+		t.UserID,
+		t.Secret,
+		t.ConfirmedAt,
+		t.LastUsedStep,
+		t.CreatedAt,
+		t.UpdatedAt,
+	}
+}
@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	mfamodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa/models/mfa"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db          *pgxpool.Pool
+	kekProvider kek.Provider
+}
+
+var _ mfarepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool, kekProvider kek.Provider) *Repository {
+	return &Repository{
+		db:          db,
+		kekProvider: kekProvider,
+	}
+}
+
+func (r *Repository) CreateTOTP(ctx context.Context, totp mfaentity.TOTP) error {
+	secret, err := kek.Seal(ctx, r.kekProvider, totp.Secret, []byte(totp.UserID))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	model := mfamodel.TOTP{
+		UserID:    totp.UserID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// Re-enrolling before confirming replaces the pending secret, a confirmed
+	// enrollment has to be disabled first.
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(mfamodel.TOTPTable).
+		Columns(mfamodel.TOTPColumns...).
+		Values(model.Values()...).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			secret = excluded.secret,
+			last_used_step = excluded.last_used_step,
+			created_at = excluded.created_at,
+			updated_at = excluded.updated_at
+		WHERE ` + mfamodel.TOTPTable + `.confirmed_at IS NULL`)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return mfarepo.ErrTOTPAlreadyConfirmed
+	}
+
+	return nil
+}
+
+func (r *Repository) GetTOTP(ctx context.Context, userID string) (mfaentity.TOTP, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(mfamodel.TOTPColumns...).
+		From(mfamodel.TOTPTable).
+		Where(sq.Eq{"user_id": userID}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return mfaentity.TOTP{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return mfaentity.TOTP{}, err
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[mfamodel.TOTP])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mfaentity.TOTP{}, mfarepo.ErrTOTPNotFound
+		}
+		return mfaentity.TOTP{}, err
+	}
+
+	secret, err := kek.Open(ctx, r.kekProvider, model.Secret, []byte(model.UserID))
+	if err != nil {
+		return mfaentity.TOTP{}, err
+	}
+
+	totp := mfaentity.TOTP{
+		UserID:       model.UserID,
+		Secret:       secret,
+		LastUsedStep: model.LastUsedStep,
+		CreatedAt:    model.CreatedAt,
+	}
+	if model.ConfirmedAt != nil {
+		totp.ConfirmedAt = *model.ConfirmedAt
+	}
+
+	return totp, nil
+}
+
+func (r *Repository) ConfirmTOTP(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Update(mfamodel.TOTPTable).
+			Set("confirmed_at", sq.Expr("now()")).
+			Set("last_used_step", step).
+			Set("updated_at", sq.Expr("now()")).
+			Where(sq.Eq{
+				"user_id":      userID,
+				"confirmed_at": nil,
+			})
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return mfarepo.ErrTOTPAlreadyConfirmed
+		}
+
+		return replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes)
+	})
+}
+
+func (r *Repository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(mfamodel.TOTPTable).
+		Set("last_used_step", step).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.NotEq{"confirmed_at": nil}).
+		Where(sq.Lt{"last_used_step": step})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return mfarepo.ErrTOTPStepUsed
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTOTP(ctx context.Context, userID string) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Delete(mfamodel.TOTPTable).
+			Where(sq.Eq{"user_id": userID})
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		tag, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return mfarepo.ErrTOTPNotFound
+		}
+
+		return replaceRecoveryCodes(ctx, tx, userID, nil)
+	})
+}
+
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+	})
+}
+
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(mfamodel.RecoveryCodesTable).
+		Set("used_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"user_id":   userID,
+			"code_hash": codeHash,
+			"used_at":   nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return mfarepo.ErrRecoveryCodeNotFound
+	}
+
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID string, codeHashes []string) error {
+	deleteQuery := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(mfamodel.RecoveryCodesTable).
+		Where(sq.Eq{"user_id": userID})
+
+	sql, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	insertQuery := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(mfamodel.RecoveryCodesTable).
+		Columns(mfamodel.RecoveryCodeColumns...)
+
+	for _, codeHash := range codeHashes {
+		insertQuery = insertQuery.Values(userID, codeHash)
+	}
+
+	sql, args, err = insertQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+
+	return err
+}
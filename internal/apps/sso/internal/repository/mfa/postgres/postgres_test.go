@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/kek"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db          *pgxpool.Pool
+	container   *cockroachdb.CockroachDBContainer
+	kekProvider kek.Provider
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+
+	s.kekProvider, err = kek.NewStatic(kek.Key{ID: "kek-1", Secret: make([]byte, kek.KeySize)})
+	require.NoError(s.T(), err, "failed to create kek provider")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	s.cleanup()
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	s.cleanup()
+}
+
+func (s *RepositoryTestSuite) cleanup() {
+	ctx := context.Background()
+
+	_, err := s.db.Exec(ctx, "DELETE FROM mfa_recovery_codes")
+	require.NoError(s.T(), err, "failed to clean mfa_recovery_codes table")
+
+	_, err = s.db.Exec(ctx, "DELETE FROM mfa_totp")
+	require.NoError(s.T(), err, "failed to clean mfa_totp table")
+}
+
+func newTOTP() mfaentity.TOTP {
+	return mfaentity.TOTP{
+		UserID:    uuid.New().String(),
+		Secret:    []byte("12345678901234567890"),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreateTOTP() {
+	ctx := context.Background()
+	repository := New(s.db, s.kekProvider)
+
+	s.Run("should store secret encrypted", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		var raw string
+		err = s.db.QueryRow(ctx, "SELECT secret::STRING FROM mfa_totp WHERE user_id = $1", totp.UserID).Scan(&raw)
+		require.NoError(s.T(), err)
+		assert.NotContains(s.T(), raw, string(totp.Secret))
+
+		retrieved, err := repository.GetTOTP(ctx, totp.UserID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), totp.Secret, retrieved.Secret)
+		assert.False(s.T(), retrieved.Confirmed())
+	})
+
+	s.Run("should replace unconfirmed enrollment", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		totp.Secret = []byte("09876543210987654321")
+		err = repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetTOTP(ctx, totp.UserID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), totp.Secret, retrieved.Secret)
+	})
+
+	s.Run("should return error when enrollment is confirmed", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, []string{"hash-1"})
+		require.NoError(s.T(), err)
+
+		err = repository.CreateTOTP(ctx, totp)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPAlreadyConfirmed)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGetTOTP() {
+	ctx := context.Background()
+	repository := New(s.db, s.kekProvider)
+
+	s.Run("should return error when enrollment not found", func() {
+		_, err := repository.GetTOTP(ctx, uuid.New().String())
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestConfirmTOTP() {
+	ctx := context.Background()
+	repository := New(s.db, s.kekProvider)
+
+	s.Run("should confirm enrollment and store recovery codes", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, []string{"hash-1", "hash-2"})
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetTOTP(ctx, totp.UserID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrieved.Confirmed())
+		assert.Equal(s.T(), int64(100), retrieved.LastUsedStep)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-2")
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should return error when already confirmed", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, nil)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 101, nil)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPAlreadyConfirmed)
+	})
+}
+
+func (s *RepositoryTestSuite) TestUseTOTPStep() {
+	ctx := context.Background()
+	repository := New(s.db, s.kekProvider)
+
+	s.Run("should only accept later steps", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, nil)
+		require.NoError(s.T(), err)
+
+		err = repository.UseTOTPStep(ctx, totp.UserID, 100)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPStepUsed)
+
+		err = repository.UseTOTPStep(ctx, totp.UserID, 101)
+		require.NoError(s.T(), err)
+
+		err = repository.UseTOTPStep(ctx, totp.UserID, 101)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPStepUsed)
+	})
+
+	s.Run("should return error when enrollment is not confirmed", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.UseTOTPStep(ctx, totp.UserID, 100)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPStepUsed)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRecoveryCodes() {
+	ctx := context.Background()
+	repository := New(s.db, s.kekProvider)
+
+	s.Run("should use recovery code only once", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, []string{"hash-1"})
+		require.NoError(s.T(), err)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-1")
+		require.NoError(s.T(), err)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-1")
+		assert.ErrorIs(s.T(), err, mfarepo.ErrRecoveryCodeNotFound)
+	})
+
+	s.Run("should invalidate previous codes on replace", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, []string{"hash-1"})
+		require.NoError(s.T(), err)
+
+		err = repository.ReplaceRecoveryCodes(ctx, totp.UserID, []string{"hash-2"})
+		require.NoError(s.T(), err)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-1")
+		assert.ErrorIs(s.T(), err, mfarepo.ErrRecoveryCodeNotFound)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-2")
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should delete recovery codes with enrollment", func() {
+		totp := newTOTP()
+
+		err := repository.CreateTOTP(ctx, totp)
+		require.NoError(s.T(), err)
+
+		err = repository.ConfirmTOTP(ctx, totp.UserID, 100, []string{"hash-1"})
+		require.NoError(s.T(), err)
+
+		err = repository.DeleteTOTP(ctx, totp.UserID)
+		require.NoError(s.T(), err)
+
+		_, err = repository.GetTOTP(ctx, totp.UserID)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPNotFound)
+
+		err = repository.UseRecoveryCode(ctx, totp.UserID, "hash-1")
+		assert.ErrorIs(s.T(), err, mfarepo.ErrRecoveryCodeNotFound)
+
+		err = repository.DeleteTOTP(ctx, totp.UserID)
+		assert.ErrorIs(s.T(), err, mfarepo.ErrTOTPNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
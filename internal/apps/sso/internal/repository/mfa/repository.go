@@ -0,0 +1,37 @@
+package mfa
+
+import (
+	"context"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTOTPNotFound         = errors.New("totp enrollment not found")
+	ErrTOTPAlreadyConfirmed = errors.New("totp enrollment already confirmed")
+	ErrTOTPStepUsed         = errors.New("totp code already used")
+	ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+)
+
+type IRepository interface {
+	// CreateTOTP stores a new enrollment, replacing an unconfirmed one. It
+	// fails with ErrTOTPAlreadyConfirmed if the user is already enrolled.
+	CreateTOTP(ctx context.Context, totp mfaentity.TOTP) error
+	GetTOTP(ctx context.Context, userID string) (mfaentity.TOTP, error)
+	// ConfirmTOTP confirms the enrollment with the code for step and stores
+	// the hashes of the user's recovery codes.
+	ConfirmTOTP(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+	// UseTOTPStep records step as used. It fails with ErrTOTPStepUsed if it
+	// is not later than the last used step.
+	UseTOTPStep(ctx context.Context, userID string, step int64) error
+	// DeleteTOTP removes the enrollment together with its recovery codes.
+	DeleteTOTP(ctx context.Context, userID string) error
+
+	// ReplaceRecoveryCodes invalidates the user's recovery codes and stores
+	// new ones.
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	// UseRecoveryCode marks a recovery code as used so it cannot be used
+	// again.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+}
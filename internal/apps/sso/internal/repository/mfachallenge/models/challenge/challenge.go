@@ -0,0 +1,35 @@
+package challenge
+
+import (
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+)
+
+type Challenge struct {
+	TokenHash string    `json:"token_hash"`
+	UserID    string    `json:"user_id"`
+	ClientID  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func FromEntity(entity mfaentity.Challenge) Challenge {
+	return Challenge{
+		TokenHash: entity.TokenHash,
+		UserID:    entity.UserID,
+		ClientID:  entity.ClientID,
+		Scopes:    entity.Scopes,
+		ExpiresAt: entity.ExpiresAt,
+	}
+}
+
+func (c Challenge) ToEntity() mfaentity.Challenge {
+	return mfaentity.Challenge{
+		TokenHash: c.TokenHash,
+		UserID:    c.UserID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	challengerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge"
+	challengemodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge/models/challenge"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "mfa:challenge:"
+	// AttemptsSuffix is appended to the challenge key for its attempt counter.
+	AttemptsSuffix = ":attempts"
+)
+
+var _ challengerepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, challenge mfaentity.Challenge) error {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("mfa challenge is already expired")
+	}
+
+	data, err := json.Marshal(challengemodel.FromEntity(challenge))
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, KeyPrefix+challenge.TokenHash, data, ttl).Err()
+}
+
+func (r *Repository) Get(ctx context.Context, tokenHash string) (mfaentity.Challenge, error) {
+	data, err := r.rdb.Get(ctx, KeyPrefix+tokenHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return mfaentity.Challenge{}, challengerepo.ErrChallengeNotFound
+		}
+		return mfaentity.Challenge{}, err
+	}
+
+	var model challengemodel.Challenge
+	if err := json.Unmarshal(data, &model); err != nil {
+		return mfaentity.Challenge{}, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *Repository) RecordAttempt(ctx context.Context, challenge mfaentity.Challenge) (int64, error) {
+	key := KeyPrefix + challenge.TokenHash + AttemptsSuffix
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireAt(ctx, key, challenge.ExpiresAt)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+func (r *Repository) Consume(ctx context.Context, tokenHash string) error {
+	deleted, err := r.rdb.Del(ctx, KeyPrefix+tokenHash).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return challengerepo.ErrChallengeNotFound
+	}
+
+	return r.rdb.Del(ctx, KeyPrefix+tokenHash+AttemptsSuffix).Err()
+}
@@ -0,0 +1,160 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	challengerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge"
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func newChallenge() mfaentity.Challenge {
+	return mfaentity.Challenge{
+		TokenHash: "hash-123",
+		UserID:    "user-123",
+		ClientID:  "client-123",
+		Scopes:    []string{"openid"},
+		ExpiresAt: time.Now().Add(time.Minute).UTC().Truncate(time.Second),
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	s.Run("should store challenge until it expires", func() {
+		challenge := newChallenge()
+
+		err := s.repository.Create(s.ctx, challenge)
+		require.NoError(s.T(), err)
+
+		ttl, err := s.rdb.TTL(s.ctx, KeyPrefix+challenge.TokenHash).Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+
+		retrieved, err := s.repository.Get(s.ctx, challenge.TokenHash)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), challenge, retrieved)
+	})
+
+	s.Run("should return error when challenge is already expired", func() {
+		challenge := newChallenge()
+		challenge.ExpiresAt = time.Now().Add(-time.Second)
+
+		err := s.repository.Create(s.ctx, challenge)
+		assert.Error(s.T(), err)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGet() {
+	s.Run("should return error for unknown challenge", func() {
+		_, err := s.repository.Get(s.ctx, "hash-unknown")
+		assert.ErrorIs(s.T(), err, challengerepo.ErrChallengeNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRecordAttempt() {
+	s.Run("should count attempts until the challenge expires", func() {
+		challenge := newChallenge()
+
+		err := s.repository.Create(s.ctx, challenge)
+		require.NoError(s.T(), err)
+
+		for i := int64(1); i <= 3; i++ {
+			attempts, err := s.repository.RecordAttempt(s.ctx, challenge)
+			require.NoError(s.T(), err)
+			assert.Equal(s.T(), i, attempts)
+		}
+
+		ttl, err := s.rdb.TTL(s.ctx, KeyPrefix+challenge.TokenHash+AttemptsSuffix).Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+	})
+}
+
+func (s *RepositoryTestSuite) TestConsume() {
+	s.Run("should consume challenge only once", func() {
+		challenge := newChallenge()
+
+		err := s.repository.Create(s.ctx, challenge)
+		require.NoError(s.T(), err)
+
+		_, err = s.repository.RecordAttempt(s.ctx, challenge)
+		require.NoError(s.T(), err)
+
+		err = s.repository.Consume(s.ctx, challenge.TokenHash)
+		require.NoError(s.T(), err)
+
+		exists, err := s.rdb.Exists(s.ctx, KeyPrefix+challenge.TokenHash+AttemptsSuffix).Result()
+		require.NoError(s.T(), err)
+		assert.Zero(s.T(), exists)
+
+		err = s.repository.Consume(s.ctx, challenge.TokenHash)
+		assert.ErrorIs(s.T(), err, challengerepo.ErrChallengeNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
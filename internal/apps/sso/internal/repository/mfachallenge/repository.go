@@ -0,0 +1,23 @@
+package mfachallenge
+
+import (
+	"context"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrChallengeNotFound = errors.New("mfa challenge not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, challenge mfaentity.Challenge) error
+	Get(ctx context.Context, tokenHash string) (mfaentity.Challenge, error)
+	// RecordAttempt counts a verification attempt against the challenge and
+	// returns the number of attempts so far.
+	RecordAttempt(ctx context.Context, challenge mfaentity.Challenge) (int64, error)
+	// Consume deletes the challenge, so that it can be completed at most
+	// once. It fails with ErrChallengeNotFound if it is already gone.
+	Consume(ctx context.Context, tokenHash string) error
+}
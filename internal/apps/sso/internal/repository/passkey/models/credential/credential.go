@@ -0,0 +1,97 @@
+package credential
+
+import (
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+)
+
+const (
+	Table = "passkeys"
+)
+
+var Columns = []string{
+	"id",
+	"user_id",
+	"credential_id",
+	"public_key",
+	"attestation_type",
+	"aaguid",
+	"sign_count",
+	"transports",
+	"backup_eligible",
+	"backup_state",
+	"created_at",
+	"last_used_at",
+}
+
+func FromEntity(entity passkeyentity.Credential) Credential {
+	transports := entity.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	return Credential{
+		ID:              entity.ID,
+		UserID:          entity.UserID,
+		CredentialID:    entity.CredentialID,
+		PublicKey:       entity.PublicKey,
+		AttestationType: entity.AttestationType,
+		AAGUID:          entity.AAGUID,
+		SignCount:       int64(entity.SignCount),
+		Transports:      transports,
+		BackupEligible:  entity.BackupEligible,
+		BackupState:     entity.BackupState,
+		CreatedAt:       entity.CreatedAt,
+		LastUsedAt:      entity.LastUsedAt,
+	}
+}
+
+type Credential struct {
+	ID              string    `db:"id"`
+	UserID          string    `db:"user_id"`
+	CredentialID    []byte    `db:"credential_id"`
+	PublicKey       []byte    `db:"public_key"`
+	AttestationType string    `db:"attestation_type"`
+	AAGUID          []byte    `db:"aaguid"`
+	SignCount       int64     `db:"sign_count"`
+	Transports      []string  `db:"transports"`
+	BackupEligible  bool      `db:"backup_eligible"`
+	BackupState     bool      `db:"backup_state"`
+	CreatedAt       time.Time `db:"created_at"`
+	LastUsedAt      time.Time `db:"last_used_at"`
+}
+
+func (c Credential) Values() []any {
+	return []any{
+		c.ID,
+		c.UserID,
+		c.CredentialID,
+		c.PublicKey,
+		c.AttestationType,
+		c.AAGUID,
+		c.SignCount,
+		c.Transports,
+		c.BackupEligible,
+		c.BackupState,
+		c.CreatedAt,
+		c.LastUsedAt,
+	}
+}
+
+func (c Credential) ToEntity() passkeyentity.Credential {
+	return passkeyentity.Credential{
+		ID:              c.ID,
+		UserID:          c.UserID,
+		CredentialID:    c.CredentialID,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		AAGUID:          c.AAGUID,
+		SignCount:       uint32(c.SignCount),
+		Transports:      c.Transports,
+		BackupEligible:  c.BackupEligible,
+		BackupState:     c.BackupState,
+		CreatedAt:       c.CreatedAt,
+		LastUsedAt:      c.LastUsedAt,
+	}
+}
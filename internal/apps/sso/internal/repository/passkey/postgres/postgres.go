@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	credentialmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey/models/credential"
+	"github.com/pkg/errors"
+)
+
+// uniqueViolation is the SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ passkeyrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, credential passkeyentity.Credential) error {
+	model := credentialmodel.FromEntity(credential)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(credentialmodel.Table).
+		Columns(credentialmodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return passkeyrepo.ErrCredentialExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByCredentialID(ctx context.Context, credentialID []byte) (passkeyentity.Credential, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(credentialmodel.Columns...).
+		From(credentialmodel.Table).
+		Where(sq.Eq{"credential_id": credentialID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+	defer rows.Close()
+
+	credential, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[credentialmodel.Credential])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return passkeyentity.Credential{}, passkeyrepo.ErrCredentialNotFound
+		}
+		return passkeyentity.Credential{}, err
+	}
+
+	return credential.ToEntity(), nil
+}
+
+func (r *Repository) ListByUser(ctx context.Context, userID string) ([]passkeyentity.Credential, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(credentialmodel.Columns...).
+		From(credentialmodel.Table).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at ASC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[credentialmodel.Credential])
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := make([]passkeyentity.Credential, 0, len(models))
+	for _, model := range models {
+		credentials = append(credentials, model.ToEntity())
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) RecordUse(ctx context.Context, id string, signCount uint32, backupState bool, at time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(credentialmodel.Table).
+		Set("sign_count", int64(signCount)).
+		Set("backup_state", backupState).
+		Set("last_used_at", at).
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return passkeyrepo.ErrCredentialNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM passkeys")
+	require.NoError(s.T(), err, "failed to clean passkeys table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM passkeys")
+	require.NoError(s.T(), err, "failed to clean passkeys table")
+}
+
+func newCredential(userID string) passkeyentity.Credential {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	return passkeyentity.Credential{
+		ID:              uuid.New().String(),
+		UserID:          userID,
+		CredentialID:    []byte(rand.Text()),
+		PublicKey:       []byte("public key"),
+		AttestationType: "none",
+		AAGUID:          make([]byte, 16),
+		SignCount:       1,
+		Transports:      []string{"internal", "hybrid"},
+		BackupEligible:  true,
+		CreatedAt:       now,
+		LastUsedAt:      now,
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create and get credential", func() {
+		credential := newCredential(uuid.New().String())
+
+		err := repository.Create(ctx, credential)
+		require.NoError(s.T(), err)
+
+		got, err := repository.GetByCredentialID(ctx, credential.CredentialID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), credential.ID, got.ID)
+		assert.Equal(s.T(), credential.UserID, got.UserID)
+		assert.Equal(s.T(), credential.PublicKey, got.PublicKey)
+		assert.Equal(s.T(), credential.AAGUID, got.AAGUID)
+		assert.Equal(s.T(), credential.SignCount, got.SignCount)
+		assert.Equal(s.T(), credential.Transports, got.Transports)
+		assert.True(s.T(), got.BackupEligible)
+		assert.False(s.T(), got.BackupState)
+	})
+
+	s.Run("should not register credential id twice", func() {
+		credential := newCredential(uuid.New().String())
+
+		err := repository.Create(ctx, credential)
+		require.NoError(s.T(), err)
+
+		other := newCredential(uuid.New().String())
+		other.CredentialID = credential.CredentialID
+
+		err = repository.Create(ctx, other)
+		assert.ErrorIs(s.T(), err, passkeyrepo.ErrCredentialExists)
+	})
+
+	s.Run("should return not found for unknown credential", func() {
+		_, err := repository.GetByCredentialID(ctx, []byte("unknown"))
+		assert.ErrorIs(s.T(), err, passkeyrepo.ErrCredentialNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestListByUser() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should list credentials of user only", func() {
+		userID := uuid.New().String()
+
+		first := newCredential(userID)
+		second := newCredential(userID)
+		second.CreatedAt = first.CreatedAt.Add(time.Minute)
+
+		for _, credential := range []passkeyentity.Credential{first, second, newCredential(uuid.New().String())} {
+			require.NoError(s.T(), repository.Create(ctx, credential))
+		}
+
+		credentials, err := repository.ListByUser(ctx, userID)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), credentials, 2)
+		assert.Equal(s.T(), first.ID, credentials[0].ID)
+		assert.Equal(s.T(), second.ID, credentials[1].ID)
+	})
+
+	s.Run("should return empty list for user without credentials", func() {
+		credentials, err := repository.ListByUser(ctx, uuid.New().String())
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), credentials)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRecordUse() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should record sign count and backup state", func() {
+		credential := newCredential(uuid.New().String())
+
+		err := repository.Create(ctx, credential)
+		require.NoError(s.T(), err)
+
+		at := credential.LastUsedAt.Add(time.Hour)
+
+		err = repository.RecordUse(ctx, credential.ID, 7, true, at)
+		require.NoError(s.T(), err)
+
+		got, err := repository.GetByCredentialID(ctx, credential.CredentialID)
+		require.NoError(s.T(), err)
+		assert.EqualValues(s.T(), 7, got.SignCount)
+		assert.True(s.T(), got.BackupState)
+		assert.True(s.T(), at.Equal(got.LastUsedAt))
+	})
+
+	s.Run("should return not found for unknown credential", func() {
+		err := repository.RecordUse(ctx, uuid.New().String(), 1, false, time.Now())
+		assert.ErrorIs(s.T(), err, passkeyrepo.ErrCredentialNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,25 @@
+package passkey
+
+import (
+	"context"
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrCredentialNotFound = errors.New("passkey not found")
+	ErrCredentialExists   = errors.New("passkey already exists")
+)
+
+type IRepository interface {
+	// Create stores a new credential. It returns ErrCredentialExists if the
+	// credential id is already registered.
+	Create(ctx context.Context, credential passkeyentity.Credential) error
+	GetByCredentialID(ctx context.Context, credentialID []byte) (passkeyentity.Credential, error)
+	ListByUser(ctx context.Context, userID string) ([]passkeyentity.Credential, error)
+	// RecordUse stores the signature counter and backup state of a sign in
+	// with the credential.
+	RecordUse(ctx context.Context, id string, signCount uint32, backupState bool, at time.Time) error
+}
@@ -0,0 +1,38 @@
+package ceremony
+
+import (
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+)
+
+type Ceremony struct {
+	TokenHash string    `json:"token_hash"`
+	Type      string    `json:"type"`
+	UserID    string    `json:"user_id,omitempty"`
+	ClientID  string    `json:"client_id,omitempty"`
+	Challenge string    `json:"challenge"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func FromEntity(entity passkeyentity.Ceremony) Ceremony {
+	return Ceremony{
+		TokenHash: entity.TokenHash,
+		Type:      string(entity.Type),
+		UserID:    entity.UserID,
+		ClientID:  entity.ClientID,
+		Challenge: entity.Challenge,
+		ExpiresAt: entity.ExpiresAt,
+	}
+}
+
+func (c Ceremony) ToEntity() passkeyentity.Ceremony {
+	return passkeyentity.Ceremony{
+		TokenHash: c.TokenHash,
+		Type:      passkeyentity.CeremonyType(c.Type),
+		UserID:    c.UserID,
+		ClientID:  c.ClientID,
+		Challenge: c.Challenge,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
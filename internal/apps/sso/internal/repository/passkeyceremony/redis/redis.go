@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	ceremonyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony"
+	ceremonymodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony/models/ceremony"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "passkey:ceremony:"
+)
+
+var _ ceremonyrepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, ceremony passkeyentity.Ceremony) error {
+	ttl := time.Until(ceremony.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("passkey ceremony is already expired")
+	}
+
+	data, err := json.Marshal(ceremonymodel.FromEntity(ceremony))
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, KeyPrefix+ceremony.TokenHash, data, ttl).Err()
+}
+
+func (r *Repository) Consume(ctx context.Context, tokenHash string) (passkeyentity.Ceremony, error) {
+	data, err := r.rdb.GetDel(ctx, KeyPrefix+tokenHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return passkeyentity.Ceremony{}, ceremonyrepo.ErrCeremonyNotFound
+		}
+		return passkeyentity.Ceremony{}, err
+	}
+
+	var model ceremonymodel.Ceremony
+	if err := json.Unmarshal(data, &model); err != nil {
+		return passkeyentity.Ceremony{}, err
+	}
+
+	return model.ToEntity(), nil
+}
@@ -0,0 +1,19 @@
+package passkeyceremony
+
+import (
+	"context"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrCeremonyNotFound = errors.New("passkey ceremony not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, ceremony passkeyentity.Ceremony) error
+	// Consume returns the ceremony stored under tokenHash and deletes it, so
+	// that every challenge is answered at most once.
+	Consume(ctx context.Context, tokenHash string) (passkeyentity.Ceremony, error)
+}
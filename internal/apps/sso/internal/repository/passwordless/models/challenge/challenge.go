@@ -0,0 +1,38 @@
+package challenge
+
+import (
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+)
+
+type Challenge struct {
+	TokenHash string    `json:"token_hash"`
+	UserID    string    `json:"user_id"`
+	ClientID  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	CodeHash  string    `json:"code_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func FromEntity(entity passwordlessentity.Challenge) Challenge {
+	return Challenge{
+		TokenHash: entity.TokenHash,
+		UserID:    entity.UserID,
+		ClientID:  entity.ClientID,
+		Scopes:    entity.Scopes,
+		CodeHash:  entity.CodeHash,
+		ExpiresAt: entity.ExpiresAt,
+	}
+}
+
+func (c Challenge) ToEntity() passwordlessentity.Challenge {
+	return passwordlessentity.Challenge{
+		TokenHash: c.TokenHash,
+		UserID:    c.UserID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		CodeHash:  c.CodeHash,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	passwordlessrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless"
+	challengemodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless/models/challenge"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "passwordless:"
+	// ChallengePrefix holds the challenges by the hash of their token.
+	ChallengePrefix = KeyPrefix + "challenge:"
+	// AttemptsSuffix is appended to the challenge key for its attempt counter.
+	AttemptsSuffix = ":attempts"
+	// SendsPrefix holds the number of codes sent per key.
+	SendsPrefix = KeyPrefix + "sends:"
+)
+
+var _ passwordlessrepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, challenge passwordlessentity.Challenge) error {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("passwordless challenge is already expired")
+	}
+
+	data, err := json.Marshal(challengemodel.FromEntity(challenge))
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, ChallengePrefix+challenge.TokenHash, data, ttl).Err()
+}
+
+func (r *Repository) Get(ctx context.Context, tokenHash string) (passwordlessentity.Challenge, error) {
+	data, err := r.rdb.Get(ctx, ChallengePrefix+tokenHash).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return passwordlessentity.Challenge{}, passwordlessrepo.ErrChallengeNotFound
+		}
+		return passwordlessentity.Challenge{}, err
+	}
+
+	var model challengemodel.Challenge
+	if err := json.Unmarshal(data, &model); err != nil {
+		return passwordlessentity.Challenge{}, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (r *Repository) RecordAttempt(ctx context.Context, challenge passwordlessentity.Challenge) (int64, error) {
+	key := ChallengePrefix + challenge.TokenHash + AttemptsSuffix
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireAt(ctx, key, challenge.ExpiresAt)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+func (r *Repository) Consume(ctx context.Context, tokenHash string) error {
+	deleted, err := r.rdb.Del(ctx, ChallengePrefix+tokenHash).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return passwordlessrepo.ErrChallengeNotFound
+	}
+
+	return r.rdb.Del(ctx, ChallengePrefix+tokenHash+AttemptsSuffix).Err()
+}
+
+func (r *Repository) RecordSend(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	key = SendsPrefix + key
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	ttl := pipe.PTTL(ctx, key)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	return incr.Val(), ttl.Val(), nil
+}
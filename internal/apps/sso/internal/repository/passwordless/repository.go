@@ -0,0 +1,29 @@
+package passwordless
+
+import (
+	"context"
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrChallengeNotFound = errors.New("passwordless challenge not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, challenge passwordlessentity.Challenge) error
+	Get(ctx context.Context, tokenHash string) (passwordlessentity.Challenge, error)
+	// RecordAttempt counts a verification attempt against the challenge and
+	// returns the number of attempts so far.
+	RecordAttempt(ctx context.Context, challenge passwordlessentity.Challenge) (int64, error)
+	// Consume deletes the challenge, so that it can be completed at most
+	// once. It fails with ErrChallengeNotFound if it is already gone.
+	Consume(ctx context.Context, tokenHash string) error
+
+	// RecordSend counts a code sent for key within a fixed window starting
+	// with the first one. It returns the number of codes sent so far and how
+	// long the window still lasts.
+	RecordSend(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
@@ -0,0 +1,94 @@
+package session
+
+import (
+	"time"
+
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+)
+
+const (
+	Table = "sessions"
+)
+
+var Columns = []string{
+	"id",
+	"user_id",
+	"client_id",
+	"device",
+	"platform",
+	"app_version",
+	"ip",
+	"created_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+func FromEntity(entity sessionentity.Session) Session {
+	var revokedAt *time.Time
+	if entity.Revoked() {
+		revokedAt = &entity.RevokedAt
+	}
+
+	return Session{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		ClientID:   entity.ClientID,
+		Device:     entity.Device.Name,
+		Platform:   entity.Device.Platform,
+		AppVersion: entity.Device.AppVersion,
+		IP:         entity.Device.IP,
+		CreatedAt:  entity.CreatedAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  revokedAt,
+	}
+}
+
+type Session struct {
+	ID         string     `db:"id"`
+	UserID     string     `db:"user_id"`
+	ClientID   string     `db:"client_id"`
+	Device     string     `db:"device"`
+	Platform   string     `db:"platform"`
+	AppVersion string     `db:"app_version"`
+	IP         string     `db:"ip"`
+	CreatedAt  time.Time  `db:"created_at"`
+	LastUsedAt time.Time  `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+func (s Session) Values() []any {
+	return []any{
+		s.ID,
+		s.UserID,
+		s.ClientID,
+		s.Device,
+		s.Platform,
+		s.AppVersion,
+		s.IP,
+		s.CreatedAt,
+		s.LastUsedAt,
+		s.RevokedAt,
+	}
+}
+
+func (s Session) ToEntity() sessionentity.Session {
+	entity := sessionentity.Session{
+		ID:       s.ID,
+		UserID:   s.UserID,
+		ClientID: s.ClientID,
+		Device: sessionentity.Device{
+			Name:       s.Device,
+			Platform:   s.Platform,
+			AppVersion: s.AppVersion,
+			IP:         s.IP,
+		},
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+	}
+
+	if s.RevokedAt != nil {
+		entity.RevokedAt = *s.RevokedAt
+	}
+
+	return entity
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	sessionrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session"
+	sessionmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/models/session"
+	"github.com/pkg/errors"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+var _ sessionrepo.IRepository = (*Repository)(nil)
+
+func New(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, session sessionentity.Session) error {
+	model := sessionmodel.FromEntity(session)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(sessionmodel.Table).
+		Columns(sessionmodel.Columns...).
+		Values(model.Values()...)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id string) (sessionentity.Session, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(sessionmodel.Columns...).
+		From(sessionmodel.Table).
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return sessionentity.Session{}, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return sessionentity.Session{}, err
+	}
+	defer rows.Close()
+
+	session, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[sessionmodel.Session])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sessionentity.Session{}, sessionrepo.ErrSessionNotFound
+		}
+		return sessionentity.Session{}, err
+	}
+
+	return session.ToEntity(), nil
+}
+
+func (r *Repository) ListActive(ctx context.Context, userID string) ([]sessionentity.Session, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(sessionmodel.Columns...).
+		From(sessionmodel.Table).
+		Where(sq.Eq{
+			"user_id":    userID,
+			"revoked_at": nil,
+		}).
+		OrderBy("last_used_at DESC")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[sessionmodel.Session])
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]sessionentity.Session, 0, len(models))
+	for _, model := range models {
+		sessions = append(sessions, model.ToEntity())
+	}
+
+	return sessions, nil
+}
+
+func (r *Repository) Touch(ctx context.Context, id, ip string, at time.Time) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(sessionmodel.Table).
+		Set("last_used_at", at).
+		Set("ip", ip).
+		Where(sq.Eq{"id": id, "revoked_at": nil})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) Revoke(ctx context.Context, id string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(sessionmodel.Table).
+		Set("revoked_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "revoked_at": nil})
+
+	return r.exec(ctx, query)
+}
+
+func (r *Repository) exec(ctx context.Context, query sq.UpdateBuilder) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sessionrepo.ErrSessionNotFound
+	}
+
+	return nil
+}
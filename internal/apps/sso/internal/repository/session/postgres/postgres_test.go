@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	sessionrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
+	"github.com/kitanoyoru/kgym/pkg/database/postgres"
+	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	db        *pgxpool.Pool
+	container *cockroachdb.CockroachDBContainer
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+
+	container, err := cockroachdb.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	s.db, err = postgres.New(ctx, postgres.Config{
+		URI: container.URI,
+	})
+	require.NoError(s.T(), err, "failed to create postgres client")
+
+	err = migrations.Up(ctx, "pgx", container.URI)
+	require.NoError(s.T(), err, "failed to run migrations")
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM sessions")
+	require.NoError(s.T(), err, "failed to clean sessions table")
+}
+
+func (s *RepositoryTestSuite) TearDownTest() {
+	ctx := context.Background()
+	_, err := s.db.Exec(ctx, "DELETE FROM sessions")
+	require.NoError(s.T(), err, "failed to clean sessions table")
+}
+
+func newSession(userID string, lastUsedAt time.Time) sessionentity.Session {
+	return sessionentity.Session{
+		ID:       uuid.New().String(),
+		UserID:   userID,
+		ClientID: "client-123",
+		Device: sessionentity.Device{
+			Name:       "Pixel 8",
+			Platform:   "android",
+			AppVersion: "1.4.0",
+			IP:         "203.0.113.1",
+		},
+		CreatedAt:  lastUsedAt,
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func (s *RepositoryTestSuite) TestCreate() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should create and get session", func() {
+		session := newSession(uuid.New().String(), time.Now())
+
+		err := repository.Create(ctx, session)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, session.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), session.UserID, retrieved.UserID)
+		assert.Equal(s.T(), session.Device, retrieved.Device)
+		assert.False(s.T(), retrieved.Revoked())
+	})
+
+	s.Run("should return error when session not found", func() {
+		_, err := repository.GetByID(ctx, uuid.New().String())
+		assert.ErrorIs(s.T(), err, sessionrepo.ErrSessionNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestListActive() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should list active sessions most recently used first", func() {
+		userID := uuid.New().String()
+
+		older := newSession(userID, time.Now().Add(-time.Hour))
+		newer := newSession(userID, time.Now())
+		revoked := newSession(userID, time.Now())
+		other := newSession(uuid.New().String(), time.Now())
+
+		for _, session := range []sessionentity.Session{older, newer, revoked, other} {
+			err := repository.Create(ctx, session)
+			require.NoError(s.T(), err)
+		}
+
+		err := repository.Revoke(ctx, revoked.ID)
+		require.NoError(s.T(), err)
+
+		sessions, err := repository.ListActive(ctx, userID)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), sessions, 2)
+		assert.Equal(s.T(), newer.ID, sessions[0].ID)
+		assert.Equal(s.T(), older.ID, sessions[1].ID)
+	})
+}
+
+func (s *RepositoryTestSuite) TestTouch() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should update last use and address", func() {
+		session := newSession(uuid.New().String(), time.Now().Add(-time.Hour))
+
+		err := repository.Create(ctx, session)
+		require.NoError(s.T(), err)
+
+		now := time.Now()
+		err = repository.Touch(ctx, session.ID, "198.51.100.7", now)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, session.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "198.51.100.7", retrieved.Device.IP)
+		assert.WithinDuration(s.T(), now, retrieved.LastUsedAt, time.Millisecond)
+	})
+
+	s.Run("should return error when session is revoked", func() {
+		session := newSession(uuid.New().String(), time.Now())
+
+		err := repository.Create(ctx, session)
+		require.NoError(s.T(), err)
+
+		err = repository.Revoke(ctx, session.ID)
+		require.NoError(s.T(), err)
+
+		err = repository.Touch(ctx, session.ID, "198.51.100.7", time.Now())
+		assert.ErrorIs(s.T(), err, sessionrepo.ErrSessionNotFound)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRevoke() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should revoke session only once", func() {
+		session := newSession(uuid.New().String(), time.Now())
+
+		err := repository.Create(ctx, session)
+		require.NoError(s.T(), err)
+
+		err = repository.Revoke(ctx, session.ID)
+		require.NoError(s.T(), err)
+
+		retrieved, err := repository.GetByID(ctx, session.ID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrieved.Revoked())
+
+		err = repository.Revoke(ctx, session.ID)
+		assert.ErrorIs(s.T(), err, sessionrepo.ErrSessionNotFound)
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,27 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+type IRepository interface {
+	Create(ctx context.Context, session sessionentity.Session) error
+	GetByID(ctx context.Context, id string) (sessionentity.Session, error)
+	// ListActive returns the sessions of a user that are not revoked, most
+	// recently used first.
+	ListActive(ctx context.Context, userID string) ([]sessionentity.Session, error)
+	// Touch records that an active session was used at the given time from
+	// the given address.
+	Touch(ctx context.Context, id, ip string, at time.Time) error
+	// Revoke ends an active session. It fails with ErrSessionNotFound when
+	// there is no such active session.
+	Revoke(ctx context.Context, id string) error
+}
@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	throttlerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle"
+	"github.com/pkg/errors"
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	KeyPrefix = "throttle:"
+	// FailuresPrefix holds a sorted set of failures per key, scored by the
+	// time they happened in milliseconds.
+	FailuresPrefix = KeyPrefix + "failures:"
+	// LockPrefix holds the time a lockout ends in milliseconds.
+	LockPrefix = KeyPrefix + "lock:"
+)
+
+var (
+	recordFailureScript = redis.NewScript(`
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", ARGV[1] - ARGV[2])
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[3])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return redis.call("ZCARD", KEYS[1])
+`)
+)
+
+var _ throttlerepo.IRepository = (*Repository)(nil)
+
+type Repository struct {
+	rdb redis.Cmdable
+}
+
+func New(rdb redis.Cmdable) *Repository {
+	return &Repository{
+		rdb: rdb,
+	}
+}
+
+func (r *Repository) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int64, error) {
+	return recordFailureScript.Run(ctx, r.rdb, []string{FailuresPrefix + key}, at.UnixMilli(), window.Milliseconds(), uuid.NewString()).Int64()
+}
+
+func (r *Repository) Failures(ctx context.Context, key string, at time.Time, window time.Duration) (int64, time.Time, error) {
+	since := strconv.FormatInt(at.Add(-window).UnixMilli(), 10)
+
+	pipe := r.rdb.Pipeline()
+	count := pipe.ZCount(ctx, FailuresPrefix+key, "("+since, "+inf")
+	latest := pipe.ZRevRangeWithScores(ctx, FailuresPrefix+key, 0, 0)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	if count.Val() == 0 || len(latest.Val()) == 0 {
+		return 0, time.Time{}, nil
+	}
+
+	return count.Val(), time.UnixMilli(int64(latest.Val()[0].Score)), nil
+}
+
+func (r *Repository) ResetFailures(ctx context.Context, key string) error {
+	return r.rdb.Del(ctx, FailuresPrefix+key).Err()
+}
+
+func (r *Repository) Lock(ctx context.Context, key string, until time.Time) error {
+	return r.rdb.SetArgs(ctx, LockPrefix+key, until.UnixMilli(), redis.SetArgs{
+		ExpireAt: until,
+	}).Err()
+}
+
+func (r *Repository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	until, err := r.rdb.Get(ctx, LockPrefix+key).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	return time.UnixMilli(until), nil
+}
+
+func (r *Repository) Unlock(ctx context.Context, key string) error {
+	return r.rdb.Del(ctx, LockPrefix+key).Err()
+}
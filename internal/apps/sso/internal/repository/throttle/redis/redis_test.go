@@ -0,0 +1,168 @@
+package redis
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	rediscontainer "github.com/kitanoyoru/kgym/pkg/testing/integration/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type RepositoryTestSuite struct {
+	suite.Suite
+
+	rdb        *redis.Client
+	container  *rediscontainer.RedisContainer
+	repository *Repository
+	ctx        context.Context
+}
+
+func (s *RepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	s.ctx = ctx
+
+	container, err := rediscontainer.SetupTestContainer(ctx)
+	require.NoError(s.T(), err, "failed to setup test container")
+
+	s.container = container
+
+	parsedURI, err := url.Parse(container.URI)
+	require.NoError(s.T(), err, "failed to parse Redis URI")
+
+	address := strings.TrimPrefix(container.URI, "redis://")
+	if parsedURI.Host != "" {
+		address = parsedURI.Host
+	}
+
+	s.rdb = redis.NewClient(&redis.Options{
+		Addr: address,
+	})
+
+	s.repository = New(s.rdb)
+}
+
+func (s *RepositoryTestSuite) TearDownSuite() {
+	if s.rdb != nil {
+		_ = s.rdb.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.T().Context())
+	}
+}
+
+func (s *RepositoryTestSuite) SetupTest() {
+	keys, err := s.rdb.Keys(s.ctx, KeyPrefix+"*").Result()
+	if err == nil && len(keys) > 0 {
+		_ = s.rdb.Del(s.ctx, keys...).Err()
+	}
+}
+
+func (s *RepositoryTestSuite) TestRecordFailure() {
+	s.Run("should count failures within the window", func() {
+		now := time.Now()
+
+		for i, at := range []time.Time{now.Add(-20 * time.Minute), now.Add(-time.Minute), now} {
+			count, err := s.repository.RecordFailure(s.ctx, "account:123", at, 15*time.Minute)
+			require.NoError(s.T(), err)
+
+			// The first failure has left the window by the time the later
+			// ones are recorded.
+			expected := int64(i)
+			if i == 0 {
+				expected = 1
+			}
+			assert.Equal(s.T(), expected, count)
+		}
+
+		count, latest, err := s.repository.Failures(s.ctx, "account:123", now, 15*time.Minute)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(2), count)
+		assert.Equal(s.T(), now.UnixMilli(), latest.UnixMilli())
+
+		ttl, err := s.rdb.PTTL(s.ctx, FailuresPrefix+"account:123").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, 15*time.Minute)
+	})
+
+	s.Run("should count failures at the same time separately", func() {
+		now := time.Now()
+
+		for range 3 {
+			_, err := s.repository.RecordFailure(s.ctx, "ip:10.0.0.1", now, time.Minute)
+			require.NoError(s.T(), err)
+		}
+
+		count, _, err := s.repository.Failures(s.ctx, "ip:10.0.0.1", now, time.Minute)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(3), count)
+	})
+}
+
+func (s *RepositoryTestSuite) TestFailures() {
+	s.Run("should return nothing for unknown key", func() {
+		count, latest, err := s.repository.Failures(s.ctx, "account:unknown", time.Now(), time.Minute)
+		require.NoError(s.T(), err)
+		assert.Zero(s.T(), count)
+		assert.True(s.T(), latest.IsZero())
+	})
+
+	s.Run("should forget failures on reset", func() {
+		now := time.Now()
+
+		_, err := s.repository.RecordFailure(s.ctx, "account:456", now, time.Minute)
+		require.NoError(s.T(), err)
+
+		err = s.repository.ResetFailures(s.ctx, "account:456")
+		require.NoError(s.T(), err)
+
+		count, _, err := s.repository.Failures(s.ctx, "account:456", now, time.Minute)
+		require.NoError(s.T(), err)
+		assert.Zero(s.T(), count)
+	})
+}
+
+func (s *RepositoryTestSuite) TestLock() {
+	s.Run("should lock until the given time", func() {
+		until := time.Now().Add(time.Minute)
+
+		err := s.repository.Lock(s.ctx, "account:123", until)
+		require.NoError(s.T(), err)
+
+		lockedUntil, err := s.repository.LockedUntil(s.ctx, "account:123")
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), until.UnixMilli(), lockedUntil.UnixMilli())
+
+		ttl, err := s.rdb.PTTL(s.ctx, LockPrefix+"account:123").Result()
+		require.NoError(s.T(), err)
+		assert.Greater(s.T(), ttl, time.Duration(0))
+		assert.LessOrEqual(s.T(), ttl, time.Minute)
+	})
+
+	s.Run("should unlock", func() {
+		err := s.repository.Lock(s.ctx, "account:456", time.Now().Add(time.Minute))
+		require.NoError(s.T(), err)
+
+		err = s.repository.Unlock(s.ctx, "account:456")
+		require.NoError(s.T(), err)
+
+		lockedUntil, err := s.repository.LockedUntil(s.ctx, "account:456")
+		require.NoError(s.T(), err)
+		assert.True(s.T(), lockedUntil.IsZero())
+	})
+}
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
@@ -0,0 +1,24 @@
+package throttle
+
+import (
+	"context"
+	"time"
+)
+
+type IRepository interface {
+	// RecordFailure adds a failure at the given time to the sliding window of
+	// key and returns the number of failures within the window, including it.
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int64, error)
+	// Failures returns the number of failures of key within the window ending
+	// at the given time, and the time of the latest one.
+	Failures(ctx context.Context, key string, at time.Time, window time.Duration) (int64, time.Time, error)
+	// ResetFailures forgets the failures of key.
+	ResetFailures(ctx context.Context, key string) error
+
+	// Lock locks key out until the given time.
+	Lock(ctx context.Context, key string, until time.Time) error
+	// LockedUntil returns when the lockout of key ends, or the zero time if
+	// it is not locked out.
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	Unlock(ctx context.Context, key string) error
+}
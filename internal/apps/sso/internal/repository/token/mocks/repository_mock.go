@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	token "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
 	token0 "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
@@ -56,6 +57,36 @@ func (mr *MockIRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIRepository)(nil).Create), ctx, arg1)
 }
 
+// DeleteExpired mocks base method.
+func (m *MockIRepository) DeleteExpired(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIRepositoryMockRecorder) DeleteExpired(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIRepository)(nil).DeleteExpired), ctx, before, limit)
+}
+
+// DeleteRevoked mocks base method.
+func (m *MockIRepository) DeleteRevoked(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRevoked", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRevoked indicates an expected call of DeleteRevoked.
+func (mr *MockIRepositoryMockRecorder) DeleteRevoked(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevoked", reflect.TypeOf((*MockIRepository)(nil).DeleteRevoked), ctx, before, limit)
+}
+
 // GetByTokenHash mocks base method.
 func (m *MockIRepository) GetByTokenHash(ctx context.Context, tokenHash string) (token0.Token, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +102,20 @@ func (mr *MockIRepositoryMockRecorder) GetByTokenHash(ctx, tokenHash any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockIRepository)(nil).GetByTokenHash), ctx, tokenHash)
 }
 
+// MarkUsed mocks base method.
+func (m *MockIRepository) MarkUsed(ctx context.Context, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockIRepositoryMockRecorder) MarkUsed(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockIRepository)(nil).MarkUsed), ctx, tokenHash)
+}
+
 // Revoke mocks base method.
 func (m *MockIRepository) Revoke(ctx context.Context, tokenHash string) error {
 	m.ctrl.T.Helper()
@@ -84,3 +129,31 @@ func (mr *MockIRepositoryMockRecorder) Revoke(ctx, tokenHash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIRepository)(nil).Revoke), ctx, tokenHash)
 }
+
+// RevokeFamily mocks base method.
+func (m *MockIRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockIRepositoryMockRecorder) RevokeFamily(ctx, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockIRepository)(nil).RevokeFamily), ctx, familyID)
+}
+
+// RevokeSubject mocks base method.
+func (m *MockIRepository) RevokeSubject(ctx context.Context, subject string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSubject", ctx, subject)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSubject indicates an expected call of RevokeSubject.
+func (mr *MockIRepositoryMockRecorder) RevokeSubject(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSubject", reflect.TypeOf((*MockIRepository)(nil).RevokeSubject), ctx, subject)
+}
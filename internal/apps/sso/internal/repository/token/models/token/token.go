@@ -17,8 +17,13 @@ var Columns = []string{
 	"client_id",
 	"token_type",
 	"token_hash",
+	"family_id",
+	"parent_id",
+	"scopes",
+	"jkt",
 	"expires_at",
 	"revoked",
+	"used_at",
 	"created_at",
 	"updated_at",
 	"deleted_at",
@@ -32,12 +37,37 @@ func FromEntity(entity tokenentity.Token) Token {
 
 	now := carbon.Now().StdTime()
 
+	// A token without a family starts a new one, rooted at itself.
+	familyID := entity.FamilyID
+	if familyID == "" {
+		familyID = entity.ID
+	}
+
+	var parentID *string
+	if entity.ParentID != "" {
+		parentID = &entity.ParentID
+	}
+
+	var jkt *string
+	if entity.JKT != "" {
+		jkt = &entity.JKT
+	}
+
+	scopes := entity.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
 	return Token{
 		ID:        entity.ID,
 		Subject:   entity.Subject,
 		ClientID:  entity.ClientID,
 		TokenType: tokenType,
 		TokenHash: entity.TokenHash,
+		FamilyID:  familyID,
+		ParentID:  parentID,
+		Scopes:    scopes,
+		JKT:       jkt,
 		ExpiresAt: entity.ExpiresAt,
 		Revoked:   entity.Revoked,
 		CreatedAt: now,
@@ -51,8 +81,13 @@ type Token struct {
 	ClientID  string     `db:"client_id"`
 	TokenType Type       `db:"token_type"`
 	TokenHash string     `db:"token_hash"`
+	FamilyID  string     `db:"family_id"`
+	ParentID  *string    `db:"parent_id"`
+	Scopes    []string   `db:"scopes"`
+	JKT       *string    `db:"jkt"`
 	ExpiresAt time.Time  `db:"expires_at"`
 	Revoked   bool       `db:"revoked"`
+	UsedAt    *time.Time `db:"used_at"`
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
 	DeletedAt *time.Time `db:"deleted_at"`
@@ -65,8 +100,13 @@ func (t Token) Values() []any {
 		t.ClientID,
 		t.TokenType,
 		t.TokenHash,
+		t.FamilyID,
+		t.ParentID,
+		t.Scopes,
+		t.JKT,
 		t.ExpiresAt,
 		t.Revoked,
+		t.UsedAt,
 		t.CreatedAt,
 		t.UpdatedAt,
 		t.DeletedAt,
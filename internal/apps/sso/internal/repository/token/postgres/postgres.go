@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -9,6 +10,7 @@ import (
 	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
 	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
 	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
+	"github.com/pkg/errors"
 )
 
 type Repository struct {
@@ -67,6 +69,9 @@ func (r *Repository) GetByTokenHash(ctx context.Context, tokenHash string) (toke
 
 	token, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[tokenmodel.Token])
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tokenmodel.Token{}, tokenrepo.ErrTokenNotFound
+		}
 		return tokenmodel.Token{}, err
 	}
 
@@ -92,3 +97,120 @@ func (r *Repository) Revoke(ctx context.Context, tokenHash string) error {
 
 	return nil
 }
+
+// MarkUsed atomically consumes a refresh token. It fails with
+// ErrTokenAlreadyUsed when the token has already been used or revoked, so that
+// concurrent rotations of the same token cannot both succeed.
+func (r *Repository) MarkUsed(ctx context.Context, tokenHash string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(tokenmodel.Table).
+		Set("used_at", sq.Expr("now()")).
+		Set("revoked", true).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"token_hash": tokenHash,
+			"used_at":    nil,
+			"revoked":    false,
+			"deleted_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return tokenrepo.ErrTokenAlreadyUsed
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeFamily(ctx context.Context, familyID string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(tokenmodel.Table).
+		Set("revoked", true).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"family_id":  familyID,
+			"revoked":    false,
+			"deleted_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeSubject(ctx context.Context, subject string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(tokenmodel.Table).
+		Set("revoked", true).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"subject":    subject,
+			"revoked":    false,
+			"deleted_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	return r.deleteBatch(ctx, sq.Lt{"expires_at": before}, limit)
+}
+
+func (r *Repository) DeleteRevoked(ctx context.Context, before time.Time, limit uint64) (int64, error) {
+	return r.deleteBatch(ctx, sq.And{
+		sq.Eq{"revoked": true},
+		sq.Lt{"updated_at": before},
+	}, limit)
+}
+
+// deleteBatch deletes at most limit tokens matching where. The rows are
+// picked in a subquery because Postgres does not support DELETE ... LIMIT.
+func (r *Repository) deleteBatch(ctx context.Context, where sq.Sqlizer, limit uint64) (int64, error) {
+	batch := sq.Select("id").
+		From(tokenmodel.Table).
+		Where(where).
+		Limit(limit)
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(tokenmodel.Table).
+		Where(sq.Expr("id IN (?)", batch))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
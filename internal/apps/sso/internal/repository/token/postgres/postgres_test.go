@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
 	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
 	"github.com/kitanoyoru/kgym/internal/apps/sso/migrations"
 	"github.com/kitanoyoru/kgym/pkg/database/postgres"
@@ -84,6 +86,7 @@ func (s *RepositoryTestSuite) TestCreate() {
 			ClientID:  uuid.New().String(),
 			TokenType: tokenentity.TypeRefresh,
 			TokenHash: tokenHash,
+			Scopes:    []string{"openid", "profile"},
 			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 			Revoked:   false,
 		}
@@ -99,6 +102,7 @@ func (s *RepositoryTestSuite) TestCreate() {
 		assert.Equal(s.T(), tokenmodel.TypeRefresh, retrievedToken.TokenType)
 		assert.Equal(s.T(), token.TokenHash, retrievedToken.TokenHash)
 		assert.Equal(s.T(), token.Revoked, retrievedToken.Revoked)
+		assert.Equal(s.T(), token.Scopes, retrievedToken.Scopes)
 	})
 
 	s.Run("should create multiple tokens with same subject and client_id", func() {
@@ -267,7 +271,7 @@ func (s *RepositoryTestSuite) TestGetByTokenHash() {
 	s.Run("should return error when token hash not found", func() {
 		nonExistentHash := hashToken("non-existent-token")
 		_, err := repository.GetByTokenHash(ctx, nonExistentHash)
-		assert.Error(s.T(), err)
+		assert.ErrorIs(s.T(), err, tokenrepo.ErrTokenNotFound)
 	})
 
 	s.Run("should not get a token because it is deleted", func() {
@@ -380,6 +384,243 @@ func (s *RepositoryTestSuite) TestRevoke() {
 	})
 }
 
+func (s *RepositoryTestSuite) TestMarkUsed() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should mark a token as used successfully", func() {
+		tokenHash := hashToken("refresh-token-to-use")
+		token := tokenentity.Token{
+			ID:        uuid.New().String(),
+			Subject:   uuid.New().String(),
+			ClientID:  uuid.New().String(),
+			TokenType: tokenentity.TypeRefresh,
+			TokenHash: tokenHash,
+			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			Revoked:   false,
+		}
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		err = repository.MarkUsed(ctx, tokenHash)
+		assert.NoError(s.T(), err)
+
+		retrievedToken, err := repository.GetByTokenHash(ctx, tokenHash)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedToken.Revoked)
+		assert.NotNil(s.T(), retrievedToken.UsedAt)
+		assert.Equal(s.T(), token.ID, retrievedToken.FamilyID)
+		assert.Nil(s.T(), retrievedToken.ParentID)
+	})
+
+	s.Run("should not mark a token as used twice", func() {
+		tokenHash := hashToken("refresh-token-used-twice")
+		token := tokenentity.Token{
+			ID:        uuid.New().String(),
+			Subject:   uuid.New().String(),
+			ClientID:  uuid.New().String(),
+			TokenType: tokenentity.TypeRefresh,
+			TokenHash: tokenHash,
+			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			Revoked:   false,
+		}
+
+		err := repository.Create(ctx, token)
+		require.NoError(s.T(), err)
+
+		err = repository.MarkUsed(ctx, tokenHash)
+		require.NoError(s.T(), err)
+
+		err = repository.MarkUsed(ctx, tokenHash)
+		assert.ErrorIs(s.T(), err, tokenrepo.ErrTokenAlreadyUsed)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRevokeFamily() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should revoke every token in a family", func() {
+		subject := uuid.New().String()
+		clientID := uuid.New().String()
+
+		root := tokenentity.Token{
+			ID:        uuid.New().String(),
+			Subject:   subject,
+			ClientID:  clientID,
+			TokenType: tokenentity.TypeRefresh,
+			TokenHash: hashToken("refresh-token-root"),
+			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		}
+		err := repository.Create(ctx, root)
+		require.NoError(s.T(), err)
+
+		child := tokenentity.Token{
+			ID:        uuid.New().String(),
+			Subject:   subject,
+			ClientID:  clientID,
+			TokenType: tokenentity.TypeRefresh,
+			TokenHash: hashToken("refresh-token-child"),
+			FamilyID:  root.ID,
+			ParentID:  root.ID,
+			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		}
+		err = repository.Create(ctx, child)
+		require.NoError(s.T(), err)
+
+		other := tokenentity.Token{
+			ID:        uuid.New().String(),
+			Subject:   subject,
+			ClientID:  clientID,
+			TokenType: tokenentity.TypeRefresh,
+			TokenHash: hashToken("refresh-token-other"),
+			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		}
+		err = repository.Create(ctx, other)
+		require.NoError(s.T(), err)
+
+		err = repository.RevokeFamily(ctx, root.ID)
+		assert.NoError(s.T(), err)
+
+		retrievedChild, err := repository.GetByTokenHash(ctx, child.TokenHash)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedChild.Revoked)
+		require.NotNil(s.T(), retrievedChild.ParentID)
+		assert.Equal(s.T(), root.ID, *retrievedChild.ParentID)
+
+		retrievedRoot, err := repository.GetByTokenHash(ctx, root.TokenHash)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedRoot.Revoked)
+
+		retrievedOther, err := repository.GetByTokenHash(ctx, other.TokenHash)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrievedOther.Revoked)
+	})
+}
+
+func (s *RepositoryTestSuite) TestRevokeSubject() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should revoke every token of a subject", func() {
+		subject := uuid.New().String()
+
+		tokens := make([]tokenentity.Token, 0, 3)
+		for i, tokenSubject := range []string{subject, subject, uuid.New().String()} {
+			token := tokenentity.Token{
+				ID:        uuid.New().String(),
+				Subject:   tokenSubject,
+				ClientID:  uuid.New().String(),
+				TokenType: tokenentity.TypeRefresh,
+				TokenHash: hashToken(fmt.Sprintf("refresh-token-subject-%d", i)),
+				ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			}
+			err := repository.Create(ctx, token)
+			require.NoError(s.T(), err)
+
+			tokens = append(tokens, token)
+		}
+
+		err := repository.RevokeSubject(ctx, subject)
+		assert.NoError(s.T(), err)
+
+		for i, token := range tokens {
+			retrieved, err := repository.GetByTokenHash(ctx, token.TokenHash)
+			require.NoError(s.T(), err)
+			assert.Equal(s.T(), i < 2, retrieved.Revoked)
+		}
+	})
+}
+
+func (s *RepositoryTestSuite) TestDeleteExpired() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should delete expired tokens in batches", func() {
+		now := time.Now()
+
+		tokens := make([]tokenentity.Token, 0, 4)
+		for i, expiresAt := range []time.Time{now.Add(-2 * time.Hour), now.Add(-2 * time.Hour), now.Add(-2 * time.Hour), now.Add(time.Hour)} {
+			token := tokenentity.Token{
+				ID:        uuid.New().String(),
+				Subject:   uuid.New().String(),
+				ClientID:  uuid.New().String(),
+				TokenType: tokenentity.TypeRefresh,
+				TokenHash: hashToken(fmt.Sprintf("refresh-token-expired-%d", i)),
+				ExpiresAt: expiresAt,
+			}
+			err := repository.Create(ctx, token)
+			require.NoError(s.T(), err)
+
+			tokens = append(tokens, token)
+		}
+
+		deleted, err := repository.DeleteExpired(ctx, now.Add(-time.Hour), 2)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(2), deleted)
+
+		deleted, err = repository.DeleteExpired(ctx, now.Add(-time.Hour), 2)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(1), deleted)
+
+		for i, token := range tokens {
+			_, err := repository.GetByTokenHash(ctx, token.TokenHash)
+			if i < 3 {
+				assert.ErrorIs(s.T(), err, tokenrepo.ErrTokenNotFound)
+			} else {
+				assert.NoError(s.T(), err)
+			}
+		}
+	})
+}
+
+func (s *RepositoryTestSuite) TestDeleteRevoked() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should delete tokens revoked before the given time", func() {
+		tokens := make([]tokenentity.Token, 0, 3)
+		for i := range 3 {
+			token := tokenentity.Token{
+				ID:        uuid.New().String(),
+				Subject:   uuid.New().String(),
+				ClientID:  uuid.New().String(),
+				TokenType: tokenentity.TypeRefresh,
+				TokenHash: hashToken(fmt.Sprintf("refresh-token-revoked-%d", i)),
+				ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			}
+			err := repository.Create(ctx, token)
+			require.NoError(s.T(), err)
+
+			tokens = append(tokens, token)
+		}
+
+		err := repository.Revoke(ctx, tokens[0].TokenHash)
+		require.NoError(s.T(), err)
+
+		err = repository.MarkUsed(ctx, tokens[1].TokenHash)
+		require.NoError(s.T(), err)
+
+		deleted, err := repository.DeleteRevoked(ctx, time.Now().Add(-time.Hour), 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(0), deleted)
+
+		deleted, err = repository.DeleteRevoked(ctx, time.Now().Add(time.Hour), 10)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), int64(2), deleted)
+
+		for i, token := range tokens {
+			_, err := repository.GetByTokenHash(ctx, token.TokenHash)
+			if i < 2 {
+				assert.ErrorIs(s.T(), err, tokenrepo.ErrTokenNotFound)
+			} else {
+				assert.NoError(s.T(), err)
+			}
+		}
+	})
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
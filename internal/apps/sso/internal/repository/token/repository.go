@@ -2,13 +2,29 @@ package token
 
 import (
 	"context"
+	"time"
 
 	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
 	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTokenNotFound    = errors.New("token not found")
+	ErrTokenAlreadyUsed = errors.New("token already used")
 )
 
 type IRepository interface {
 	Create(ctx context.Context, token tokenentity.Token) error
 	GetByTokenHash(ctx context.Context, tokenHash string) (tokenmodel.Token, error)
 	Revoke(ctx context.Context, tokenHash string) error
+	MarkUsed(ctx context.Context, tokenHash string) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeSubject(ctx context.Context, subject string) error
+	// DeleteExpired deletes at most limit tokens that expired before the given
+	// time and returns how many it deleted.
+	DeleteExpired(ctx context.Context, before time.Time, limit uint64) (int64, error)
+	// DeleteRevoked deletes at most limit tokens that were revoked or used
+	// before the given time and returns how many it deleted.
+	DeleteRevoked(ctx context.Context, before time.Time, limit uint64) (int64, error)
 }
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// tokenRefreshMargin is how long before it expires an access token is
+// replaced, so that it does not expire on the way to the user service.
+const tokenRefreshMargin = 30 * time.Second
+
+// TokenSource issues the access tokens the user service is called with.
+type TokenSource func(ctx context.Context) (accessToken string, expiresAt time.Time, err error)
+
+var _ credentials.PerRPCCredentials = (*Credentials)(nil)
+
+// Credentials attach an access token to every call to the user service,
+// which only lets services call its internal methods. The token is reused
+// until shortly before it expires.
+type Credentials struct {
+	source TokenSource
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewCredentials(source TokenSource) *Credentials {
+	return &Credentials{
+		source: source,
+	}
+}
+
+func (c *Credentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Until(c.expiresAt) < tokenRefreshMargin {
+		accessToken, expiresAt, err := c.source(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.accessToken, c.expiresAt = accessToken, expiresAt
+	}
+
+	return map[string]string{
+		"authorization": "Bearer " + c.accessToken,
+	}, nil
+}
+
+// RequireTransportSecurity allows the token to be sent over the plaintext
+// connection to the user service, which is only reachable in the cluster.
+func (c *Credentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -6,6 +6,9 @@ import (
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/user/v1"
 	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
 	"github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ userrepo.IRepository = (*Repository)(nil)
@@ -20,6 +23,45 @@ func New(client pb.UserServiceClient) *Repository {
 	}
 }
 
+func (r *Repository) Create(ctx context.Context, user models.User, password string) (string, error) {
+	request := &pb.CreateUser_Request{
+		Email:     user.Email,
+		Role:      pb.Role_USER,
+		Username:  user.Username,
+		Password:  password,
+		AvatarUrl: user.AvatarURL,
+		Mobile:    user.Mobile,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+	if !user.BirthDate.IsZero() {
+		request.BirthDate = timestamppb.New(user.BirthDate)
+	}
+
+	resp, err := r.client.CreateUser(ctx, request)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Id, nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id string) (models.User, error) {
+	request := &pb.GetUser_Request{
+		Id: &id,
+	}
+
+	resp, err := r.client.GetUser(ctx, request)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return models.User{}, userrepo.ErrUserNotFound
+		}
+		return models.User{}, err
+	}
+
+	return userFromProto(resp.User)
+}
+
 func (r *Repository) GetByEmail(ctx context.Context, email string) (models.User, error) {
 	request := &pb.GetUser_Request{
 		Email: &email,
@@ -27,25 +69,68 @@ func (r *Repository) GetByEmail(ctx context.Context, email string) (models.User,
 
 	resp, err := r.client.GetUser(ctx, request)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return models.User{}, userrepo.ErrUserNotFound
+		}
 		return models.User{}, err
 	}
 
-	role, err := models.RoleFromProto(resp.User.Role)
+	return userFromProto(resp.User)
+}
+
+func (r *Repository) VerifyPassword(ctx context.Context, email, password string) (models.User, error) {
+	request := &pb.VerifyPassword_Request{
+		Email:    email,
+		Password: password,
+	}
+
+	resp, err := r.client.VerifyPassword(ctx, request)
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return models.User{}, userrepo.ErrInvalidCredentials
+		}
+		return models.User{}, err
+	}
+
+	return userFromProto(resp.User)
+}
+
+func (r *Repository) UpdatePassword(ctx context.Context, id, password string) error {
+	request := &pb.UpdatePassword_Request{
+		Id:       id,
+		Password: password,
+	}
+
+	_, err := r.client.UpdatePassword(ctx, request)
+	return err
+}
+
+func (r *Repository) MarkEmailVerified(ctx context.Context, id string) error {
+	request := &pb.VerifyEmail_Request{
+		Id: id,
+	}
+
+	_, err := r.client.VerifyEmail(ctx, request)
+	return err
+}
+
+func userFromProto(user *pb.User) (models.User, error) {
+	role, err := models.RoleFromProto(user.Role)
 	if err != nil {
 		return models.User{}, err
 	}
 
 	return models.User{
-		ID:        resp.User.Id,
-		Email:     resp.User.Email,
-		Role:      role,
-		Username:  resp.User.Username,
-		Password:  resp.User.Password,
-		AvatarURL: resp.User.AvatarUrl,
-		Mobile:    resp.User.Mobile,
-		FirstName: resp.User.FirstName,
-		LastName:  resp.User.LastName,
-		BirthDate: resp.User.BirthDate.AsTime(),
-		CreatedAt: resp.User.CreatedAt.AsTime(),
+		ID:            user.Id,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		Role:          role,
+		Username:      user.Username,
+		AvatarURL:     user.AvatarUrl,
+		Mobile:        user.Mobile,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		BirthDate:     user.BirthDate.AsTime(),
+		CreatedAt:     user.CreatedAt.AsTime(),
 	}, nil
 }
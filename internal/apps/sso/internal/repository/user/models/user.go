@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/user/v1"
@@ -30,15 +31,20 @@ func RoleFromProto(role pb.Role) (Role, error) {
 }
 
 type User struct {
-	ID        string
-	Email     string
-	Role      Role
-	Username  string
-	Password  string
-	AvatarURL string
-	Mobile    string
-	FirstName string
-	LastName  string
-	BirthDate time.Time
-	CreatedAt time.Time
+	ID    string
+	Email string
+	// EmailVerified is set once the user proves ownership of Email.
+	EmailVerified bool
+	Role          Role
+	Username      string
+	AvatarURL     string
+	Mobile        string
+	FirstName     string
+	LastName      string
+	BirthDate     time.Time
+	CreatedAt     time.Time
+}
+
+func (u User) FullName() string {
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
 }
@@ -4,8 +4,21 @@ import (
 	"context"
 
 	"github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserNotFound       = errors.New("user not found")
 )
 
 type IRepository interface {
+	// Create registers a user with the given password and returns its id. The
+	// role of user is ignored, new users always get the user role.
+	Create(ctx context.Context, user models.User, password string) (string, error)
+	GetByID(ctx context.Context, id string) (models.User, error)
 	GetByEmail(ctx context.Context, email string) (models.User, error)
+	VerifyPassword(ctx context.Context, email, password string) (models.User, error)
+	UpdatePassword(ctx context.Context, id, password string) error
+	MarkEmailVerified(ctx context.Context, id string) error
 }
@@ -0,0 +1,234 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	actiontokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	actionTokenRepository actiontokenrepo.IRepository
+	tokenRepository       tokenrepo.IRepository
+	userRepository        userrepo.IRepository
+
+	sessionService sessionservice.IService
+	mailer         mailer.Mailer
+}
+
+func NewService(cfg Config, actionTokenRepository actiontokenrepo.IRepository, tokenRepository tokenrepo.IRepository, userRepository userrepo.IRepository, sessionService sessionservice.IService, mailer mailer.Mailer) *Service {
+	return &Service{
+		cfg:                   cfg,
+		actionTokenRepository: actionTokenRepository,
+		tokenRepository:       tokenRepository,
+		userRepository:        userRepository,
+		sessionService:        sessionService,
+		mailer:                mailer,
+	}
+}
+
+func (s *Service) RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) error {
+	user, err := s.userRepository.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.issue(ctx, user.ID, actiontokenentity.PurposePasswordReset, s.cfg.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	link, err := actionURL(s.cfg.PasswordResetURL, token)
+	if err != nil {
+		return err
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Someone asked to reset the password of your account.\n\nTo choose a new password, open the link below within %s:\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			s.cfg.PasswordResetTTL, link,
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventPasswordResetRequested).
+		Str("user_id", user.ID).
+		Msg("password reset requested")
+
+	return nil
+}
+
+func (s *Service) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	// The password is checked before the token is used up, so that a
+	// rejected password can be corrected with the same link.
+	length := utf8.RuneCountInString(req.Password)
+	if length < MinPasswordLength || length > MaxPasswordLength {
+		return ErrInvalidPassword
+	}
+
+	token, err := s.consume(ctx, actiontokenentity.PurposePasswordReset, req.Token)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepository.UpdatePassword(ctx, token.UserID, req.Password); err != nil {
+		return err
+	}
+
+	// Whoever knew the old password may hold refresh tokens, including ones
+	// issued before sessions were recorded.
+	if _, err := s.sessionService.RevokeAll(ctx, sessionservice.RevokeAllRequest{UserID: token.UserID}); err != nil {
+		return err
+	}
+	if err := s.tokenRepository.RevokeSubject(ctx, token.UserID); err != nil {
+		return err
+	}
+
+	log.Warn().
+		Str("event", SecurityEventPasswordReset).
+		Str("user_id", token.UserID).
+		Msg("password reset, all sessions revoked")
+
+	return nil
+}
+
+func (s *Service) SendVerificationEmail(ctx context.Context, userID string) error {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := s.issue(ctx, user.ID, actiontokenentity.PurposeEmailVerification, s.cfg.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	link, err := actionURL(s.cfg.EmailVerificationURL, token)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"To confirm that this address belongs to your account, open the link below within %s:\n\n%s\n",
+			s.cfg.EmailVerificationTTL, link,
+		),
+	})
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, value string) error {
+	token, err := s.consume(ctx, actiontokenentity.PurposeEmailVerification, value)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepository.MarkEmailVerified(ctx, token.UserID); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventEmailVerified).
+		Str("user_id", token.UserID).
+		Msg("email verified")
+
+	return nil
+}
+
+// issue creates a token for purpose and returns its value. Earlier tokens of
+// the user for the same purpose stop working, so only the latest link does.
+func (s *Service) issue(ctx context.Context, userID string, purpose actiontokenentity.Purpose, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	if err := s.actionTokenRepository.Invalidate(ctx, userID, purpose, now); err != nil {
+		return "", err
+	}
+
+	value, err := randomToken(ActionTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.actionTokenRepository.Create(ctx, actiontokenentity.ActionToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: tokenentity.Hash(value),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+func (s *Service) consume(ctx context.Context, purpose actiontokenentity.Purpose, value string) (actiontokenentity.ActionToken, error) {
+	if value == "" {
+		return actiontokenentity.ActionToken{}, ErrInvalidToken
+	}
+
+	token, err := s.actionTokenRepository.Consume(ctx, purpose, tokenentity.Hash(value), time.Now())
+	if err != nil {
+		if errors.Is(err, actiontokenrepo.ErrTokenNotFound) {
+			return actiontokenentity.ActionToken{}, ErrInvalidToken
+		}
+		return actiontokenentity.ActionToken{}, err
+	}
+
+	return token, nil
+}
+
+func actionURL(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,258 @@
+package account
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	actiontokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/actiontoken"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	actiontokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken"
+	actiontokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/actiontoken/mocks"
+	sessionmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/mocks"
+	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	PasswordResetTTL:     time.Hour,
+	EmailVerificationTTL: 24 * time.Hour,
+	PasswordResetURL:     "https://kgym.test/reset-password",
+	EmailVerificationURL: "https://kgym.test/verify-email?lang=en",
+}
+
+var testUser = usermodel.User{
+	ID:    "user-123",
+	Email: "user@example.com",
+	Role:  usermodel.RoleUser,
+}
+
+type testDeps struct {
+	actionTokenRepo *actiontokenmocks.MockIRepository
+	tokenRepo       *tokenmocks.MockIRepository
+	userRepo        *usermocks.MockIRepository
+	sessionRepo     *sessionmocks.MockIRepository
+	mailer          *mailer.Memory
+}
+
+func newTestService(ctrl *gomock.Controller) (*Service, testDeps) {
+	deps := testDeps{
+		actionTokenRepo: actiontokenmocks.NewMockIRepository(ctrl),
+		tokenRepo:       tokenmocks.NewMockIRepository(ctrl),
+		userRepo:        usermocks.NewMockIRepository(ctrl),
+		sessionRepo:     sessionmocks.NewMockIRepository(ctrl),
+		mailer:          mailer.NewMemory(),
+	}
+
+	sessionService := sessionservice.NewService(deps.sessionRepo, deps.tokenRepo)
+
+	return NewService(testConfig, deps.actionTokenRepo, deps.tokenRepo, deps.userRepo, sessionService, deps.mailer), deps
+}
+
+// tokenFromMessage extracts the token from the link in a mailed message.
+func tokenFromMessage(t *testing.T, msg mailer.Message, base string) string {
+	t.Helper()
+
+	for _, field := range strings.Fields(msg.Body) {
+		if !strings.HasPrefix(field, strings.Split(base, "?")[0]) {
+			continue
+		}
+
+		u, err := url.Parse(field)
+		require.NoError(t, err)
+
+		return u.Query().Get("token")
+	}
+
+	require.FailNow(t, "link is missing")
+	return ""
+}
+
+func TestService_RequestPasswordReset(t *testing.T) {
+	t.Run("should mail reset link with hashed token stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		var stored actiontokenentity.ActionToken
+
+		deps.userRepo.EXPECT().GetByEmail(ctx, testUser.Email).Return(testUser, nil)
+		deps.actionTokenRepo.EXPECT().
+			Invalidate(ctx, testUser.ID, actiontokenentity.PurposePasswordReset, gomock.Any()).
+			Return(nil)
+		deps.actionTokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token actiontokenentity.ActionToken) error {
+				stored = token
+				return nil
+			})
+
+		err := service.RequestPasswordReset(ctx, RequestPasswordResetRequest{Email: testUser.Email})
+		require.NoError(t, err)
+
+		msg, ok := deps.mailer.Last(testUser.Email)
+		require.True(t, ok)
+
+		token := tokenFromMessage(t, msg, testConfig.PasswordResetURL)
+		require.NotEmpty(t, token)
+		assert.Equal(t, tokenentity.Hash(token), stored.TokenHash)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.WithinDuration(t, time.Now().Add(testConfig.PasswordResetTTL), stored.ExpiresAt, time.Second)
+	})
+
+	t.Run("should not reveal unknown email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().
+			GetByEmail(ctx, "missing@example.com").
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+
+		err := service.RequestPasswordReset(ctx, RequestPasswordResetRequest{Email: "missing@example.com"})
+		assert.NoError(t, err)
+		assert.Empty(t, deps.mailer.Messages())
+	})
+}
+
+func TestService_ResetPassword(t *testing.T) {
+	t.Run("should set password and revoke all refresh tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.actionTokenRepo.EXPECT().
+			Consume(ctx, actiontokenentity.PurposePasswordReset, tokenentity.Hash("reset-token"), gomock.Any()).
+			Return(actiontokenentity.ActionToken{UserID: testUser.ID, Purpose: actiontokenentity.PurposePasswordReset}, nil)
+		deps.userRepo.EXPECT().UpdatePassword(ctx, testUser.ID, "new-password").Return(nil)
+		deps.sessionRepo.EXPECT().ListActive(ctx, testUser.ID).Return(nil, nil)
+		deps.tokenRepo.EXPECT().RevokeSubject(ctx, testUser.ID).Return(nil)
+
+		err := service.ResetPassword(ctx, ResetPasswordRequest{
+			Token:    "reset-token",
+			Password: "new-password",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject used or expired token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.actionTokenRepo.EXPECT().
+			Consume(ctx, actiontokenentity.PurposePasswordReset, gomock.Any(), gomock.Any()).
+			Return(actiontokenentity.ActionToken{}, actiontokenrepo.ErrTokenNotFound)
+
+		err := service.ResetPassword(ctx, ResetPasswordRequest{
+			Token:    "reset-token",
+			Password: "new-password",
+		})
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("should keep token when password is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, _ := newTestService(ctrl)
+
+		err := service.ResetPassword(context.Background(), ResetPasswordRequest{
+			Token:    "reset-token",
+			Password: "short",
+		})
+		assert.ErrorIs(t, err, ErrInvalidPassword)
+	})
+}
+
+func TestService_SendVerificationEmail(t *testing.T) {
+	t.Run("should mail verification link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+		deps.actionTokenRepo.EXPECT().
+			Invalidate(ctx, testUser.ID, actiontokenentity.PurposeEmailVerification, gomock.Any()).
+			Return(nil)
+		deps.actionTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		err := service.SendVerificationEmail(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		msg, ok := deps.mailer.Last(testUser.Email)
+		require.True(t, ok)
+		assert.Contains(t, msg.Body, "lang=en")
+		assert.NotEmpty(t, tokenFromMessage(t, msg, testConfig.EmailVerificationURL))
+	})
+
+	t.Run("should reject verified email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		verified := testUser
+		verified.EmailVerified = true
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(verified, nil)
+
+		err := service.SendVerificationEmail(ctx, testUser.ID)
+		assert.ErrorIs(t, err, ErrEmailAlreadyVerified)
+		assert.Empty(t, deps.mailer.Messages())
+	})
+}
+
+func TestService_VerifyEmail(t *testing.T) {
+	t.Run("should mark email verified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.actionTokenRepo.EXPECT().
+			Consume(ctx, actiontokenentity.PurposeEmailVerification, tokenentity.Hash("verify-token"), gomock.Any()).
+			Return(actiontokenentity.ActionToken{UserID: testUser.ID}, nil)
+		deps.userRepo.EXPECT().MarkEmailVerified(ctx, testUser.ID).Return(nil)
+
+		err := service.VerifyEmail(ctx, "verify-token")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject empty token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, _ := newTestService(ctrl)
+
+		err := service.VerifyEmail(context.Background(), "")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidToken is returned for unknown, used and expired tokens alike.
+	ErrInvalidToken         = errors.New("invalid token")
+	ErrInvalidPassword      = errors.New("invalid password")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
+
+const (
+	ActionTokenLength = 32
+
+	// MinPasswordLength and MaxPasswordLength match the limits of the user
+	// service.
+	MinPasswordLength = 8
+	MaxPasswordLength = 64
+
+	SecurityEventPasswordResetRequested = "password_reset_requested"
+	SecurityEventPasswordReset          = "password_reset"
+	SecurityEventEmailVerified          = "email_verified"
+)
+
+type Config struct {
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	// PasswordResetURL and EmailVerificationURL are the pages mailed links
+	// point to. The token is added as the token query parameter.
+	PasswordResetURL     string
+	EmailVerificationURL string
+}
+
+type IService interface {
+	// RequestPasswordReset mails a password reset link. Unknown addresses are
+	// not reported, so that the endpoint cannot be used to find accounts.
+	RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) error
+	// ResetPassword sets a new password and signs the user out everywhere.
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+	SendVerificationEmail(ctx context.Context, userID string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type (
+	RequestPasswordResetRequest struct {
+		Email string
+	}
+
+	ResetPasswordRequest struct {
+		Token    string
+		Password string
+	}
+)
@@ -0,0 +1,226 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	apikeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const maxNameLength = 255
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	apiKeyRepository apikeyrepo.IRepository
+	clientRepository clientrepo.IRepository
+}
+
+func NewService(apiKeyRepository apikeyrepo.IRepository, clientRepository clientrepo.IRepository) *Service {
+	return &Service{
+		apiKeyRepository: apiKeyRepository,
+		clientRepository: clientRepository,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (CreateResponse, error) {
+	if req.Owner.Type == apikeyentity.OwnerTypeClient && req.ClientID == "" {
+		req.ClientID = req.Owner.ID
+	}
+	if req.TTL == 0 {
+		req.TTL = DefaultTTL
+	}
+
+	if err := validateCreateRequest(req); err != nil {
+		return CreateResponse{}, err
+	}
+
+	client, err := s.clientRepository.GetByID(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return CreateResponse{}, errors.Wrapf(ErrInvalidKeyRequest, "unknown client %q", req.ClientID)
+		}
+		return CreateResponse{}, err
+	}
+
+	if client.Disabled || !client.AllowsGrantType(cliententity.GrantTypeAPIKey) {
+		return CreateResponse{}, errors.Wrapf(ErrInvalidKeyRequest, "client %q does not accept api keys", req.ClientID)
+	}
+
+	for _, scope := range req.Scopes {
+		if !slices.Contains(client.Scopes, scope) {
+			return CreateResponse{}, errors.Wrapf(ErrInvalidKeyRequest, "scope %q is not registered for the client", scope)
+		}
+	}
+
+	prefix, err := randomHex(PrefixLength)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+
+	secret, err := randomSecret(SecretLength)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+
+	now := time.Now()
+	key := apikeyentity.Key{
+		ID:         uuid.NewString(),
+		Name:       req.Name,
+		Prefix:     PrefixTag + prefix,
+		SecretHash: tokenentity.Hash(secret),
+		Owner:      req.Owner,
+		ClientID:   client.ID,
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(req.TTL),
+	}
+
+	if err := s.apiKeyRepository.Create(ctx, key); err != nil {
+		return CreateResponse{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventKeyCreated).
+		Str("api_key_id", key.ID).
+		Str("prefix", key.Prefix).
+		Str("owner_type", string(key.Owner.Type)).
+		Str("owner_id", key.Owner.ID).
+		Str("client_id", key.ClientID).
+		Time("expires_at", key.ExpiresAt).
+		Msg("api key created")
+
+	return CreateResponse{
+		Key:    key,
+		APIKey: key.Prefix + Separator + secret,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, owner apikeyentity.Owner) ([]apikeyentity.Key, error) {
+	return s.apiKeyRepository.ListByOwner(ctx, owner)
+}
+
+func (s *Service) Revoke(ctx context.Context, req RevokeRequest) error {
+	key, err := s.apiKeyRepository.GetByID(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, apikeyrepo.ErrKeyNotFound) {
+			return ErrKeyNotFound
+		}
+		return err
+	}
+
+	// Keys of other owners are reported as missing rather than forbidden, so
+	// that their ids cannot be probed.
+	if req.Owner != (apikeyentity.Owner{}) && key.Owner != req.Owner {
+		return ErrKeyNotFound
+	}
+
+	if err := s.apiKeyRepository.Revoke(ctx, key.ID); err != nil {
+		if errors.Is(err, apikeyrepo.ErrKeyNotFound) {
+			return ErrKeyNotFound
+		}
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventKeyRevoked).
+		Str("api_key_id", key.ID).
+		Str("prefix", key.Prefix).
+		Str("owner_type", string(key.Owner.Type)).
+		Str("owner_id", key.Owner.ID).
+		Msg("api key revoked")
+
+	return nil
+}
+
+func (s *Service) Verify(ctx context.Context, apiKey string) (apikeyentity.Key, error) {
+	prefix, secret, ok := strings.Cut(apiKey, Separator)
+	if !ok || !strings.HasPrefix(prefix, PrefixTag) || secret == "" {
+		return apikeyentity.Key{}, ErrInvalidKey
+	}
+
+	key, err := s.apiKeyRepository.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, apikeyrepo.ErrKeyNotFound) {
+			return apikeyentity.Key{}, ErrInvalidKey
+		}
+		return apikeyentity.Key{}, err
+	}
+
+	now := time.Now()
+
+	if !key.VerifySecret(secret) || !key.Active(now) {
+		return apikeyentity.Key{}, ErrInvalidKey
+	}
+
+	if err := s.apiKeyRepository.RecordUse(ctx, key.ID, now); err != nil {
+		return apikeyentity.Key{}, err
+	}
+	key.LastUsedAt = now
+
+	return key, nil
+}
+
+func validateCreateRequest(req CreateRequest) error {
+	if req.Name == "" || len(req.Name) > maxNameLength {
+		return errors.Wrap(ErrInvalidKeyRequest, "name is required and must not exceed 255 characters")
+	}
+
+	switch req.Owner.Type {
+	case apikeyentity.OwnerTypeUser:
+	case apikeyentity.OwnerTypeClient:
+		if req.ClientID != req.Owner.ID {
+			return errors.Wrap(ErrInvalidKeyRequest, "keys owned by a client are issued to it")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidKeyRequest, "unsupported owner type %q", req.Owner.Type)
+	}
+
+	if req.Owner.ID == "" {
+		return errors.Wrap(ErrInvalidKeyRequest, "owner is required")
+	}
+
+	if req.ClientID == "" {
+		return errors.Wrap(ErrInvalidKeyRequest, "client is required")
+	}
+
+	if len(req.Scopes) == 0 {
+		return errors.Wrap(ErrInvalidKeyRequest, "at least one scope is required")
+	}
+
+	if req.TTL < 0 || req.TTL > MaxTTL {
+		return errors.Wrap(ErrInvalidKeyRequest, "ttl must be positive and at most 365 days")
+	}
+
+	return nil
+}
+
+func randomHex(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func randomSecret(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,324 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	apikeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey"
+	apikeymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey/mocks"
+	clientmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testOwner = apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: "user-123"}
+
+func newTestClient() cliententity.Client {
+	return cliententity.Client{
+		ID:         "client-123",
+		GrantTypes: []string{cliententity.GrantTypeAPIKey},
+		Scopes:     []string{"profile", "bookings:read"},
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("should create key with hashed secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient(), nil)
+
+		var stored apikeyentity.Key
+		apiKeyRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, key apikeyentity.Key) error {
+				stored = key
+				return nil
+			})
+
+		resp, err := service.Create(ctx, CreateRequest{
+			Name:     "front desk",
+			Owner:    testOwner,
+			ClientID: "client-123",
+			Scopes:   []string{"profile", "bookings:read", "profile"},
+		})
+		require.NoError(t, err)
+
+		prefix, secret, ok := strings.Cut(resp.APIKey, Separator)
+		require.True(t, ok)
+		assert.True(t, strings.HasPrefix(prefix, PrefixTag))
+		assert.Equal(t, prefix, stored.Prefix)
+		assert.Equal(t, tokenentity.Hash(secret), stored.SecretHash)
+		assert.NotContains(t, stored.SecretHash, secret)
+		assert.Equal(t, testOwner, stored.Owner)
+		assert.Equal(t, []string{"bookings:read", "profile"}, stored.Scopes)
+		assert.WithinDuration(t, time.Now().Add(DefaultTTL), stored.ExpiresAt, time.Minute)
+		assert.Equal(t, stored, resp.Key)
+	})
+
+	t.Run("should issue keys owned by client to the client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient(), nil)
+
+		apiKeyRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.Create(ctx, CreateRequest{
+			Name:   "front desk",
+			Owner:  apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: "client-123"},
+			Scopes: []string{"bookings:read"},
+			TTL:    time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "client-123", resp.Key.ClientID)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), resp.Key.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should reject invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			client func(*cliententity.Client)
+			req    func(*CreateRequest)
+		}{
+			{name: "missing name", req: func(req *CreateRequest) { req.Name = "" }},
+			{name: "missing scopes", req: func(req *CreateRequest) { req.Scopes = nil }},
+			{name: "ttl too long", req: func(req *CreateRequest) { req.TTL = MaxTTL + time.Hour }},
+			{name: "unknown owner type", req: func(req *CreateRequest) { req.Owner.Type = "team" }},
+			{name: "client owner issued to other client", req: func(req *CreateRequest) {
+				req.Owner = apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: "client-456"}
+			}},
+			{name: "unregistered scope", req: func(req *CreateRequest) { req.Scopes = []string{"admin"} }},
+			{name: "client without grant", client: func(client *cliententity.Client) {
+				client.GrantTypes = []string{cliententity.GrantTypePassword}
+			}},
+			{name: "disabled client", client: func(client *cliententity.Client) { client.Disabled = true }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				clientRepo := clientmocks.NewMockIRepository(ctrl)
+				service := NewService(apikeymocks.NewMockIRepository(ctrl), clientRepo)
+
+				client := newTestClient()
+				if tt.client != nil {
+					tt.client(&client)
+				}
+				clientRepo.EXPECT().
+					GetByID(gomock.Any(), "client-123").
+					Return(client, nil).
+					MaxTimes(1)
+
+				req := CreateRequest{
+					Name:     "front desk",
+					Owner:    testOwner,
+					ClientID: "client-123",
+					Scopes:   []string{"profile"},
+				}
+				if tt.req != nil {
+					tt.req(&req)
+				}
+
+				_, err := service.Create(context.Background(), req)
+				assert.ErrorIs(t, err, ErrInvalidKeyRequest)
+			})
+		}
+	})
+}
+
+func TestService_Verify(t *testing.T) {
+	newKey := func(t *testing.T, modify func(*apikeyentity.Key)) (apikeyentity.Key, string) {
+		t.Helper()
+
+		key := apikeyentity.Key{
+			ID:         "key-123",
+			Prefix:     PrefixTag + "0123456789abcdef",
+			SecretHash: tokenentity.Hash("secret"),
+			Owner:      testOwner,
+			ClientID:   "client-123",
+			Scopes:     []string{"profile"},
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		if modify != nil {
+			modify(&key)
+		}
+
+		return key, key.Prefix + Separator + "secret"
+	}
+
+	t.Run("should verify key and record its use", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+		key, apiKey := newKey(t, nil)
+
+		apiKeyRepo.EXPECT().
+			GetByPrefix(ctx, key.Prefix).
+			Return(key, nil)
+		apiKeyRepo.EXPECT().
+			RecordUse(ctx, key.ID, gomock.Any()).
+			Return(nil)
+
+		got, err := service.Verify(ctx, apiKey)
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, got.ID)
+		assert.False(t, got.LastUsedAt.IsZero())
+	})
+
+	t.Run("should reject keys that cannot be used", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(*apikeyentity.Key)
+			apiKey func(apiKey string) string
+		}{
+			{name: "wrong secret", apiKey: func(apiKey string) string { return apiKey + "x" }},
+			{name: "revoked", modify: func(key *apikeyentity.Key) { key.RevokedAt = time.Now() }},
+			{name: "expired", modify: func(key *apikeyentity.Key) { key.ExpiresAt = time.Now().Add(-time.Second) }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+				service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+				key, apiKey := newKey(t, tt.modify)
+				if tt.apiKey != nil {
+					apiKey = tt.apiKey(apiKey)
+				}
+
+				apiKeyRepo.EXPECT().
+					GetByPrefix(gomock.Any(), key.Prefix).
+					Return(key, nil)
+
+				_, err := service.Verify(context.Background(), apiKey)
+				assert.ErrorIs(t, err, ErrInvalidKey)
+			})
+		}
+	})
+
+	t.Run("should reject unknown and malformed keys", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		apiKeyRepo.EXPECT().
+			GetByPrefix(ctx, PrefixTag+"unknown").
+			Return(apikeyentity.Key{}, apikeyrepo.ErrKeyNotFound)
+
+		_, err := service.Verify(ctx, PrefixTag+"unknown"+Separator+"secret")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+
+		for _, apiKey := range []string{"", "secret", "other_prefix.secret", PrefixTag + "prefix."} {
+			_, err := service.Verify(ctx, apiKey)
+			assert.ErrorIs(t, err, ErrInvalidKey, apiKey)
+		}
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	key := apikeyentity.Key{ID: "key-123", Owner: testOwner}
+
+	t.Run("should revoke key of owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		apiKeyRepo.EXPECT().GetByID(ctx, key.ID).Return(key, nil)
+		apiKeyRepo.EXPECT().Revoke(ctx, key.ID).Return(nil)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: key.ID, Owner: testOwner})
+		require.NoError(t, err)
+	})
+
+	t.Run("should let admins revoke any key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		apiKeyRepo.EXPECT().GetByID(ctx, key.ID).Return(key, nil)
+		apiKeyRepo.EXPECT().Revoke(ctx, key.ID).Return(nil)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: key.ID})
+		require.NoError(t, err)
+	})
+
+	t.Run("should not revoke key of another owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		apiKeyRepo.EXPECT().GetByID(ctx, key.ID).Return(key, nil)
+
+		err := service.Revoke(ctx, RevokeRequest{
+			ID:    key.ID,
+			Owner: apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: "user-456"},
+		})
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("should not revoke key twice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+		service := NewService(apiKeyRepo, clientmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		apiKeyRepo.EXPECT().GetByID(ctx, key.ID).Return(key, nil)
+		apiKeyRepo.EXPECT().Revoke(ctx, key.ID).Return(apikeyrepo.ErrKeyNotFound)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: key.ID, Owner: testOwner})
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
@@ -0,0 +1,72 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrKeyNotFound = errors.New("api key not found")
+	// ErrInvalidKey is returned for keys that are malformed, unknown,
+	// revoked or expired, or whose secret does not match.
+	ErrInvalidKey        = errors.New("invalid api key")
+	ErrInvalidKeyRequest = errors.New("invalid api key request")
+)
+
+const (
+	// PrefixTag starts every key, so that leaked keys are easy to spot.
+	PrefixTag = "kgym_"
+	// Separator separates the prefix of a key from its secret.
+	Separator = "."
+
+	PrefixLength = 8
+	SecretLength = 32
+
+	DefaultTTL = 90 * 24 * time.Hour  // 90 days
+	MaxTTL     = 365 * 24 * time.Hour // 365 days
+
+	SecurityEventKeyCreated = "api_key_created"
+	SecurityEventKeyRevoked = "api_key_revoked"
+)
+
+type IService interface {
+	// Create issues a key. The secret is only returned here.
+	Create(ctx context.Context, req CreateRequest) (CreateResponse, error)
+	// List returns the keys of an owner that are not revoked, newest first.
+	List(ctx context.Context, owner apikeyentity.Owner) ([]apikeyentity.Key, error)
+	Revoke(ctx context.Context, req RevokeRequest) error
+	// Verify checks a key presented as prefix and secret, records its use
+	// and returns it.
+	Verify(ctx context.Context, apiKey string) (apikeyentity.Key, error)
+}
+
+type (
+	CreateRequest struct {
+		Name  string
+		Owner apikeyentity.Owner
+		// ClientID is the client tokens are issued to. It must allow the
+		// api_key grant, and is the owner itself for keys owned by a client.
+		ClientID string
+		// Scopes must all be registered for the client.
+		Scopes []string
+		// TTL is how long the key is valid. Zero means DefaultTTL.
+		TTL time.Duration
+	}
+
+	CreateResponse struct {
+		Key apikeyentity.Key
+		// APIKey is the prefix and secret to hand to the integration. It is
+		// not stored and cannot be retrieved again.
+		APIKey string
+	}
+)
+
+type RevokeRequest struct {
+	ID string
+	// Owner is the owner the key has to belong to. The zero value lets
+	// admins revoke any key.
+	Owner apikeyentity.Owner
+}
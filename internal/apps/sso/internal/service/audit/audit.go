@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	autheventrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	authEventRepository autheventrepo.IRepository
+}
+
+func NewService(authEventRepository autheventrepo.IRepository) *Service {
+	return &Service{
+		authEventRepository: authEventRepository,
+	}
+}
+
+func (s *Service) Record(ctx context.Context, event authevententity.Event) error {
+	event.ID = uuid.NewString()
+	// The database keeps microseconds, so page tokens are built from the
+	// stored time.
+	event.CreatedAt = time.Now().Truncate(time.Microsecond)
+	event.IP = truncate(event.IP, MaxIPLength)
+	event.UserAgent = truncate(event.UserAgent, MaxUserAgentLength)
+	event.Reason = truncate(event.Reason, MaxReasonLength)
+
+	return s.authEventRepository.Create(ctx, event)
+}
+
+func (s *Service) List(ctx context.Context, req ListRequest) (ListResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	var after *autheventrepo.Cursor
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return ListResponse{}, err
+		}
+		after = &cursor
+	}
+
+	// One more event than asked for tells whether there is a next page.
+	events, err := s.authEventRepository.List(ctx, autheventrepo.Filter{
+		Types:    req.Types,
+		Subject:  req.Subject,
+		ClientID: req.ClientID,
+		IP:       req.IP,
+		Outcome:  req.Outcome,
+		Since:    req.Since,
+		Until:    req.Until,
+	}, after, pageSize+1)
+	if err != nil {
+		return ListResponse{}, err
+	}
+
+	if len(events) <= pageSize {
+		return ListResponse{
+			Events: events,
+		}, nil
+	}
+
+	events = events[:pageSize]
+	last := events[len(events)-1]
+
+	return ListResponse{
+		Events: events,
+		NextPageToken: encodePageToken(autheventrepo.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}),
+	}, nil
+}
+
+// encodePageToken packs a cursor into an opaque token. Only the filters are
+// part of a request, so a token used with other filters still pages through
+// events older than the cursor.
+func encodePageToken(cursor autheventrepo.Cursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10) + ":" + cursor.ID
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (autheventrepo.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return autheventrepo.Cursor{}, ErrInvalidPageToken
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return autheventrepo.Cursor{}, ErrInvalidPageToken
+	}
+
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return autheventrepo.Cursor{}, ErrInvalidPageToken
+	}
+
+	if err := uuid.Validate(id); err != nil {
+		return autheventrepo.Cursor{}, ErrInvalidPageToken
+	}
+
+	return autheventrepo.Cursor{
+		CreatedAt: time.UnixMicro(createdAt),
+		ID:        id,
+	}, nil
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+
+	return string(runes[:length])
+}
@@ -0,0 +1,159 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	autheventrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent"
+	autheventmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestEvents(n int) []authevententity.Event {
+	now := time.Now().Truncate(time.Microsecond)
+
+	events := make([]authevententity.Event, 0, n)
+	for i := range n {
+		events = append(events, authevententity.Event{
+			ID:        uuid.NewString(),
+			Type:      authevententity.TypeLogin,
+			Subject:   "user-1",
+			Outcome:   authevententity.OutcomeSuccess,
+			CreatedAt: now.Add(-time.Duration(i) * time.Second),
+		})
+	}
+
+	return events
+}
+
+func TestService_Record(t *testing.T) {
+	t.Run("should record event with id, time and truncated details", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+		service := NewService(authEventRepo)
+
+		ctx := context.Background()
+
+		authEventRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, event authevententity.Event) error {
+				assert.NoError(t, uuid.Validate(event.ID))
+				assert.False(t, event.CreatedAt.IsZero())
+				assert.Equal(t, authevententity.TypeLogin, event.Type)
+				assert.Equal(t, "user-1", event.Subject)
+				assert.Len(t, event.UserAgent, MaxUserAgentLength)
+				assert.Len(t, event.Reason, MaxReasonLength)
+				return nil
+			})
+
+		err := service.Record(ctx, authevententity.Event{
+			Type:      authevententity.TypeLogin,
+			Subject:   "user-1",
+			UserAgent: strings.Repeat("a", 1000),
+			Outcome:   authevententity.OutcomeFailure,
+			Reason:    strings.Repeat("b", 1000),
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	t.Run("should apply default page size and pass filters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+		service := NewService(authEventRepo)
+
+		ctx := context.Background()
+		events := newTestEvents(3)
+
+		authEventRepo.EXPECT().
+			List(ctx, autheventrepo.Filter{
+				Types:   []authevententity.Type{authevententity.TypeLogin},
+				Subject: "user-1",
+				Outcome: authevententity.OutcomeSuccess,
+			}, nil, DefaultPageSize+1).
+			Return(events, nil)
+
+		resp, err := service.List(ctx, ListRequest{
+			Types:   []authevententity.Type{authevententity.TypeLogin},
+			Subject: "user-1",
+			Outcome: authevententity.OutcomeSuccess,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, events, resp.Events)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("should cap page size", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+		service := NewService(authEventRepo)
+
+		ctx := context.Background()
+
+		authEventRepo.EXPECT().
+			List(ctx, gomock.Any(), nil, MaxPageSize+1).
+			Return(nil, nil)
+
+		_, err := service.List(ctx, ListRequest{PageSize: 10 * MaxPageSize})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should continue after the last event of the previous page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+		service := NewService(authEventRepo)
+
+		ctx := context.Background()
+		events := newTestEvents(3)
+
+		authEventRepo.EXPECT().
+			List(ctx, gomock.Any(), nil, 3).
+			Return(events, nil)
+
+		first, err := service.List(ctx, ListRequest{PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, events[:2], first.Events)
+		require.NotEmpty(t, first.NextPageToken)
+
+		authEventRepo.EXPECT().
+			List(ctx, gomock.Any(), gomock.Any(), 3).
+			DoAndReturn(func(_ context.Context, _ autheventrepo.Filter, after *autheventrepo.Cursor, _ int) ([]authevententity.Event, error) {
+				require.NotNil(t, after)
+				assert.Equal(t, events[1].ID, after.ID)
+				assert.True(t, events[1].CreatedAt.Equal(after.CreatedAt))
+				return events[2:], nil
+			})
+
+		second, err := service.List(ctx, ListRequest{PageSize: 2, PageToken: first.NextPageToken})
+		require.NoError(t, err)
+		assert.Equal(t, events[2:], second.Events)
+		assert.Empty(t, second.NextPageToken)
+	})
+
+	t.Run("should reject invalid page tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := NewService(autheventmocks.NewMockIRepository(ctrl))
+
+		for _, token := range []string{"%%%", "bm90LWEtY3Vyc29y", encodePageToken(autheventrepo.Cursor{ID: "not-a-uuid"})} {
+			_, err := service.List(context.Background(), ListRequest{PageToken: token})
+			assert.ErrorIs(t, err, ErrInvalidPageToken, token)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+
+	// Limits of the details stored with an event. Longer values are cut
+	// off.
+	MaxIPLength        = 64
+	MaxUserAgentLength = 512
+	MaxReasonLength    = 255
+)
+
+type IService interface {
+	// Record appends an event to the audit trail. Its id and creation time
+	// are set here.
+	Record(ctx context.Context, event authevententity.Event) error
+	// List returns a page of the events matching the request, newest first.
+	List(ctx context.Context, req ListRequest) (ListResponse, error)
+}
+
+type (
+	// ListRequest filters the events listed. Zero fields match every event.
+	ListRequest struct {
+		Types    []authevententity.Type
+		Subject  string
+		ClientID string
+		IP       string
+		Outcome  authevententity.Outcome
+		Since    time.Time
+		Until    time.Time
+
+		// PageSize defaults to DefaultPageSize and is capped at MaxPageSize.
+		PageSize int
+		// PageToken is the NextPageToken of the previous page.
+		PageToken string
+	}
+
+	ListResponse struct {
+		Events []authevententity.Event
+		// NextPageToken is empty on the last page.
+		NextPageToken string
+	}
+)
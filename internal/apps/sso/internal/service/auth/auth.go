@@ -3,82 +3,408 @@ package auth
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/base64"
-	"encoding/hex"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
 	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	authcoderepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
 	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
 	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
 	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 )
 
 var _ IService = (*Service)(nil)
 
+var signingMethods = map[string]jwt.SigningMethod{
+	keyentity.AlgorithmRS256: jwt.SigningMethodRS256,
+	keyentity.AlgorithmES256: jwt.SigningMethodES256,
+	keyentity.AlgorithmEdDSA: jwt.SigningMethodEdDSA,
+}
+
+// failureReasons are the errors whose message is recorded as the reason of a
+// failed attempt. Any other error is recorded as an internal error, as its
+// message may leak details of the infrastructure.
+var failureReasons = []error{
+	ErrInvalidCredentials,
+	ErrInvalidRefreshToken,
+	ErrInvalidClient,
+	ErrUnauthorizedClient,
+	ErrInvalidScope,
+	ErrUnsupportedResponseType,
+	ErrInvalidRedirectURI,
+	ErrInvalidCodeChallenge,
+	ErrInvalidAuthorizationCode,
+	ErrMFAEnrollmentRequired,
+	ErrUnsupportedTokenType,
+	ErrImpersonationNotAllowed,
+	ErrInvalidRequestedSubject,
+	ErrInvalidDPoPProof,
+	throttleservice.ErrLocked,
+	throttleservice.ErrThrottled,
+	mfaservice.ErrInvalidCode,
+	mfaservice.ErrInvalidChallenge,
+	mfaservice.ErrTooManyAttempts,
+	passwordlessservice.ErrInvalidChallenge,
+	passwordlessservice.ErrInvalidCode,
+	passwordlessservice.ErrTooManyAttempts,
+	federationservice.ErrUnknownProvider,
+	federationservice.ErrInvalidState,
+	federationservice.ErrMissingEmail,
+	federationservice.ErrAccountConflict,
+	deviceservice.ErrInvalidUserCode,
+	deviceservice.ErrInvalidDeviceCode,
+	deviceservice.ErrAccessDenied,
+	deviceservice.ErrExpiredToken,
+	passkeyservice.ErrInvalidCeremony,
+	passkeyservice.ErrInvalidCredential,
+	apikeyservice.ErrInvalidKey,
+	oidcclient.ErrDiscovery,
+	oidcclient.ErrIssuerMismatch,
+	oidcclient.ErrExchange,
+	oidcclient.ErrMissingIDToken,
+	oidcclient.ErrInvalidIDToken,
+	oidcclient.ErrUnknownKey,
+}
+
 type Service struct {
-	userRepository  userrepo.IRepository
-	tokenRepository tokenrepo.IRepository
-	keyRepository   keyrepo.IRepository
+	cfg Config
+
+	userRepository     userrepo.IRepository
+	tokenRepository    tokenrepo.IRepository
+	keyRepository      keyrepo.IRepository
+	authCodeRepository authcoderepo.IRepository
+	clientRepository   clientrepo.IRepository
+
+	mfaService          mfaservice.IService
+	throttleService     throttleservice.IService
+	sessionService      sessionservice.IService
+	passwordlessService passwordlessservice.IService
+	federationService   federationservice.IService
+	deviceService       deviceservice.IService
+	passkeyService      passkeyservice.IService
+	apiKeyService       apikeyservice.IService
+	auditService        auditservice.IService
 }
 
-func NewService(userRepository userrepo.IRepository, tokenRepository tokenrepo.IRepository, keyRepository keyrepo.IRepository) *Service {
+func NewService(cfg Config, userRepository userrepo.IRepository, tokenRepository tokenrepo.IRepository, keyRepository keyrepo.IRepository, authCodeRepository authcoderepo.IRepository, clientRepository clientrepo.IRepository, mfaService mfaservice.IService, throttleService throttleservice.IService, sessionService sessionservice.IService, passwordlessService passwordlessservice.IService, federationService federationservice.IService, deviceService deviceservice.IService, passkeyService passkeyservice.IService, apiKeyService apikeyservice.IService, auditService auditservice.IService) *Service {
 	return &Service{
-		userRepository:  userRepository,
-		tokenRepository: tokenRepository,
-		keyRepository:   keyRepository,
+		cfg:                cfg,
+		userRepository:     userRepository,
+		tokenRepository:    tokenRepository,
+		keyRepository:      keyRepository,
+		authCodeRepository: authCodeRepository,
+		clientRepository:   clientRepository,
+		mfaService:         mfaService,
+		throttleService:    throttleService,
+		sessionService:     sessionService,
+
+		passwordlessService: passwordlessService,
+		federationService:   federationService,
+		deviceService:       deviceService,
+		passkeyService:      passkeyService,
+		apiKeyService:       apiKeyService,
+		auditService:        auditService,
 	}
 }
 
-func (s *Service) PasswordGrant(ctx context.Context, req PasswordGrantRequest) (PasswordGrantResponse, error) {
-	user, err := s.userRepository.GetByEmail(ctx, req.Email)
+func (s *Service) PasswordGrant(ctx context.Context, req PasswordGrantRequest) (_ PasswordGrantResponse, err error) {
+	event := newEvent(authevententity.TypeLogin, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypePassword)
 	if err != nil {
 		return PasswordGrantResponse{}, err
 	}
 
-	if user.Password != req.Password {
-		return PasswordGrantResponse{}, ErrInvalidCredentials
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return PasswordGrantResponse{}, err
+	}
+
+	attempt := throttleservice.Attempt{
+		Email:    req.Email,
+		IP:       req.Device.IP,
+		ClientID: client.ID,
 	}
 
-	accessToken, err := s.issueAccessToken(ctx, user.ID, req.ClientID)
+	user, err := s.verifyPassword(ctx, req.Email, req.Password, attempt)
 	if err != nil {
 		return PasswordGrantResponse{}, err
 	}
 
-	refreshToken, err := s.issueRefreshToken(ctx, user.ID, req.ClientID)
-	if err != nil {
+	event.Subject = user.ID
+
+	// The one-time password is checked by the mfa_otp grant, which caps the
+	// attempts of each challenge.
+	if err := s.throttleService.RecordSuccess(ctx, attempt); err != nil {
 		return PasswordGrantResponse{}, err
 	}
 
-	return PasswordGrantResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return s.signIn(ctx, user, client, grantScopes(client, scopes, user.Role), req.Device, req.JKT)
 }
 
-func (s *Service) RefreshTokenGrant(ctx context.Context, req RefreshTokenGrantRequest) (RefreshTokenGrantResponse, error) {
-	token, err := s.tokenRepository.GetByTokenHash(ctx, req.RefreshToken)
+func (s *Service) MFAOTPGrant(ctx context.Context, req MFAOTPGrantRequest) (_ MFAOTPGrantResponse, err error) {
+	event := newEvent(authevententity.TypeLogin, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	// The grant completes a password or passwordless grant, so it is
+	// available to every client that may use either.
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypePassword, cliententity.GrantTypePasswordless)
+	if err != nil {
+		return MFAOTPGrantResponse{}, err
+	}
+
+	challenge, err := s.mfaService.CompleteChallenge(ctx, mfaservice.CompleteChallengeRequest{
+		Token:    req.MFAToken,
+		ClientID: client.ID,
+		Code:     req.OTP,
+	})
+	if err != nil {
+		return MFAOTPGrantResponse{}, err
+	}
+
+	event.Subject = challenge.UserID
+
+	user, err := s.userRepository.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		return MFAOTPGrantResponse{}, err
+	}
+
+	resp, err := s.issueUserTokens(ctx, user, client, grantScopes(client, challenge.Scopes, user.Role), "", req.Device, req.JKT)
 	if err != nil {
+		return MFAOTPGrantResponse{}, err
+	}
+
+	return MFAOTPGrantResponse(resp), nil
+}
+
+func (s *Service) PasswordlessGrant(ctx context.Context, req PasswordlessGrantRequest) (_ PasswordlessGrantResponse, err error) {
+	event := newEvent(authevententity.TypeLogin, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypePasswordless)
+	if err != nil {
+		return PasswordlessGrantResponse{}, err
+	}
+
+	challenge, err := s.passwordlessService.Complete(ctx, passwordlessservice.CompleteRequest{
+		Token:    req.Token,
+		Code:     req.Code,
+		Link:     req.Link,
+		ClientID: client.ID,
+	})
+	if err != nil {
+		return PasswordlessGrantResponse{}, err
+	}
+
+	event.Subject = challenge.UserID
+
+	user, err := s.userRepository.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		return PasswordlessGrantResponse{}, err
+	}
+
+	resp, err := s.signIn(ctx, user, client, grantScopes(client, challenge.Scopes, user.Role), req.Device, req.JKT)
+	if err != nil {
+		return PasswordlessGrantResponse{}, err
+	}
+
+	return PasswordlessGrantResponse(resp), nil
+}
+
+func (s *Service) DeviceCodeGrant(ctx context.Context, req DeviceCodeGrantRequest) (_ DeviceCodeGrantResponse, err error) {
+	event := newEvent(authevententity.TypeDeviceCode, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeDeviceCode)
+	if err != nil {
+		return DeviceCodeGrantResponse{}, err
+	}
+
+	authorization, err := s.deviceService.Poll(ctx, deviceservice.PollRequest{
+		DeviceCode: req.DeviceCode,
+		ClientID:   client.ID,
+	})
+	if err != nil {
+		return DeviceCodeGrantResponse{}, err
+	}
+
+	event.Subject = authorization.UserID
+
+	user, err := s.userRepository.GetByID(ctx, authorization.UserID)
+	if err != nil {
+		return DeviceCodeGrantResponse{}, err
+	}
+
+	// The user presented their second factor when approving the device,
+	// which has no way to ask for one.
+	resp, err := s.issueUserTokens(ctx, user, client, grantScopes(client, authorization.Scopes, user.Role), "", req.Device, req.JKT)
+	if err != nil {
+		return DeviceCodeGrantResponse{}, err
+	}
+
+	return DeviceCodeGrantResponse(resp), nil
+}
+
+func (s *Service) WebAuthnGrant(ctx context.Context, req WebAuthnGrantRequest) (_ WebAuthnGrantResponse, err error) {
+	event := newEvent(authevententity.TypeLogin, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeWebAuthn)
+	if err != nil {
+		return WebAuthnGrantResponse{}, err
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return WebAuthnGrantResponse{}, err
+	}
+
+	credential, err := s.passkeyService.FinishLogin(ctx, passkeyservice.FinishLoginRequest{
+		Token:      req.Token,
+		ClientID:   client.ID,
+		Credential: req.Credential,
+	})
+	if err != nil {
+		return WebAuthnGrantResponse{}, err
+	}
+
+	event.Subject = credential.UserID
+
+	user, err := s.userRepository.GetByID(ctx, credential.UserID)
+	if err != nil {
+		return WebAuthnGrantResponse{}, err
+	}
+
+	// Passkeys require user verification, so the device the key lives on
+	// and the biometric or PIN that unlocks it are two factors already.
+	resp, err := s.issueUserTokens(ctx, user, client, grantScopes(client, scopes, user.Role), "", req.Device, req.JKT)
+	if err != nil {
+		return WebAuthnGrantResponse{}, err
+	}
+
+	return WebAuthnGrantResponse(resp), nil
+}
+
+func (s *Service) RefreshTokenGrant(ctx context.Context, req RefreshTokenGrantRequest) (_ RefreshTokenGrantResponse, err error) {
+	event := newEvent(authevententity.TypeTokenRefresh, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeRefreshToken)
+	if err != nil {
+		return RefreshTokenGrantResponse{}, err
+	}
+
+	tokenHash := tokenentity.Hash(req.RefreshToken)
+
+	token, err := s.tokenRepository.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, tokenrepo.ErrTokenNotFound) {
+			return RefreshTokenGrantResponse{}, ErrInvalidRefreshToken
+		}
 		return RefreshTokenGrantResponse{}, err
 	}
 
-	if token.Revoked || token.ExpiresAt.Before(time.Now()) {
+	event.Subject = token.Subject
+
+	// A refresh token is bound to the client it was issued to.
+	if token.ClientID != client.ID {
+		return RefreshTokenGrantResponse{}, ErrInvalidRefreshToken
+	}
+
+	if token.Revoked || token.UsedAt != nil {
+		return RefreshTokenGrantResponse{}, s.handleRefreshTokenReuse(ctx, token, req.Device)
+	}
+
+	if token.ExpiresAt.Before(time.Now()) {
 		return RefreshTokenGrantResponse{}, ErrInvalidRefreshToken
 	}
 
-	err = s.tokenRepository.Revoke(ctx, req.RefreshToken)
+	// A bound refresh token is checked before it is marked used, so that
+	// someone who stole it without the key cannot burn it either.
+	if token.JKT != nil && *token.JKT != req.JKT {
+		return RefreshTokenGrantResponse{}, ErrInvalidDPoPProof
+	}
+
+	// The access token may be narrowed to fewer scopes, but never widened
+	// beyond what the refresh token was granted.
+	scopes := token.Scopes
+	if len(req.Scopes) > 0 {
+		for _, scope := range req.Scopes {
+			if !slices.Contains(token.Scopes, scope) {
+				return RefreshTokenGrantResponse{}, ErrInvalidScope
+			}
+		}
+		scopes = req.Scopes
+	}
+
+	err = s.tokenRepository.MarkUsed(ctx, tokenHash)
 	if err != nil {
+		if errors.Is(err, tokenrepo.ErrTokenAlreadyUsed) {
+			return RefreshTokenGrantResponse{}, s.handleRefreshTokenReuse(ctx, token, req.Device)
+		}
 		return RefreshTokenGrantResponse{}, err
 	}
 
-	access, err := s.issueAccessToken(ctx, token.Subject, token.ClientID)
+	// The role is looked up again so that a change of role takes effect on
+	// the next refresh rather than when the token family expires.
+	user, err := s.userRepository.GetByID(ctx, token.Subject)
 	if err != nil {
 		return RefreshTokenGrantResponse{}, err
 	}
 
-	refresh, err := s.issueRefreshToken(ctx, token.Subject, token.ClientID)
+	scopes = grantScopes(client, scopes, user.Role)
+
+	// The token family is the session the user signed in with.
+	err = s.sessionService.Touch(ctx, sessionservice.TouchRequest{
+		ID: token.FamilyID,
+		IP: req.Device.IP,
+	})
+	if err != nil {
+		return RefreshTokenGrantResponse{}, err
+	}
+
+	access, err := s.issueAccessToken(ctx, user.ID, user.Role, client, scopes, token.FamilyID, req.JKT)
+	if err != nil {
+		return RefreshTokenGrantResponse{}, err
+	}
+
+	// Per RFC 6749 section 6, the new refresh token keeps the scopes of the
+	// one it replaces, even when the access token was narrowed.
+	refresh, err := s.issueRefreshToken(ctx, tokenentity.Token{
+		Subject:  token.Subject,
+		ClientID: token.ClientID,
+		FamilyID: token.FamilyID,
+		ParentID: token.ID,
+		Scopes:   token.Scopes,
+		JKT:      refreshTokenKey(client, req.JKT),
+	}, client)
 	if err != nil {
 		return RefreshTokenGrantResponse{}, err
 	}
@@ -86,52 +412,1057 @@ func (s *Service) RefreshTokenGrant(ctx context.Context, req RefreshTokenGrantRe
 	return RefreshTokenGrantResponse{
 		AccessToken:  access,
 		RefreshToken: refresh,
+		Scopes:       scopes,
 	}, nil
 }
 
-func (s *Service) issueAccessToken(ctx context.Context, subject, clientID string) (string, error) {
-	key, err := s.keyRepository.GetCurrentSigningKey(ctx)
+func (s *Service) AuthorizationCodeGrant(ctx context.Context, req AuthorizationCodeGrantRequest) (_ AuthorizationCodeGrantResponse, err error) {
+	event := newEvent(authevententity.TypeCodeExchange, req.ClientID, req.Device)
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeAuthorizationCode)
 	if err != nil {
-		return "", err
+		return AuthorizationCodeGrantResponse{}, err
+	}
+
+	code, err := s.authCodeRepository.Consume(ctx, tokenentity.Hash(req.Code))
+	if err != nil {
+		if errors.Is(err, authcoderepo.ErrAuthorizationCodeNotFound) {
+			return AuthorizationCodeGrantResponse{}, ErrInvalidAuthorizationCode
+		}
+		return AuthorizationCodeGrantResponse{}, err
+	}
+
+	event.Subject = code.Subject
+
+	if code.ExpiresAt.Before(time.Now()) {
+		return AuthorizationCodeGrantResponse{}, ErrInvalidAuthorizationCode
+	}
+
+	if code.ClientID != client.ID || code.RedirectURI != req.RedirectURI {
+		return AuthorizationCodeGrantResponse{}, ErrInvalidAuthorizationCode
+	}
+
+	if !verifyCodeVerifier(req.CodeVerifier, code.CodeChallenge) {
+		return AuthorizationCodeGrantResponse{}, ErrInvalidAuthorizationCode
+	}
+
+	user, err := s.userRepository.GetByID(ctx, code.Subject)
+	if err != nil {
+		return AuthorizationCodeGrantResponse{}, err
+	}
+
+	resp, err := s.issueUserTokens(ctx, user, client, grantScopes(client, code.Scopes, user.Role), code.Nonce, req.Device, req.JKT)
+	if err != nil {
+		return AuthorizationCodeGrantResponse{}, err
+	}
+
+	return AuthorizationCodeGrantResponse(resp), nil
+}
+
+func (s *Service) ClientCredentialsGrant(ctx context.Context, req ClientCredentialsGrantRequest) (_ ClientCredentialsGrantResponse, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeClientCredentials,
+		Subject:   req.ClientID,
+		ClientID:  req.ClientID,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeClientCredentials)
+	if err != nil {
+		return ClientCredentialsGrantResponse{}, err
+	}
+
+	// Only confidential clients can keep the secret this grant relies on.
+	if !client.Confidential {
+		return ClientCredentialsGrantResponse{}, ErrUnauthorizedClient
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return ClientCredentialsGrantResponse{}, err
+	}
+
+	// The client acts on its own behalf, so it is the subject of the token
+	// and has no role.
+	scopes = grantScopes(client, scopes, "")
+
+	accessToken, err := s.issueAccessToken(ctx, client.ID, "", client, scopes, "", req.JKT)
+	if err != nil {
+		return ClientCredentialsGrantResponse{}, err
+	}
+
+	return ClientCredentialsGrantResponse{
+		AccessToken: accessToken,
+		Scopes:      scopes,
+	}, nil
+}
+
+func (s *Service) TokenExchangeGrant(ctx context.Context, req TokenExchangeGrantRequest) (_ TokenExchangeGrantResponse, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeImpersonation,
+		Subject:   req.RequestedSubject,
+		Actor:     req.Actor.Subject,
+		ClientID:  req.ClientID,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeTokenExchange)
+	if err != nil {
+		return TokenExchangeGrantResponse{}, err
+	}
+
+	if req.SubjectTokenType != TokenTypeAccessToken {
+		return TokenExchangeGrantResponse{}, ErrUnsupportedTokenType
+	}
+
+	user, err := s.impersonatedUser(ctx, req)
+	if err != nil {
+		if errors.Is(err, ErrImpersonationNotAllowed) {
+			log.Warn().
+				Str("event", SecurityEventImpersonationDenied).
+				Str("actor", req.Actor.Subject).
+				Str("subject", req.RequestedSubject).
+				Str("client_id", client.ID).
+				Str("ip", req.IP).
+				Msg("impersonation denied")
+		}
+		return TokenExchangeGrantResponse{}, err
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return TokenExchangeGrantResponse{}, err
+	}
+
+	// The admin scope is never granted, whoever is impersonated.
+	scopes = grantScopes(client, scopes, "")
+
+	ttl := min(client.AccessTokenTTL, ImpersonationTokenTTL)
+	now := time.Now()
+
+	claims := AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.ID,
+			Audience:  jwt.ClaimStrings{client.ID},
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Scope:  strings.Join(scopes, " "),
+		Role:   string(user.Role),
+		Tenant: s.cfg.Tenant,
+		Actor: &ActorClaims{
+			Subject: req.Actor.Subject,
+		},
+	}
+
+	accessToken, err := s.signToken(ctx, claims)
+	if err != nil {
+		return TokenExchangeGrantResponse{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventImpersonation).
+		Str("actor", req.Actor.Subject).
+		Str("subject", user.ID).
+		Str("client_id", client.ID).
+		Str("token_id", claims.ID).
+		Str("scope", claims.Scope).
+		Str("ip", req.IP).
+		Time("expires_at", claims.ExpiresAt.Time).
+		Msg("impersonation token issued")
+
+	// There is no refresh token: impersonating someone for longer takes
+	// another exchange, which is audited again.
+	return TokenExchangeGrantResponse{
+		AccessToken:     accessToken,
+		IssuedTokenType: TokenTypeAccessToken,
+		Scopes:          scopes,
+	}, nil
+}
+
+func (s *Service) APIKeyGrant(ctx context.Context, req APIKeyGrantRequest) (_ APIKeyGrantResponse, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeAPIKey,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	key, err := s.apiKeyService.Verify(ctx, req.APIKey)
+	if err != nil {
+		return APIKeyGrantResponse{}, err
+	}
+	event.Subject = key.Owner.ID
+	event.ClientID = key.ClientID
+
+	client, err := s.enabledClient(ctx, key.ClientID)
+	if err != nil {
+		return APIKeyGrantResponse{}, err
+	}
+
+	if !client.AllowsGrantType(cliententity.GrantTypeAPIKey) {
+		return APIKeyGrantResponse{}, ErrUnauthorizedClient
+	}
+
+	// Keys of deleted users stop working even before they are revoked.
+	if key.Owner.Type == apikeyentity.OwnerTypeUser {
+		if _, err := s.userRepository.GetByID(ctx, key.Owner.ID); err != nil {
+			if errors.Is(err, userrepo.ErrUserNotFound) {
+				return APIKeyGrantResponse{}, apikeyservice.ErrInvalidKey
+			}
+			return APIKeyGrantResponse{}, err
+		}
+	}
+
+	scopes := key.Scopes
+	if len(req.Scopes) > 0 {
+		for _, scope := range req.Scopes {
+			if !slices.Contains(key.Scopes, scope) {
+				return APIKeyGrantResponse{}, ErrInvalidScope
+			}
+		}
+		scopes = req.Scopes
+	}
+
+	// Tokens of API keys are authorized by their scopes alone. They carry no
+	// role and never the admin scope, so a leaked key cannot act as an
+	// admin, and name the key, so that they cannot manage keys either.
+	scopes = grantScopes(client, scopes, "")
+
+	ttl := min(client.AccessTokenTTL, APIKeyTokenTTL)
+	now := time.Now()
+
+	accessToken, err := s.signToken(ctx, AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   key.Owner.ID,
+			Audience:  jwt.ClaimStrings{client.ID},
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Scope:    strings.Join(scopes, " "),
+		Tenant:   s.cfg.Tenant,
+		APIKeyID: key.ID,
+	})
+	if err != nil {
+		return APIKeyGrantResponse{}, err
+	}
+
+	return APIKeyGrantResponse{
+		AccessToken: accessToken,
+		Scopes:      scopes,
+	}, nil
+}
+
+func (s *Service) Authorize(ctx context.Context, req AuthorizeRequest) (_ AuthorizeResponse, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeLogin,
+		ClientID:  req.ClientID,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	client, scopes, err := s.validateAuthorizeRequest(ctx, req)
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	attempt := throttleservice.Attempt{
+		Email:    req.Email,
+		IP:       req.IP,
+		ClientID: client.ID,
+	}
+
+	user, err := s.verifyPassword(ctx, req.Email, req.Password, attempt)
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	event.Subject = user.ID
+
+	if err := s.verifySecondFactor(ctx, user, req.OTP, attempt); err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	if err := s.throttleService.RecordSuccess(ctx, attempt); err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	return s.issueAuthorizationCode(ctx, user, client, scopes, req)
+}
+
+func (s *Service) StartFederation(ctx context.Context, req StartFederationRequest) (StartFederationResponse, error) {
+	client, scopes, err := s.validateAuthorizeRequest(ctx, req.Authorization)
+	if err != nil {
+		return StartFederationResponse{}, err
+	}
+
+	resp, err := s.federationService.Start(ctx, federationservice.StartRequest{
+		Provider: req.Provider,
+		Authorization: federationentity.Authorization{
+			ClientID:            client.ID,
+			RedirectURI:         req.Authorization.RedirectURI,
+			Scopes:              scopes,
+			State:               req.Authorization.State,
+			Nonce:               req.Authorization.Nonce,
+			CodeChallenge:       req.Authorization.CodeChallenge,
+			CodeChallengeMethod: req.Authorization.CodeChallengeMethod,
+		},
+	})
+	if err != nil {
+		return StartFederationResponse{}, err
+	}
+
+	return StartFederationResponse{
+		AuthorizationURL: resp.AuthorizationURL,
+	}, nil
+}
+
+func (s *Service) CompleteFederation(ctx context.Context, req CompleteFederationRequest) (_ AuthorizeResponse, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeLogin,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	resp, err := s.federationService.Complete(ctx, federationservice.CompleteRequest{
+		State: req.State,
+		Code:  req.Code,
+	})
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	event.Subject = resp.User.ID
+	event.ClientID = resp.Authorization.ClientID
+
+	// The client is checked again, as it may have been changed while the
+	// user was signing in upstream.
+	authorization := AuthorizeRequest{
+		ClientID:            resp.Authorization.ClientID,
+		RedirectURI:         resp.Authorization.RedirectURI,
+		ResponseType:        ResponseTypeCode,
+		Scopes:              resp.Authorization.Scopes,
+		State:               resp.Authorization.State,
+		Nonce:               resp.Authorization.Nonce,
+		CodeChallenge:       resp.Authorization.CodeChallenge,
+		CodeChallengeMethod: resp.Authorization.CodeChallengeMethod,
+	}
+
+	client, scopes, err := s.validateAuthorizeRequest(ctx, authorization)
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	// There is no way to ask for a one-time password on the way back from
+	// the provider, so users who have to present one sign in with their
+	// password instead.
+	if err := s.verifySecondFactor(ctx, resp.User, "", throttleservice.Attempt{}); err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	return s.issueAuthorizationCode(ctx, resp.User, client, scopes, authorization)
+}
+
+func (s *Service) StartPasswordless(ctx context.Context, req StartPasswordlessRequest) (StartPasswordlessResponse, error) {
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypePasswordless)
+	if err != nil {
+		return StartPasswordlessResponse{}, err
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return StartPasswordlessResponse{}, err
+	}
+
+	resp, err := s.passwordlessService.Start(ctx, passwordlessservice.StartRequest{
+		Email:    req.Email,
+		ClientID: client.ID,
+		Scopes:   scopes,
+		IP:       req.IP,
+	})
+	if err != nil {
+		return StartPasswordlessResponse{}, err
+	}
+
+	return StartPasswordlessResponse{
+		Token:     resp.Token,
+		ExpiresIn: time.Until(resp.ExpiresAt).Round(time.Second),
+	}, nil
+}
+
+func (s *Service) StartDeviceAuthorization(ctx context.Context, req StartDeviceAuthorizationRequest) (StartDeviceAuthorizationResponse, error) {
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeDeviceCode)
+	if err != nil {
+		return StartDeviceAuthorizationResponse{}, err
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return StartDeviceAuthorizationResponse{}, err
+	}
+
+	resp, err := s.deviceService.Start(ctx, deviceservice.StartRequest{
+		ClientID: client.ID,
+		Scopes:   scopes,
+	})
+	if err != nil {
+		return StartDeviceAuthorizationResponse{}, err
+	}
+
+	return StartDeviceAuthorizationResponse{
+		DeviceCode:              resp.DeviceCode,
+		UserCode:                resp.UserCode,
+		VerificationURL:         resp.VerificationURL,
+		VerificationURLComplete: resp.VerificationURLComplete,
+		ExpiresIn:               time.Until(resp.ExpiresAt).Round(time.Second),
+		Interval:                resp.Interval,
+	}, nil
+}
+
+func (s *Service) StartPasskeyLogin(ctx context.Context, req StartPasskeyLoginRequest) (StartPasskeyLoginResponse, error) {
+	client, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret, cliententity.GrantTypeWebAuthn)
+	if err != nil {
+		return StartPasskeyLoginResponse{}, err
+	}
+
+	resp, err := s.passkeyService.BeginLogin(ctx, client.ID)
+	if err != nil {
+		return StartPasskeyLoginResponse{}, err
+	}
+
+	return StartPasskeyLoginResponse{
+		Token:     resp.Token,
+		Options:   resp.Options,
+		ExpiresIn: time.Until(resp.ExpiresAt).Round(time.Second),
+	}, nil
+}
+
+func (s *Service) AuthenticateClient(ctx context.Context, clientID, clientSecret string) (cliententity.Client, error) {
+	return s.authenticateClient(ctx, clientID, clientSecret)
+}
+
+func (s *Service) ServiceToken(ctx context.Context, audience string) (ServiceTokenResponse, error) {
+	now := time.Now()
+	expiresAt := now.Add(ServiceTokenTTL)
+
+	accessToken, err := s.signToken(ctx, AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   ServiceTokenSubject,
+			Audience:  jwt.ClaimStrings{audience},
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Scope:  ScopeService,
+		Tenant: s.cfg.Tenant,
+	})
+	if err != nil {
+		return ServiceTokenResponse{}, err
+	}
+
+	return ServiceTokenResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func (s *Service) GetDeviceAuthorization(ctx context.Context, userCode string) (DeviceAuthorization, error) {
+	authorization, err := s.deviceService.Lookup(ctx, userCode)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	client, err := s.enabledClient(ctx, authorization.ClientID)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	return DeviceAuthorization{
+		ClientID:   client.ID,
+		ClientName: client.Name,
+		Scopes:     authorization.Scopes,
+	}, nil
+}
+
+func (s *Service) AuthorizeDevice(ctx context.Context, req AuthorizeDeviceRequest) (_ DeviceAuthorization, err error) {
+	event := authevententity.Event{
+		Type:      authevententity.TypeLogin,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	defer func() { s.audit(ctx, event, err) }()
+
+	authorization, err := s.deviceService.Lookup(ctx, req.UserCode)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	event.ClientID = authorization.ClientID
+
+	client, err := s.enabledClient(ctx, authorization.ClientID)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	attempt := throttleservice.Attempt{
+		Email:    req.Email,
+		IP:       req.IP,
+		ClientID: client.ID,
+	}
+
+	user, err := s.verifyPassword(ctx, req.Email, req.Password, attempt)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	event.Subject = user.ID
+
+	if err := s.verifySecondFactor(ctx, user, req.OTP, attempt); err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	if err := s.throttleService.RecordSuccess(ctx, attempt); err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	_, err = s.deviceService.Decide(ctx, deviceservice.DecideRequest{
+		UserCode: req.UserCode,
+		UserID:   user.ID,
+		Approve:  req.Approve,
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	return DeviceAuthorization{
+		ClientID:   client.ID,
+		ClientName: client.Name,
+		Scopes:     authorization.Scopes,
+	}, nil
+}
+
+// verifyPassword checks the credentials of a user, unless too many attempts
+// failed recently. Failed attempts are counted against the account, the
+// address and the client they come from. The failures of the account are
+// kept until the caller records the success, once every factor passed.
+func (s *Service) verifyPassword(ctx context.Context, email, password string, attempt throttleservice.Attempt) (usermodel.User, error) {
+	if err := s.throttleService.Check(ctx, attempt); err != nil {
+		return usermodel.User{}, err
+	}
+
+	user, err := s.userRepository.VerifyPassword(ctx, email, password)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrInvalidCredentials) {
+			if err := s.throttleService.RecordFailure(ctx, attempt); err != nil {
+				return usermodel.User{}, err
+			}
+			return usermodel.User{}, ErrInvalidCredentials
+		}
+		return usermodel.User{}, err
+	}
+
+	return user, nil
+}
+
+// impersonatedUser checks that the actor of a token exchange may impersonate
+// the requested subject and returns that user. Only admins holding the admin
+// scope may impersonate, and only users who are not admins themselves.
+// Impersonation tokens cannot be exchanged again.
+func (s *Service) impersonatedUser(ctx context.Context, req TokenExchangeGrantRequest) (usermodel.User, error) {
+	if req.Actor.Actor != nil || req.Actor.Role != string(usermodel.RoleAdmin) || !slices.Contains(req.Actor.Scopes(), ScopeAdmin) {
+		return usermodel.User{}, ErrImpersonationNotAllowed
+	}
+
+	if req.RequestedSubject == "" || req.RequestedSubject == req.Actor.Subject {
+		return usermodel.User{}, ErrInvalidRequestedSubject
+	}
+
+	// The role in the token may be outdated.
+	actor, err := s.userRepository.GetByID(ctx, req.Actor.Subject)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return usermodel.User{}, ErrImpersonationNotAllowed
+		}
+		return usermodel.User{}, err
+	}
+	if actor.Role != usermodel.RoleAdmin {
+		return usermodel.User{}, ErrImpersonationNotAllowed
+	}
+
+	user, err := s.userRepository.GetByID(ctx, req.RequestedSubject)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return usermodel.User{}, ErrInvalidRequestedSubject
+		}
+		return usermodel.User{}, err
+	}
+	if user.Role == usermodel.RoleAdmin {
+		return usermodel.User{}, ErrImpersonationNotAllowed
+	}
+
+	return user, nil
+}
+
+// issueUserTokens starts a session for a user who signed in and issues its
+// tokens. The refresh token family shares the id of the session.
+func (s *Service) issueUserTokens(ctx context.Context, user usermodel.User, client cliententity.Client, scopes []string, nonce string, device sessionentity.Device, jkt string) (PasswordGrantResponse, error) {
+	sessionID := uuid.NewString()
+
+	err := s.sessionService.Start(ctx, sessionservice.StartRequest{
+		ID:       sessionID,
+		UserID:   user.ID,
+		ClientID: client.ID,
+		Device:   device,
+	})
+	if err != nil {
+		return PasswordGrantResponse{}, err
+	}
+
+	accessToken, err := s.issueAccessToken(ctx, user.ID, user.Role, client, scopes, sessionID, jkt)
+	if err != nil {
+		return PasswordGrantResponse{}, err
+	}
+
+	refreshToken, err := s.issueRefreshToken(ctx, tokenentity.Token{
+		Subject:  user.ID,
+		ClientID: client.ID,
+		FamilyID: sessionID,
+		Scopes:   scopes,
+		JKT:      refreshTokenKey(client, jkt),
+	}, client)
+	if err != nil {
+		return PasswordGrantResponse{}, err
+	}
+
+	var idToken string
+	if slices.Contains(scopes, ScopeOpenID) {
+		idToken, err = s.issueIDToken(ctx, user, client.ID, nonce)
+		if err != nil {
+			return PasswordGrantResponse{}, err
+		}
+	}
+
+	return PasswordGrantResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		IDToken:      idToken,
+		Scopes:       scopes,
+	}, nil
+}
+
+// signIn issues the tokens of a user who presented their first factor, or
+// hands out an MFA challenge if they have to present a second one.
+func (s *Service) signIn(ctx context.Context, user usermodel.User, client cliententity.Client, scopes []string, device sessionentity.Device, jkt string) (PasswordGrantResponse, error) {
+	status, err := s.mfaService.Status(ctx, user)
+	if err != nil {
+		return PasswordGrantResponse{}, err
+	}
+
+	if status.Required {
+		token, err := s.mfaService.CreateChallenge(ctx, mfaservice.CreateChallengeRequest{
+			UserID:   user.ID,
+			ClientID: client.ID,
+			Scopes:   scopes,
+		})
+		if err != nil {
+			return PasswordGrantResponse{}, err
+		}
+
+		return PasswordGrantResponse{}, &MFARequiredError{
+			Token:              token,
+			EnrollmentRequired: !status.Enrolled,
+		}
+	}
+
+	return s.issueUserTokens(ctx, user, client, scopes, "", device, jkt)
+}
+
+// verifySecondFactor checks the one-time password of a user who has to
+// present one. The authorization endpoint takes it together with the
+// credentials, as there is no challenge to hand out. Wrong one-time passwords
+// are counted against attempt like wrong passwords, as there is no challenge
+// to cap them either.
+func (s *Service) verifySecondFactor(ctx context.Context, user usermodel.User, otp string, attempt throttleservice.Attempt) error {
+	status, err := s.mfaService.Status(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if !status.Required {
+		return nil
+	}
+	if !status.Enrolled {
+		return ErrMFAEnrollmentRequired
+	}
+	if otp == "" {
+		return ErrMFARequired
+	}
+
+	err = s.mfaService.Verify(ctx, user.ID, otp)
+	if errors.Is(err, mfaservice.ErrInvalidCode) {
+		if err := s.throttleService.RecordFailure(ctx, attempt); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// validateAuthorizeRequest checks the parameters of an authorization request
+// and returns its client and requested scopes. The credentials of the user
+// are not checked.
+func (s *Service) validateAuthorizeRequest(ctx context.Context, req AuthorizeRequest) (cliententity.Client, []string, error) {
+	client, err := s.clientRepository.GetByID(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return cliententity.Client{}, nil, ErrInvalidClient
+		}
+		return cliententity.Client{}, nil, err
+	}
+
+	if client.Disabled {
+		return cliententity.Client{}, nil, ErrInvalidClient
+	}
+
+	if !client.AllowsRedirectURI(req.RedirectURI) {
+		return cliententity.Client{}, nil, ErrInvalidRedirectURI
+	}
+
+	if !client.AllowsGrantType(cliententity.GrantTypeAuthorizationCode) {
+		return cliententity.Client{}, nil, ErrUnauthorizedClient
+	}
+
+	if req.ResponseType != ResponseTypeCode {
+		return cliententity.Client{}, nil, ErrUnsupportedResponseType
+	}
+
+	if !validCodeChallenge(req.CodeChallenge, req.CodeChallengeMethod) {
+		return cliententity.Client{}, nil, ErrInvalidCodeChallenge
+	}
+
+	scopes, err := requestedScopes(client, req.Scopes)
+	if err != nil {
+		return cliententity.Client{}, nil, err
 	}
 
+	return client, scopes, nil
+}
+
+// issueAuthorizationCode issues the code answering an authorization request
+// of a user who signed in.
+func (s *Service) issueAuthorizationCode(ctx context.Context, user usermodel.User, client cliententity.Client, scopes []string, req AuthorizeRequest) (AuthorizeResponse, error) {
+	value, err := randomToken(AuthorizationCodeLength)
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	err = s.authCodeRepository.Create(ctx, authcodeentity.AuthorizationCode{
+		CodeHash:            tokenentity.Hash(value),
+		ClientID:            client.ID,
+		Subject:             user.ID,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              grantScopes(client, scopes, user.Role),
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		ExpiresAt:           time.Now().Add(AuthorizationCodeTTL),
+	})
+	if err != nil {
+		return AuthorizeResponse{}, err
+	}
+
+	return AuthorizeResponse{
+		Code:        value,
+		RedirectURI: req.RedirectURI,
+		State:       req.State,
+	}, nil
+}
+
+// enabledClient returns the client a device authorization was requested by
+// or an API key was issued to, unless it was disabled since.
+func (s *Service) enabledClient(ctx context.Context, clientID string) (cliententity.Client, error) {
+	client, err := s.clientRepository.GetByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return cliententity.Client{}, ErrInvalidClient
+		}
+		return cliententity.Client{}, err
+	}
+
+	if client.Disabled {
+		return cliententity.Client{}, ErrInvalidClient
+	}
+
+	return client, nil
+}
+
+// authenticateClient looks up a registered client and checks that it may use
+// one of grantTypes, if any are given. Confidential clients must present a
+// valid secret.
+func (s *Service) authenticateClient(ctx context.Context, clientID, clientSecret string, grantTypes ...string) (cliententity.Client, error) {
+	client, err := s.clientRepository.GetByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return cliententity.Client{}, ErrInvalidClient
+		}
+		return cliententity.Client{}, err
+	}
+
+	if client.Disabled {
+		return cliententity.Client{}, ErrInvalidClient
+	}
+
+	if client.Confidential && !client.VerifySecret(clientSecret, time.Now()) {
+		return cliententity.Client{}, ErrInvalidClient
+	}
+
+	if len(grantTypes) > 0 && !slices.ContainsFunc(grantTypes, client.AllowsGrantType) {
+		return cliententity.Client{}, ErrUnauthorizedClient
+	}
+
+	return client, nil
+}
+
+// handleRefreshTokenReuse is called when a refresh token that has already been
+// rotated or revoked is presented again. The token may have been stolen, so
+// every token descending from the same login is revoked.
+func (s *Service) handleRefreshTokenReuse(ctx context.Context, token tokenmodel.Token, device sessionentity.Device) error {
+	log.Warn().
+		Str("event", SecurityEventRefreshTokenReuse).
+		Str("subject", token.Subject).
+		Str("client_id", token.ClientID).
+		Str("token_id", token.ID).
+		Str("family_id", token.FamilyID).
+		Msg("refresh token reuse detected, revoking token family")
+
+	event := newEvent(authevententity.TypeRefreshTokenReuse, token.ClientID, device)
+	event.Subject = token.Subject
+	event.Outcome = authevententity.OutcomeFailure
+	event.Reason = "token family " + token.FamilyID + " revoked"
+	s.record(ctx, event)
+
+	if err := s.sessionService.End(ctx, token.FamilyID); err != nil {
+		return err
+	}
+
+	return ErrInvalidRefreshToken
+}
+
+// audit records an attempt in the audit trail, as failed if err is set.
+// Attempts interrupted to ask for a second factor are left to the grant
+// completing them, and polls of a device still waiting for the user are
+// left to the one that redeems its code.
+func (s *Service) audit(ctx context.Context, event authevententity.Event, err error) {
+	if errors.Is(err, ErrMFARequired) || errors.Is(err, deviceservice.ErrAuthorizationPending) || errors.Is(err, deviceservice.ErrSlowDown) {
+		return
+	}
+
+	event.Outcome = authevententity.OutcomeSuccess
+	if err != nil {
+		event.Outcome = authevententity.OutcomeFailure
+		event.Reason = failureReason(err)
+	}
+
+	s.record(ctx, event)
+}
+
+// record appends an event to the audit trail. The attempt it describes has
+// already succeeded or failed, so an error recording it is only logged.
+func (s *Service) record(ctx context.Context, event authevententity.Event) {
+	// The event is recorded even if the caller gave up on the request.
+	if err := s.auditService.Record(context.WithoutCancel(ctx), event); err != nil {
+		log.Error().
+			Err(err).
+			Str("type", string(event.Type)).
+			Str("subject", event.Subject).
+			Str("client_id", event.ClientID).
+			Str("outcome", string(event.Outcome)).
+			Msg("failed to record auth event")
+	}
+}
+
+// issueAccessToken issues an access token, bound to the DPoP key jkt if set.
+func (s *Service) issueAccessToken(ctx context.Context, subject string, role usermodel.Role, client cliententity.Client, scopes []string, sessionID, jkt string) (string, error) {
 	now := time.Now()
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
-		ID:        uuid.NewString(),
-		Subject:   subject,
-		Audience:  jwt.ClaimStrings{clientID},
-		Issuer:    IssuerServiceName,
-		IssuedAt:  jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+	var confirmation *ConfirmationClaims
+	if jkt != "" {
+		confirmation = &ConfirmationClaims{JKT: jkt}
+	}
+
+	return s.signToken(ctx, AccessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{client.ID},
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(client.AccessTokenTTL)),
+		},
+		Scope:        strings.Join(scopes, " "),
+		Role:         string(role),
+		Tenant:       s.cfg.Tenant,
+		SessionID:    sessionID,
+		Confirmation: confirmation,
 	})
+}
+
+func (s *Service) issueIDToken(ctx context.Context, user usermodel.User, clientID, nonce string) (string, error) {
+	now := time.Now()
+
+	return s.signToken(ctx, IDTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID,
+			Audience:  jwt.ClaimStrings{clientID},
+			Issuer:    s.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(IDTokenTTL)),
+		},
+		Nonce:             nonce,
+		Email:             user.Email,
+		EmailVerified:     user.EmailVerified,
+		Name:              user.FullName(),
+		PreferredUsername: user.Username,
+		Role:              string(user.Role),
+	})
+}
+
+func (s *Service) signToken(ctx context.Context, claims jwt.Claims) (string, error) {
+	key, err := s.keyRepository.GetCurrentSigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	method, ok := signingMethods[key.Algorithm]
+	if !ok {
+		return "", errors.Wrap(ErrUnsupportedSigningKey, key.Algorithm)
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+	if _, ok := claims.(AccessTokenClaims); ok {
+		token.Header["typ"] = JWTTypeAccessToken
+	}
 
 	return token.SignedString(key.Private)
 }
 
-func (s *Service) issueRefreshToken(ctx context.Context, subject, clientID string) (string, error) {
-	b := make([]byte, RefreshTokenLength)
-	if _, err := rand.Read(b); err != nil {
+func (s *Service) issueRefreshToken(ctx context.Context, token tokenentity.Token, client cliententity.Client) (string, error) {
+	value, err := randomToken(RefreshTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	token.ID = uuid.NewString()
+	if token.FamilyID == "" {
+		token.FamilyID = token.ID
+	}
+	token.TokenType = tokenentity.TypeRefresh
+	token.TokenHash = tokenentity.Hash(value)
+	token.ExpiresAt = time.Now().Add(client.RefreshTokenTTL)
+	token.Revoked = false
+
+	if err := s.tokenRepository.Create(ctx, token); err != nil {
 		return "", err
 	}
 
-	token := base64.RawURLEncoding.EncodeToString(b)
+	return value, nil
+}
+
+// refreshTokenKey returns the DPoP key a refresh token issued along with an
+// access token bound to jkt is bound to. As RFC 9449 section 5 describes, only
+// the refresh tokens of public clients are bound: confidential clients
+// authenticate to redeem theirs already.
+func refreshTokenKey(client cliententity.Client, jkt string) string {
+	if client.Confidential {
+		return ""
+	}
+
+	return jkt
+}
+
+// requestedScopes validates the scopes of a new grant against those
+// registered for the client. A request without scopes asks for all of them
+// except openid, which OpenID Connect requires to be requested explicitly.
+func requestedScopes(client cliententity.Client, scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		scopes = make([]string, 0, len(client.Scopes))
+		for _, scope := range client.Scopes {
+			if scope != ScopeOpenID {
+				scopes = append(scopes, scope)
+			}
+		}
 
-	sum := sha256.Sum256([]byte(token))
-	tokenHash := hex.EncodeToString(sum[:])
+		return scopes, nil
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(client.Scopes, scope) {
+			return nil, ErrInvalidScope
+		}
+	}
 
-	err := s.tokenRepository.Create(ctx, tokenentity.Token{
-		ID:        uuid.NewString(),
-		Subject:   subject,
+	return scopes, nil
+}
+
+// grantScopes returns the subset of scopes that may actually be granted: those
+// still registered for the client, without ScopeAdmin unless role is admin and
+// never with ScopeService.
+func grantScopes(client cliententity.Client, scopes []string, role usermodel.Role) []string {
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(client.Scopes, scope) || slices.Contains(granted, scope) {
+			continue
+		}
+		if scope == ScopeAdmin && role != usermodel.RoleAdmin {
+			continue
+		}
+		if scope == ScopeService {
+			continue
+		}
+		granted = append(granted, scope)
+	}
+
+	return granted
+}
+
+// newEvent describes an attempt made from a device.
+func newEvent(eventType authevententity.Type, clientID string, device sessionentity.Device) authevententity.Event {
+	return authevententity.Event{
+		Type:      eventType,
 		ClientID:  clientID,
-		TokenType: tokenentity.TypeRefresh,
-		TokenHash: tokenHash,
-		ExpiresAt: time.Now().Add(RefreshTokenTTL),
-		Revoked:   false,
-	})
-	if err != nil {
+		IP:        device.IP,
+		UserAgent: device.Name,
+	}
+}
+
+func failureReason(err error) string {
+	for _, reason := range failureReasons {
+		if errors.Is(err, reason) {
+			return reason.Error()
+		}
+	}
+
+	return "internal error"
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
 		return "", err
 	}
 
-	return token, nil
+	return base64.RawURLEncoding.EncodeToString(b), nil
 }
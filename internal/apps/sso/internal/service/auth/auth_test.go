@@ -2,25 +2,128 @@ package auth
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	apikeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/apikey"
+	authcodeentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authcode"
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
 	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	apikeymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/apikey/mocks"
+	authcoderepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode"
+	authcodemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authcode/mocks"
+	autheventmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/mocks"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	clientmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/mocks"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	devicemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device/mocks"
 	keymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/mocks"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	mfamocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa/mocks"
+	mfachallengemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge/mocks"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	passkeymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey/mocks"
+	passkeyceremonyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony"
+	passkeyceremonymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony/mocks"
+	passwordlessrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless"
+	passwordlessmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless/mocks"
+	sessionmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/mocks"
+	throttlemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle/mocks"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
 	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
 	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
 	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
 	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	apikeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/apikey"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	deviceservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/device"
+	federationservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/federation"
+	mfaservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/mfa"
+	passkeyservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passkey"
+	passwordlessservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/passwordless"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/totp"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/webauthntest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+const testIssuer = "https://sso.kgym.test"
+
+func newTestClient(id string) cliententity.Client {
+	return cliententity.Client{
+		ID:              id,
+		Name:            "test",
+		GrantTypes:      cliententity.GrantTypes,
+		RedirectURIs:    []string{"https://app.example.com/callback"},
+		Scopes:          []string{ScopeOpenID, ScopeProfile, ScopeEmail},
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+	}
+}
+
+// newMFAService returns an MFA service for users who are not enrolled and
+// whose role does not require a second factor.
+func newMFAService(ctrl *gomock.Controller) *mfaservice.Service {
+	mfaRepo := mfamocks.NewMockIRepository(ctrl)
+	mfaRepo.EXPECT().
+		GetTOTP(gomock.Any(), gomock.Any()).
+		Return(mfaentity.TOTP{}, mfarepo.ErrTOTPNotFound).
+		AnyTimes()
+
+	return mfaservice.NewService(mfaservice.Config{}, mfaRepo, nil, nil)
+}
+
+// newThrottleService returns a throttle service for attempts that have not
+// failed before.
+func newThrottleService(ctrl *gomock.Controller) *throttleservice.Service {
+	throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+	throttleRepo.EXPECT().LockedUntil(gomock.Any(), gomock.Any()).Return(time.Time{}, nil).AnyTimes()
+	throttleRepo.EXPECT().Failures(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), time.Time{}, nil).AnyTimes()
+	throttleRepo.EXPECT().RecordFailure(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+	throttleRepo.EXPECT().ResetFailures(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return throttleservice.NewService(throttleservice.Config{}, throttleRepo)
+}
+
+// newAuditService returns an audit service that records every event.
+func newAuditService(ctrl *gomock.Controller) *auditservice.Service {
+	authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+	authEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return auditservice.NewService(authEventRepo)
+}
+
+// newSessionService returns a session service that records every session.
+// Revoked sessions revoke their refresh tokens through tokenRepo.
+func newSessionService(ctrl *gomock.Controller, tokenRepo tokenrepo.IRepository) *sessionservice.Service {
+	sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+	sessionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	sessionRepo.EXPECT().Touch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	sessionRepo.EXPECT().Revoke(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return sessionservice.NewService(sessionRepo, tokenRepo)
+}
+
 func TestService_PasswordGrant(t *testing.T) {
 	t.Run("should grant tokens successfully", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -30,12 +133,24 @@ func TestService_PasswordGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
 		email := "test@example.com"
 		password := "password123"
@@ -43,9 +158,8 @@ func TestService_PasswordGrant(t *testing.T) {
 		userID := "user-123"
 
 		user := usermodel.User{
-			ID:       userID,
-			Email:    email,
-			Password: password,
+			ID:    userID,
+			Email: email,
 		}
 
 		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
@@ -56,11 +170,11 @@ func TestService_PasswordGrant(t *testing.T) {
 			Private:   privateKey,
 			Public:    privateKey.Public(),
 			Algorithm: "RS256",
-			Active:    true,
+			State:     keyentity.StateActive,
 		}
 
 		userRepo.EXPECT().
-			GetByEmail(ctx, email).
+			VerifyPassword(ctx, email, password).
 			Return(user, nil)
 
 		keyRepo.EXPECT().
@@ -91,13 +205,104 @@ func TestService_PasswordGrant(t *testing.T) {
 
 		parser := jwt.NewParser()
 		claims := jwt.RegisteredClaims{}
-		_, err = parser.ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		parsed, err := parser.ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
 			return privateKey.Public(), nil
 		})
 		require.NoError(t, err)
+		assert.Equal(t, key.ID, parsed.Header["kid"])
 		assert.Equal(t, userID, claims.Subject)
 		assert.Equal(t, []string{clientID}, []string(claims.Audience))
-		assert.Equal(t, IssuerServiceName, claims.Issuer)
+		assert.Equal(t, testIssuer, claims.Issuer)
+		assert.Empty(t, resp.IDToken)
+	})
+
+	t.Run("should issue id token when openid scope is requested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		email := "test@example.com"
+		password := "password123"
+		clientID := "client-123"
+
+		user := usermodel.User{
+			ID:        "user-123",
+			Email:     email,
+			Role:      usermodel.RoleAdmin,
+			Username:  "tester",
+			FirstName: "Test",
+			LastName:  "User",
+		}
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: "RS256",
+			State:     keyentity.StateActive,
+		}
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, email, password).
+			Return(user, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    email,
+			Password: password,
+			ClientID: clientID,
+			Scopes:   []string{ScopeOpenID, ScopeProfile, ScopeEmail},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.IDToken)
+
+		claims := IDTokenClaims{}
+		parsed, err := jwt.NewParser().ParseWithClaims(resp.IDToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, parsed.Header["kid"])
+		assert.Equal(t, "JWT", parsed.Header["typ"])
+		assert.Equal(t, testIssuer, claims.Issuer)
+		assert.Equal(t, user.ID, claims.Subject)
+		assert.Equal(t, []string{clientID}, []string(claims.Audience))
+		assert.Equal(t, email, claims.Email)
+		assert.Equal(t, "Test User", claims.Name)
+		assert.Equal(t, "tester", claims.PreferredUsername)
+		assert.Equal(t, string(usermodel.RoleAdmin), claims.Role)
 	})
 
 	t.Run("should return error when user not found", func(t *testing.T) {
@@ -108,17 +313,29 @@ func TestService_PasswordGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
 		email := "notfound@example.com"
 
 		userRepo.EXPECT().
-			GetByEmail(ctx, email).
+			VerifyPassword(ctx, email, "password").
 			Return(usermodel.User{}, errors.New("user not found"))
 
 		req := PasswordGrantRequest{
@@ -141,26 +358,31 @@ func TestService_PasswordGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
 		email := "test@example.com"
-		password := "correct-password"
 		wrongPassword := "wrong-password"
 
-		user := usermodel.User{
-			ID:       "user-123",
-			Email:    email,
-			Password: password,
-		}
-
 		userRepo.EXPECT().
-			GetByEmail(ctx, email).
-			Return(user, nil)
+			VerifyPassword(ctx, email, wrongPassword).
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
 
 		req := PasswordGrantRequest{
 			Email:    email,
@@ -175,6 +397,87 @@ func TestService_PasswordGrant(t *testing.T) {
 		assert.Empty(t, resp.RefreshToken)
 	})
 
+	t.Run("should reject locked out account without checking password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  throttleservice.NewService(throttleservice.Config{}, throttleRepo),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().
+			LockedUntil(ctx, gomock.Any()).
+			Return(time.Now().Add(time.Minute), nil).
+			Times(3)
+
+		_, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+			Device:   sessionentity.Device{IP: "203.0.113.1"},
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, throttleservice.ErrLocked)
+	})
+
+	t.Run("should count failed attempt", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService: throttleservice.NewService(throttleservice.Config{
+				Account: throttleservice.Limits{LockoutAfter: 1},
+			}, throttleRepo),
+			sessionService: newSessionService(ctrl, nil),
+			auditService:   newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		email := "test@example.com"
+
+		throttleRepo.EXPECT().LockedUntil(ctx, gomock.Any()).Return(time.Time{}, nil).Times(2)
+		userRepo.EXPECT().
+			VerifyPassword(ctx, email, "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+		throttleRepo.EXPECT().RecordFailure(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).Times(2)
+		throttleRepo.EXPECT().Lock(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		throttleRepo.EXPECT().ResetFailures(ctx, gomock.Any()).Return(nil)
+
+		_, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    email,
+			Password: "wrong-password",
+			ClientID: "client-123",
+		})
+		assert.Equal(t, ErrInvalidCredentials, err)
+	})
+
 	t.Run("should return error when key repository fails", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -183,24 +486,35 @@ func TestService_PasswordGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
 		email := "test@example.com"
 		password := "password123"
 
 		user := usermodel.User{
-			ID:       "user-123",
-			Email:    email,
-			Password: password,
+			ID:    "user-123",
+			Email: email,
 		}
 
 		userRepo.EXPECT().
-			GetByEmail(ctx, email).
+			VerifyPassword(ctx, email, password).
 			Return(user, nil)
 
 		keyRepo.EXPECT().
@@ -227,20 +541,31 @@ func TestService_PasswordGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
 		email := "test@example.com"
 		password := "password123"
 
 		user := usermodel.User{
-			ID:       "user-123",
-			Email:    email,
-			Password: password,
+			ID:    "user-123",
+			Email: email,
 		}
 
 		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
@@ -251,11 +576,11 @@ func TestService_PasswordGrant(t *testing.T) {
 			Private:   privateKey,
 			Public:    privateKey.Public(),
 			Algorithm: "RS256",
-			Active:    true,
+			State:     keyentity.StateActive,
 		}
 
 		userRepo.EXPECT().
-			GetByEmail(ctx, email).
+			VerifyPassword(ctx, email, password).
 			Return(user, nil)
 
 		keyRepo.EXPECT().
@@ -288,19 +613,31 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 		subject := "user-123"
 		clientID := "client-123"
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   subject,
 			ClientID:  clientID,
 			TokenHash: refreshTokenHash,
@@ -316,7 +653,7 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Private:   privateKey,
 			Public:    privateKey.Public(),
 			Algorithm: "RS256",
-			Active:    true,
+			State:     keyentity.StateActive,
 		}
 
 		tokenRepo.EXPECT().
@@ -324,9 +661,13 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(token, nil)
 
 		tokenRepo.EXPECT().
-			Revoke(ctx, refreshTokenHash).
+			MarkUsed(ctx, refreshTokenHash).
 			Return(nil)
 
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
 		keyRepo.EXPECT().
 			GetCurrentSigningKey(ctx).
 			Return(key, nil)
@@ -337,12 +678,15 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 				assert.Equal(t, subject, newToken.Subject)
 				assert.Equal(t, clientID, newToken.ClientID)
 				assert.Equal(t, tokenentity.TypeRefresh, newToken.TokenType)
+				assert.Equal(t, token.FamilyID, newToken.FamilyID)
+				assert.Equal(t, token.ID, newToken.ParentID)
 				assert.False(t, newToken.Revoked)
 				return nil
 			})
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -368,21 +712,33 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "invalid-hash"
+		refreshToken := "invalid-token"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		tokenRepo.EXPECT().
 			GetByTokenHash(ctx, refreshTokenHash).
 			Return(tokenmodel.Token{}, errors.New("token not found"))
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -391,7 +747,7 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		assert.Empty(t, resp.RefreshToken)
 	})
 
-	t.Run("should return error when token is revoked", func(t *testing.T) {
+	t.Run("should revoke token family when revoked token is reused", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -399,17 +755,29 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   "user-123",
 			ClientID:  "client-123",
 			TokenHash: refreshTokenHash,
@@ -421,8 +789,13 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			GetByTokenHash(ctx, refreshTokenHash).
 			Return(token, nil)
 
+		tokenRepo.EXPECT().
+			RevokeFamily(ctx, token.FamilyID).
+			Return(nil)
+
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -432,6 +805,124 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		assert.Empty(t, resp.RefreshToken)
 	})
 
+	t.Run("should revoke token family when used token is reused", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
+		usedAt := time.Now().Add(-1 * time.Minute)
+
+		token := tokenmodel.Token{
+			ID:        "token-123",
+			FamilyID:  "family-123",
+			Subject:   "user-123",
+			ClientID:  "client-123",
+			TokenHash: refreshTokenHash,
+			ExpiresAt: time.Now().Add(24 * time.Hour),
+			UsedAt:    &usedAt,
+		}
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(token, nil)
+
+		tokenRepo.EXPECT().
+			RevokeFamily(ctx, token.FamilyID).
+			Return(nil)
+
+		req := RefreshTokenGrantRequest{
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
+		}
+
+		resp, err := service.RefreshTokenGrant(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+		assert.Empty(t, resp.AccessToken)
+		assert.Empty(t, resp.RefreshToken)
+	})
+
+	t.Run("should revoke token family when token is rotated concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
+
+		token := tokenmodel.Token{
+			ID:        "token-123",
+			FamilyID:  "family-123",
+			Subject:   "user-123",
+			ClientID:  "client-123",
+			TokenHash: refreshTokenHash,
+			ExpiresAt: time.Now().Add(24 * time.Hour),
+		}
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(token, nil)
+
+		tokenRepo.EXPECT().
+			MarkUsed(ctx, refreshTokenHash).
+			Return(tokenrepo.ErrTokenAlreadyUsed)
+
+		tokenRepo.EXPECT().
+			RevokeFamily(ctx, token.FamilyID).
+			Return(nil)
+
+		req := RefreshTokenGrantRequest{
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
+		}
+
+		resp, err := service.RefreshTokenGrant(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+		assert.Empty(t, resp.AccessToken)
+		assert.Empty(t, resp.RefreshToken)
+	})
+
 	t.Run("should return error when token is expired", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -440,17 +931,29 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   "user-123",
 			ClientID:  "client-123",
 			TokenHash: refreshTokenHash,
@@ -463,7 +966,8 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(token, nil)
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -473,7 +977,7 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		assert.Empty(t, resp.RefreshToken)
 	})
 
-	t.Run("should return error when revoke fails", func(t *testing.T) {
+	t.Run("should return error when marking token as used fails", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -481,17 +985,29 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   "user-123",
 			ClientID:  "client-123",
 			TokenHash: refreshTokenHash,
@@ -504,11 +1020,12 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(token, nil)
 
 		tokenRepo.EXPECT().
-			Revoke(ctx, refreshTokenHash).
-			Return(errors.New("revoke error"))
+			MarkUsed(ctx, refreshTokenHash).
+			Return(errors.New("mark used error"))
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -525,17 +1042,29 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   "user-123",
 			ClientID:  "client-123",
 			TokenHash: refreshTokenHash,
@@ -548,15 +1077,20 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(token, nil)
 
 		tokenRepo.EXPECT().
-			Revoke(ctx, refreshTokenHash).
+			MarkUsed(ctx, refreshTokenHash).
 			Return(nil)
 
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
 		keyRepo.EXPECT().
 			GetCurrentSigningKey(ctx).
 			Return(keyentity.Key{}, errors.New("key repository error"))
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -573,17 +1107,29 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
 		keyRepo := keymocks.NewMockIRepository(ctrl)
 
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
 		service := &Service{
-			userRepository:  userRepo,
-			tokenRepository: tokenRepo,
-			keyRepository:   keyRepo,
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
 		}
 
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
 		ctx := context.Background()
-		refreshTokenHash := "token-hash-123"
+		refreshToken := "refresh-token-123"
+		refreshTokenHash := tokenentity.Hash(refreshToken)
 
 		token := tokenmodel.Token{
 			ID:        "token-123",
+			FamilyID:  "family-123",
 			Subject:   "user-123",
 			ClientID:  "client-123",
 			TokenHash: refreshTokenHash,
@@ -599,7 +1145,7 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Private:   privateKey,
 			Public:    privateKey.Public(),
 			Algorithm: "RS256",
-			Active:    true,
+			State:     keyentity.StateActive,
 		}
 
 		tokenRepo.EXPECT().
@@ -607,9 +1153,13 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(token, nil)
 
 		tokenRepo.EXPECT().
-			Revoke(ctx, refreshTokenHash).
+			MarkUsed(ctx, refreshTokenHash).
 			Return(nil)
 
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
 		keyRepo.EXPECT().
 			GetCurrentSigningKey(ctx).
 			Return(key, nil)
@@ -619,7 +1169,8 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 			Return(errors.New("token repository error"))
 
 		req := RefreshTokenGrantRequest{
-			RefreshToken: refreshTokenHash,
+			RefreshToken: refreshToken,
+			ClientID:     "client-123",
 		}
 
 		resp, err := service.RefreshTokenGrant(ctx, req)
@@ -628,3 +1179,3748 @@ func TestService_RefreshTokenGrant(t *testing.T) {
 		assert.Empty(t, resp.RefreshToken)
 	})
 }
+
+func TestService_Authorize(t *testing.T) {
+	redirectURI := "https://app.example.com/callback"
+	codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	newRequest := func() AuthorizeRequest {
+		return AuthorizeRequest{
+			ClientID:            "client-123",
+			RedirectURI:         redirectURI,
+			ResponseType:        ResponseTypeCode,
+			Scopes:              []string{ScopeOpenID},
+			State:               "state-123",
+			Nonce:               "nonce-123",
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: CodeChallengeMethodS256,
+			Email:               "test@example.com",
+			Password:            "password123",
+		}
+	}
+
+	t.Run("should issue authorization code successfully", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			userRepository:     userRepo,
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			mfaService:         newMFAService(ctrl),
+			throttleService:    newThrottleService(ctrl),
+			sessionService:     newSessionService(ctrl, nil),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		req := newRequest()
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, req.Email, req.Password).
+			Return(usermodel.User{ID: "user-123", Email: req.Email}, nil)
+
+		var stored authcodeentity.AuthorizationCode
+		authCodeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, code authcodeentity.AuthorizationCode) error {
+				stored = code
+				return nil
+			})
+
+		resp, err := service.Authorize(ctx, req)
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.Code)
+		assert.Equal(t, redirectURI, resp.RedirectURI)
+		assert.Equal(t, "state-123", resp.State)
+
+		assert.Equal(t, tokenentity.Hash(resp.Code), stored.CodeHash)
+		assert.Equal(t, "client-123", stored.ClientID)
+		assert.Equal(t, "user-123", stored.Subject)
+		assert.Equal(t, redirectURI, stored.RedirectURI)
+		assert.Equal(t, []string{ScopeOpenID}, stored.Scopes)
+		assert.Equal(t, "nonce-123", stored.Nonce)
+		assert.Equal(t, codeChallenge, stored.CodeChallenge)
+		assert.WithinDuration(t, time.Now().Add(AuthorizationCodeTTL), stored.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("should return error when response type is not code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		req := newRequest()
+		req.ResponseType = "token"
+
+		_, err := service.Authorize(ctx, req)
+		assert.ErrorIs(t, err, ErrUnsupportedResponseType)
+	})
+
+	t.Run("should return error when redirect uri does not match exactly", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		req := newRequest()
+		req.RedirectURI = redirectURI + "/"
+
+		_, err := service.Authorize(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidRedirectURI)
+	})
+
+	t.Run("should return error when code challenge method is plain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		req := newRequest()
+		req.CodeChallengeMethod = "plain"
+
+		_, err := service.Authorize(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidCodeChallenge)
+	})
+
+	t.Run("should return error when credentials are invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+		req := newRequest()
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, req.Email, req.Password).
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+
+		_, err := service.Authorize(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
+
+func TestService_AuthorizationCodeGrant(t *testing.T) {
+	// Test vector from RFC 7636 appendix B.
+	codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	redirectURI := "https://app.example.com/callback"
+
+	newCode := func(scopes ...string) authcodeentity.AuthorizationCode {
+		return authcodeentity.AuthorizationCode{
+			CodeHash:            tokenentity.Hash("code-123"),
+			ClientID:            "client-123",
+			Subject:             "user-123",
+			RedirectURI:         redirectURI,
+			Scopes:              scopes,
+			Nonce:               "nonce-123",
+			CodeChallenge:       codeChallenge,
+			CodeChallengeMethod: CodeChallengeMethodS256,
+			ExpiresAt:           time.Now().Add(AuthorizationCodeTTL),
+		}
+	}
+
+	t.Run("should exchange code for tokens successfully", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			userRepository:     userRepo,
+			tokenRepository:    tokenRepo,
+			keyRepository:      keyRepo,
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			sessionService:     newSessionService(ctrl, tokenRepo),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-123",
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: "RS256",
+			State:     keyentity.StateActive,
+		}
+
+		authCodeRepo.EXPECT().
+			Consume(ctx, tokenentity.Hash("code-123")).
+			Return(newCode(ScopeOpenID), nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, token tokenentity.Token) error {
+				assert.Equal(t, "user-123", token.Subject)
+				assert.Equal(t, "client-123", token.ClientID)
+				assert.NotEmpty(t, token.FamilyID)
+				return nil
+			})
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Email: "test@example.com"}, nil)
+
+		resp, err := service.AuthorizationCodeGrant(ctx, AuthorizationCodeGrantRequest{
+			Code:         "code-123",
+			RedirectURI:  redirectURI,
+			CodeVerifier: codeVerifier,
+			ClientID:     "client-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		require.NotEmpty(t, resp.IDToken)
+
+		claims := IDTokenClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.IDToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "nonce-123", claims.Nonce)
+		assert.Equal(t, "test@example.com", claims.Email)
+	})
+
+	t.Run("should return error when code is unknown or already used", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			sessionService:     newSessionService(ctrl, nil),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+
+		authCodeRepo.EXPECT().
+			Consume(ctx, tokenentity.Hash("code-123")).
+			Return(authcodeentity.AuthorizationCode{}, authcoderepo.ErrAuthorizationCodeNotFound)
+
+		_, err := service.AuthorizationCodeGrant(ctx, AuthorizationCodeGrantRequest{
+			Code:         "code-123",
+			RedirectURI:  redirectURI,
+			CodeVerifier: codeVerifier,
+			ClientID:     "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationCode)
+	})
+
+	t.Run("should return error when code verifier does not match", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			sessionService:     newSessionService(ctrl, nil),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+
+		authCodeRepo.EXPECT().
+			Consume(ctx, tokenentity.Hash("code-123")).
+			Return(newCode(), nil)
+
+		_, err := service.AuthorizationCodeGrant(ctx, AuthorizationCodeGrantRequest{
+			Code:         "code-123",
+			RedirectURI:  redirectURI,
+			CodeVerifier: strings.Repeat("a", 43),
+			ClientID:     "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationCode)
+	})
+
+	t.Run("should return error when redirect uri differs from authorization request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			sessionService:     newSessionService(ctrl, nil),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		ctx := context.Background()
+
+		authCodeRepo.EXPECT().
+			Consume(ctx, tokenentity.Hash("code-123")).
+			Return(newCode(), nil)
+
+		_, err := service.AuthorizationCodeGrant(ctx, AuthorizationCodeGrantRequest{
+			Code:         "code-123",
+			RedirectURI:  "https://app.example.com/other",
+			CodeVerifier: codeVerifier,
+			ClientID:     "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationCode)
+	})
+
+	t.Run("should return error when code was issued to another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			sessionService:     newSessionService(ctrl, nil),
+			auditService:       newAuditService(ctrl),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(gomock.Any(), "client-456").
+			Return(newTestClient("client-456"), nil)
+
+		ctx := context.Background()
+
+		authCodeRepo.EXPECT().
+			Consume(ctx, tokenentity.Hash("code-123")).
+			Return(newCode(), nil)
+
+		_, err := service.AuthorizationCodeGrant(ctx, AuthorizationCodeGrantRequest{
+			Code:         "code-123",
+			RedirectURI:  redirectURI,
+			CodeVerifier: codeVerifier,
+			ClientID:     "client-456",
+		})
+		assert.ErrorIs(t, err, ErrInvalidAuthorizationCode)
+	})
+}
+
+func TestService_ClientCredentialsGrant(t *testing.T) {
+	newConfidentialClient := func() cliententity.Client {
+		client := newTestClient("service-123")
+		client.Confidential = true
+		client.SecretHash = tokenentity.Hash("secret-123")
+		client.Scopes = []string{"files:write"}
+		return client
+	}
+
+	t.Run("should issue access token to client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		client := newConfidentialClient()
+		client.AccessTokenTTL = 5 * time.Minute
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		resp, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+			Scopes:       []string{"files:write"},
+		})
+		require.NoError(t, err)
+
+		claims := jwt.RegisteredClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "service-123", claims.Subject)
+		assert.Equal(t, []string{"service-123"}, []string(claims.Audience))
+		assert.Equal(t, 5*time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+	})
+
+	t.Run("should accept previous secret during grace period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		client := newConfidentialClient()
+		client.SecretHash = tokenentity.Hash("secret-456")
+		client.PreviousSecretHash = tokenentity.Hash("secret-123")
+		client.PreviousSecretExpiresAt = time.Now().Add(time.Hour)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		_, err = service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when previous secret has expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newConfidentialClient()
+		client.SecretHash = tokenentity.Hash("secret-456")
+		client.PreviousSecretHash = tokenentity.Hash("secret-123")
+		client.PreviousSecretExpiresAt = time.Now().Add(-time.Second)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidClient)
+	})
+
+	t.Run("should return error when secret is wrong", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(newConfidentialClient(), nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "wrong-secret",
+		})
+		assert.ErrorIs(t, err, ErrInvalidClient)
+	})
+
+	t.Run("should return error when client is unknown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(cliententity.Client{}, clientrepo.ErrClientNotFound)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidClient)
+	})
+
+	t.Run("should return error when client is disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newConfidentialClient()
+		client.Disabled = true
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidClient)
+	})
+
+	t.Run("should return error when grant type is not allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newConfidentialClient()
+		client.GrantTypes = []string{cliententity.GrantTypeAuthorizationCode}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+
+	t.Run("should return error when scope is not allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(newConfidentialClient(), nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+			Scopes:       []string{"admin"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+
+	t.Run("should return error for public client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+
+	t.Run("should never grant service scope", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		client := newConfidentialClient()
+		client.Scopes = append(client.Scopes, ScopeService)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "service-123").
+			Return(client, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		resp, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "service-123",
+			ClientSecret: "secret-123",
+			Scopes:       []string{"files:write", ScopeService},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:write"}, resp.Scopes)
+	})
+}
+
+func TestService_ServiceToken(t *testing.T) {
+	t.Run("should issue service token for audience", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:           Config{Issuer: testIssuer, Tenant: "kgym"},
+			keyRepository: keyRepo,
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		resp, err := service.ServiceToken(ctx, "user")
+		require.NoError(t, err)
+
+		claims := AccessTokenClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ServiceTokenSubject, claims.Subject)
+		assert.Equal(t, []string{"user"}, []string(claims.Audience))
+		assert.Equal(t, ScopeService, claims.Scope)
+		assert.Empty(t, claims.Role)
+		assert.Equal(t, ServiceTokenTTL, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+		assert.WithinDuration(t, resp.ExpiresAt, claims.ExpiresAt.Time, time.Second)
+	})
+
+	t.Run("should return error when key repository fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:           Config{Issuer: testIssuer},
+			keyRepository: keyRepo,
+		}
+
+		ctx := context.Background()
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{}, errors.New("no signing key"))
+
+		_, err := service.ServiceToken(ctx, "user")
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Scopes(t *testing.T) {
+	newScopedClient := func() cliententity.Client {
+		client := newTestClient("client-123")
+		client.Scopes = []string{"files:read", "files:write", ScopeAdmin}
+		return client
+	}
+
+	parseAccessToken := func(t *testing.T, key keyentity.Key, accessToken string) AccessTokenClaims {
+		t.Helper()
+
+		claims := AccessTokenClaims{}
+		_, err := jwt.NewParser().ParseWithClaims(accessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return key.Public, nil
+		})
+		require.NoError(t, err)
+
+		return claims
+	}
+
+	newKey := func(t *testing.T) keyentity.Key {
+		t.Helper()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		return keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}
+	}
+
+	t.Run("should embed scope, role and tenant claims", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer, Tenant: "kgym"},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		key := newKey(t)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "admin@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleAdmin}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token tokenentity.Token) error {
+				assert.Equal(t, []string{"files:read", ScopeAdmin}, token.Scopes)
+				return nil
+			})
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "admin@example.com",
+			Password: "password123",
+			ClientID: "client-123",
+			Scopes:   []string{"files:read", ScopeAdmin},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:read", ScopeAdmin}, resp.Scopes)
+
+		claims := parseAccessToken(t, key, resp.AccessToken)
+		assert.Equal(t, "files:read admin", claims.Scope)
+		assert.Equal(t, string(usermodel.RoleAdmin), claims.Role)
+		assert.Equal(t, "kgym", claims.Tenant)
+	})
+
+	t.Run("should grant all client scopes when none are requested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		key := newKey(t)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "user@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "user@example.com",
+			Password: "password123",
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:read", "files:write"}, resp.Scopes)
+	})
+
+	t.Run("should drop admin scope for users without admin role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		key := newKey(t)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "user@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "user@example.com",
+			Password: "password123",
+			ClientID: "client-123",
+			Scopes:   []string{"files:read", ScopeAdmin},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:read"}, resp.Scopes)
+
+		claims := parseAccessToken(t, key, resp.AccessToken)
+		assert.Equal(t, "files:read", claims.Scope)
+		assert.Equal(t, string(usermodel.RoleUser), claims.Role)
+	})
+
+	t.Run("should narrow scopes on refresh and keep refresh token scopes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		key := newKey(t)
+		refreshTokenHash := tokenentity.Hash("refresh-token-123")
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				FamilyID:  "family-123",
+				Subject:   "user-123",
+				ClientID:  "client-123",
+				Scopes:    []string{"files:read", "files:write"},
+				ExpiresAt: time.Now().Add(time.Hour),
+			}, nil)
+
+		tokenRepo.EXPECT().
+			MarkUsed(ctx, refreshTokenHash).
+			Return(nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token tokenentity.Token) error {
+				assert.Equal(t, []string{"files:read", "files:write"}, token.Scopes)
+				return nil
+			})
+
+		resp, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+			RefreshToken: "refresh-token-123",
+			ClientID:     "client-123",
+			Scopes:       []string{"files:read"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:read"}, resp.Scopes)
+
+		claims := parseAccessToken(t, key, resp.AccessToken)
+		assert.Equal(t, "files:read", claims.Scope)
+	})
+
+	t.Run("should return error when refresh widens scopes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			tokenRepository:  tokenRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		refreshTokenHash := tokenentity.Hash("refresh-token-123")
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				FamilyID:  "family-123",
+				Subject:   "user-123",
+				ClientID:  "client-123",
+				Scopes:    []string{"files:read"},
+				ExpiresAt: time.Now().Add(time.Hour),
+			}, nil)
+
+		_, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+			RefreshToken: "refresh-token-123",
+			ClientID:     "client-123",
+			Scopes:       []string{"files:read", "files:write"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+
+	t.Run("should drop admin scope on refresh after role is revoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		key := newKey(t)
+		refreshTokenHash := tokenentity.Hash("refresh-token-123")
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newScopedClient(), nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				FamilyID:  "family-123",
+				Subject:   "user-123",
+				ClientID:  "client-123",
+				Scopes:    []string{"files:read", ScopeAdmin},
+				ExpiresAt: time.Now().Add(time.Hour),
+			}, nil)
+
+		tokenRepo.EXPECT().
+			MarkUsed(ctx, refreshTokenHash).
+			Return(nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+			RefreshToken: "refresh-token-123",
+			ClientID:     "client-123",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"files:read"}, resp.Scopes)
+
+		claims := parseAccessToken(t, key, resp.AccessToken)
+		assert.Equal(t, string(usermodel.RoleUser), claims.Role)
+	})
+}
+
+func TestService_SigningAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cases := map[string]crypto.Signer{
+		keyentity.AlgorithmRS256: rsaKey,
+		keyentity.AlgorithmES256: ecdsaKey,
+		keyentity.AlgorithmEdDSA: ed25519Key,
+	}
+
+	for algorithm, privateKey := range cases {
+		t.Run("should sign access token with "+algorithm+" key", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			keyRepo := keymocks.NewMockIRepository(ctrl)
+
+			service := &Service{
+				cfg:            Config{Issuer: testIssuer},
+				keyRepository:  keyRepo,
+				sessionService: newSessionService(ctrl, nil),
+				auditService:   newAuditService(ctrl),
+			}
+
+			ctx := context.Background()
+
+			keyRepo.EXPECT().
+				GetCurrentSigningKey(ctx).
+				Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: algorithm, State: keyentity.StateActive}, nil)
+
+			accessToken, err := service.issueAccessToken(ctx, "user-123", usermodel.RoleUser, newTestClient("client-123"), nil, "", "")
+			require.NoError(t, err)
+
+			claims := AccessTokenClaims{}
+			parsed, err := jwt.ParseWithClaims(accessToken, &claims, func(token *jwt.Token) (any, error) {
+				return privateKey.Public(), nil
+			}, jwt.WithValidMethods([]string{algorithm}))
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, parsed.Header["alg"])
+			assert.Equal(t, JWTTypeAccessToken, parsed.Header["typ"])
+			assert.Equal(t, "user-123", claims.Subject)
+		})
+	}
+
+	t.Run("should return error for unsupported key algorithm", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:            Config{Issuer: testIssuer},
+			keyRepository:  keyRepo,
+			sessionService: newSessionService(ctrl, nil),
+			auditService:   newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: rsaKey, Public: rsaKey.Public(), Algorithm: "PS512", State: keyentity.StateActive}, nil)
+
+		_, err := service.issueAccessToken(ctx, "user-123", usermodel.RoleUser, newTestClient("client-123"), nil, "", "")
+		assert.ErrorIs(t, err, ErrUnsupportedSigningKey)
+	})
+}
+
+func TestService_MFA(t *testing.T) {
+	mfaConfig := mfaservice.Config{
+		Issuer:        "kgym",
+		RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+	}
+
+	secret := []byte("12345678901234567890")
+	enrollment := mfaentity.TOTP{
+		UserID:      "user-123",
+		Secret:      secret,
+		ConfirmedAt: time.Now().Add(-time.Hour),
+	}
+
+	admin := usermodel.User{ID: "user-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}
+
+	t.Run("should return mfa challenge instead of tokens when mfa is required", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, mfaRepo, challengeRepo, userRepo),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, admin.Email, "password123").
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(enrollment, nil)
+
+		var stored mfaentity.Challenge
+		challengeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, challenge mfaentity.Challenge) error {
+				stored = challenge
+				return nil
+			})
+
+		_, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    admin.Email,
+			Password: "password123",
+			ClientID: "client-123",
+			Scopes:   []string{ScopeOpenID},
+		})
+		require.ErrorIs(t, err, ErrMFARequired)
+
+		var mfaErr *MFARequiredError
+		require.True(t, errors.As(err, &mfaErr))
+		assert.False(t, mfaErr.EnrollmentRequired)
+		assert.Equal(t, tokenentity.Hash(mfaErr.Token), stored.TokenHash)
+		assert.Equal(t, admin.ID, stored.UserID)
+		assert.Equal(t, "client-123", stored.ClientID)
+		assert.Equal(t, []string{ScopeOpenID}, stored.Scopes)
+	})
+
+	t.Run("should require enrollment when role requires mfa", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, mfaRepo, challengeRepo, userRepo),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, admin.Email, "password123").
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(mfaentity.TOTP{}, mfarepo.ErrTOTPNotFound)
+
+		challengeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		_, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    admin.Email,
+			Password: "password123",
+			ClientID: "client-123",
+		})
+
+		var mfaErr *MFARequiredError
+		require.True(t, errors.As(err, &mfaErr))
+		assert.True(t, mfaErr.EnrollmentRequired)
+		assert.NotEmpty(t, mfaErr.Token)
+	})
+
+	t.Run("should issue tokens when mfa challenge is completed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, mfaRepo, challengeRepo, userRepo),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		challenge := mfaentity.Challenge{
+			TokenHash: tokenentity.Hash("mfa-token-123"),
+			UserID:    admin.ID,
+			ClientID:  "client-123",
+			Scopes:    []string{ScopeOpenID},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		challengeRepo.EXPECT().
+			Get(ctx, challenge.TokenHash).
+			Return(challenge, nil)
+
+		challengeRepo.EXPECT().
+			RecordAttempt(ctx, challenge).
+			Return(int64(1), nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(enrollment, nil)
+
+		mfaRepo.EXPECT().
+			UseTOTPStep(ctx, admin.ID, gomock.Any()).
+			Return(nil)
+
+		challengeRepo.EXPECT().
+			Consume(ctx, challenge.TokenHash).
+			Return(nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.MFAOTPGrant(ctx, MFAOTPGrantRequest{
+			MFAToken: "mfa-token-123",
+			OTP:      totp.Code(secret, totp.Step(time.Now())),
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotEmpty(t, resp.IDToken)
+		assert.Equal(t, []string{ScopeOpenID}, resp.Scopes)
+	})
+
+	t.Run("should return error when mfa challenge was issued to another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, nil, challengeRepo, nil),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-456").
+			Return(newTestClient("client-456"), nil)
+
+		challengeRepo.EXPECT().
+			Get(ctx, tokenentity.Hash("mfa-token-123")).
+			Return(mfaentity.Challenge{
+				UserID:    admin.ID,
+				ClientID:  "client-123",
+				ExpiresAt: time.Now().Add(time.Minute),
+			}, nil)
+
+		_, err := service.MFAOTPGrant(ctx, MFAOTPGrantRequest{
+			MFAToken: "mfa-token-123",
+			OTP:      "123456",
+			ClientID: "client-456",
+		})
+		assert.ErrorIs(t, err, mfaservice.ErrInvalidChallenge)
+	})
+
+	t.Run("should require one-time password on authorize", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, mfaRepo, nil, userRepo),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, admin.Email, "password123").
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(enrollment, nil)
+
+		_, err := service.Authorize(ctx, AuthorizeRequest{
+			ClientID:            "client-123",
+			RedirectURI:         "https://app.example.com/callback",
+			ResponseType:        ResponseTypeCode,
+			CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			CodeChallengeMethod: CodeChallengeMethodS256,
+			Email:               admin.Email,
+			Password:            "password123",
+		})
+		assert.ErrorIs(t, err, ErrMFARequired)
+	})
+
+	t.Run("should count wrong one-time passwords on authorize as failed attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       mfaservice.NewService(mfaConfig, mfaRepo, nil, userRepo),
+			throttleService:  throttleservice.NewService(throttleservice.Config{}, throttleRepo),
+			sessionService:   newSessionService(ctrl, nil),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, admin.Email, "password123").
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(enrollment, nil).
+			Times(2)
+
+		// The failures of the account are not reset by the right password,
+		// or the one-time password could be guessed without limit.
+		throttleRepo.EXPECT().LockedUntil(ctx, gomock.Any()).Return(time.Time{}, nil).Times(3)
+		throttleRepo.EXPECT().RecordFailure(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).Times(3)
+
+		_, err := service.Authorize(ctx, AuthorizeRequest{
+			ClientID:            "client-123",
+			RedirectURI:         "https://app.example.com/callback",
+			ResponseType:        ResponseTypeCode,
+			CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			CodeChallengeMethod: CodeChallengeMethodS256,
+			Email:               admin.Email,
+			Password:            "password123",
+			IP:                  "203.0.113.1",
+			OTP:                 totp.Code(secret, totp.Step(time.Now())-10),
+		})
+		assert.ErrorIs(t, err, mfaservice.ErrInvalidCode)
+	})
+}
+
+// stubSender keeps the passwordless messages it is asked to send.
+type stubSender struct {
+	messages []passwordlessservice.Message
+}
+
+func (s *stubSender) Send(_ context.Context, msg passwordlessservice.Message) error {
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// newPasswordlessService returns a passwordless service whose challenges are
+// kept in memory by the mocked repository.
+func newPasswordlessService(ctrl *gomock.Controller, userRepo userrepo.IRepository, sender passwordlessservice.Sender) *passwordlessservice.Service {
+	challenges := make(map[string]passwordlessentity.Challenge)
+
+	passwordlessRepo := passwordlessmocks.NewMockIRepository(ctrl)
+	passwordlessRepo.EXPECT().RecordSend(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), time.Hour, nil).AnyTimes()
+	passwordlessRepo.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+	passwordlessRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, challenge passwordlessentity.Challenge) error {
+			challenges[challenge.TokenHash] = challenge
+			return nil
+		}).
+		AnyTimes()
+	passwordlessRepo.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tokenHash string) (passwordlessentity.Challenge, error) {
+			challenge, ok := challenges[tokenHash]
+			if !ok {
+				return passwordlessentity.Challenge{}, passwordlessrepo.ErrChallengeNotFound
+			}
+			return challenge, nil
+		}).
+		AnyTimes()
+	passwordlessRepo.EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tokenHash string) error {
+			if _, ok := challenges[tokenHash]; !ok {
+				return passwordlessrepo.ErrChallengeNotFound
+			}
+			delete(challenges, tokenHash)
+			return nil
+		}).
+		AnyTimes()
+
+	return passwordlessservice.NewService(passwordlessservice.Config{
+		Secret:       []byte("0123456789abcdef0123456789abcdef"),
+		ChallengeTTL: 10 * time.Minute,
+		LinkURL:      "https://app.example.com/sign-in",
+	}, passwordlessRepo, userRepo, sender)
+}
+
+func TestService_Passwordless(t *testing.T) {
+	user := usermodel.User{ID: "user-123", Email: "test@example.com", Role: usermodel.RoleUser}
+
+	t.Run("should issue tokens for code sent by email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		sender := &stubSender{}
+
+		service := &Service{
+			cfg:                 Config{Issuer: testIssuer},
+			userRepository:      userRepo,
+			tokenRepository:     tokenRepo,
+			keyRepository:       keyRepo,
+			clientRepository:    clientRepo,
+			mfaService:          newMFAService(ctrl),
+			throttleService:     newThrottleService(ctrl),
+			sessionService:      newSessionService(ctrl, tokenRepo),
+			passwordlessService: newPasswordlessService(ctrl, userRepo, sender),
+			auditService:        newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(3)
+
+		userRepo.EXPECT().
+			GetByEmail(ctx, user.Email).
+			Return(user, nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, user.ID).
+			Return(user, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		started, err := service.StartPasswordless(ctx, StartPasswordlessRequest{
+			Email:    user.Email,
+			ClientID: "client-123",
+			Scopes:   []string{ScopeOpenID},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, started.Token)
+		assert.Equal(t, 10*time.Minute, started.ExpiresIn)
+		require.Len(t, sender.messages, 1)
+
+		resp, err := service.PasswordlessGrant(ctx, PasswordlessGrantRequest{
+			Token:    started.Token,
+			Code:     sender.messages[0].Code,
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotEmpty(t, resp.IDToken)
+		assert.Equal(t, []string{ScopeOpenID}, resp.Scopes)
+
+		_, err = service.PasswordlessGrant(ctx, PasswordlessGrantRequest{
+			Token:    started.Token,
+			Code:     sender.messages[0].Code,
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, passwordlessservice.ErrInvalidChallenge)
+	})
+
+	t.Run("should return mfa challenge when mfa is required", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		admin := usermodel.User{ID: "user-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+		sender := &stubSender{}
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService: mfaservice.NewService(mfaservice.Config{
+				RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+			}, mfaRepo, challengeRepo, userRepo),
+			throttleService:     newThrottleService(ctrl),
+			sessionService:      newSessionService(ctrl, nil),
+			passwordlessService: newPasswordlessService(ctrl, userRepo, sender),
+			auditService:        newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(2)
+
+		userRepo.EXPECT().
+			GetByEmail(ctx, admin.Email).
+			Return(admin, nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(mfaentity.TOTP{UserID: admin.ID, ConfirmedAt: time.Now()}, nil)
+
+		challengeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		started, err := service.StartPasswordless(ctx, StartPasswordlessRequest{
+			Email:    admin.Email,
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		require.Len(t, sender.messages, 1)
+
+		_, err = service.PasswordlessGrant(ctx, PasswordlessGrantRequest{
+			Token:    started.Token,
+			Code:     sender.messages[0].Code,
+			ClientID: "client-123",
+		})
+
+		var mfaErr *MFARequiredError
+		require.True(t, errors.As(err, &mfaErr))
+		assert.False(t, mfaErr.EnrollmentRequired)
+		assert.NotEmpty(t, mfaErr.Token)
+	})
+
+	t.Run("should return error when client may not use passwordless grant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		sender := &stubSender{}
+
+		service := &Service{
+			cfg:                 Config{Issuer: testIssuer},
+			clientRepository:    clientRepo,
+			passwordlessService: newPasswordlessService(ctrl, nil, sender),
+			auditService:        newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newTestClient("client-123")
+		client.GrantTypes = []string{cliententity.GrantTypePassword}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		_, err := service.StartPasswordless(ctx, StartPasswordlessRequest{
+			Email:    user.Email,
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+		assert.Empty(t, sender.messages)
+	})
+}
+
+// newDeviceService returns a device service whose authorizations are kept in
+// memory by the mocked repository. Devices are never slowed down.
+func newDeviceService(ctrl *gomock.Controller) *deviceservice.Service {
+	authorizations := make(map[string]deviceentity.Authorization)
+
+	get := func(deviceCodeHash string) (deviceentity.Authorization, error) {
+		authorization, ok := authorizations[deviceCodeHash]
+		if !ok {
+			return deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound
+		}
+		return authorization, nil
+	}
+
+	deviceRepo := devicemocks.NewMockIRepository(ctrl)
+	deviceRepo.EXPECT().RecordPoll(gomock.Any(), gomock.Any(), gomock.Any()).Return(time.Time{}, nil).AnyTimes()
+	deviceRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, authorization deviceentity.Authorization) error {
+			authorizations[authorization.DeviceCodeHash] = authorization
+			return nil
+		}).
+		AnyTimes()
+	deviceRepo.EXPECT().
+		GetByDeviceCode(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, deviceCodeHash string) (deviceentity.Authorization, error) {
+			return get(deviceCodeHash)
+		}).
+		AnyTimes()
+	deviceRepo.EXPECT().
+		GetByUserCode(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, userCodeHash string) (deviceentity.Authorization, error) {
+			for _, authorization := range authorizations {
+				if authorization.UserCodeHash == userCodeHash {
+					return authorization, nil
+				}
+			}
+			return deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound
+		}).
+		AnyTimes()
+	deviceRepo.EXPECT().
+		Decide(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, deviceCodeHash string, status deviceentity.Status, userID string) error {
+			authorization, err := get(deviceCodeHash)
+			if err != nil {
+				return err
+			}
+			if authorization.Status != deviceentity.StatusPending {
+				return devicerepo.ErrAlreadyDecided
+			}
+			authorization.Status = status
+			authorization.UserID = userID
+			authorizations[deviceCodeHash] = authorization
+			return nil
+		}).
+		AnyTimes()
+	deviceRepo.EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, authorization deviceentity.Authorization) error {
+			if _, err := get(authorization.DeviceCodeHash); err != nil {
+				return err
+			}
+			delete(authorizations, authorization.DeviceCodeHash)
+			return nil
+		}).
+		AnyTimes()
+
+	return deviceservice.NewService(deviceservice.Config{
+		CodeTTL:         10 * time.Minute,
+		Interval:        5 * time.Second,
+		VerificationURL: "https://app.example.com/device",
+	}, deviceRepo)
+}
+
+func TestService_DeviceAuthorization(t *testing.T) {
+	user := usermodel.User{ID: "user-123", Email: "test@example.com", Role: usermodel.RoleUser}
+
+	device := sessionentity.Device{
+		Name: "Lobby TV",
+		IP:   "203.0.113.7",
+	}
+
+	t.Run("should issue tokens once the user approves the device", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			deviceService:    newDeviceService(ctrl),
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(6)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, user.Email, "password123").
+			Return(user, nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, user.ID).
+			Return(user, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		started, err := service.StartDeviceAuthorization(ctx, StartDeviceAuthorizationRequest{
+			ClientID: "client-123",
+			Scopes:   []string{ScopeOpenID, ScopeProfile},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, started.DeviceCode)
+		require.NotEmpty(t, started.UserCode)
+		assert.Equal(t, 10*time.Minute, started.ExpiresIn)
+		assert.Equal(t, 5*time.Second, started.Interval)
+		assert.Contains(t, started.VerificationURLComplete, started.VerificationURL+"?user_code=")
+
+		_, err = service.DeviceCodeGrant(ctx, DeviceCodeGrantRequest{
+			DeviceCode: started.DeviceCode,
+			Device:     device,
+			ClientID:   "client-123",
+		})
+		assert.ErrorIs(t, err, deviceservice.ErrAuthorizationPending)
+
+		authorization, err := service.GetDeviceAuthorization(ctx, started.UserCode)
+		require.NoError(t, err)
+		assert.Equal(t, "client-123", authorization.ClientID)
+		assert.Equal(t, "test", authorization.ClientName)
+		assert.Equal(t, []string{ScopeOpenID, ScopeProfile}, authorization.Scopes)
+
+		_, err = service.AuthorizeDevice(ctx, AuthorizeDeviceRequest{
+			UserCode: strings.ToLower(started.UserCode),
+			Approve:  true,
+			Email:    user.Email,
+			Password: "password123",
+			IP:       "198.51.100.1",
+		})
+		require.NoError(t, err)
+
+		resp, err := service.DeviceCodeGrant(ctx, DeviceCodeGrantRequest{
+			DeviceCode: started.DeviceCode,
+			Device:     device,
+			ClientID:   "client-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotEmpty(t, resp.IDToken)
+		assert.Equal(t, []string{ScopeOpenID, ScopeProfile}, resp.Scopes)
+
+		// Polls of the waiting device are not recorded.
+		require.Len(t, events, 2)
+		assert.Equal(t, authevententity.TypeLogin, events[0].Type)
+		assert.Equal(t, user.ID, events[0].Subject)
+		assert.Equal(t, "client-123", events[0].ClientID)
+		assert.Equal(t, "198.51.100.1", events[0].IP)
+		assert.Equal(t, authevententity.TypeDeviceCode, events[1].Type)
+		assert.Equal(t, user.ID, events[1].Subject)
+		assert.Equal(t, device.IP, events[1].IP)
+		assert.Equal(t, authevententity.OutcomeSuccess, events[1].Outcome)
+
+		_, err = service.DeviceCodeGrant(ctx, DeviceCodeGrantRequest{
+			DeviceCode: started.DeviceCode,
+			Device:     device,
+			ClientID:   "client-123",
+		})
+		assert.ErrorIs(t, err, deviceservice.ErrInvalidDeviceCode)
+	})
+
+	t.Run("should deny device without issuing tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			deviceService:    newDeviceService(ctrl),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(3)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, user.Email, "password123").
+			Return(user, nil)
+
+		started, err := service.StartDeviceAuthorization(ctx, StartDeviceAuthorizationRequest{
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+
+		_, err = service.AuthorizeDevice(ctx, AuthorizeDeviceRequest{
+			UserCode: started.UserCode,
+			Email:    user.Email,
+			Password: "password123",
+		})
+		require.NoError(t, err)
+
+		_, err = service.DeviceCodeGrant(ctx, DeviceCodeGrantRequest{
+			DeviceCode: started.DeviceCode,
+			ClientID:   "client-123",
+		})
+		assert.ErrorIs(t, err, deviceservice.ErrAccessDenied)
+	})
+
+	t.Run("should require second factor to approve device", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		admin := usermodel.User{ID: "user-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService: mfaservice.NewService(mfaservice.Config{
+				RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+			}, mfaRepo, nil, userRepo),
+			throttleService: newThrottleService(ctrl),
+			deviceService:   newDeviceService(ctrl),
+			auditService:    newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(3)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, admin.Email, "password123").
+			Return(admin, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, admin.ID).
+			Return(mfaentity.TOTP{UserID: admin.ID, ConfirmedAt: time.Now()}, nil)
+
+		started, err := service.StartDeviceAuthorization(ctx, StartDeviceAuthorizationRequest{
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+
+		_, err = service.AuthorizeDevice(ctx, AuthorizeDeviceRequest{
+			UserCode: started.UserCode,
+			Approve:  true,
+			Email:    admin.Email,
+			Password: "password123",
+		})
+		assert.ErrorIs(t, err, ErrMFARequired)
+
+		_, err = service.GetDeviceAuthorization(ctx, started.UserCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject unknown user code before checking credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service := &Service{
+			cfg:           Config{Issuer: testIssuer},
+			deviceService: newDeviceService(ctrl),
+			auditService:  newAuditService(ctrl),
+		}
+
+		_, err := service.AuthorizeDevice(context.Background(), AuthorizeDeviceRequest{
+			UserCode: "BCDF-GHJK",
+			Approve:  true,
+			Email:    user.Email,
+			Password: "password123",
+		})
+		assert.ErrorIs(t, err, deviceservice.ErrInvalidUserCode)
+	})
+
+	t.Run("should return error when client may not use device code grant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			deviceService:    newDeviceService(ctrl),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newTestClient("client-123")
+		client.GrantTypes = []string{cliententity.GrantTypePassword}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		_, err := service.StartDeviceAuthorization(ctx, StartDeviceAuthorizationRequest{
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+}
+
+// stubFederationService stands in for the upstream providers. It records the
+// started request and completes every callback as user.
+type stubFederationService struct {
+	started federationservice.StartRequest
+	user    usermodel.User
+}
+
+func (s *stubFederationService) ListProviders(context.Context) []federationservice.Provider {
+	return nil
+}
+
+func (s *stubFederationService) Start(_ context.Context, req federationservice.StartRequest) (federationservice.StartResponse, error) {
+	s.started = req
+	return federationservice.StartResponse{AuthorizationURL: "https://idp.example.com/authorize"}, nil
+}
+
+func (s *stubFederationService) Complete(_ context.Context, req federationservice.CompleteRequest) (federationservice.CompleteResponse, error) {
+	if req.State == "" {
+		return federationservice.CompleteResponse{}, federationservice.ErrInvalidState
+	}
+
+	return federationservice.CompleteResponse{
+		User:          s.user,
+		Authorization: s.started.Authorization,
+	}, nil
+}
+
+// newPasskeyService returns a passkey service whose credentials and
+// ceremonies are kept in memory by the mocked repositories.
+func newPasskeyService(ctrl *gomock.Controller, userRepo userrepo.IRepository) *passkeyservice.Service {
+	credentials := make(map[string]passkeyentity.Credential)
+	ceremonies := make(map[string]passkeyentity.Ceremony)
+
+	passkeyRepo := passkeymocks.NewMockIRepository(ctrl)
+	passkeyRepo.EXPECT().ListByUser(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	passkeyRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, credential passkeyentity.Credential) error {
+			credentials[string(credential.CredentialID)] = credential
+			return nil
+		}).
+		AnyTimes()
+	passkeyRepo.EXPECT().
+		GetByCredentialID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, credentialID []byte) (passkeyentity.Credential, error) {
+			credential, ok := credentials[string(credentialID)]
+			if !ok {
+				return passkeyentity.Credential{}, passkeyrepo.ErrCredentialNotFound
+			}
+			return credential, nil
+		}).
+		AnyTimes()
+	passkeyRepo.EXPECT().RecordUse(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	ceremonyRepo := passkeyceremonymocks.NewMockIRepository(ctrl)
+	ceremonyRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ceremony passkeyentity.Ceremony) error {
+			ceremonies[ceremony.TokenHash] = ceremony
+			return nil
+		}).
+		AnyTimes()
+	ceremonyRepo.EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tokenHash string) (passkeyentity.Ceremony, error) {
+			ceremony, ok := ceremonies[tokenHash]
+			if !ok {
+				return passkeyentity.Ceremony{}, passkeyceremonyrepo.ErrCeremonyNotFound
+			}
+			delete(ceremonies, tokenHash)
+			return ceremony, nil
+		}).
+		AnyTimes()
+
+	return passkeyservice.NewService(testPasskeyConfig, passkeyRepo, ceremonyRepo, userRepo)
+}
+
+var testPasskeyConfig = passkeyservice.Config{
+	RPID:        "app.example.com",
+	RPName:      "kgym",
+	Origins:     []string{"https://app.example.com"},
+	CeremonyTTL: 5 * time.Minute,
+}
+
+func TestService_WebAuthnGrant(t *testing.T) {
+	admin := usermodel.User{ID: "user-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}
+
+	device := sessionentity.Device{
+		Name: "iPhone",
+		IP:   "203.0.113.7",
+	}
+
+	// registerPasskey registers a passkey of admin on the authenticator.
+	registerPasskey := func(t *testing.T, passkeyService *passkeyservice.Service, authenticator *webauthntest.Authenticator) {
+		t.Helper()
+
+		ctx := context.Background()
+
+		begin, err := passkeyService.BeginRegistration(ctx, admin.ID)
+		require.NoError(t, err)
+
+		response, err := authenticator.Register(begin.Options)
+		require.NoError(t, err)
+
+		_, err = passkeyService.FinishRegistration(ctx, passkeyservice.FinishRegistrationRequest{
+			Token:      begin.Token,
+			UserID:     admin.ID,
+			Credential: response,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("should issue tokens without asking for second factor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		passkeyService := newPasskeyService(ctrl, userRepo)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService: mfaservice.NewService(mfaservice.Config{
+				RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+			}, mfaRepo, nil, userRepo),
+			sessionService: newSessionService(ctrl, tokenRepo),
+			passkeyService: passkeyService,
+			auditService:   newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil).
+			Times(2)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(2)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		authenticator := webauthntest.New(webauthntest.Config{Origin: testPasskeyConfig.Origins[0]})
+		registerPasskey(t, passkeyService, authenticator)
+
+		started, err := service.StartPasskeyLogin(ctx, StartPasskeyLoginRequest{
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, started.Token)
+		assert.Equal(t, testPasskeyConfig.CeremonyTTL, started.ExpiresIn)
+
+		response, err := authenticator.Login(started.Options)
+		require.NoError(t, err)
+
+		resp, err := service.WebAuthnGrant(ctx, WebAuthnGrantRequest{
+			Token:      started.Token,
+			Credential: response,
+			Device:     device,
+			ClientID:   "client-123",
+			Scopes:     []string{ScopeOpenID, ScopeProfile},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotEmpty(t, resp.IDToken)
+		assert.Equal(t, []string{ScopeOpenID, ScopeProfile}, resp.Scopes)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeLogin, events[0].Type)
+		assert.Equal(t, admin.ID, events[0].Subject)
+		assert.Equal(t, device.IP, events[0].IP)
+		assert.Equal(t, authevententity.OutcomeSuccess, events[0].Outcome)
+	})
+
+	t.Run("should not answer challenge twice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		passkeyService := newPasskeyService(ctrl, userRepo)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			passkeyService:   passkeyService,
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil).
+			Times(2)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(3)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		authenticator := webauthntest.New(webauthntest.Config{Origin: testPasskeyConfig.Origins[0]})
+		registerPasskey(t, passkeyService, authenticator)
+
+		started, err := service.StartPasskeyLogin(ctx, StartPasskeyLoginRequest{
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+
+		response, err := authenticator.Login(started.Options)
+		require.NoError(t, err)
+
+		req := WebAuthnGrantRequest{
+			Token:      started.Token,
+			Credential: response,
+			Device:     device,
+			ClientID:   "client-123",
+		}
+
+		_, err = service.WebAuthnGrant(ctx, req)
+		require.NoError(t, err)
+
+		_, err = service.WebAuthnGrant(ctx, req)
+		assert.ErrorIs(t, err, passkeyservice.ErrInvalidCeremony)
+
+		require.Len(t, events, 2)
+		assert.Equal(t, authevententity.OutcomeFailure, events[1].Outcome)
+		assert.Empty(t, events[1].Subject)
+		assert.Equal(t, passkeyservice.ErrInvalidCeremony.Error(), events[1].Reason)
+	})
+
+	t.Run("should reject client not allowed to use grant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			passkeyService:   newPasskeyService(ctrl, usermocks.NewMockIRepository(ctrl)),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newTestClient("client-123")
+		client.GrantTypes = []string{cliententity.GrantTypePassword}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil).
+			Times(2)
+
+		_, err := service.StartPasskeyLogin(ctx, StartPasskeyLoginRequest{
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+
+		_, err = service.WebAuthnGrant(ctx, WebAuthnGrantRequest{
+			Token:    "token-123",
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+}
+
+func TestService_Federation(t *testing.T) {
+	redirectURI := "https://app.example.com/callback"
+	codeChallenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	newRequest := func() StartFederationRequest {
+		return StartFederationRequest{
+			Provider: "acme",
+			Authorization: AuthorizeRequest{
+				ClientID:            "client-123",
+				RedirectURI:         redirectURI,
+				ResponseType:        ResponseTypeCode,
+				Scopes:              []string{ScopeOpenID, ScopeEmail},
+				State:               "state-123",
+				Nonce:               "nonce-123",
+				CodeChallenge:       codeChallenge,
+				CodeChallengeMethod: CodeChallengeMethodS256,
+			},
+		}
+	}
+
+	t.Run("should issue authorization code once the user signed in upstream", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		authCodeRepo := authcodemocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		federation := &stubFederationService{
+			user: usermodel.User{ID: "user-123", Email: "jane@corp.test", Role: usermodel.RoleUser},
+		}
+
+		service := &Service{
+			cfg:                Config{Issuer: testIssuer},
+			authCodeRepository: authCodeRepo,
+			clientRepository:   clientRepo,
+			mfaService:         newMFAService(ctrl),
+			federationService:  federation,
+			auditService:       newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(2)
+
+		started, err := service.StartFederation(ctx, newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize", started.AuthorizationURL)
+		assert.Equal(t, "acme", federation.started.Provider)
+		assert.Equal(t, "client-123", federation.started.Authorization.ClientID)
+		assert.Equal(t, []string{ScopeOpenID, ScopeEmail}, federation.started.Authorization.Scopes)
+
+		var stored authcodeentity.AuthorizationCode
+		authCodeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, code authcodeentity.AuthorizationCode) error {
+				stored = code
+				return nil
+			})
+
+		resp, err := service.CompleteFederation(ctx, CompleteFederationRequest{
+			State: "upstream-state",
+			Code:  "upstream-code",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.Code)
+		assert.Equal(t, redirectURI, resp.RedirectURI)
+		assert.Equal(t, "state-123", resp.State)
+
+		assert.Equal(t, tokenentity.Hash(resp.Code), stored.CodeHash)
+		assert.Equal(t, "user-123", stored.Subject)
+		assert.Equal(t, "nonce-123", stored.Nonce)
+		assert.Equal(t, codeChallenge, stored.CodeChallenge)
+		assert.Equal(t, []string{ScopeOpenID, ScopeEmail}, stored.Scopes)
+	})
+
+	t.Run("should validate authorization request before redirecting upstream", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		federation := &stubFederationService{}
+
+		service := &Service{
+			cfg:               Config{Issuer: testIssuer},
+			clientRepository:  clientRepo,
+			federationService: federation,
+			auditService:      newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		req := newRequest()
+		req.Authorization.RedirectURI = "https://evil.example.com/callback"
+
+		_, err := service.StartFederation(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidRedirectURI)
+		assert.Empty(t, federation.started.Provider)
+	})
+
+	t.Run("should return error when user has to present a second factor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		federation := &stubFederationService{
+			user: usermodel.User{ID: "user-123", Email: "admin@corp.test", Role: usermodel.RoleAdmin},
+		}
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			mfaService: mfaservice.NewService(mfaservice.Config{
+				RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+			}, mfaRepo, nil, nil),
+			federationService: federation,
+			auditService:      newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(2)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{UserID: "user-123", ConfirmedAt: time.Now()}, nil)
+
+		_, err := service.StartFederation(ctx, newRequest())
+		require.NoError(t, err)
+
+		_, err = service.CompleteFederation(ctx, CompleteFederationRequest{
+			State: "upstream-state",
+			Code:  "upstream-code",
+		})
+		assert.ErrorIs(t, err, ErrMFARequired)
+	})
+}
+
+func TestService_TokenExchangeGrant(t *testing.T) {
+	admin := usermodel.User{ID: "admin-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}
+	member := usermodel.User{ID: "user-123", Email: "user@example.com", Role: usermodel.RoleUser}
+
+	newActor := func() AccessTokenClaims {
+		return AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: admin.ID},
+			Scope:            strings.Join([]string{ScopeOpenID, ScopeAdmin}, " "),
+			Role:             string(usermodel.RoleAdmin),
+		}
+	}
+
+	newRequest := func() TokenExchangeGrantRequest {
+		return TokenExchangeGrantRequest{
+			Actor:            newActor(),
+			SubjectTokenType: TokenTypeAccessToken,
+			RequestedSubject: member.ID,
+			ClientID:         "console-123",
+		}
+	}
+
+	newConsoleClient := func() cliententity.Client {
+		client := newTestClient("console-123")
+		client.Scopes = []string{ScopeOpenID, ScopeProfile, ScopeAdmin}
+		client.AccessTokenTTL = time.Hour
+		return client
+	}
+
+	t.Run("should issue short-lived token naming the admin as actor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(newConsoleClient(), nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+		userRepo.EXPECT().
+			GetByID(ctx, member.ID).
+			Return(member, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		req := newRequest()
+		req.Scopes = []string{ScopeProfile, ScopeAdmin}
+
+		resp, err := service.TokenExchangeGrant(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeAccessToken, resp.IssuedTokenType)
+		assert.Equal(t, []string{ScopeProfile}, resp.Scopes)
+
+		claims := AccessTokenClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, member.ID, claims.Subject)
+		assert.Equal(t, string(usermodel.RoleUser), claims.Role)
+		assert.Equal(t, ScopeProfile, claims.Scope)
+		assert.Empty(t, claims.SessionID)
+		require.NotNil(t, claims.Actor)
+		assert.Equal(t, admin.ID, claims.Actor.Subject)
+		assert.Equal(t, ImpersonationTokenTTL, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+	})
+
+	t.Run("should return error when actor is not an admin", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			modify func(actor *AccessTokenClaims)
+		}{
+			{
+				name:   "user role",
+				modify: func(actor *AccessTokenClaims) { actor.Role = string(usermodel.RoleUser) },
+			},
+			{
+				name:   "no admin scope",
+				modify: func(actor *AccessTokenClaims) { actor.Scope = ScopeOpenID },
+			},
+			{
+				name:   "impersonation token",
+				modify: func(actor *AccessTokenClaims) { actor.Actor = &ActorClaims{Subject: "admin-456"} },
+			},
+		}
+
+		for _, tc := range testCases {
+			ctrl := gomock.NewController(t)
+
+			clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+			service := &Service{
+				cfg:              Config{Issuer: testIssuer},
+				clientRepository: clientRepo,
+				auditService:     newAuditService(ctrl),
+			}
+
+			ctx := context.Background()
+
+			clientRepo.EXPECT().
+				GetByID(ctx, "console-123").
+				Return(newConsoleClient(), nil)
+
+			req := newRequest()
+			tc.modify(&req.Actor)
+
+			_, err := service.TokenExchangeGrant(ctx, req)
+			assert.ErrorIs(t, err, ErrImpersonationNotAllowed, tc.name)
+
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("should return error when admin role was revoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(newConsoleClient(), nil)
+
+		demoted := admin
+		demoted.Role = usermodel.RoleUser
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(demoted, nil)
+
+		_, err := service.TokenExchangeGrant(ctx, newRequest())
+		assert.ErrorIs(t, err, ErrImpersonationNotAllowed)
+	})
+
+	t.Run("should return error when impersonating another admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(newConsoleClient(), nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+		userRepo.EXPECT().
+			GetByID(ctx, "admin-456").
+			Return(usermodel.User{ID: "admin-456", Role: usermodel.RoleAdmin}, nil)
+
+		req := newRequest()
+		req.RequestedSubject = "admin-456"
+
+		_, err := service.TokenExchangeGrant(ctx, req)
+		assert.ErrorIs(t, err, ErrImpersonationNotAllowed)
+	})
+
+	t.Run("should return error when requested subject is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(newConsoleClient(), nil).
+			Times(3)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+		userRepo.EXPECT().
+			GetByID(ctx, "user-unknown").
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+
+		for _, subject := range []string{"", admin.ID, "user-unknown"} {
+			req := newRequest()
+			req.RequestedSubject = subject
+
+			_, err := service.TokenExchangeGrant(ctx, req)
+			assert.ErrorIs(t, err, ErrInvalidRequestedSubject, subject)
+		}
+	})
+
+	t.Run("should return error for unsupported subject token type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(newConsoleClient(), nil)
+
+		req := newRequest()
+		req.SubjectTokenType = "urn:ietf:params:oauth:token-type:refresh_token"
+
+		_, err := service.TokenExchangeGrant(ctx, req)
+		assert.ErrorIs(t, err, ErrUnsupportedTokenType)
+	})
+
+	t.Run("should return error when client may not exchange tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newConsoleClient()
+		client.GrantTypes = []string{cliententity.GrantTypeAuthorizationCode}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "console-123").
+			Return(client, nil)
+
+		_, err := service.TokenExchangeGrant(ctx, newRequest())
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+}
+
+// newAPIKeyService returns an API key service that knows key, whose secret
+// is "secret".
+func newAPIKeyService(ctrl *gomock.Controller, key apikeyentity.Key) *apikeyservice.Service {
+	apiKeyRepo := apikeymocks.NewMockIRepository(ctrl)
+	apiKeyRepo.EXPECT().GetByPrefix(gomock.Any(), key.Prefix).Return(key, nil).AnyTimes()
+	apiKeyRepo.EXPECT().RecordUse(gomock.Any(), key.ID, gomock.Any()).Return(nil).AnyTimes()
+
+	return apikeyservice.NewService(apiKeyRepo, nil)
+}
+
+func TestService_APIKeyGrant(t *testing.T) {
+	admin := usermodel.User{
+		ID:    "admin-123",
+		Email: "admin@example.com",
+		Role:  usermodel.RoleAdmin,
+	}
+
+	newKey := func(owner apikeyentity.Owner) apikeyentity.Key {
+		return apikeyentity.Key{
+			ID:         "key-123",
+			Prefix:     apikeyservice.PrefixTag + "0123456789abcdef",
+			SecretHash: tokenentity.Hash("secret"),
+			Owner:      owner,
+			ClientID:   "client-123",
+			Scopes:     []string{ScopeAdmin, ScopeProfile},
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+	}
+
+	newClient := func() cliententity.Client {
+		client := newTestClient("client-123")
+		client.Scopes = append(client.Scopes, ScopeAdmin)
+		return client
+	}
+
+	apiKey := func(key apikeyentity.Key) string {
+		return key.Prefix + apikeyservice.Separator + "secret"
+	}
+
+	t.Run("should issue short-lived token without admin rights", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: admin.ID})
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			apiKeyService:    newAPIKeyService(ctrl, key),
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		userRepo.EXPECT().
+			GetByID(ctx, admin.ID).
+			Return(admin, nil)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newClient(), nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		resp, err := service.APIKeyGrant(ctx, APIKeyGrantRequest{
+			APIKey: apiKey(key),
+			IP:     "203.0.113.7",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{ScopeProfile}, resp.Scopes)
+
+		claims := AccessTokenClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, admin.ID, claims.Subject)
+		assert.Equal(t, []string{"client-123"}, []string(claims.Audience))
+		assert.Equal(t, ScopeProfile, claims.Scope)
+		assert.Empty(t, claims.Role)
+		assert.Equal(t, key.ID, claims.APIKeyID)
+		assert.Equal(t, APIKeyTokenTTL, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeAPIKey, events[0].Type)
+		assert.Equal(t, admin.ID, events[0].Subject)
+		assert.Equal(t, "client-123", events[0].ClientID)
+		assert.Equal(t, "203.0.113.7", events[0].IP)
+		assert.Equal(t, authevententity.OutcomeSuccess, events[0].Outcome)
+	})
+
+	t.Run("should issue token of key owned by client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: "client-123"})
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			apiKeyService:    newAPIKeyService(ctrl, key),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		client := newClient()
+		client.AccessTokenTTL = time.Minute
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		resp, err := service.APIKeyGrant(ctx, APIKeyGrantRequest{
+			APIKey: apiKey(key),
+			Scopes: []string{ScopeProfile},
+		})
+		require.NoError(t, err)
+
+		claims := AccessTokenClaims{}
+		_, err = jwt.NewParser().ParseWithClaims(resp.AccessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "client-123", claims.Subject)
+		assert.Equal(t, time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+	})
+
+	t.Run("should reject scopes the key does not have", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: "client-123"})
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			apiKeyService:    newAPIKeyService(ctrl, key),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newClient(), nil)
+
+		_, err := service.APIKeyGrant(ctx, APIKeyGrantRequest{
+			APIKey: apiKey(key),
+			Scopes: []string{ScopeEmail},
+		})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+
+	t.Run("should reject key with wrong secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: admin.ID})
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:           Config{Issuer: testIssuer},
+			apiKeyService: newAPIKeyService(ctrl, key),
+			auditService:  newRecordingAuditService(ctrl, &events),
+		}
+
+		_, err := service.APIKeyGrant(context.Background(), APIKeyGrantRequest{
+			APIKey: key.Prefix + apikeyservice.Separator + "guess",
+		})
+		assert.ErrorIs(t, err, apikeyservice.ErrInvalidKey)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.OutcomeFailure, events[0].Outcome)
+		assert.Equal(t, apikeyservice.ErrInvalidKey.Error(), events[0].Reason)
+	})
+
+	t.Run("should reject key of deleted user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeUser, ID: "user-123"})
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			apiKeyService:    newAPIKeyService(ctrl, key),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newClient(), nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+
+		_, err := service.APIKeyGrant(ctx, APIKeyGrantRequest{APIKey: apiKey(key)})
+		assert.ErrorIs(t, err, apikeyservice.ErrInvalidKey)
+	})
+
+	t.Run("should reject client no longer allowed to use grant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		key := newKey(apikeyentity.Owner{Type: apikeyentity.OwnerTypeClient, ID: "client-123"})
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			apiKeyService:    newAPIKeyService(ctrl, key),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newClient()
+		client.GrantTypes = []string{cliententity.GrantTypeClientCredentials}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		_, err := service.APIKeyGrant(ctx, APIKeyGrantRequest{APIKey: apiKey(key)})
+		assert.ErrorIs(t, err, ErrUnauthorizedClient)
+	})
+}
+
+func TestService_DPoP(t *testing.T) {
+	const jkt = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key := keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}
+
+	parse := func(t *testing.T, accessToken string) AccessTokenClaims {
+		t.Helper()
+
+		claims := AccessTokenClaims{}
+		_, err := jwt.NewParser().ParseWithClaims(accessToken, &claims, func(token *jwt.Token) (interface{}, error) {
+			return privateKey.Public(), nil
+		})
+		require.NoError(t, err)
+
+		return claims
+	}
+
+	t.Run("should bind tokens of public clients to the key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "test@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, token tokenentity.Token) error {
+				assert.Equal(t, jkt, token.JKT)
+				return nil
+			})
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+			JKT:      jkt,
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+
+		claims := parse(t, resp.AccessToken)
+		require.NotNil(t, claims.Confirmation)
+		assert.Equal(t, jkt, claims.Confirmation.JKT)
+	})
+
+	t.Run("should not bind refresh tokens of confidential clients", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+
+		client := newTestClient("client-123")
+		client.Confidential = true
+		client.SecretHash = tokenentity.Hash("secret-123")
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "test@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, token tokenentity.Token) error {
+				assert.Empty(t, token.JKT)
+				return nil
+			})
+
+		resp, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:        "test@example.com",
+			Password:     "password123",
+			JKT:          jkt,
+			ClientID:     "client-123",
+			ClientSecret: "secret-123",
+		})
+		require.NoError(t, err)
+
+		// The access token is bound all the same.
+		claims := parse(t, resp.AccessToken)
+		require.NotNil(t, claims.Confirmation)
+		assert.Equal(t, jkt, claims.Confirmation.JKT)
+	})
+
+	t.Run("should keep refresh token bound on rotation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		refreshTokenHash := tokenentity.Hash("refresh-token-123")
+		boundKey := jkt
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				FamilyID:  "family-123",
+				Subject:   "user-123",
+				ClientID:  "client-123",
+				TokenHash: refreshTokenHash,
+				JKT:       &boundKey,
+				ExpiresAt: time.Now().Add(24 * time.Hour),
+			}, nil)
+
+		tokenRepo.EXPECT().
+			MarkUsed(ctx, refreshTokenHash).
+			Return(nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Role: usermodel.RoleUser}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(key, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, token tokenentity.Token) error {
+				assert.Equal(t, jkt, token.JKT)
+				return nil
+			})
+
+		resp, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+			RefreshToken: "refresh-token-123",
+			JKT:          jkt,
+			ClientID:     "client-123",
+		})
+		require.NoError(t, err)
+
+		claims := parse(t, resp.AccessToken)
+		require.NotNil(t, claims.Confirmation)
+		assert.Equal(t, jkt, claims.Confirmation.JKT)
+	})
+
+	t.Run("should refuse bound refresh token without proof of the key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			tokenRepository:  tokenRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newAuditService(ctrl),
+		}
+
+		ctx := context.Background()
+		refreshTokenHash := tokenentity.Hash("refresh-token-123")
+		boundKey := jkt
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil).
+			Times(2)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, refreshTokenHash).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				FamilyID:  "family-123",
+				Subject:   "user-123",
+				ClientID:  "client-123",
+				TokenHash: refreshTokenHash,
+				JKT:       &boundKey,
+				ExpiresAt: time.Now().Add(24 * time.Hour),
+			}, nil).
+			Times(2)
+
+		// The token is not marked used, so the client holding the key can
+		// still redeem it.
+		for _, proofKey := range []string{"", "kYlDw4lq2Ynb0ZCdVvZ3mmH1HOwWzWIpMSShvAVzMqE"} {
+			_, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+				RefreshToken: "refresh-token-123",
+				JKT:          proofKey,
+				ClientID:     "client-123",
+			})
+			assert.ErrorIs(t, err, ErrInvalidDPoPProof)
+		}
+	})
+}
+
+// newRecordingAuditService returns an audit service that appends every event
+// it records to events.
+func newRecordingAuditService(ctrl *gomock.Controller, events *[]authevententity.Event) *auditservice.Service {
+	authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+	authEventRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event authevententity.Event) error {
+			*events = append(*events, event)
+			return nil
+		}).
+		AnyTimes()
+
+	return auditservice.NewService(authEventRepo)
+}
+
+func TestService_Audit(t *testing.T) {
+	device := sessionentity.Device{
+		Name: "Mozilla/5.0",
+		IP:   "203.0.113.7",
+	}
+
+	t.Run("should record successful login", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			tokenRepository:  tokenRepo,
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			mfaService:       newMFAService(ctrl),
+			throttleService:  newThrottleService(ctrl),
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "test@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Email: "test@example.com"}, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		tokenRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		_, err = service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "test@example.com",
+			Password: "password123",
+			Device:   device,
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeLogin, events[0].Type)
+		assert.Equal(t, "user-123", events[0].Subject)
+		assert.Equal(t, "client-123", events[0].ClientID)
+		assert.Equal(t, device.IP, events[0].IP)
+		assert.Equal(t, device.Name, events[0].UserAgent)
+		assert.Equal(t, authevententity.OutcomeSuccess, events[0].Outcome)
+		assert.Empty(t, events[0].Reason)
+	})
+
+	t.Run("should record failed login with reason", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			throttleService:  newThrottleService(ctrl),
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "test@example.com", "wrong-password").
+			Return(usermodel.User{}, userrepo.ErrInvalidCredentials)
+
+		_, err := service.PasswordGrant(ctx, PasswordGrantRequest{
+			Email:    "test@example.com",
+			Password: "wrong-password",
+			Device:   device,
+			ClientID: "client-123",
+		})
+		require.ErrorIs(t, err, ErrInvalidCredentials)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeLogin, events[0].Type)
+		assert.Empty(t, events[0].Subject)
+		assert.Equal(t, authevententity.OutcomeFailure, events[0].Outcome)
+		assert.Equal(t, ErrInvalidCredentials.Error(), events[0].Reason)
+	})
+
+	t.Run("should not leak unexpected errors into reason", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			clientRepository: clientRepo,
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(cliententity.Client{}, errors.New("dial tcp 10.0.0.3:26257: connection refused"))
+
+		_, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			IP:       device.IP,
+			ClientID: "client-123",
+		})
+		require.Error(t, err)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeClientCredentials, events[0].Type)
+		assert.Equal(t, "client-123", events[0].Subject)
+		assert.Equal(t, "internal error", events[0].Reason)
+	})
+
+	t.Run("should not record login interrupted for second factor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			userRepository:   userRepo,
+			clientRepository: clientRepo,
+			mfaService: mfaservice.NewService(mfaservice.Config{
+				RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+			}, mfaRepo, nil, nil),
+			throttleService: newThrottleService(ctrl),
+			auditService:    newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		userRepo.EXPECT().
+			VerifyPassword(ctx, "admin@example.com", "password123").
+			Return(usermodel.User{ID: "user-123", Email: "admin@example.com", Role: usermodel.RoleAdmin}, nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{UserID: "user-123", ConfirmedAt: time.Now().Add(-time.Hour)}, nil)
+
+		_, err := service.Authorize(ctx, AuthorizeRequest{
+			ClientID:            "client-123",
+			RedirectURI:         "https://app.example.com/callback",
+			ResponseType:        ResponseTypeCode,
+			CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			CodeChallengeMethod: CodeChallengeMethodS256,
+			Email:               "admin@example.com",
+			Password:            "password123",
+			IP:                  device.IP,
+			UserAgent:           device.Name,
+		})
+		require.ErrorIs(t, err, ErrMFARequired)
+
+		assert.Empty(t, events)
+	})
+
+	t.Run("should record reuse of rotated refresh token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+
+		var events []authevententity.Event
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			tokenRepository:  tokenRepo,
+			clientRepository: clientRepo,
+			sessionService:   newSessionService(ctrl, tokenRepo),
+			auditService:     newRecordingAuditService(ctrl, &events),
+		}
+
+		ctx := context.Background()
+		usedAt := time.Now().Add(-time.Minute)
+
+		token := tokenmodel.Token{
+			ID:        "token-123",
+			FamilyID:  "family-123",
+			Subject:   "user-123",
+			ClientID:  "client-123",
+			TokenHash: tokenentity.Hash("refresh-token-123"),
+			ExpiresAt: time.Now().Add(24 * time.Hour),
+			UsedAt:    &usedAt,
+		}
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(newTestClient("client-123"), nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, token.TokenHash).
+			Return(token, nil)
+
+		tokenRepo.EXPECT().
+			RevokeFamily(ctx, token.FamilyID).
+			Return(nil)
+
+		_, err := service.RefreshTokenGrant(ctx, RefreshTokenGrantRequest{
+			RefreshToken: "refresh-token-123",
+			Device:       device,
+			ClientID:     "client-123",
+		})
+		require.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+		require.Len(t, events, 2)
+		assert.Equal(t, authevententity.TypeRefreshTokenReuse, events[0].Type)
+		assert.Equal(t, "user-123", events[0].Subject)
+		assert.Equal(t, authevententity.OutcomeFailure, events[0].Outcome)
+		assert.Equal(t, device.IP, events[0].IP)
+		assert.Equal(t, authevententity.TypeTokenRefresh, events[1].Type)
+		assert.Equal(t, "user-123", events[1].Subject)
+		assert.Equal(t, ErrInvalidRefreshToken.Error(), events[1].Reason)
+	})
+
+	t.Run("should not fail grant when event cannot be recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+
+		service := &Service{
+			cfg:              Config{Issuer: testIssuer},
+			keyRepository:    keyRepo,
+			clientRepository: clientRepo,
+			auditService:     auditservice.NewService(authEventRepo),
+		}
+
+		ctx := context.Background()
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		client := newTestClient("client-123")
+		client.Confidential = true
+		client.SecretHash = tokenentity.Hash("secret-123")
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(client, nil)
+
+		keyRepo.EXPECT().
+			GetCurrentSigningKey(ctx).
+			Return(keyentity.Key{ID: "key-123", Private: privateKey, Public: privateKey.Public(), Algorithm: "RS256", State: keyentity.StateActive}, nil)
+
+		authEventRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Return(errors.New("connection refused"))
+
+		resp, err := service.ClientCredentialsGrant(ctx, ClientCredentialsGrantRequest{
+			ClientID:     "client-123",
+			ClientSecret: "secret-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+	})
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"regexp"
+)
+
+// codeVerifierPattern follows RFC 7636 section 4.1: 43 to 128 characters
+// from the unreserved URI set.
+var codeVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// codeChallengePattern matches the base64url encoding of a SHA-256 digest.
+var codeChallengePattern = regexp.MustCompile(`^[A-Za-z0-9\-_]{43}$`)
+
+func validCodeChallenge(challenge, method string) bool {
+	return method == CodeChallengeMethodS256 && codeChallengePattern.MatchString(challenge)
+}
+
+func verifyCodeVerifier(verifier, challenge string) bool {
+	if !codeVerifierPattern.MatchString(verifier) {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
@@ -2,51 +2,549 @@ package auth
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
 	"github.com/pkg/errors"
 )
 
 var (
 	ErrInvalidCredentials  = errors.New("invalid credentials")
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+	ErrInvalidClient      = errors.New("invalid client")
+	ErrUnauthorizedClient = errors.New("unauthorized client")
+	ErrInvalidScope       = errors.New("invalid scope")
+
+	ErrUnsupportedResponseType  = errors.New("unsupported response type")
+	ErrInvalidRedirectURI       = errors.New("invalid redirect uri")
+	ErrInvalidCodeChallenge     = errors.New("invalid code challenge")
+	ErrInvalidAuthorizationCode = errors.New("invalid authorization code")
+
+	ErrUnsupportedSigningKey = errors.New("unsupported signing key algorithm")
+
+	ErrMFARequired           = errors.New("mfa required")
+	ErrMFAEnrollmentRequired = errors.New("mfa enrollment required")
+
+	ErrUnsupportedTokenType    = errors.New("unsupported token type")
+	ErrImpersonationNotAllowed = errors.New("impersonation not allowed")
+	ErrInvalidRequestedSubject = errors.New("invalid requested subject")
+
+	ErrInvalidDPoPProof = errors.New("invalid dpop proof")
 )
 
-const (
-	IssuerServiceName = "sso.kgym"
+// MFARequiredError is returned by the password grant instead of tokens when
+// the user has to present a second factor. Token identifies the challenge to
+// complete with the mfa_otp grant. If EnrollmentRequired is set, the user has
+// to enroll an authenticator with the token first.
+type MFARequiredError struct {
+	Token              string
+	EnrollmentRequired bool
+}
+
+func (e *MFARequiredError) Error() string {
+	return ErrMFARequired.Error()
+}
 
-	AccessTokenTTL = 15 * time.Minute // 15 minutes
+func (e *MFARequiredError) Is(target error) bool {
+	return target == ErrMFARequired
+}
+
+const (
+	IDTokenTTL = 15 * time.Minute // 15 minutes
 
-	RefreshTokenTTL    = 7 * 24 * time.Hour // 7 days
 	RefreshTokenLength = 32
+
+	AuthorizationCodeTTL    = 1 * time.Minute // 1 minute
+	AuthorizationCodeLength = 32
+
+	ResponseTypeCode        = "code"
+	CodeChallengeMethodS256 = "S256"
+
+	// TokenTypeAccessToken identifies access tokens in the token exchange
+	// grant, as registered by RFC 8693.
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	// ImpersonationTokenTTL caps the lifetime of tokens issued by the token
+	// exchange grant, whatever the access token lifetime of the client.
+	ImpersonationTokenTTL = 15 * time.Minute // 15 minutes
+	// APIKeyTokenTTL caps the lifetime of tokens issued for API keys, so that
+	// revoking a key takes effect soon.
+	APIKeyTokenTTL = 5 * time.Minute // 5 minutes
+	// ServiceTokenTTL is the lifetime of the tokens SSO issues itself to call
+	// other services.
+	ServiceTokenTTL = 5 * time.Minute // 5 minutes
+	// ServiceTokenSubject is the subject of the tokens SSO issues itself.
+	ServiceTokenSubject = "sso"
+	// JWTTypeAccessToken is the typ header of access tokens, as in RFC 9068.
+	// ID tokens are signed with the same keys, so resource servers rely on
+	// it to tell them apart.
+	JWTTypeAccessToken = "at+jwt"
+
+	SecurityEventRefreshTokenReuse   = "refresh_token_reuse"
+	SecurityEventImpersonation       = "impersonation"
+	SecurityEventImpersonationDenied = "impersonation_denied"
+
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+	// ScopeAdmin is only granted to users with the admin role. It is dropped
+	// from the granted scopes of everyone else instead of failing the request.
+	ScopeAdmin = "admin"
+	// ScopeService lets SSO call the internal methods of other services. It
+	// is only put into the tokens of ServiceToken and never granted to users
+	// or clients.
+	ScopeService = "service"
 )
 
+type Config struct {
+	Issuer string
+	// Tenant is put into the tenant claim of every access token.
+	Tenant string
+}
+
 type IService interface {
 	PasswordGrant(ctx context.Context, req PasswordGrantRequest) (PasswordGrantResponse, error)
+	MFAOTPGrant(ctx context.Context, req MFAOTPGrantRequest) (MFAOTPGrantResponse, error)
+	PasswordlessGrant(ctx context.Context, req PasswordlessGrantRequest) (PasswordlessGrantResponse, error)
+	// DeviceCodeGrant issues the tokens of a device once the user approved
+	// its device code.
+	DeviceCodeGrant(ctx context.Context, req DeviceCodeGrantRequest) (DeviceCodeGrantResponse, error)
+	// WebAuthnGrant signs a user in with the assertion of a passkey, which
+	// answers the challenge of StartPasskeyLogin.
+	WebAuthnGrant(ctx context.Context, req WebAuthnGrantRequest) (WebAuthnGrantResponse, error)
 	RefreshTokenGrant(ctx context.Context, req RefreshTokenGrantRequest) (RefreshTokenGrantResponse, error)
+	AuthorizationCodeGrant(ctx context.Context, req AuthorizationCodeGrantRequest) (AuthorizationCodeGrantResponse, error)
+	ClientCredentialsGrant(ctx context.Context, req ClientCredentialsGrantRequest) (ClientCredentialsGrantResponse, error)
+	// TokenExchangeGrant issues an admin a short-lived access token for
+	// another user. The token names the admin in its act claim.
+	TokenExchangeGrant(ctx context.Context, req TokenExchangeGrantRequest) (TokenExchangeGrantResponse, error)
+	// APIKeyGrant exchanges the API key of an integration for a short-lived
+	// access token of the owner of the key.
+	APIKeyGrant(ctx context.Context, req APIKeyGrantRequest) (APIKeyGrantResponse, error)
+
+	Authorize(ctx context.Context, req AuthorizeRequest) (AuthorizeResponse, error)
+	// StartPasswordless sends a code to complete the passwordless grant with
+	// to the given email address.
+	StartPasswordless(ctx context.Context, req StartPasswordlessRequest) (StartPasswordlessResponse, error)
+	// StartFederation validates an authorization request and returns the URL
+	// of the upstream provider the user signs in with instead of a password.
+	StartFederation(ctx context.Context, req StartFederationRequest) (StartFederationResponse, error)
+	// CompleteFederation answers the authorization request a federated sign
+	// in was started for, once the provider sent the user back.
+	CompleteFederation(ctx context.Context, req CompleteFederationRequest) (AuthorizeResponse, error)
+	// StartDeviceAuthorization issues the device code a device without a
+	// keyboard polls the device code grant with, and the user code the user
+	// enters on another device to approve it.
+	StartDeviceAuthorization(ctx context.Context, req StartDeviceAuthorizationRequest) (StartDeviceAuthorizationResponse, error)
+	// GetDeviceAuthorization describes the pending request of a user code,
+	// so that the user can check what they are asked to approve.
+	GetDeviceAuthorization(ctx context.Context, userCode string) (DeviceAuthorization, error)
+	// AuthorizeDevice signs the user in on the verification page and
+	// approves or denies the request of a user code.
+	AuthorizeDevice(ctx context.Context, req AuthorizeDeviceRequest) (DeviceAuthorization, error)
+	// StartPasskeyLogin issues the challenge a passkey signs for the
+	// webauthn grant.
+	StartPasskeyLogin(ctx context.Context, req StartPasskeyLoginRequest) (StartPasskeyLoginResponse, error)
+	// AuthenticateClient checks the credentials of a client calling an
+	// endpoint that is not tied to a grant type, such as revocation and
+	// introspection.
+	AuthenticateClient(ctx context.Context, clientID, clientSecret string) (cliententity.Client, error)
+	// ServiceToken issues SSO an access token of its own, with ScopeService,
+	// to call the internal methods of the service known by audience.
+	ServiceToken(ctx context.Context, audience string) (ServiceTokenResponse, error)
 }
 
 type (
 	PasswordGrantRequest struct {
 		Email    string
 		Password string
+		// Device is recorded with the session. Its address is also used to
+		// throttle failed attempts.
+		Device sessionentity.Device
+		// JKT is the thumbprint of the key the client proved possession of
+		// with a DPoP proof. The tokens are bound to it when set.
+		JKT string
 
-		ClientID string
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
 	}
 
 	PasswordGrantResponse struct {
 		AccessToken  string
 		RefreshToken string
+		IDToken      string
+		Scopes       []string
+	}
+)
+
+type (
+	MFAOTPGrantRequest struct {
+		MFAToken string
+		OTP      string
+		Device   sessionentity.Device
+		JKT      string
+
+		ClientID     string
+		ClientSecret string
+	}
+
+	MFAOTPGrantResponse struct {
+		AccessToken  string
+		RefreshToken string
+		IDToken      string
+		Scopes       []string
+	}
+)
+
+type (
+	// PasswordlessGrantRequest is completed either with Token and Code, or
+	// with the signed Link alone.
+	PasswordlessGrantRequest struct {
+		Token  string
+		Code   string
+		Link   string
+		Device sessionentity.Device
+		JKT    string
+
+		ClientID     string
+		ClientSecret string
+	}
+
+	PasswordlessGrantResponse struct {
+		AccessToken  string
+		RefreshToken string
+		IDToken      string
+		Scopes       []string
+	}
+)
+
+type (
+	DeviceCodeGrantRequest struct {
+		DeviceCode string
+		Device     sessionentity.Device
+		JKT        string
+
+		ClientID     string
+		ClientSecret string
+	}
+
+	DeviceCodeGrantResponse struct {
+		AccessToken  string
+		RefreshToken string
+		IDToken      string
+		Scopes       []string
+	}
+)
+
+type (
+	// WebAuthnGrantRequest carries the JSON encoded PublicKeyCredential the
+	// authenticator signed the challenge of Token with.
+	WebAuthnGrantRequest struct {
+		Token      string
+		Credential []byte
+		Device     sessionentity.Device
+		JKT        string
+
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+	}
+
+	WebAuthnGrantResponse struct {
+		AccessToken  string
+		RefreshToken string
+		IDToken      string
+		Scopes       []string
 	}
 )
 
 type (
 	RefreshTokenGrantRequest struct {
 		RefreshToken string
+		// Device is the device the session is used from, to record its last
+		// use.
+		Device sessionentity.Device
+		// JKT must match the key the refresh token is bound to, if any.
+		JKT string
+
+		ClientID     string
+		ClientSecret string
+		// Scopes narrows the scopes of the new access token. It must be a
+		// subset of the scopes the refresh token was granted.
+		Scopes []string
 	}
 
 	RefreshTokenGrantResponse struct {
 		AccessToken  string
 		RefreshToken string
+		Scopes       []string
+	}
+)
+
+type (
+	AuthorizationCodeGrantRequest struct {
+		Code         string
+		RedirectURI  string
+		CodeVerifier string
+		Device       sessionentity.Device
+		JKT          string
+
+		ClientID     string
+		ClientSecret string
+	}
+
+	AuthorizationCodeGrantResponse struct {
+		AccessToken  string
+		RefreshToken string
+		IDToken      string
+		Scopes       []string
+	}
+)
+
+type (
+	ClientCredentialsGrantRequest struct {
+		// IP and UserAgent describe where the request comes from, for the
+		// audit trail.
+		IP        string
+		UserAgent string
+		JKT       string
+
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+	}
+
+	ClientCredentialsGrantResponse struct {
+		AccessToken string
+		Scopes      []string
+	}
+)
+
+type ServiceTokenResponse struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+type (
+	// TokenExchangeGrantRequest asks for a token for RequestedSubject. Actor
+	// holds the verified claims of the subject token of the admin asking.
+	TokenExchangeGrantRequest struct {
+		Actor            AccessTokenClaims
+		SubjectTokenType string
+		RequestedSubject string
+		// IP and UserAgent describe where the exchange is requested from,
+		// for the audit trail.
+		IP        string
+		UserAgent string
+
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+	}
+
+	TokenExchangeGrantResponse struct {
+		AccessToken     string
+		IssuedTokenType string
+		Scopes          []string
+	}
+)
+
+type (
+	// APIKeyGrantRequest may narrow the scopes of the key with Scopes. The
+	// client is the one the key was issued to.
+	APIKeyGrantRequest struct {
+		APIKey string
+		// IP and UserAgent describe where the key is used from, for the
+		// audit trail.
+		IP        string
+		UserAgent string
+
+		Scopes []string
+	}
+
+	APIKeyGrantResponse struct {
+		AccessToken string
+		Scopes      []string
+	}
+)
+
+type (
+	AuthorizeRequest struct {
+		ClientID            string
+		RedirectURI         string
+		ResponseType        string
+		Scopes              []string
+		State               string
+		Nonce               string
+		CodeChallenge       string
+		CodeChallengeMethod string
+
+		Email    string
+		Password string
+		// OTP is the one-time password of users who have to present a second
+		// factor.
+		OTP string
+		// IP is the address of the user agent, used to throttle failed
+		// attempts.
+		IP        string
+		UserAgent string
+	}
+
+	AuthorizeResponse struct {
+		Code        string
+		RedirectURI string
+		State       string
+	}
+)
+
+type (
+	StartPasswordlessRequest struct {
+		Email string
+		// IP is the address of the user agent, used to limit the codes sent.
+		IP string
+
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+	}
+
+	StartPasswordlessResponse struct {
+		// Token identifies the challenge to complete with the code.
+		Token     string
+		ExpiresIn time.Duration
+	}
+)
+
+type (
+	StartFederationRequest struct {
+		Provider string
+		// Authorization is the request to answer once the user signed in
+		// upstream. Its credentials are ignored.
+		Authorization AuthorizeRequest
+	}
+
+	StartFederationResponse struct {
+		AuthorizationURL string
+	}
+)
+
+// CompleteFederationRequest holds the callback parameters of the provider,
+// and where the user agent following the callback comes from.
+type CompleteFederationRequest struct {
+	State string
+	Code  string
+
+	IP        string
+	UserAgent string
+}
+
+type (
+	StartDeviceAuthorizationRequest struct {
+		ClientID     string
+		ClientSecret string
+		Scopes       []string
+	}
+
+	StartDeviceAuthorizationResponse struct {
+		DeviceCode string
+		UserCode   string
+		// VerificationURLComplete is VerificationURL with the user code
+		// filled in.
+		VerificationURL         string
+		VerificationURLComplete string
+		ExpiresIn               time.Duration
+		// Interval is how long the device waits between two polls.
+		Interval time.Duration
+	}
+)
+
+type (
+	StartPasskeyLoginRequest struct {
+		ClientID     string
+		ClientSecret string
+	}
+
+	StartPasskeyLoginResponse struct {
+		// Token identifies the challenge to answer with the webauthn grant.
+		Token string
+		// Options is the JSON encoded PublicKeyCredentialRequestOptions to
+		// pass to the authenticator.
+		Options   []byte
+		ExpiresIn time.Duration
 	}
 )
+
+// AuthorizeDeviceRequest holds the credentials of the user and their decision
+// on the request of a user code.
+type AuthorizeDeviceRequest struct {
+	UserCode string
+	Approve  bool
+
+	Email    string
+	Password string
+	OTP      string
+	// IP is the address of the user agent, used to throttle failed
+	// attempts.
+	IP        string
+	UserAgent string
+}
+
+// DeviceAuthorization describes the request of a device to the user asked to
+// approve it.
+type DeviceAuthorization struct {
+	ClientID   string
+	ClientName string
+	Scopes     []string
+}
+
+// AccessTokenClaims are the claims of an access token. Scope holds the granted
+// scopes, space-delimited as in RFC 9068.
+type AccessTokenClaims struct {
+	jwt.RegisteredClaims
+
+	Scope  string `json:"scope,omitempty"`
+	Role   string `json:"role,omitempty"`
+	Tenant string `json:"tenant,omitempty"`
+	// SessionID is the session the token was issued for. Tokens issued to
+	// clients on their own behalf have none.
+	SessionID string `json:"sid,omitempty"`
+	// Actor is set on tokens issued by the token exchange grant and names
+	// the admin acting as the subject.
+	Actor *ActorClaims `json:"act,omitempty"`
+	// Confirmation is set on tokens bound to a key with DPoP, as in RFC
+	// 9449.
+	Confirmation *ConfirmationClaims `json:"cnf,omitempty"`
+	// APIKeyID is set on tokens issued by the API key grant and names the
+	// key they were exchanged for.
+	APIKeyID string `json:"api_key_id,omitempty"`
+}
+
+// ActorClaims identify the party acting on behalf of the subject of a token,
+// as in the act claim of RFC 8693.
+type ActorClaims struct {
+	Subject string `json:"sub"`
+}
+
+// ConfirmationClaims hold the thumbprint of the key a token is bound to.
+type ConfirmationClaims struct {
+	JKT string `json:"jkt"`
+}
+
+func (c AccessTokenClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Role              string `json:"role,omitempty"`
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	"github.com/pkg/errors"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	clientRepository clientrepo.IRepository
+}
+
+func NewService(clientRepository clientrepo.IRepository) *Service {
+	return &Service{
+		clientRepository: clientRepository,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, req CreateRequest) (CreateResponse, error) {
+	if err := validateCreateRequest(req); err != nil {
+		return CreateResponse{}, err
+	}
+
+	client := cliententity.Client{
+		ID:              uuid.NewString(),
+		Name:            req.Name,
+		Confidential:    req.Confidential,
+		GrantTypes:      req.GrantTypes,
+		RedirectURIs:    req.RedirectURIs,
+		Scopes:          req.Scopes,
+		AccessTokenTTL:  req.AccessTokenTTL,
+		RefreshTokenTTL: req.RefreshTokenTTL,
+		CreatedAt:       time.Now(),
+	}
+
+	if client.AccessTokenTTL == 0 {
+		client.AccessTokenTTL = DefaultAccessTokenTTL
+	}
+	if client.RefreshTokenTTL == 0 {
+		client.RefreshTokenTTL = DefaultRefreshTokenTTL
+	}
+
+	var secret string
+	if client.Confidential {
+		var err error
+
+		secret, err = generateSecret()
+		if err != nil {
+			return CreateResponse{}, err
+		}
+
+		client.SecretHash = tokenentity.Hash(secret)
+	}
+
+	if err := s.clientRepository.Create(ctx, client); err != nil {
+		return CreateResponse{}, err
+	}
+
+	return CreateResponse{
+		Client: client,
+		Secret: secret,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context) ([]cliententity.Client, error) {
+	return s.clientRepository.List(ctx)
+}
+
+func (s *Service) RotateSecret(ctx context.Context, req RotateSecretRequest) (RotateSecretResponse, error) {
+	if req.GracePeriod < 0 {
+		return RotateSecretResponse{}, errors.Wrap(ErrInvalidClientMetadata, "grace period must not be negative")
+	}
+
+	client, err := s.clientRepository.GetByID(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return RotateSecretResponse{}, ErrClientNotFound
+		}
+		return RotateSecretResponse{}, err
+	}
+
+	if !client.Confidential {
+		return RotateSecretResponse{}, ErrPublicClient
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return RotateSecretResponse{}, err
+	}
+
+	err = s.clientRepository.UpdateSecret(ctx, client.ID, tokenentity.Hash(secret), time.Now().Add(req.GracePeriod))
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return RotateSecretResponse{}, ErrClientNotFound
+		}
+		return RotateSecretResponse{}, err
+	}
+
+	return RotateSecretResponse{
+		Secret: secret,
+	}, nil
+}
+
+func (s *Service) Disable(ctx context.Context, clientID string) error {
+	err := s.clientRepository.Disable(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, clientrepo.ErrClientNotFound) {
+			return ErrClientNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func validateCreateRequest(req CreateRequest) error {
+	if req.Name == "" {
+		return errors.Wrap(ErrInvalidClientMetadata, "name is required")
+	}
+
+	if len(req.GrantTypes) == 0 {
+		return errors.Wrap(ErrInvalidClientMetadata, "at least one grant type is required")
+	}
+
+	for _, grantType := range req.GrantTypes {
+		if !slices.Contains(cliententity.GrantTypes, grantType) {
+			return errors.Wrapf(ErrInvalidClientMetadata, "unsupported grant type %q", grantType)
+		}
+	}
+
+	if slices.Contains(req.GrantTypes, cliententity.GrantTypeClientCredentials) && !req.Confidential {
+		return errors.Wrap(ErrInvalidClientMetadata, "client_credentials grant requires a confidential client")
+	}
+
+	if slices.Contains(req.GrantTypes, cliententity.GrantTypeAuthorizationCode) && len(req.RedirectURIs) == 0 {
+		return errors.Wrap(ErrInvalidClientMetadata, "authorization_code grant requires a redirect uri")
+	}
+
+	for _, redirectURI := range req.RedirectURIs {
+		u, err := url.Parse(redirectURI)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return errors.Wrapf(ErrInvalidClientMetadata, "invalid redirect uri %q", redirectURI)
+		}
+	}
+
+	if req.AccessTokenTTL < 0 || req.RefreshTokenTTL < 0 {
+		return errors.Wrap(ErrInvalidClientMetadata, "token ttl must not be negative")
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, SecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	clientrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client"
+	clientmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_Create(t *testing.T) {
+	t.Run("should create confidential client with hashed secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		var stored cliententity.Client
+		clientRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, client cliententity.Client) error {
+				stored = client
+				return nil
+			})
+
+		resp, err := service.Create(ctx, CreateRequest{
+			Name:         "billing-worker",
+			Confidential: true,
+			GrantTypes:   []string{cliententity.GrantTypeClientCredentials},
+			Scopes:       []string{"files:write"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Secret)
+		assert.NotEmpty(t, resp.Client.ID)
+		assert.Equal(t, tokenentity.Hash(resp.Secret), stored.SecretHash)
+		assert.NotEqual(t, resp.Secret, stored.SecretHash)
+		assert.Equal(t, DefaultAccessTokenTTL, stored.AccessTokenTTL)
+		assert.Equal(t, DefaultRefreshTokenTTL, stored.RefreshTokenTTL)
+	})
+
+	t.Run("should create public client without secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+
+		resp, err := service.Create(ctx, CreateRequest{
+			Name:           "web",
+			GrantTypes:     []string{cliententity.GrantTypeAuthorizationCode, cliententity.GrantTypeRefreshToken},
+			RedirectURIs:   []string{"https://app.example.com/callback"},
+			AccessTokenTTL: 5 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Secret)
+		assert.Empty(t, resp.Client.SecretHash)
+		assert.Equal(t, 5*time.Minute, resp.Client.AccessTokenTTL)
+	})
+
+	t.Run("should return error when client metadata is invalid", func(t *testing.T) {
+		cases := map[string]CreateRequest{
+			"missing name": {
+				GrantTypes: []string{cliententity.GrantTypePassword},
+			},
+			"missing grant types": {
+				Name: "web",
+			},
+			"unsupported grant type": {
+				Name:       "web",
+				GrantTypes: []string{"implicit"},
+			},
+			"public client credentials client": {
+				Name:       "web",
+				GrantTypes: []string{cliententity.GrantTypeClientCredentials},
+			},
+			"authorization code without redirect uri": {
+				Name:       "web",
+				GrantTypes: []string{cliententity.GrantTypeAuthorizationCode},
+			},
+			"relative redirect uri": {
+				Name:         "web",
+				GrantTypes:   []string{cliententity.GrantTypeAuthorizationCode},
+				RedirectURIs: []string{"/callback"},
+			},
+			"redirect uri with fragment": {
+				Name:         "web",
+				GrantTypes:   []string{cliententity.GrantTypeAuthorizationCode},
+				RedirectURIs: []string{"https://app.example.com/callback#token"},
+			},
+			"negative ttl": {
+				Name:           "web",
+				GrantTypes:     []string{cliententity.GrantTypePassword},
+				AccessTokenTTL: -time.Minute,
+			},
+		}
+
+		for name, req := range cases {
+			t.Run(name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				service := NewService(clientmocks.NewMockIRepository(ctrl))
+
+				_, err := service.Create(context.Background(), req)
+				assert.ErrorIs(t, err, ErrInvalidClientMetadata)
+			})
+		}
+	})
+}
+
+func TestService_RotateSecret(t *testing.T) {
+	t.Run("should rotate secret of confidential client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(cliententity.Client{ID: "client-123", Confidential: true}, nil)
+
+		var secretHash string
+		var previousSecretExpiresAt time.Time
+		clientRepo.EXPECT().
+			UpdateSecret(ctx, "client-123", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, hash string, expiresAt time.Time) error {
+				secretHash = hash
+				previousSecretExpiresAt = expiresAt
+				return nil
+			})
+
+		resp, err := service.RotateSecret(ctx, RotateSecretRequest{
+			ClientID:    "client-123",
+			GracePeriod: time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tokenentity.Hash(resp.Secret), secretHash)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), previousSecretExpiresAt, time.Second)
+	})
+
+	t.Run("should return error for public client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(cliententity.Client{ID: "client-123"}, nil)
+
+		_, err := service.RotateSecret(ctx, RotateSecretRequest{ClientID: "client-123"})
+		assert.ErrorIs(t, err, ErrPublicClient)
+	})
+
+	t.Run("should return error when client not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			GetByID(ctx, "client-123").
+			Return(cliententity.Client{}, clientrepo.ErrClientNotFound)
+
+		_, err := service.RotateSecret(ctx, RotateSecretRequest{ClientID: "client-123"})
+		assert.ErrorIs(t, err, ErrClientNotFound)
+	})
+}
+
+func TestService_Disable(t *testing.T) {
+	t.Run("should return error when client not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientRepo := clientmocks.NewMockIRepository(ctrl)
+		service := NewService(clientRepo)
+
+		ctx := context.Background()
+
+		clientRepo.EXPECT().
+			Disable(ctx, "client-123").
+			Return(clientrepo.ErrClientNotFound)
+
+		err := service.Disable(ctx, "client-123")
+		assert.ErrorIs(t, err, ErrClientNotFound)
+	})
+}
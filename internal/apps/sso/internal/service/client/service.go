@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrClientNotFound        = errors.New("client not found")
+	ErrInvalidClientMetadata = errors.New("invalid client metadata")
+	ErrPublicClient          = errors.New("public clients have no secret")
+)
+
+const (
+	DefaultAccessTokenTTL  = 15 * time.Minute   // 15 minutes
+	DefaultRefreshTokenTTL = 7 * 24 * time.Hour // 7 days
+
+	SecretLength = 32
+)
+
+type IService interface {
+	Create(ctx context.Context, req CreateRequest) (CreateResponse, error)
+	List(ctx context.Context) ([]cliententity.Client, error)
+	RotateSecret(ctx context.Context, req RotateSecretRequest) (RotateSecretResponse, error)
+	Disable(ctx context.Context, clientID string) error
+}
+
+type (
+	CreateRequest struct {
+		Name         string
+		Confidential bool
+
+		GrantTypes   []string
+		RedirectURIs []string
+		Scopes       []string
+
+		AccessTokenTTL  time.Duration
+		RefreshTokenTTL time.Duration
+	}
+
+	CreateResponse struct {
+		Client cliententity.Client
+		// Secret is only set for confidential clients. It is not stored and
+		// cannot be retrieved again.
+		Secret string
+	}
+)
+
+type (
+	RotateSecretRequest struct {
+		ClientID string
+		// GracePeriod is how long the replaced secret keeps working, so that
+		// deployments can pick up the new one without downtime.
+		GracePeriod time.Duration
+	}
+
+	RotateSecretResponse struct {
+		Secret string
+	}
+)
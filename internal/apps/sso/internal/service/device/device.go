@@ -0,0 +1,254 @@
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	deviceRepository devicerepo.IRepository
+}
+
+func NewService(cfg Config, deviceRepository devicerepo.IRepository) *Service {
+	return &Service{
+		cfg:              cfg,
+		deviceRepository: deviceRepository,
+	}
+}
+
+func (s *Service) Start(ctx context.Context, req StartRequest) (StartResponse, error) {
+	deviceCode, err := randomToken(DeviceCodeLength)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	authorization := deviceentity.Authorization{
+		DeviceCodeHash: tokenentity.Hash(deviceCode),
+		ClientID:       req.ClientID,
+		Scopes:         req.Scopes,
+		Status:         deviceentity.StatusPending,
+		ExpiresAt:      time.Now().Add(s.cfg.CodeTTL),
+	}
+
+	// User codes are short enough to collide with another pending one now
+	// and then.
+	var userCode string
+	for attempt := 1; ; attempt++ {
+		userCode, err = randomUserCode()
+		if err != nil {
+			return StartResponse{}, err
+		}
+
+		authorization.UserCodeHash = tokenentity.Hash(userCode)
+
+		err = s.deviceRepository.Create(ctx, authorization)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, devicerepo.ErrUserCodeTaken) || attempt == MaxUserCodeAttempts {
+			return StartResponse{}, err
+		}
+	}
+
+	userCode = formatUserCode(userCode)
+
+	verificationURLComplete, err := s.verificationURLComplete(userCode)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	return StartResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURL:         s.cfg.VerificationURL,
+		VerificationURLComplete: verificationURLComplete,
+		ExpiresAt:               authorization.ExpiresAt,
+		Interval:                s.cfg.Interval,
+	}, nil
+}
+
+func (s *Service) Lookup(ctx context.Context, userCode string) (deviceentity.Authorization, error) {
+	userCode = normalizeUserCode(userCode)
+	if len(userCode) != UserCodeLength {
+		return deviceentity.Authorization{}, ErrInvalidUserCode
+	}
+
+	authorization, err := s.deviceRepository.GetByUserCode(ctx, tokenentity.Hash(userCode))
+	if err != nil {
+		if errors.Is(err, devicerepo.ErrAuthorizationNotFound) {
+			return deviceentity.Authorization{}, ErrInvalidUserCode
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	if authorization.Status != deviceentity.StatusPending || time.Now().After(authorization.ExpiresAt) {
+		return deviceentity.Authorization{}, ErrInvalidUserCode
+	}
+
+	return authorization, nil
+}
+
+func (s *Service) Decide(ctx context.Context, req DecideRequest) (deviceentity.Authorization, error) {
+	authorization, err := s.Lookup(ctx, req.UserCode)
+	if err != nil {
+		return deviceentity.Authorization{}, err
+	}
+
+	status, event := deviceentity.StatusDenied, SecurityEventDenied
+	if req.Approve {
+		status, event = deviceentity.StatusApproved, SecurityEventApproved
+	}
+
+	err = s.deviceRepository.Decide(ctx, authorization.DeviceCodeHash, status, req.UserID)
+	if err != nil {
+		if errors.Is(err, devicerepo.ErrAuthorizationNotFound) || errors.Is(err, devicerepo.ErrAlreadyDecided) {
+			return deviceentity.Authorization{}, ErrInvalidUserCode
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	log.Info().
+		Str("event", event).
+		Str("user_id", req.UserID).
+		Str("client_id", authorization.ClientID).
+		Msg("device authorization decided")
+
+	authorization.Status = status
+	authorization.UserID = req.UserID
+
+	return authorization, nil
+}
+
+func (s *Service) Poll(ctx context.Context, req PollRequest) (deviceentity.Authorization, error) {
+	if req.DeviceCode == "" {
+		return deviceentity.Authorization{}, ErrInvalidDeviceCode
+	}
+
+	authorization, err := s.deviceRepository.GetByDeviceCode(ctx, tokenentity.Hash(req.DeviceCode))
+	if err != nil {
+		if errors.Is(err, devicerepo.ErrAuthorizationNotFound) {
+			return deviceentity.Authorization{}, ErrInvalidDeviceCode
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	if authorization.ClientID != req.ClientID {
+		return deviceentity.Authorization{}, ErrInvalidDeviceCode
+	}
+
+	now := time.Now()
+	if now.After(authorization.ExpiresAt) {
+		return deviceentity.Authorization{}, ErrExpiredToken
+	}
+
+	// Every poll counts, so a device that keeps polling too fast is slowed
+	// down until it backs off.
+	previous, err := s.deviceRepository.RecordPoll(ctx, authorization.DeviceCodeHash, now)
+	if err != nil {
+		if errors.Is(err, devicerepo.ErrAuthorizationNotFound) {
+			return deviceentity.Authorization{}, ErrInvalidDeviceCode
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	interval := max(authorization.Interval, s.cfg.Interval)
+	if !previous.IsZero() && now.Sub(previous) < interval {
+		interval, err := s.deviceRepository.SlowDown(ctx, authorization.DeviceCodeHash, interval, SlowDownIncrement)
+		if err != nil {
+			if errors.Is(err, devicerepo.ErrAuthorizationNotFound) {
+				return deviceentity.Authorization{}, ErrInvalidDeviceCode
+			}
+			return deviceentity.Authorization{}, err
+		}
+		return deviceentity.Authorization{}, &SlowDownError{Interval: interval}
+	}
+
+	switch authorization.Status {
+	case deviceentity.StatusApproved, deviceentity.StatusDenied:
+	default:
+		return deviceentity.Authorization{}, ErrAuthorizationPending
+	}
+
+	if err := s.deviceRepository.Consume(ctx, authorization); err != nil {
+		if errors.Is(err, devicerepo.ErrAuthorizationNotFound) {
+			return deviceentity.Authorization{}, ErrInvalidDeviceCode
+		}
+		return deviceentity.Authorization{}, err
+	}
+
+	if authorization.Status == deviceentity.StatusDenied {
+		return deviceentity.Authorization{}, ErrAccessDenied
+	}
+
+	return authorization, nil
+}
+
+func (s *Service) verificationURLComplete(userCode string) (string, error) {
+	u, err := url.Parse(s.cfg.VerificationURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("user_code", userCode)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// normalizeUserCode drops the dash and anything else users may type along
+// with a user code, and ignores case.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		if r < 'A' || r > 'Z' {
+			return -1
+		}
+		return r
+	}, userCode)
+}
+
+func formatUserCode(userCode string) string {
+	return userCode[:UserCodeLength/2] + "-" + userCode[UserCodeLength/2:]
+}
+
+func randomUserCode() (string, error) {
+	charsetSize := big.NewInt(int64(len(UserCodeCharset)))
+
+	b := make([]byte, UserCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, charsetSize)
+		if err != nil {
+			return "", err
+		}
+		b[i] = UserCodeCharset[n.Int64()]
+	}
+
+	return string(b), nil
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,392 @@
+package device
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	devicerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device"
+	devicemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/device/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	CodeTTL:         10 * time.Minute,
+	Interval:        5 * time.Second,
+	VerificationURL: "https://kgym.test/device",
+}
+
+func newTestService(ctrl *gomock.Controller) (*Service, *devicemocks.MockIRepository) {
+	deviceRepo := devicemocks.NewMockIRepository(ctrl)
+
+	return NewService(testConfig, deviceRepo), deviceRepo
+}
+
+func newTestAuthorization(deviceCode, userCode string) deviceentity.Authorization {
+	return deviceentity.Authorization{
+		DeviceCodeHash: tokenentity.Hash(deviceCode),
+		UserCodeHash:   tokenentity.Hash(userCode),
+		ClientID:       "client-123",
+		Scopes:         []string{"openid"},
+		Status:         deviceentity.StatusPending,
+		ExpiresAt:      time.Now().Add(time.Minute),
+	}
+}
+
+func TestService_Start(t *testing.T) {
+	t.Run("should issue codes with their hashes stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		var stored deviceentity.Authorization
+
+		deviceRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, authorization deviceentity.Authorization) error {
+				stored = authorization
+				return nil
+			})
+
+		resp, err := service.Start(ctx, StartRequest{
+			ClientID: "client-123",
+			Scopes:   []string{"openid"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.DeviceCode)
+		assert.Equal(t, testConfig.Interval, resp.Interval)
+		assert.WithinDuration(t, time.Now().Add(testConfig.CodeTTL), resp.ExpiresAt, time.Second)
+
+		require.Regexp(t, `^[`+UserCodeCharset+`]{4}-[`+UserCodeCharset+`]{4}$`, resp.UserCode)
+
+		assert.Equal(t, tokenentity.Hash(resp.DeviceCode), stored.DeviceCodeHash)
+		assert.Equal(t, tokenentity.Hash(strings.ReplaceAll(resp.UserCode, "-", "")), stored.UserCodeHash)
+		assert.Equal(t, deviceentity.StatusPending, stored.Status)
+		assert.Equal(t, "client-123", stored.ClientID)
+		assert.Equal(t, []string{"openid"}, stored.Scopes)
+
+		assert.Equal(t, testConfig.VerificationURL, resp.VerificationURL)
+		link, err := url.Parse(resp.VerificationURLComplete)
+		require.NoError(t, err)
+		assert.Equal(t, resp.UserCode, link.Query().Get("user_code"))
+	})
+
+	t.Run("should draw another user code when it is taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		gomock.InOrder(
+			deviceRepo.EXPECT().Create(ctx, gomock.Any()).Return(devicerepo.ErrUserCodeTaken),
+			deviceRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+		)
+
+		_, err := service.Start(ctx, StartRequest{ClientID: "client-123"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should give up when user codes keep being taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deviceRepo.EXPECT().Create(ctx, gomock.Any()).Return(devicerepo.ErrUserCodeTaken).Times(MaxUserCodeAttempts)
+
+		_, err := service.Start(ctx, StartRequest{ClientID: "client-123"})
+		assert.ErrorIs(t, err, devicerepo.ErrUserCodeTaken)
+	})
+}
+
+func TestService_Lookup(t *testing.T) {
+	t.Run("should find authorization whatever the user code is typed like", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization("device-code", "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByUserCode(ctx, authorization.UserCodeHash).Return(authorization, nil).Times(2)
+
+		found, err := service.Lookup(ctx, "BCDF-GHJK")
+		require.NoError(t, err)
+		assert.Equal(t, authorization, found)
+
+		_, err = service.Lookup(ctx, " bcdf ghjk ")
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject decided and expired user codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		decided := newTestAuthorization("device-code", "BCDFGHJK")
+		decided.Status = deviceentity.StatusApproved
+
+		expired := newTestAuthorization("other-device-code", "LMNPQRST")
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+
+		deviceRepo.EXPECT().GetByUserCode(ctx, decided.UserCodeHash).Return(decided, nil)
+		deviceRepo.EXPECT().GetByUserCode(ctx, expired.UserCodeHash).Return(expired, nil)
+		deviceRepo.EXPECT().GetByUserCode(ctx, tokenentity.Hash("VWXZBCDF")).Return(deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound)
+
+		for _, userCode := range []string{"BCDF-GHJK", "LMNP-QRST", "VWXZ-BCDF", "BCDF", ""} {
+			_, err := service.Lookup(ctx, userCode)
+			assert.ErrorIs(t, err, ErrInvalidUserCode, userCode)
+		}
+	})
+}
+
+func TestService_Decide(t *testing.T) {
+	t.Run("should approve authorization on behalf of the user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization("device-code", "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByUserCode(ctx, authorization.UserCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().Decide(ctx, authorization.DeviceCodeHash, deviceentity.StatusApproved, "user-123").Return(nil)
+
+		decided, err := service.Decide(ctx, DecideRequest{
+			UserCode: "BCDF-GHJK",
+			UserID:   "user-123",
+			Approve:  true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, deviceentity.StatusApproved, decided.Status)
+		assert.Equal(t, "user-123", decided.UserID)
+	})
+
+	t.Run("should deny authorization unless approved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization("device-code", "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByUserCode(ctx, authorization.UserCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().Decide(ctx, authorization.DeviceCodeHash, deviceentity.StatusDenied, "user-123").Return(nil)
+
+		decided, err := service.Decide(ctx, DecideRequest{
+			UserCode: "BCDF-GHJK",
+			UserID:   "user-123",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, deviceentity.StatusDenied, decided.Status)
+	})
+
+	t.Run("should reject authorization decided concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization("device-code", "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByUserCode(ctx, authorization.UserCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().Decide(ctx, authorization.DeviceCodeHash, deviceentity.StatusApproved, "user-123").Return(devicerepo.ErrAlreadyDecided)
+
+		_, err := service.Decide(ctx, DecideRequest{
+			UserCode: "BCDF-GHJK",
+			UserID:   "user-123",
+			Approve:  true,
+		})
+		assert.ErrorIs(t, err, ErrInvalidUserCode)
+	})
+}
+
+func TestService_Poll(t *testing.T) {
+	const deviceCode = "device-code"
+
+	t.Run("should keep device waiting until the user decides", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Time{}, nil)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrAuthorizationPending)
+	})
+
+	t.Run("should slow down device polling faster than the interval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+
+		slowedDown := authorization
+		slowedDown.Interval = testConfig.Interval + SlowDownIncrement
+
+		gomock.InOrder(
+			deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil),
+			deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Now().Add(-time.Second), nil),
+			deviceRepo.EXPECT().SlowDown(ctx, authorization.DeviceCodeHash, testConfig.Interval, SlowDownIncrement).Return(slowedDown.Interval, nil),
+			deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(slowedDown, nil),
+			deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Now().Add(-slowedDown.Interval), nil),
+		)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrSlowDown)
+
+		var slowDownErr *SlowDownError
+		require.ErrorAs(t, err, &slowDownErr)
+		assert.Equal(t, slowedDown.Interval, slowDownErr.Interval)
+
+		_, err = service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrAuthorizationPending)
+	})
+
+	t.Run("should keep slowing down device polling faster than its grown interval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+		authorization.Interval = testConfig.Interval + SlowDownIncrement
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Now().Add(-testConfig.Interval), nil)
+		deviceRepo.EXPECT().
+			SlowDown(ctx, authorization.DeviceCodeHash, authorization.Interval, SlowDownIncrement).
+			Return(authorization.Interval+SlowDownIncrement, nil)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+
+		var slowDownErr *SlowDownError
+		require.ErrorAs(t, err, &slowDownErr)
+		assert.Equal(t, testConfig.Interval+2*SlowDownIncrement, slowDownErr.Interval)
+	})
+
+	t.Run("should consume approved authorization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+		authorization.Status = deviceentity.StatusApproved
+		authorization.UserID = "user-123"
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Time{}, nil)
+		deviceRepo.EXPECT().Consume(ctx, authorization).Return(nil)
+
+		approved, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		require.NoError(t, err)
+		assert.Equal(t, authorization, approved)
+	})
+
+	t.Run("should consume denied authorization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+		authorization.Status = deviceentity.StatusDenied
+		authorization.UserID = "user-123"
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Time{}, nil)
+		deviceRepo.EXPECT().Consume(ctx, authorization).Return(nil)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("should reject approved authorization consumed concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+		authorization.Status = deviceentity.StatusApproved
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().RecordPoll(ctx, authorization.DeviceCodeHash, gomock.Any()).Return(time.Time{}, nil)
+		deviceRepo.EXPECT().Consume(ctx, authorization).Return(devicerepo.ErrAuthorizationNotFound)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrInvalidDeviceCode)
+	})
+
+	t.Run("should tell device its code expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+		authorization.Status = deviceentity.StatusApproved
+		authorization.ExpiresAt = time.Now().Add(-time.Second)
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+
+	t.Run("should reject unknown device code and code of another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deviceRepo := newTestService(ctrl)
+
+		ctx := context.Background()
+		authorization := newTestAuthorization(deviceCode, "BCDFGHJK")
+
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, authorization.DeviceCodeHash).Return(authorization, nil)
+		deviceRepo.EXPECT().GetByDeviceCode(ctx, tokenentity.Hash("unknown")).Return(deviceentity.Authorization{}, devicerepo.ErrAuthorizationNotFound)
+
+		_, err := service.Poll(ctx, PollRequest{DeviceCode: deviceCode, ClientID: "client-456"})
+		assert.ErrorIs(t, err, ErrInvalidDeviceCode)
+
+		_, err = service.Poll(ctx, PollRequest{DeviceCode: "unknown", ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrInvalidDeviceCode)
+
+		_, err = service.Poll(ctx, PollRequest{ClientID: authorization.ClientID})
+		assert.ErrorIs(t, err, ErrInvalidDeviceCode)
+	})
+}
@@ -0,0 +1,116 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	deviceentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/device"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidUserCode is returned for unknown, expired and decided user
+	// codes.
+	ErrInvalidUserCode = errors.New("invalid user code")
+	// ErrInvalidDeviceCode is returned for unknown device codes and codes
+	// issued to another client.
+	ErrInvalidDeviceCode = errors.New("invalid device code")
+
+	// ErrAuthorizationPending, ErrSlowDown, ErrAccessDenied and
+	// ErrExpiredToken answer polls of the device until tokens are issued, as
+	// in RFC 8628 section 3.5.
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("slow down")
+	ErrAccessDenied         = errors.New("access denied")
+	ErrExpiredToken         = errors.New("device code expired")
+)
+
+const (
+	DeviceCodeLength = 32
+	// UserCodeCharset has no vowels, so that user codes spell no words, and
+	// no characters that are easily confused, as RFC 8628 section 6.1
+	// suggests.
+	UserCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	UserCodeLength  = 8
+	// MaxUserCodeAttempts is how often a new user code is drawn when the
+	// previous one is still taken by another authorization.
+	MaxUserCodeAttempts = 3
+
+	// SlowDownIncrement is added to the interval of a device each time it
+	// polls too fast, as RFC 8628 section 3.5 asks.
+	SlowDownIncrement = 5 * time.Second
+
+	SecurityEventApproved = "device_authorization_approved"
+	SecurityEventDenied   = "device_authorization_denied"
+)
+
+type Config struct {
+	// CodeTTL is how long the user has to enter the user code.
+	CodeTTL time.Duration
+	// Interval is how long devices wait between two polls.
+	Interval time.Duration
+	// VerificationURL is the page where users enter the user code.
+	VerificationURL string
+}
+
+type IService interface {
+	// Start issues the device code the device polls with and the user code
+	// the user enters on the verification page.
+	Start(ctx context.Context, req StartRequest) (StartResponse, error)
+	// Lookup returns the pending authorization of a user code, so that the
+	// user can check what they are asked to approve.
+	Lookup(ctx context.Context, userCode string) (deviceentity.Authorization, error)
+	// Decide approves or denies the pending authorization of a user code on
+	// behalf of the user.
+	Decide(ctx context.Context, req DecideRequest) (deviceentity.Authorization, error)
+	// Poll returns the authorization of a device code once it is approved,
+	// and consumes it. Until then it fails with ErrAuthorizationPending, or
+	// with a *SlowDownError if the device polls more often than its
+	// interval.
+	Poll(ctx context.Context, req PollRequest) (deviceentity.Authorization, error)
+}
+
+type (
+	StartRequest struct {
+		ClientID string
+		Scopes   []string
+	}
+
+	StartResponse struct {
+		DeviceCode string
+		// UserCode is formatted for display, as in BCDF-GHJK.
+		UserCode string
+		// VerificationURLComplete is the verification page with the user
+		// code filled in, for devices that can show a QR code.
+		VerificationURL         string
+		VerificationURLComplete string
+		ExpiresAt               time.Time
+		Interval                time.Duration
+	}
+)
+
+// SlowDownError is returned for polls that came too fast. Interval is how
+// long the device has to wait between polls from now on.
+type SlowDownError struct {
+	Interval time.Duration
+}
+
+func (e *SlowDownError) Error() string {
+	return fmt.Sprintf("%s: poll every %s", ErrSlowDown, e.Interval)
+}
+
+func (e *SlowDownError) Is(target error) bool {
+	return target == ErrSlowDown
+}
+
+type DecideRequest struct {
+	UserCode string
+	UserID   string
+	Approve  bool
+}
+
+type PollRequest struct {
+	DeviceCode string
+	ClientID   string
+}
@@ -0,0 +1,313 @@
+package federation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	federationrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation"
+	identityrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// generatedPasswordLength is the length of the random password of
+	// provisioned users. Nobody knows it; users who want to sign in with a
+	// password reset it first.
+	generatedPasswordLength = 32
+
+	defaultUsername = "user"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	providers map[string]*oidcclient.Provider
+
+	federationRepository federationrepo.IRepository
+	identityRepository   identityrepo.IRepository
+	userRepository       userrepo.IRepository
+}
+
+func NewService(cfg Config, federationRepository federationrepo.IRepository, identityRepository identityrepo.IRepository, userRepository userrepo.IRepository) *Service {
+	providers := make(map[string]*oidcclient.Provider, len(cfg.Providers))
+	for _, provider := range cfg.Providers {
+		providers[provider.ID] = oidcclient.New(oidcclient.Config{
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       provider.Scopes,
+			HTTPClient:   cfg.HTTPClient,
+		})
+	}
+
+	return &Service{
+		cfg:                  cfg,
+		providers:            providers,
+		federationRepository: federationRepository,
+		identityRepository:   identityRepository,
+		userRepository:       userRepository,
+	}
+}
+
+func (s *Service) ListProviders(ctx context.Context) []Provider {
+	providers := make([]Provider, 0, len(s.cfg.Providers))
+	for _, provider := range s.cfg.Providers {
+		providers = append(providers, Provider{
+			ID:   provider.ID,
+			Name: provider.Name,
+		})
+	}
+
+	return providers
+}
+
+func (s *Service) Start(ctx context.Context, req StartRequest) (StartResponse, error) {
+	provider, ok := s.providers[req.Provider]
+	if !ok {
+		return StartResponse{}, ErrUnknownProvider
+	}
+
+	state, err := randomToken(StateLength)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	nonce, err := randomToken(NonceLength)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	codeVerifier, err := oidcclient.NewCodeVerifier()
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	authorizationURL, err := provider.AuthCodeURL(ctx, state, nonce, oidcclient.CodeChallengeS256(codeVerifier))
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	err = s.federationRepository.Create(ctx, federationentity.Request{
+		StateHash:     tokenentity.Hash(state),
+		Provider:      req.Provider,
+		CodeVerifier:  codeVerifier,
+		Nonce:         nonce,
+		Authorization: req.Authorization,
+		ExpiresAt:     time.Now().Add(s.cfg.RequestTTL),
+	})
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	return StartResponse{
+		AuthorizationURL: authorizationURL,
+	}, nil
+}
+
+func (s *Service) Complete(ctx context.Context, req CompleteRequest) (CompleteResponse, error) {
+	request, err := s.federationRepository.Consume(ctx, tokenentity.Hash(req.State))
+	if err != nil {
+		if errors.Is(err, federationrepo.ErrRequestNotFound) {
+			return CompleteResponse{}, ErrInvalidState
+		}
+		return CompleteResponse{}, err
+	}
+
+	provider, ok := s.providers[request.Provider]
+	if !ok {
+		// The provider was removed from the configuration while the user
+		// was signing in.
+		return CompleteResponse{}, ErrUnknownProvider
+	}
+
+	token, err := provider.Exchange(ctx, req.Code, request.CodeVerifier)
+	if err != nil {
+		return CompleteResponse{}, err
+	}
+
+	claims, err := provider.VerifyIDToken(ctx, token.IDToken, request.Nonce)
+	if err != nil {
+		return CompleteResponse{}, err
+	}
+
+	user, err := s.linkIdentity(ctx, request.Provider, claims)
+	if err != nil {
+		return CompleteResponse{}, err
+	}
+
+	return CompleteResponse{
+		User:          user,
+		Authorization: request.Authorization,
+	}, nil
+}
+
+// linkIdentity returns the user an upstream identity belongs to. On first
+// sign in the identity is linked to the user with the same email address if
+// both sides verified it, or to a newly provisioned user if there is none.
+func (s *Service) linkIdentity(ctx context.Context, provider string, claims oidcclient.Claims) (usermodel.User, error) {
+	identity, err := s.identityRepository.Get(ctx, provider, claims.Subject)
+	if err == nil {
+		if err := s.identityRepository.Touch(ctx, identity.ID, time.Now()); err != nil {
+			return usermodel.User{}, err
+		}
+		return s.userRepository.GetByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, identityrepo.ErrIdentityNotFound) {
+		return usermodel.User{}, err
+	}
+
+	if claims.Email == "" {
+		return usermodel.User{}, ErrMissingEmail
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, claims.Email)
+	switch {
+	case err == nil:
+		// Linking on an unverified address on either side would let
+		// whoever controls one account take over the other.
+		if !claims.EmailVerified || !user.EmailVerified {
+			log.Warn().
+				Str("event", SecurityEventAccountConflict).
+				Str("provider", provider).
+				Str("subject", claims.Subject).
+				Str("user_id", user.ID).
+				Msg("federated identity matches an account it cannot be linked to")
+
+			return usermodel.User{}, ErrAccountConflict
+		}
+	case errors.Is(err, userrepo.ErrUserNotFound):
+		user, err = s.provisionUser(ctx, provider, claims)
+		if err != nil {
+			return usermodel.User{}, err
+		}
+	default:
+		return usermodel.User{}, err
+	}
+
+	now := time.Now()
+
+	err = s.identityRepository.Create(ctx, identityentity.Identity{
+		ID:          uuid.NewString(),
+		Provider:    provider,
+		Subject:     claims.Subject,
+		UserID:      user.ID,
+		Email:       claims.Email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	})
+	if err != nil {
+		return usermodel.User{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventIdentityLinked).
+		Str("provider", provider).
+		Str("subject", claims.Subject).
+		Str("user_id", user.ID).
+		Msg("federated identity linked")
+
+	return user, nil
+}
+
+// provisionUser creates a user for an identity signing in for the first
+// time.
+func (s *Service) provisionUser(ctx context.Context, provider string, claims oidcclient.Claims) (usermodel.User, error) {
+	password, err := randomToken(generatedPasswordLength)
+	if err != nil {
+		return usermodel.User{}, err
+	}
+
+	username, err := newUsername(claims)
+	if err != nil {
+		return usermodel.User{}, err
+	}
+
+	firstName, lastName := claims.GivenName, claims.FamilyName
+	if firstName == "" && lastName == "" {
+		firstName = claims.Name
+	}
+
+	id, err := s.userRepository.Create(ctx, usermodel.User{
+		Email:     claims.Email,
+		Role:      usermodel.RoleUser,
+		Username:  username,
+		AvatarURL: claims.Picture,
+		FirstName: firstName,
+		LastName:  lastName,
+	}, password)
+	if err != nil {
+		return usermodel.User{}, err
+	}
+
+	if claims.EmailVerified {
+		if err := s.userRepository.MarkEmailVerified(ctx, id); err != nil {
+			return usermodel.User{}, err
+		}
+	}
+
+	log.Info().
+		Str("event", SecurityEventUserProvisioned).
+		Str("provider", provider).
+		Str("subject", claims.Subject).
+		Str("user_id", id).
+		Msg("user provisioned for federated identity")
+
+	return s.userRepository.GetByID(ctx, id)
+}
+
+// newUsername derives a username from the preferred username or the email
+// address of an identity, with a random suffix to keep it unique.
+func newUsername(claims oidcclient.Claims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	base = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return -1
+		}
+	}, base)
+	if base == "" {
+		base = defaultUsername
+	}
+	if len(base) > MaxUsernameBaseLength {
+		base = base[:MaxUsernameBaseLength]
+	}
+
+	suffix := make([]byte, UsernameSuffixLength)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+
+	return base + "-" + hex.EncodeToString(suffix), nil
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
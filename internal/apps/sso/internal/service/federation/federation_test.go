@@ -0,0 +1,409 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	identityentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/identity"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	federationrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation"
+	federationmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/federation/mocks"
+	identityrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity"
+	identitymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/identity/mocks"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient/oidctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testProvider    = "acme"
+	testRedirectURL = "https://gateway.kgym.test/authorize/federation/callback"
+)
+
+var testAuthorization = federationentity.Authorization{
+	ClientID:            "client-123",
+	RedirectURI:         "https://app.example.com/callback",
+	Scopes:              []string{"openid", "email"},
+	State:               "client-state",
+	Nonce:               "client-nonce",
+	CodeChallenge:       "challenge",
+	CodeChallengeMethod: "S256",
+}
+
+var testIdentity = oidctest.Identity{
+	Subject:           "upstream-123",
+	Email:             "jane@corp.test",
+	EmailVerified:     true,
+	GivenName:         "Jane",
+	FamilyName:        "Doe",
+	PreferredUsername: "jane.doe",
+}
+
+type testDeps struct {
+	upstream       *oidctest.Server
+	federationRepo *federationmocks.MockIRepository
+	identityRepo   *identitymocks.MockIRepository
+	userRepo       *usermocks.MockIRepository
+}
+
+func newTestService(t *testing.T, ctrl *gomock.Controller) (*Service, testDeps) {
+	t.Helper()
+
+	upstream := oidctest.NewServer(oidctest.Config{
+		ClientID:     "kgym",
+		ClientSecret: "secret",
+	})
+	t.Cleanup(upstream.Close)
+
+	deps := testDeps{
+		upstream:       upstream,
+		federationRepo: federationmocks.NewMockIRepository(ctrl),
+		identityRepo:   identitymocks.NewMockIRepository(ctrl),
+		userRepo:       usermocks.NewMockIRepository(ctrl),
+	}
+
+	service := NewService(Config{
+		Providers: []ProviderConfig{{
+			ID:           testProvider,
+			Name:         "Acme Corp",
+			Issuer:       upstream.Issuer(),
+			ClientID:     "kgym",
+			ClientSecret: "secret",
+			Scopes:       []string{"email", "profile"},
+		}},
+		RedirectURL: testRedirectURL,
+		RequestTTL:  10 * time.Minute,
+	}, deps.federationRepo, deps.identityRepo, deps.userRepo)
+
+	return service, deps
+}
+
+// signInUpstream starts signing in with the test provider as identity and
+// returns the callback the provider sent the user agent back with. The
+// stored request is handed back by the next Consume.
+func signInUpstream(t *testing.T, service *Service, deps testDeps, identity oidctest.Identity) CompleteRequest {
+	t.Helper()
+
+	deps.upstream.SetIdentity(identity)
+
+	var stored federationentity.Request
+	deps.federationRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request federationentity.Request) error {
+			stored = request
+			return nil
+		})
+
+	resp, err := service.Start(context.Background(), StartRequest{
+		Provider:      testProvider,
+		Authorization: testAuthorization,
+	})
+	require.NoError(t, err)
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	upstreamResp, err := client.Get(resp.AuthorizationURL)
+	require.NoError(t, err)
+	defer upstreamResp.Body.Close()
+	require.Equal(t, http.StatusFound, upstreamResp.StatusCode)
+
+	callback, err := url.Parse(upstreamResp.Header.Get("Location"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(callback.String(), testRedirectURL))
+
+	state := callback.Query().Get("state")
+	deps.federationRepo.EXPECT().
+		Consume(gomock.Any(), tokenentity.Hash(state)).
+		DoAndReturn(func(context.Context, string) (federationentity.Request, error) {
+			return stored, nil
+		})
+
+	return CompleteRequest{
+		State: state,
+		Code:  callback.Query().Get("code"),
+	}
+}
+
+func TestService_Start(t *testing.T) {
+	t.Run("should store request and return upstream authorization url", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+
+		var stored federationentity.Request
+		deps.federationRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request federationentity.Request) error {
+				stored = request
+				return nil
+			})
+
+		resp, err := service.Start(context.Background(), StartRequest{
+			Provider:      testProvider,
+			Authorization: testAuthorization,
+		})
+		require.NoError(t, err)
+
+		authorizationURL, err := url.Parse(resp.AuthorizationURL)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(resp.AuthorizationURL, deps.upstream.Issuer()+oidctest.AuthorizePath))
+
+		query := authorizationURL.Query()
+		assert.Equal(t, tokenentity.Hash(query.Get("state")), stored.StateHash)
+		assert.Equal(t, stored.Nonce, query.Get("nonce"))
+		assert.Equal(t, oidcclient.CodeChallengeS256(stored.CodeVerifier), query.Get("code_challenge"))
+		assert.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+		assert.Equal(t, testProvider, stored.Provider)
+		assert.Equal(t, testAuthorization, stored.Authorization)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), stored.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should reject unknown provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, _ := newTestService(t, ctrl)
+
+		_, err := service.Start(context.Background(), StartRequest{
+			Provider:      "unknown",
+			Authorization: testAuthorization,
+		})
+		assert.ErrorIs(t, err, ErrUnknownProvider)
+	})
+}
+
+func TestService_Complete(t *testing.T) {
+	t.Run("should provision user on first sign in", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+		req := signInUpstream(t, service, deps, testIdentity)
+
+		provisioned := usermodel.User{
+			ID:            "user-new",
+			Email:         testIdentity.Email,
+			EmailVerified: true,
+			Role:          usermodel.RoleUser,
+		}
+
+		deps.identityRepo.EXPECT().
+			Get(gomock.Any(), testProvider, testIdentity.Subject).
+			Return(identityentity.Identity{}, identityrepo.ErrIdentityNotFound)
+		deps.userRepo.EXPECT().
+			GetByEmail(gomock.Any(), testIdentity.Email).
+			Return(usermodel.User{}, userrepo.ErrUserNotFound)
+		deps.userRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, user usermodel.User, password string) (string, error) {
+				assert.Equal(t, testIdentity.Email, user.Email)
+				assert.Equal(t, usermodel.RoleUser, user.Role)
+				assert.True(t, strings.HasPrefix(user.Username, "jane.doe-"))
+				assert.Equal(t, testIdentity.GivenName, user.FirstName)
+				assert.Equal(t, testIdentity.FamilyName, user.LastName)
+				assert.NotEmpty(t, password)
+				return provisioned.ID, nil
+			})
+		deps.userRepo.EXPECT().
+			MarkEmailVerified(gomock.Any(), provisioned.ID).
+			Return(nil)
+		deps.userRepo.EXPECT().
+			GetByID(gomock.Any(), provisioned.ID).
+			Return(provisioned, nil)
+		deps.identityRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, identity identityentity.Identity) error {
+				assert.Equal(t, testProvider, identity.Provider)
+				assert.Equal(t, testIdentity.Subject, identity.Subject)
+				assert.Equal(t, provisioned.ID, identity.UserID)
+				return nil
+			})
+
+		resp, err := service.Complete(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, provisioned, resp.User)
+		assert.Equal(t, testAuthorization, resp.Authorization)
+	})
+
+	t.Run("should sign in user of linked identity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+		req := signInUpstream(t, service, deps, testIdentity)
+
+		user := usermodel.User{ID: "user-123", Email: "other@kgym.test", Role: usermodel.RoleUser}
+
+		deps.identityRepo.EXPECT().
+			Get(gomock.Any(), testProvider, testIdentity.Subject).
+			Return(identityentity.Identity{ID: "identity-123", UserID: user.ID}, nil)
+		deps.identityRepo.EXPECT().
+			Touch(gomock.Any(), "identity-123", gomock.Any()).
+			Return(nil)
+		deps.userRepo.EXPECT().
+			GetByID(gomock.Any(), user.ID).
+			Return(user, nil)
+
+		resp, err := service.Complete(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, user, resp.User)
+	})
+
+	t.Run("should link account with verified email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+		req := signInUpstream(t, service, deps, testIdentity)
+
+		user := usermodel.User{ID: "user-123", Email: testIdentity.Email, EmailVerified: true, Role: usermodel.RoleUser}
+
+		deps.identityRepo.EXPECT().
+			Get(gomock.Any(), testProvider, testIdentity.Subject).
+			Return(identityentity.Identity{}, identityrepo.ErrIdentityNotFound)
+		deps.userRepo.EXPECT().
+			GetByEmail(gomock.Any(), testIdentity.Email).
+			Return(user, nil)
+		deps.identityRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		resp, err := service.Complete(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, user, resp.User)
+	})
+
+	t.Run("should not link account when either side did not verify email", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			upstreamVerified bool
+			localVerified    bool
+		}{
+			{name: "upstream unverified", upstreamVerified: false, localVerified: true},
+			{name: "local unverified", upstreamVerified: true, localVerified: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				identity := testIdentity
+				identity.EmailVerified = tc.upstreamVerified
+
+				service, deps := newTestService(t, ctrl)
+				req := signInUpstream(t, service, deps, identity)
+
+				deps.identityRepo.EXPECT().
+					Get(gomock.Any(), testProvider, identity.Subject).
+					Return(identityentity.Identity{}, identityrepo.ErrIdentityNotFound)
+				deps.userRepo.EXPECT().
+					GetByEmail(gomock.Any(), identity.Email).
+					Return(usermodel.User{ID: "user-123", Email: identity.Email, EmailVerified: tc.localVerified}, nil)
+
+				_, err := service.Complete(context.Background(), req)
+				assert.ErrorIs(t, err, ErrAccountConflict)
+			})
+		}
+	})
+
+	t.Run("should not provision user without email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		identity := testIdentity
+		identity.Email = ""
+
+		service, deps := newTestService(t, ctrl)
+		req := signInUpstream(t, service, deps, identity)
+
+		deps.identityRepo.EXPECT().
+			Get(gomock.Any(), testProvider, identity.Subject).
+			Return(identityentity.Identity{}, identityrepo.ErrIdentityNotFound)
+
+		_, err := service.Complete(context.Background(), req)
+		assert.ErrorIs(t, err, ErrMissingEmail)
+	})
+
+	t.Run("should reject unknown state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+
+		deps.federationRepo.EXPECT().
+			Consume(gomock.Any(), tokenentity.Hash("unknown")).
+			Return(federationentity.Request{}, federationrepo.ErrRequestNotFound)
+
+		_, err := service.Complete(context.Background(), CompleteRequest{
+			State: "unknown",
+			Code:  "code",
+		})
+		assert.ErrorIs(t, err, ErrInvalidState)
+	})
+
+	t.Run("should reject code of another request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(t, ctrl)
+		req := signInUpstream(t, service, deps, testIdentity)
+		req.Code = "forged"
+
+		_, err := service.Complete(context.Background(), req)
+		assert.ErrorIs(t, err, oidcclient.ErrExchange)
+	})
+}
+
+func TestNewUsername(t *testing.T) {
+	testCases := []struct {
+		name   string
+		claims oidcclient.Claims
+		prefix string
+	}{
+		{
+			name:   "preferred username",
+			claims: oidcclient.Claims{PreferredUsername: "Jane.Doe", Email: "jd@corp.test"},
+			prefix: "jane.doe-",
+		},
+		{
+			name:   "email local part",
+			claims: oidcclient.Claims{Email: "j+d@corp.test"},
+			prefix: "jd-",
+		},
+		{
+			name:   "nothing usable",
+			claims: oidcclient.Claims{PreferredUsername: "张伟"},
+			prefix: defaultUsername + "-",
+		},
+		{
+			name:   "long username",
+			claims: oidcclient.Claims{PreferredUsername: strings.Repeat("a", 64)},
+			prefix: strings.Repeat("a", MaxUsernameBaseLength) + "-",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("should derive username from "+tc.name, func(t *testing.T) {
+			username, err := newUsername(tc.claims)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(username, tc.prefix), username)
+			assert.Len(t, username, len(tc.prefix)+2*UsernameSuffixLength)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package federation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	federationentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/federation"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown identity provider")
+	// ErrInvalidState is returned for callbacks that do not belong to a
+	// pending request, including ones that were already completed.
+	ErrInvalidState = errors.New("invalid federation state")
+	// ErrMissingEmail is returned when the provider does not share an email
+	// address, which new users cannot be provisioned without.
+	ErrMissingEmail = errors.New("identity provider did not return an email address")
+	// ErrAccountConflict is returned when an account with the email address
+	// of the identity exists, but either side has not verified the address,
+	// so the identity cannot be linked to it safely.
+	ErrAccountConflict = errors.New("account with the same email address exists")
+)
+
+const (
+	StateLength = 32
+	NonceLength = 32
+
+	// UsernameSuffixLength is the number of random bytes appended to the
+	// usernames of provisioned users, which must be unique.
+	UsernameSuffixLength = 3
+	// MaxUsernameBaseLength keeps provisioned usernames, suffix included,
+	// within the limit of the user service.
+	MaxUsernameBaseLength = 24
+
+	SecurityEventIdentityLinked  = "federated_identity_linked"
+	SecurityEventUserProvisioned = "federated_user_provisioned"
+	SecurityEventAccountConflict = "federated_account_conflict"
+)
+
+type ProviderConfig struct {
+	// ID names the provider in URLs and in linked identities. It must not
+	// change once users signed in with the provider.
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Scopes       []string `json:"scopes"`
+}
+
+type Config struct {
+	Providers []ProviderConfig
+	// RedirectURL is the callback registered with every provider.
+	RedirectURL string
+	// RequestTTL is how long a user may take to sign in upstream.
+	RequestTTL time.Duration
+
+	// HTTPClient is used to talk to the providers. It defaults to the one
+	// of oidcclient.
+	HTTPClient *http.Client
+}
+
+// Provider is an upstream identity provider as shown to users.
+type Provider struct {
+	ID   string
+	Name string
+}
+
+type IService interface {
+	ListProviders(ctx context.Context) []Provider
+	// Start returns the URL to send the user agent to for signing in with
+	// the provider. The authorization request is resumed by Complete.
+	Start(ctx context.Context, req StartRequest) (StartResponse, error)
+	// Complete validates the callback of a provider and returns the user the
+	// upstream identity is linked to, linking or provisioning one on first
+	// sign in, together with the authorization request to resume.
+	Complete(ctx context.Context, req CompleteRequest) (CompleteResponse, error)
+}
+
+type (
+	StartRequest struct {
+		Provider      string
+		Authorization federationentity.Authorization
+	}
+
+	StartResponse struct {
+		AuthorizationURL string
+	}
+)
+
+type (
+	CompleteRequest struct {
+		State string
+		Code  string
+	}
+
+	CompleteResponse struct {
+		User          usermodel.User
+		Authorization federationentity.Authorization
+	}
+)
@@ -2,23 +2,351 @@ package key
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"slices"
+	"time"
 
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
 	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 )
 
 var _ IService = (*Service)(nil)
 
 type Service struct {
+	cfg Config
+
 	keyRepository keyrepo.IRepository
+
+	auditService auditservice.IService
 }
 
-func NewService(keyRepository keyrepo.IRepository) *Service {
+func NewService(cfg Config, keyRepository keyrepo.IRepository, auditService auditservice.IService) *Service {
 	return &Service{
+		cfg:           cfg,
 		keyRepository: keyRepository,
+		auditService:  auditService,
 	}
 }
 
 func (s *Service) GetPublicKeys(ctx context.Context) ([]keyentity.Key, error) {
 	return s.keyRepository.GetPublicKeys(ctx)
 }
+
+func (s *Service) List(ctx context.Context) ([]keyentity.Key, error) {
+	keys, err := s.keyRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(keys, func(a, b keyentity.Key) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (s *Service) Rotate(ctx context.Context, req RotateRequest) (keyentity.Key, error) {
+	algorithm := req.Algorithm
+	if algorithm == "" {
+		algorithm = s.cfg.Algorithm
+	}
+
+	privateKey, err := generateKey(algorithm)
+	if err != nil {
+		return keyentity.Key{}, err
+	}
+
+	return s.create(ctx, time.Now().UTC().Format(KeyIDFormat), algorithm, privateKey, req.Immediate)
+}
+
+func (s *Service) Import(ctx context.Context, req ImportRequest) (keyentity.Key, error) {
+	algorithm, err := algorithmOf(req.PrivateKey)
+	if err != nil {
+		return keyentity.Key{}, err
+	}
+
+	kid := req.ID
+	if kid == "" {
+		kid = time.Now().UTC().Format(KeyIDFormat)
+	}
+
+	_, err = s.keyRepository.Get(ctx, kid)
+	if err == nil {
+		return keyentity.Key{}, ErrKeyExists
+	}
+	if !errors.Is(err, keyrepo.ErrKeyNotFound) {
+		return keyentity.Key{}, err
+	}
+
+	return s.create(ctx, kid, algorithm, req.PrivateKey.(crypto.Signer), req.Immediate)
+}
+
+func (s *Service) Retire(ctx context.Context, kid string) error {
+	key, err := s.keyRepository.Get(ctx, kid)
+	if err != nil {
+		if errors.Is(err, keyrepo.ErrKeyNotFound) {
+			return ErrKeyNotFound
+		}
+		return err
+	}
+
+	if key.State == keyentity.StateActive {
+		return ErrActiveKey
+	}
+
+	if key.State == keyentity.StateRetired {
+		return nil
+	}
+
+	return s.retire(ctx, key)
+}
+
+func (s *Service) Advance(ctx context.Context) error {
+	now := time.Now()
+
+	keys, err := s.keyRepository.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		active  []keyentity.Key
+		pending []keyentity.Key
+		due     *keyentity.Key
+	)
+
+	for _, key := range keys {
+		switch key.State {
+		case keyentity.StateActive:
+			active = append(active, key)
+		case keyentity.StatePending:
+			pending = append(pending, key)
+			if !now.Before(key.ActivateAt) && (due == nil || key.CreatedAt.After(due.CreatedAt)) {
+				due = &key
+			}
+		case keyentity.StateVerificationOnly:
+			if !key.RetireAt.IsZero() && !now.Before(key.RetireAt) {
+				if err := s.retire(ctx, key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if due != nil {
+		// The listed key has no private half, the stored one does.
+		key, err := s.keyRepository.Get(ctx, due.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.activate(ctx, key, active, now)
+	}
+
+	// Nothing can sign yet, so waiting out the pre-publication window would
+	// only keep the service from issuing tokens.
+	if len(active) == 0 {
+		_, err := s.Rotate(ctx, RotateRequest{Immediate: true})
+		return err
+	}
+
+	if len(pending) > 0 {
+		return nil
+	}
+
+	for _, key := range active {
+		if now.Sub(key.ActivateAt) < s.cfg.RotationInterval {
+			return nil
+		}
+	}
+
+	_, err = s.Rotate(ctx, RotateRequest{})
+
+	return err
+}
+
+func (s *Service) ReEncrypt(ctx context.Context) (int, error) {
+	count, err := s.keyRepository.ReEncrypt(ctx)
+	if err != nil {
+		return count, err
+	}
+
+	log.Info().
+		Str("event", EventKeysReEncrypted).
+		Int("count", count).
+		Msg("signing keys re-encrypted")
+
+	s.record(ctx, authevententity.TypeKeysReEncrypted, "")
+
+	return count, nil
+}
+
+func (s *Service) create(ctx context.Context, kid, algorithm string, privateKey crypto.Signer, immediate bool) (keyentity.Key, error) {
+	now := time.Now()
+
+	key := keyentity.Key{
+		ID:         kid,
+		Private:    privateKey,
+		Public:     privateKey.Public(),
+		Algorithm:  algorithm,
+		State:      keyentity.StatePending,
+		CreatedAt:  now,
+		ActivateAt: now.Add(s.cfg.PrePublication),
+	}
+
+	if immediate {
+		active, err := s.activeKeys(ctx)
+		if err != nil {
+			return keyentity.Key{}, err
+		}
+
+		if err := s.activate(ctx, key, active, now); err != nil {
+			return keyentity.Key{}, err
+		}
+
+		key.State = keyentity.StateActive
+		key.ActivateAt = now
+
+		return key, nil
+	}
+
+	if err := s.keyRepository.Save(ctx, key); err != nil {
+		return keyentity.Key{}, err
+	}
+
+	log.Info().
+		Str("event", EventKeyCreated).
+		Str("kid", key.ID).
+		Time("activate_at", key.ActivateAt).
+		Msg("signing key published")
+
+	s.record(ctx, authevententity.TypeKeyCreated, key.ID)
+
+	return key, nil
+}
+
+// activate makes key the signing key. The keys it replaces keep verifying for
+// the overlap window.
+func (s *Service) activate(ctx context.Context, key keyentity.Key, active []keyentity.Key, now time.Time) error {
+	key.State = keyentity.StateActive
+	key.ActivateAt = now
+
+	keys := []keyentity.Key{key}
+	for _, previous := range active {
+		if previous.ID == key.ID {
+			continue
+		}
+
+		previous.State = keyentity.StateVerificationOnly
+		previous.Private = nil
+		previous.RetireAt = now.Add(s.cfg.Overlap)
+
+		keys = append(keys, previous)
+	}
+
+	if err := s.keyRepository.Save(ctx, keys...); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", EventKeyActivated).
+		Str("kid", key.ID).
+		Msg("signing key activated")
+
+	s.record(ctx, authevententity.TypeKeyActivated, key.ID)
+
+	return nil
+}
+
+func (s *Service) retire(ctx context.Context, key keyentity.Key) error {
+	key.State = keyentity.StateRetired
+	key.Private = nil
+
+	if err := s.keyRepository.Save(ctx, key); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", EventKeyRetired).
+		Str("kid", key.ID).
+		Msg("signing key retired")
+
+	s.record(ctx, authevententity.TypeKeyRetired, key.ID)
+
+	return nil
+}
+
+// record appends a change of the keys to the audit trail. The change is
+// already saved, so an error recording it is only logged.
+func (s *Service) record(ctx context.Context, eventType authevententity.Type, kid string) {
+	err := s.auditService.Record(context.WithoutCancel(ctx), authevententity.Event{
+		Type:    eventType,
+		Subject: kid,
+		Outcome: authevententity.OutcomeSuccess,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("type", string(eventType)).
+			Str("kid", kid).
+			Msg("failed to record auth event")
+	}
+}
+
+func (s *Service) activeKeys(ctx context.Context) ([]keyentity.Key, error) {
+	keys, err := s.keyRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]keyentity.Key, 0, 1)
+	for _, key := range keys {
+		if key.State == keyentity.StateActive {
+			active = append(active, key)
+		}
+	}
+
+	return active, nil
+}
+
+func generateKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case keyentity.AlgorithmRS256:
+		return rsa.GenerateKey(rand.Reader, RSAKeySize)
+	case keyentity.AlgorithmES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case keyentity.AlgorithmEdDSA:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+func algorithmOf(privateKey crypto.PrivateKey) (string, error) {
+	switch privateKey := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if privateKey.N.BitLen() < RSAKeySize {
+			return "", errors.Wrapf(ErrInvalidKey, "RSA keys must have at least %d bits", RSAKeySize)
+		}
+		return keyentity.AlgorithmRS256, nil
+	case *ecdsa.PrivateKey:
+		if privateKey.Curve != elliptic.P256() {
+			return "", errors.Wrap(ErrInvalidKey, "only P-256 ECDSA keys are supported")
+		}
+		return keyentity.AlgorithmES256, nil
+	case ed25519.PrivateKey:
+		return keyentity.AlgorithmEdDSA, nil
+	default:
+		return "", errors.Wrap(ErrInvalidKey, "unsupported key type")
+	}
+}
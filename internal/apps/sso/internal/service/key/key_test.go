@@ -0,0 +1,487 @@
+package key
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	authevententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/authevent"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	autheventmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/authevent/mocks"
+	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
+	keymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/mocks"
+	auditservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	Algorithm:        keyentity.AlgorithmRS256,
+	RotationInterval: 7 * 24 * time.Hour,
+	PrePublication:   24 * time.Hour,
+	Overlap:          24 * time.Hour,
+}
+
+func newTestKey(t *testing.T, kid string, state keyentity.State) keyentity.Key {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, RSAKeySize)
+	require.NoError(t, err)
+
+	return keyentity.Key{
+		ID:        kid,
+		Private:   privateKey,
+		Public:    privateKey.Public(),
+		Algorithm: keyentity.AlgorithmRS256,
+		State:     state,
+		CreatedAt: time.Now().Add(-time.Hour),
+	}
+}
+
+// newAuditService returns an audit service that records every event.
+func newAuditService(ctrl *gomock.Controller) *auditservice.Service {
+	authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+	authEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return auditservice.NewService(authEventRepo)
+}
+
+func publicOnly(key keyentity.Key) keyentity.Key {
+	key.Private = nil
+	return key
+}
+
+func TestService_Rotate(t *testing.T) {
+	t.Run("should publish pending key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		var saved []keyentity.Key
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+				saved = keys
+				return nil
+			})
+
+		key, err := service.Rotate(ctx, RotateRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.StatePending, key.State)
+		assert.NotNil(t, key.Private)
+		assert.WithinDuration(t, time.Now().Add(testConfig.PrePublication), key.ActivateAt, time.Minute)
+		require.Len(t, saved, 1)
+		assert.Equal(t, key.ID, saved[0].ID)
+	})
+
+	t.Run("should activate key immediately and demote the previous one", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		previous := newTestKey(t, "previous", keyentity.StateActive)
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return([]keyentity.Key{publicOnly(previous)}, nil)
+
+		var saved []keyentity.Key
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+				saved = keys
+				return nil
+			})
+
+		key, err := service.Rotate(ctx, RotateRequest{Immediate: true})
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.StateActive, key.State)
+
+		require.Len(t, saved, 2)
+		assert.Equal(t, key.ID, saved[0].ID)
+		assert.Equal(t, keyentity.StateActive, saved[0].State)
+		assert.NotNil(t, saved[0].Private)
+
+		assert.Equal(t, previous.ID, saved[1].ID)
+		assert.Equal(t, keyentity.StateVerificationOnly, saved[1].State)
+		assert.Nil(t, saved[1].Private)
+		assert.WithinDuration(t, time.Now().Add(testConfig.Overlap), saved[1].RetireAt, time.Minute)
+	})
+
+	t.Run("should record key changes in audit trail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		authEventRepo := autheventmocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, auditservice.NewService(authEventRepo))
+
+		ctx := context.Background()
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return(nil, nil)
+
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			Return(nil)
+
+		var events []authevententity.Event
+		authEventRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, event authevententity.Event) error {
+				events = append(events, event)
+				return nil
+			})
+
+		key, err := service.Rotate(ctx, RotateRequest{Immediate: true})
+		require.NoError(t, err)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, authevententity.TypeKeyActivated, events[0].Type)
+		assert.Equal(t, key.ID, events[0].Subject)
+		assert.Equal(t, authevententity.OutcomeSuccess, events[0].Outcome)
+	})
+}
+
+func TestService_Import(t *testing.T) {
+	t.Run("should import RSA key as pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		imported := newTestKey(t, "imported", keyentity.StatePending)
+
+		keyRepo.EXPECT().
+			Get(ctx, "imported").
+			Return(keyentity.Key{}, keyrepo.ErrKeyNotFound)
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			Return(nil)
+
+		key, err := service.Import(ctx, ImportRequest{
+			ID:         "imported",
+			PrivateKey: imported.Private,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "imported", key.ID)
+		assert.Equal(t, keyentity.StatePending, key.State)
+	})
+
+	t.Run("should return ErrKeyExists when kid is taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		existing := newTestKey(t, "existing", keyentity.StateActive)
+
+		keyRepo.EXPECT().
+			Get(ctx, "existing").
+			Return(existing, nil)
+
+		_, err := service.Import(ctx, ImportRequest{
+			ID:         "existing",
+			PrivateKey: existing.Private,
+		})
+		assert.ErrorIs(t, err, ErrKeyExists)
+	})
+
+	t.Run("should return ErrInvalidKey for short RSA keys", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+
+		_, err = service.Import(context.Background(), ImportRequest{PrivateKey: privateKey})
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func TestService_Retire(t *testing.T) {
+	t.Run("should retire key and drop its private half", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		key := newTestKey(t, "previous", keyentity.StateVerificationOnly)
+
+		keyRepo.EXPECT().
+			Get(ctx, key.ID).
+			Return(key, nil)
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+				require.Len(t, keys, 1)
+				assert.Equal(t, keyentity.StateRetired, keys[0].State)
+				assert.Nil(t, keys[0].Private)
+				return nil
+			})
+
+		err := service.Retire(ctx, key.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("should return ErrActiveKey for the signing key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		key := newTestKey(t, "active", keyentity.StateActive)
+
+		keyRepo.EXPECT().
+			Get(ctx, key.ID).
+			Return(key, nil)
+
+		err := service.Retire(ctx, key.ID)
+		assert.ErrorIs(t, err, ErrActiveKey)
+	})
+
+	t.Run("should return ErrKeyNotFound for unknown key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		keyRepo.EXPECT().
+			Get(ctx, "missing").
+			Return(keyentity.Key{}, keyrepo.ErrKeyNotFound)
+
+		err := service.Retire(ctx, "missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestService_Advance(t *testing.T) {
+	t.Run("should activate due pending key and retire expired previous key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		expired := newTestKey(t, "expired", keyentity.StateVerificationOnly)
+		expired.RetireAt = time.Now().Add(-time.Minute)
+
+		active := newTestKey(t, "active", keyentity.StateActive)
+
+		pending := newTestKey(t, "pending", keyentity.StatePending)
+		pending.ActivateAt = time.Now().Add(-time.Minute)
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return([]keyentity.Key{publicOnly(expired), publicOnly(active), publicOnly(pending)}, nil)
+
+		gomock.InOrder(
+			keyRepo.EXPECT().
+				Save(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+					assert.Equal(t, expired.ID, keys[0].ID)
+					assert.Equal(t, keyentity.StateRetired, keys[0].State)
+					return nil
+				}),
+			keyRepo.EXPECT().
+				Get(ctx, pending.ID).
+				Return(pending, nil),
+			keyRepo.EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+					assert.Equal(t, pending.ID, keys[0].ID)
+					assert.Equal(t, keyentity.StateActive, keys[0].State)
+					assert.NotNil(t, keys[0].Private)
+					assert.Equal(t, active.ID, keys[1].ID)
+					assert.Equal(t, keyentity.StateVerificationOnly, keys[1].State)
+					return nil
+				}),
+		)
+
+		err := service.Advance(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should keep pending key until its activation time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		active := newTestKey(t, "active", keyentity.StateActive)
+		active.ActivateAt = time.Now().Add(-2 * testConfig.RotationInterval)
+
+		pending := newTestKey(t, "pending", keyentity.StatePending)
+		pending.ActivateAt = time.Now().Add(time.Hour)
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return([]keyentity.Key{publicOnly(active), publicOnly(pending)}, nil)
+
+		err := service.Advance(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should publish a replacement once the active key is due", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		active := newTestKey(t, "active", keyentity.StateActive)
+		active.ActivateAt = time.Now().Add(-testConfig.RotationInterval)
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return([]keyentity.Key{publicOnly(active)}, nil)
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+				assert.Equal(t, keyentity.StatePending, keys[0].State)
+				return nil
+			})
+
+		err := service.Advance(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should do nothing while the active key is fresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		active := newTestKey(t, "active", keyentity.StateActive)
+		active.ActivateAt = time.Now().Add(-time.Hour)
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return([]keyentity.Key{publicOnly(active)}, nil)
+
+		err := service.Advance(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should activate a new key when there is none", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		ctx := context.Background()
+
+		keyRepo.EXPECT().
+			List(ctx).
+			Return(nil, nil).
+			Times(2)
+		keyRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, keys ...keyentity.Key) error {
+				assert.Equal(t, keyentity.StateActive, keys[0].State)
+				return nil
+			})
+
+		err := service.Advance(ctx)
+		require.NoError(t, err)
+	})
+}
+
+func TestService_Algorithms(t *testing.T) {
+	for _, algorithm := range keyentity.Algorithms {
+		t.Run("should rotate to "+algorithm+" key", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			keyRepo := keymocks.NewMockIRepository(ctrl)
+			service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+			ctx := context.Background()
+
+			keyRepo.EXPECT().
+				Save(ctx, gomock.Any()).
+				Return(nil)
+
+			key, err := service.Rotate(ctx, RotateRequest{Algorithm: algorithm})
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, key.Algorithm)
+		})
+	}
+
+	t.Run("should return ErrUnsupportedAlgorithm for unknown algorithm", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, keyRepo, newAuditService(ctrl))
+
+		_, err := service.Rotate(context.Background(), RotateRequest{Algorithm: "HS256"})
+		assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+	})
+
+	t.Run("should infer algorithm of imported keys", func(t *testing.T) {
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		algorithm, err := algorithmOf(ecdsaKey)
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.AlgorithmES256, algorithm)
+
+		algorithm, err = algorithmOf(ed25519Key)
+		require.NoError(t, err)
+		assert.Equal(t, keyentity.AlgorithmEdDSA, algorithm)
+
+		_, err = algorithmOf(p384Key)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
@@ -0,0 +1,67 @@
+package key
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	lockrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock"
+	"github.com/rs/zerolog/log"
+)
+
+// Scheduler advances the key lifecycle periodically. Several replicas may run
+// one; a lock in Redis makes sure only one of them acts at a time.
+type Scheduler struct {
+	keyService     IService
+	lockRepository lockrepo.IRepository
+
+	owner    string
+	interval time.Duration
+}
+
+func NewScheduler(keyService IService, lockRepository lockrepo.IRepository, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		keyService:     keyService,
+		lockRepository: lockRepository,
+		owner:          uuid.NewString(),
+		interval:       interval,
+	}
+}
+
+// Run blocks until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.tick(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.lockRepository.Release(context.WithoutCancel(ctx), SchedulerLockName, s.owner); err != nil {
+				log.Error().Err(err).Msg("failed to release key rotation lock")
+			}
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	// The lock outlives a missed tick so leadership does not flap between
+	// replicas, but expires if the leader goes away.
+	acquired, err := s.lockRepository.Acquire(ctx, SchedulerLockName, s.owner, 2*s.interval)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to acquire key rotation lock")
+		return
+	}
+
+	if !acquired {
+		return
+	}
+
+	if err := s.keyService.Advance(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to advance signing keys")
+	}
+}
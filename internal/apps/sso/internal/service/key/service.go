@@ -2,10 +2,83 @@ package key
 
 import (
 	"context"
+	"crypto"
+	"time"
 
 	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	"github.com/pkg/errors"
 )
 
+var (
+	ErrKeyNotFound = errors.New("key not found")
+	ErrKeyExists   = errors.New("key already exists")
+	ErrActiveKey   = errors.New("the active signing key cannot be retired")
+	ErrInvalidKey  = errors.New("invalid key")
+
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
+
+const (
+	RSAKeySize = 2048
+
+	// KeyIDFormat is the layout of generated key ids, the creation time in UTC.
+	KeyIDFormat = "20060102T150405"
+
+	SchedulerLockName = "jwks:rotation"
+
+	EventKeyCreated      = "signing_key_created"
+	EventKeyActivated    = "signing_key_activated"
+	EventKeyRetired      = "signing_key_retired"
+	EventKeysReEncrypted = "signing_keys_reencrypted"
+)
+
+type Config struct {
+	// Algorithm is used for keys created by rotation unless the request asks
+	// for another one.
+	Algorithm string
+	// RotationInterval is how long a key signs before a replacement is
+	// created.
+	RotationInterval time.Duration
+	// PrePublication is how long a new key is published before it starts
+	// signing, so that verifiers caching the JWKS pick it up first.
+	PrePublication time.Duration
+	// Overlap is how long a replaced key keeps verifying. It must be longer
+	// than the lifetime of any token the key signed.
+	Overlap time.Duration
+}
+
 type IService interface {
 	GetPublicKeys(ctx context.Context) ([]keyentity.Key, error)
+	List(ctx context.Context) ([]keyentity.Key, error)
+	Rotate(ctx context.Context, req RotateRequest) (keyentity.Key, error)
+	Import(ctx context.Context, req ImportRequest) (keyentity.Key, error)
+	Retire(ctx context.Context, kid string) error
+	// Advance moves keys along their lifecycle: due pending keys are
+	// activated, verification-only keys past their overlap window are retired
+	// and a replacement is created once the active key is old enough.
+	Advance(ctx context.Context) error
+	// ReEncrypt re-wraps stored private keys under the current key-encryption
+	// key. Run it after adding a new KEK and before removing the old one.
+	ReEncrypt(ctx context.Context) (int, error)
 }
+
+type (
+	RotateRequest struct {
+		// Algorithm defaults to the configured one.
+		Algorithm string
+		// Immediate activates the new key right away instead of publishing it
+		// for the pre-publication window first.
+		Immediate bool
+	}
+)
+
+type (
+	ImportRequest struct {
+		// ID defaults to one derived from the current time.
+		ID string
+		// PrivateKey must be an RSA key of at least RSAKeySize bits, a P-256
+		// ECDSA key or an Ed25519 key. The algorithm follows from its type.
+		PrivateKey crypto.PrivateKey
+		Immediate  bool
+	}
+)
@@ -0,0 +1,386 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	mfachallengerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/totp"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type Service struct {
+	cfg Config
+
+	mfaRepository       mfarepo.IRepository
+	challengeRepository mfachallengerepo.IRepository
+	userRepository      userrepo.IRepository
+}
+
+func NewService(cfg Config, mfaRepository mfarepo.IRepository, challengeRepository mfachallengerepo.IRepository, userRepository userrepo.IRepository) *Service {
+	return &Service{
+		cfg:                 cfg,
+		mfaRepository:       mfaRepository,
+		challengeRepository: challengeRepository,
+		userRepository:      userRepository,
+	}
+}
+
+func (s *Service) Enroll(ctx context.Context, req EnrollRequest) (EnrollResponse, error) {
+	enrollment, err := s.mfaRepository.GetTOTP(ctx, req.UserID)
+	if err != nil && !errors.Is(err, mfarepo.ErrTOTPNotFound) {
+		return EnrollResponse{}, err
+	}
+	if err == nil && enrollment.Confirmed() {
+		return EnrollResponse{}, ErrAlreadyEnrolled
+	}
+
+	user, err := s.userRepository.GetByID(ctx, req.UserID)
+	if err != nil {
+		return EnrollResponse{}, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return EnrollResponse{}, err
+	}
+
+	err = s.mfaRepository.CreateTOTP(ctx, mfaentity.TOTP{
+		UserID:    user.ID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPAlreadyConfirmed) {
+			return EnrollResponse{}, ErrAlreadyEnrolled
+		}
+		return EnrollResponse{}, err
+	}
+
+	uri := totp.ProvisioningURI(s.cfg.Issuer, user.Email, secret)
+
+	return EnrollResponse{
+		Secret:          totp.EncodeSecret(secret),
+		ProvisioningURI: uri,
+		QRPayload:       uri,
+	}, nil
+}
+
+func (s *Service) Confirm(ctx context.Context, req ConfirmRequest) (ConfirmResponse, error) {
+	enrollment, err := s.mfaRepository.GetTOTP(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPNotFound) {
+			return ConfirmResponse{}, ErrNotEnrolled
+		}
+		return ConfirmResponse{}, err
+	}
+	if enrollment.Confirmed() {
+		return ConfirmResponse{}, ErrAlreadyEnrolled
+	}
+
+	step, ok := totp.Validate(enrollment.Secret, req.Code, time.Now())
+	if !ok {
+		return ConfirmResponse{}, ErrInvalidCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return ConfirmResponse{}, err
+	}
+
+	if err := s.mfaRepository.ConfirmTOTP(ctx, req.UserID, step, hashes); err != nil {
+		switch {
+		case errors.Is(err, mfarepo.ErrTOTPNotFound):
+			return ConfirmResponse{}, ErrNotEnrolled
+		case errors.Is(err, mfarepo.ErrTOTPAlreadyConfirmed):
+			return ConfirmResponse{}, ErrAlreadyEnrolled
+		}
+		return ConfirmResponse{}, err
+	}
+
+	return ConfirmResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *Service) Disable(ctx context.Context, req DisableRequest) error {
+	if s.required(req.Role) {
+		return ErrMFAMandatory
+	}
+
+	if err := s.Verify(ctx, req.UserID, req.Code); err != nil {
+		return err
+	}
+
+	if err := s.mfaRepository.DeleteTOTP(ctx, req.UserID); err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPNotFound) {
+			return ErrNotEnrolled
+		}
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventTOTPDisabled).
+		Str("subject", req.UserID).
+		Msg("totp disabled")
+
+	return nil
+}
+
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, req RegenerateRecoveryCodesRequest) (RegenerateRecoveryCodesResponse, error) {
+	if err := s.Verify(ctx, req.UserID, req.Code); err != nil {
+		return RegenerateRecoveryCodesResponse{}, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return RegenerateRecoveryCodesResponse{}, err
+	}
+
+	if err := s.mfaRepository.ReplaceRecoveryCodes(ctx, req.UserID, hashes); err != nil {
+		return RegenerateRecoveryCodesResponse{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventRecoveryCodesRegenerated).
+		Str("subject", req.UserID).
+		Msg("recovery codes regenerated")
+
+	return RegenerateRecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *Service) Status(ctx context.Context, user usermodel.User) (Status, error) {
+	status := Status{
+		Required: s.required(user.Role),
+	}
+
+	enrollment, err := s.mfaRepository.GetTOTP(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPNotFound) {
+			return status, nil
+		}
+		return Status{}, err
+	}
+
+	status.Enrolled = enrollment.Confirmed()
+	// An enrolled user always has to present the second factor, whether their
+	// role requires it or not.
+	status.Required = status.Required || status.Enrolled
+
+	return status, nil
+}
+
+func (s *Service) Verify(ctx context.Context, userID, code string) error {
+	enrollment, err := s.mfaRepository.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPNotFound) {
+			return ErrNotEnrolled
+		}
+		return err
+	}
+	if !enrollment.Confirmed() {
+		return ErrNotEnrolled
+	}
+
+	if len(code) == totp.Digits {
+		err = s.verifyTOTP(ctx, enrollment, code)
+	} else {
+		err = s.verifyRecoveryCode(ctx, userID, code)
+	}
+	if errors.Is(err, ErrInvalidCode) {
+		log.Warn().
+			Str("event", SecurityEventInvalidCode).
+			Str("subject", userID).
+			Msg("invalid one-time password")
+	}
+
+	return err
+}
+
+func (s *Service) CreateChallenge(ctx context.Context, req CreateChallengeRequest) (string, error) {
+	token, err := randomToken(ChallengeTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.challengeRepository.Create(ctx, mfaentity.Challenge{
+		TokenHash: tokenentity.Hash(token),
+		UserID:    req.UserID,
+		ClientID:  req.ClientID,
+		Scopes:    req.Scopes,
+		ExpiresAt: time.Now().Add(ChallengeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *Service) GetChallenge(ctx context.Context, token string) (mfaentity.Challenge, error) {
+	challenge, err := s.challengeRepository.Get(ctx, tokenentity.Hash(token))
+	if err != nil {
+		if errors.Is(err, mfachallengerepo.ErrChallengeNotFound) {
+			return mfaentity.Challenge{}, ErrInvalidChallenge
+		}
+		return mfaentity.Challenge{}, err
+	}
+
+	if time.Now().After(challenge.ExpiresAt) {
+		return mfaentity.Challenge{}, ErrInvalidChallenge
+	}
+
+	return challenge, nil
+}
+
+func (s *Service) CompleteChallenge(ctx context.Context, req CompleteChallengeRequest) (mfaentity.Challenge, error) {
+	challenge, err := s.GetChallenge(ctx, req.Token)
+	if err != nil {
+		return mfaentity.Challenge{}, err
+	}
+
+	if challenge.ClientID != req.ClientID {
+		return mfaentity.Challenge{}, ErrInvalidChallenge
+	}
+
+	attempts, err := s.challengeRepository.RecordAttempt(ctx, challenge)
+	if err != nil {
+		return mfaentity.Challenge{}, err
+	}
+	if attempts > MaxChallengeAttempts {
+		log.Warn().
+			Str("event", SecurityEventTooManyAttempts).
+			Str("subject", challenge.UserID).
+			Str("client_id", challenge.ClientID).
+			Int64("attempts", attempts).
+			Msg("too many mfa attempts, dropping challenge")
+
+		if err := s.challengeRepository.Consume(ctx, challenge.TokenHash); err != nil && !errors.Is(err, mfachallengerepo.ErrChallengeNotFound) {
+			return mfaentity.Challenge{}, err
+		}
+
+		return mfaentity.Challenge{}, ErrTooManyAttempts
+	}
+
+	if err := s.Verify(ctx, challenge.UserID, req.Code); err != nil {
+		return mfaentity.Challenge{}, err
+	}
+
+	if err := s.challengeRepository.Consume(ctx, challenge.TokenHash); err != nil {
+		if errors.Is(err, mfachallengerepo.ErrChallengeNotFound) {
+			return mfaentity.Challenge{}, ErrInvalidChallenge
+		}
+		return mfaentity.Challenge{}, err
+	}
+
+	return challenge, nil
+}
+
+func (s *Service) required(role usermodel.Role) bool {
+	return slices.Contains(s.cfg.RequiredRoles, role)
+}
+
+func (s *Service) verifyTOTP(ctx context.Context, enrollment mfaentity.TOTP, code string) error {
+	step, ok := totp.Validate(enrollment.Secret, code, time.Now())
+	if !ok {
+		return ErrInvalidCode
+	}
+
+	if err := s.mfaRepository.UseTOTPStep(ctx, enrollment.UserID, step); err != nil {
+		if errors.Is(err, mfarepo.ErrTOTPStepUsed) {
+			return ErrInvalidCode
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) verifyRecoveryCode(ctx context.Context, userID, code string) error {
+	code = normalizeRecoveryCode(code)
+	if len(code) != RecoveryCodeLength {
+		return ErrInvalidCode
+	}
+
+	if err := s.mfaRepository.UseRecoveryCode(ctx, userID, tokenentity.Hash(code)); err != nil {
+		if errors.Is(err, mfarepo.ErrRecoveryCodeNotFound) {
+			return ErrInvalidCode
+		}
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventRecoveryCodeUsed).
+		Str("subject", userID).
+		Msg("recovery code used")
+
+	return nil
+}
+
+// generateRecoveryCodes returns fresh recovery codes formatted for the user
+// together with the hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+
+	for range RecoveryCodeCount {
+		b := make([]byte, RecoveryCodeLength*5/8)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+
+		codes = append(codes, formatRecoveryCode(code))
+		hashes = append(hashes, tokenentity.Hash(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func formatRecoveryCode(code string) string {
+	groups := make([]string, 0, len(code)/RecoveryCodeGroupSize)
+	for chunk := range slices.Chunk([]byte(code), RecoveryCodeGroupSize) {
+		groups = append(groups, string(chunk))
+	}
+
+	return strings.Join(groups, "-")
+}
+
+// normalizeRecoveryCode accepts recovery codes the way users tend to type
+// them: in any case, with or without the separators.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
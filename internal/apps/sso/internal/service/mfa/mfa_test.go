@@ -0,0 +1,511 @@
+package mfa
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	mfarepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa"
+	mfamocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfa/mocks"
+	mfachallengerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge"
+	mfachallengemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/mfachallenge/mocks"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	Issuer:        "kgym",
+	RequiredRoles: []usermodel.Role{usermodel.RoleAdmin},
+}
+
+var testSecret = []byte("12345678901234567890")
+
+func newEnrollment() mfaentity.TOTP {
+	return mfaentity.TOTP{
+		UserID:      "user-123",
+		Secret:      testSecret,
+		ConfirmedAt: time.Now().Add(-time.Hour),
+	}
+}
+
+func TestService_Enroll(t *testing.T) {
+	t.Run("should create enrollment and return provisioning uri", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		userRepo := usermocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, userRepo)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{}, mfarepo.ErrTOTPNotFound)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{ID: "user-123", Email: "admin@example.com"}, nil)
+
+		var stored mfaentity.TOTP
+		mfaRepo.EXPECT().
+			CreateTOTP(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, enrollment mfaentity.TOTP) error {
+				stored = enrollment
+				return nil
+			})
+
+		resp, err := service.Enroll(ctx, EnrollRequest{UserID: "user-123"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "user-123", stored.UserID)
+		assert.Len(t, stored.Secret, totp.SecretSize)
+		assert.False(t, stored.Confirmed())
+
+		assert.Equal(t, totp.EncodeSecret(stored.Secret), resp.Secret)
+		assert.Equal(t, resp.ProvisioningURI, resp.QRPayload)
+
+		uri, err := url.Parse(resp.ProvisioningURI)
+		require.NoError(t, err)
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, "/kgym:admin@example.com", uri.Path)
+		assert.Equal(t, resp.Secret, uri.Query().Get("secret"))
+	})
+
+	t.Run("should return error when already enrolled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		_, err := service.Enroll(ctx, EnrollRequest{UserID: "user-123"})
+		assert.ErrorIs(t, err, ErrAlreadyEnrolled)
+	})
+}
+
+func TestService_Confirm(t *testing.T) {
+	t.Run("should confirm enrollment and return recovery codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		now := time.Now()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{UserID: "user-123", Secret: testSecret}, nil)
+
+		var hashes []string
+		mfaRepo.EXPECT().
+			ConfirmTOTP(ctx, "user-123", totp.Step(now), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ int64, recoveryCodeHashes []string) error {
+				hashes = recoveryCodeHashes
+				return nil
+			})
+
+		resp, err := service.Confirm(ctx, ConfirmRequest{
+			UserID: "user-123",
+			Code:   totp.Code(testSecret, totp.Step(now)),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.RecoveryCodes, RecoveryCodeCount)
+		require.Len(t, hashes, RecoveryCodeCount)
+
+		for i, code := range resp.RecoveryCodes {
+			assert.Len(t, code, RecoveryCodeLength+RecoveryCodeLength/RecoveryCodeGroupSize-1)
+			assert.Equal(t, tokenentity.Hash(normalizeRecoveryCode(code)), hashes[i])
+		}
+	})
+
+	t.Run("should return error when code is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{UserID: "user-123", Secret: testSecret}, nil)
+
+		_, err := service.Confirm(ctx, ConfirmRequest{
+			UserID: "user-123",
+			Code:   totp.Code(testSecret, totp.Step(time.Now())-10),
+		})
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("should return error when not enrolled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{}, mfarepo.ErrTOTPNotFound)
+
+		_, err := service.Confirm(ctx, ConfirmRequest{UserID: "user-123", Code: "123456"})
+		assert.ErrorIs(t, err, ErrNotEnrolled)
+	})
+}
+
+func TestService_Verify(t *testing.T) {
+	t.Run("should accept current code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		step := totp.Step(time.Now())
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		mfaRepo.EXPECT().
+			UseTOTPStep(ctx, "user-123", step).
+			Return(nil)
+
+		err := service.Verify(ctx, "user-123", totp.Code(testSecret, step))
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject replayed code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		step := totp.Step(time.Now())
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		mfaRepo.EXPECT().
+			UseTOTPStep(ctx, "user-123", step).
+			Return(mfarepo.ErrTOTPStepUsed)
+
+		err := service.Verify(ctx, "user-123", totp.Code(testSecret, step))
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("should accept recovery code in any format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		mfaRepo.EXPECT().
+			UseRecoveryCode(ctx, "user-123", tokenentity.Hash("abcdefghijklmnop")).
+			Return(nil)
+
+		err := service.Verify(ctx, "user-123", "ABCD-EFGH ijkl-mnop")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject used recovery code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		mfaRepo.EXPECT().
+			UseRecoveryCode(ctx, "user-123", gomock.Any()).
+			Return(mfarepo.ErrRecoveryCodeNotFound)
+
+		err := service.Verify(ctx, "user-123", "abcd-efgh-ijkl-mnop")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("should return error when enrollment is not confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(mfaentity.TOTP{UserID: "user-123", Secret: testSecret}, nil)
+
+		err := service.Verify(ctx, "user-123", totp.Code(testSecret, totp.Step(time.Now())))
+		assert.ErrorIs(t, err, ErrNotEnrolled)
+	})
+}
+
+func TestService_Disable(t *testing.T) {
+	t.Run("should remove enrollment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, nil, nil)
+		ctx := context.Background()
+
+		step := totp.Step(time.Now())
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		mfaRepo.EXPECT().
+			UseTOTPStep(ctx, "user-123", step).
+			Return(nil)
+
+		mfaRepo.EXPECT().
+			DeleteTOTP(ctx, "user-123").
+			Return(nil)
+
+		err := service.Disable(ctx, DisableRequest{
+			UserID: "user-123",
+			Role:   usermodel.RoleUser,
+			Code:   totp.Code(testSecret, step),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when role requires mfa", func(t *testing.T) {
+		service := NewService(testConfig, nil, nil, nil)
+
+		err := service.Disable(context.Background(), DisableRequest{
+			UserID: "user-123",
+			Role:   usermodel.RoleAdmin,
+			Code:   "123456",
+		})
+		assert.ErrorIs(t, err, ErrMFAMandatory)
+	})
+}
+
+func TestService_Status(t *testing.T) {
+	cases := []struct {
+		name       string
+		role       usermodel.Role
+		enrollment mfaentity.TOTP
+		err        error
+		expected   Status
+	}{
+		{
+			name:     "should require enrollment for admin",
+			role:     usermodel.RoleAdmin,
+			err:      mfarepo.ErrTOTPNotFound,
+			expected: Status{Required: true},
+		},
+		{
+			name:     "should not require mfa for user without enrollment",
+			role:     usermodel.RoleUser,
+			err:      mfarepo.ErrTOTPNotFound,
+			expected: Status{},
+		},
+		{
+			name:       "should not require mfa for unconfirmed enrollment",
+			role:       usermodel.RoleUser,
+			enrollment: mfaentity.TOTP{UserID: "user-123"},
+			expected:   Status{},
+		},
+		{
+			name:       "should require mfa for enrolled user",
+			role:       usermodel.RoleUser,
+			enrollment: newEnrollment(),
+			expected:   Status{Required: true, Enrolled: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mfaRepo := mfamocks.NewMockIRepository(ctrl)
+
+			service := NewService(testConfig, mfaRepo, nil, nil)
+			ctx := context.Background()
+
+			mfaRepo.EXPECT().
+				GetTOTP(ctx, "user-123").
+				Return(tc.enrollment, tc.err)
+
+			status, err := service.Status(ctx, usermodel.User{ID: "user-123", Role: tc.role})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+func TestService_CompleteChallenge(t *testing.T) {
+	newChallenge := func() mfaentity.Challenge {
+		return mfaentity.Challenge{
+			TokenHash: tokenentity.Hash("mfa-token-123"),
+			UserID:    "user-123",
+			ClientID:  "client-123",
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+	}
+
+	t.Run("should create challenge with hashed token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, nil, challengeRepo, nil)
+		ctx := context.Background()
+
+		var stored mfaentity.Challenge
+		challengeRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, challenge mfaentity.Challenge) error {
+				stored = challenge
+				return nil
+			})
+
+		token, err := service.CreateChallenge(ctx, CreateChallengeRequest{
+			UserID:   "user-123",
+			ClientID: "client-123",
+			Scopes:   []string{"openid"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tokenentity.Hash(token), stored.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(ChallengeTTL), stored.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("should drop challenge after too many attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, nil, challengeRepo, nil)
+		ctx := context.Background()
+
+		challenge := newChallenge()
+
+		challengeRepo.EXPECT().
+			Get(ctx, challenge.TokenHash).
+			Return(challenge, nil)
+
+		challengeRepo.EXPECT().
+			RecordAttempt(ctx, challenge).
+			Return(int64(MaxChallengeAttempts+1), nil)
+
+		challengeRepo.EXPECT().
+			Consume(ctx, challenge.TokenHash).
+			Return(nil)
+
+		_, err := service.CompleteChallenge(ctx, CompleteChallengeRequest{
+			Token:    "mfa-token-123",
+			ClientID: "client-123",
+			Code:     "123456",
+		})
+		assert.ErrorIs(t, err, ErrTooManyAttempts)
+	})
+
+	t.Run("should keep challenge when code is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mfaRepo := mfamocks.NewMockIRepository(ctrl)
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, mfaRepo, challengeRepo, nil)
+		ctx := context.Background()
+
+		challenge := newChallenge()
+
+		challengeRepo.EXPECT().
+			Get(ctx, challenge.TokenHash).
+			Return(challenge, nil)
+
+		challengeRepo.EXPECT().
+			RecordAttempt(ctx, challenge).
+			Return(int64(1), nil)
+
+		mfaRepo.EXPECT().
+			GetTOTP(ctx, "user-123").
+			Return(newEnrollment(), nil)
+
+		_, err := service.CompleteChallenge(ctx, CompleteChallengeRequest{
+			Token:    "mfa-token-123",
+			ClientID: "client-123",
+			Code:     totp.Code(testSecret, totp.Step(time.Now())-10),
+		})
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("should return error when challenge is unknown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		challengeRepo := mfachallengemocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, nil, challengeRepo, nil)
+		ctx := context.Background()
+
+		challengeRepo.EXPECT().
+			Get(ctx, gomock.Any()).
+			Return(mfaentity.Challenge{}, mfachallengerepo.ErrChallengeNotFound)
+
+		_, err := service.CompleteChallenge(ctx, CompleteChallengeRequest{
+			Token:    "mfa-token-123",
+			ClientID: "client-123",
+			Code:     "123456",
+		})
+		assert.ErrorIs(t, err, ErrInvalidChallenge)
+	})
+}
+
+func TestFormatRecoveryCode(t *testing.T) {
+	t.Run("should group code and normalize it back", func(t *testing.T) {
+		formatted := formatRecoveryCode("abcdefghijklmnop")
+		assert.Equal(t, "abcd-efgh-ijkl-mnop", formatted)
+		assert.Equal(t, "abcdefghijklmnop", normalizeRecoveryCode(strings.ToUpper(formatted)))
+	})
+}
@@ -0,0 +1,126 @@
+package mfa
+
+import (
+	"context"
+	"time"
+
+	mfaentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/mfa"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAlreadyEnrolled = errors.New("totp already enrolled")
+	ErrNotEnrolled     = errors.New("totp not enrolled")
+	ErrInvalidCode     = errors.New("invalid one-time password")
+	ErrMFAMandatory    = errors.New("mfa is mandatory for the user's role")
+
+	ErrInvalidChallenge = errors.New("invalid mfa challenge")
+	ErrTooManyAttempts  = errors.New("too many mfa attempts")
+)
+
+const (
+	RecoveryCodeCount = 10
+	// RecoveryCodeLength is the number of base32 characters of a recovery
+	// code, shown to the user in groups of RecoveryCodeGroupSize.
+	RecoveryCodeLength    = 16
+	RecoveryCodeGroupSize = 4
+
+	ChallengeTTL         = 5 * time.Minute // 5 minutes
+	ChallengeTokenLength = 32
+	MaxChallengeAttempts = 5
+
+	SecurityEventInvalidCode              = "mfa_invalid_code"
+	SecurityEventTooManyAttempts          = "mfa_too_many_attempts"
+	SecurityEventRecoveryCodeUsed         = "mfa_recovery_code_used"
+	SecurityEventTOTPDisabled             = "mfa_totp_disabled"
+	SecurityEventRecoveryCodesRegenerated = "mfa_recovery_codes_regenerated"
+)
+
+type Config struct {
+	// Issuer labels the account in authenticator apps.
+	Issuer string
+	// RequiredRoles cannot sign in without a second factor. Users with other
+	// roles may enroll voluntarily.
+	RequiredRoles []usermodel.Role
+}
+
+type IService interface {
+	Enroll(ctx context.Context, req EnrollRequest) (EnrollResponse, error)
+	Confirm(ctx context.Context, req ConfirmRequest) (ConfirmResponse, error)
+	Disable(ctx context.Context, req DisableRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, req RegenerateRecoveryCodesRequest) (RegenerateRecoveryCodesResponse, error)
+
+	// Status reports whether the user has to present a second factor at sign
+	// in and whether they are enrolled.
+	Status(ctx context.Context, user usermodel.User) (Status, error)
+	// Verify checks a one-time password or an unused recovery code of a user
+	// and consumes it.
+	Verify(ctx context.Context, userID, code string) error
+
+	CreateChallenge(ctx context.Context, req CreateChallengeRequest) (string, error)
+	GetChallenge(ctx context.Context, token string) (mfaentity.Challenge, error)
+	// CompleteChallenge verifies the code presented for a challenge and
+	// consumes the challenge on success.
+	CompleteChallenge(ctx context.Context, req CompleteChallengeRequest) (mfaentity.Challenge, error)
+}
+
+type (
+	EnrollRequest struct {
+		UserID string
+	}
+
+	EnrollResponse struct {
+		// Secret is the base32 encoded secret for manual entry.
+		Secret          string
+		ProvisioningURI string
+		// QRPayload is the content to render as a QR code for authenticator
+		// apps to scan.
+		QRPayload string
+	}
+)
+
+type (
+	ConfirmRequest struct {
+		UserID string
+		Code   string
+	}
+
+	ConfirmResponse struct {
+		RecoveryCodes []string
+	}
+)
+
+type DisableRequest struct {
+	UserID string
+	Role   usermodel.Role
+	Code   string
+}
+
+type (
+	RegenerateRecoveryCodesRequest struct {
+		UserID string
+		Code   string
+	}
+
+	RegenerateRecoveryCodesResponse struct {
+		RecoveryCodes []string
+	}
+)
+
+type Status struct {
+	Required bool
+	Enrolled bool
+}
+
+type CreateChallengeRequest struct {
+	UserID   string
+	ClientID string
+	Scopes   []string
+}
+
+type CompleteChallengeRequest struct {
+	Token    string
+	ClientID string
+	Code     string
+}
@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"context"
+	"strings"
+
+	cliententity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/client"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	tokenService   tokenservice.IService
+	userRepository userrepo.IRepository
+}
+
+func NewService(cfg Config, tokenService tokenservice.IService, userRepository userrepo.IRepository) *Service {
+	return &Service{
+		cfg:            cfg,
+		tokenService:   tokenService,
+		userRepository: userRepository,
+	}
+}
+
+func (s *Service) GetConfiguration(_ context.Context) (Configuration, error) {
+	issuer := strings.TrimSuffix(s.cfg.Issuer, "/")
+
+	return Configuration{
+		Issuer:                      issuer,
+		AuthorizationEndpoint:       issuer + AuthorizationEndpointPath,
+		TokenEndpoint:               issuer + TokenEndpointPath,
+		UserInfoEndpoint:            issuer + UserInfoEndpointPath,
+		JWKSURI:                     issuer + JWKSPath,
+		RevocationEndpoint:          issuer + RevocationEndpointPath,
+		IntrospectionEndpoint:       issuer + IntrospectionEndpointPath,
+		DeviceAuthorizationEndpoint: issuer + DeviceAuthorizationEndpointPath,
+		ScopesSupported: []string{
+			authservice.ScopeOpenID,
+			authservice.ScopeProfile,
+			authservice.ScopeEmail,
+			authservice.ScopeAdmin,
+		},
+		ResponseTypesSupported: []string{
+			authservice.ResponseTypeCode,
+		},
+		GrantTypesSupported: cliententity.GrantTypes,
+		SubjectTypesSupported: []string{
+			"public",
+		},
+		IDTokenSigningAlgValuesSupported: keyentity.Algorithms,
+		TokenEndpointAuthMethodsSupported: []string{
+			"client_secret_basic",
+			"client_secret_post",
+			"none",
+		},
+		ClaimsSupported: []string{
+			"sub",
+			"iss",
+			"aud",
+			"exp",
+			"iat",
+			"email",
+			"email_verified",
+			"name",
+			"preferred_username",
+			"role",
+			"nonce",
+			"scope",
+			"tenant",
+		},
+		CodeChallengeMethodsSupported: []string{
+			authservice.CodeChallengeMethodS256,
+		},
+	}, nil
+}
+
+func (s *Service) GetUserInfo(ctx context.Context, req tokenservice.VerifyAccessTokenRequest) (UserInfo, error) {
+	claims, err := s.tokenService.VerifyAccessToken(ctx, req)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	return s.GetUserInfoBySubject(ctx, claims.Subject)
+}
+
+func (s *Service) GetUserInfoBySubject(ctx context.Context, subject string) (UserInfo, error) {
+	user, err := s.userRepository.GetByID(ctx, subject)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	return UserInfo{
+		Subject:           user.ID,
+		Email:             user.Email,
+		EmailVerified:     user.EmailVerified,
+		Name:              user.FullName(),
+		PreferredUsername: user.Username,
+		Role:              string(user.Role),
+	}, nil
+}
@@ -0,0 +1,223 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	denylistmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist/mocks"
+	keymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/mocks"
+	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testIssuer = "https://sso.kgym.test"
+
+func newSigningKey(t *testing.T) keyentity.Key {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return keyentity.Key{
+		ID:        "key-123",
+		Private:   privateKey,
+		Public:    privateKey.Public(),
+		Algorithm: "RS256",
+		State:     keyentity.StateActive,
+	}
+}
+
+func signAccessToken(t *testing.T, key keyentity.Key, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(key.Private)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestService_GetConfiguration(t *testing.T) {
+	t.Run("should build endpoints from issuer", func(t *testing.T) {
+		service := NewService(Config{Issuer: testIssuer + "/"}, nil, nil)
+
+		cfg, err := service.GetConfiguration(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, testIssuer, cfg.Issuer)
+		assert.Equal(t, testIssuer+AuthorizationEndpointPath, cfg.AuthorizationEndpoint)
+		assert.Equal(t, testIssuer+TokenEndpointPath, cfg.TokenEndpoint)
+		assert.Equal(t, testIssuer+UserInfoEndpointPath, cfg.UserInfoEndpoint)
+		assert.Equal(t, testIssuer+JWKSPath, cfg.JWKSURI)
+		assert.Equal(t, testIssuer+RevocationEndpointPath, cfg.RevocationEndpoint)
+		assert.Equal(t, testIssuer+IntrospectionEndpointPath, cfg.IntrospectionEndpoint)
+		assert.Equal(t, testIssuer+DeviceAuthorizationEndpointPath, cfg.DeviceAuthorizationEndpoint)
+		assert.Contains(t, cfg.ScopesSupported, "openid")
+		assert.Contains(t, cfg.IDTokenSigningAlgValuesSupported, "RS256")
+		assert.Equal(t, []string{"S256"}, cfg.CodeChallengeMethodsSupported)
+	})
+}
+
+func TestService_GetUserInfo(t *testing.T) {
+	t.Run("should return claims of token subject", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		tokenService := tokenservice.NewService(tokenservice.Config{Issuer: testIssuer}, tokenmocks.NewMockIRepository(ctrl), keyRepo, denylistRepo, nil)
+		service := NewService(Config{Issuer: testIssuer}, tokenService, userRepo)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Issuer:    testIssuer,
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+
+		user := usermodel.User{
+			ID:            "user-123",
+			Email:         "test@example.com",
+			EmailVerified: true,
+			Role:          usermodel.RoleUser,
+			Username:      "tester",
+			FirstName:     "Test",
+			LastName:      "User",
+		}
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		denylistRepo.EXPECT().
+			Contains(ctx, "jti-123").
+			Return(false, nil)
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(user, nil)
+
+		userInfo, err := service.GetUserInfo(ctx, tokenservice.VerifyAccessTokenRequest{Token: accessToken})
+		require.NoError(t, err)
+		assert.Equal(t, UserInfo{
+			Subject:           "user-123",
+			Email:             "test@example.com",
+			EmailVerified:     true,
+			Name:              "Test User",
+			PreferredUsername: "tester",
+			Role:              "user",
+		}, userInfo)
+	})
+
+	t.Run("should reject revoked access token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		tokenService := tokenservice.NewService(tokenservice.Config{Issuer: testIssuer}, tokenmocks.NewMockIRepository(ctrl), keyRepo, denylistRepo, nil)
+		service := NewService(Config{Issuer: testIssuer}, tokenService, userRepo)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Issuer:    testIssuer,
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		denylistRepo.EXPECT().
+			Contains(ctx, "jti-123").
+			Return(true, nil)
+
+		_, err := service.GetUserInfo(ctx, tokenservice.VerifyAccessTokenRequest{Token: accessToken})
+		assert.ErrorIs(t, err, tokenservice.ErrInvalidToken)
+	})
+
+	t.Run("should reject token from another issuer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		tokenService := tokenservice.NewService(tokenservice.Config{Issuer: testIssuer}, tokenmocks.NewMockIRepository(ctrl), keyRepo, denylistRepo, nil)
+		service := NewService(Config{Issuer: testIssuer}, tokenService, userRepo)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Issuer:    "https://evil.example.com",
+			Subject:   "user-123",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		_, err := service.GetUserInfo(ctx, tokenservice.VerifyAccessTokenRequest{Token: accessToken})
+		assert.ErrorIs(t, err, tokenservice.ErrInvalidToken)
+	})
+}
+
+func TestService_GetUserInfoBySubject(t *testing.T) {
+	t.Run("should return claims of subject", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := usermocks.NewMockIRepository(ctrl)
+		service := NewService(Config{Issuer: testIssuer}, nil, userRepo)
+
+		ctx := context.Background()
+
+		userRepo.EXPECT().
+			GetByID(ctx, "user-123").
+			Return(usermodel.User{
+				ID:        "user-123",
+				Email:     "admin@example.com",
+				Role:      usermodel.RoleAdmin,
+				Username:  "admin",
+				FirstName: "Test",
+				LastName:  "Admin",
+			}, nil)
+
+		userInfo, err := service.GetUserInfoBySubject(ctx, "user-123")
+		require.NoError(t, err)
+		assert.Equal(t, UserInfo{
+			Subject:           "user-123",
+			Email:             "admin@example.com",
+			Name:              "Test Admin",
+			PreferredUsername: "admin",
+			Role:              "admin",
+		}, userInfo)
+	})
+}
@@ -0,0 +1,58 @@
+package oidc
+
+import (
+	"context"
+
+	tokenservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/token"
+)
+
+const (
+	AuthorizationEndpointPath = "/authorize"
+	TokenEndpointPath         = "/api/v1/oauth/token"
+	UserInfoEndpointPath      = "/api/v1/oauth/userinfo"
+	JWKSPath                  = "/.well-known/jwks.json"
+	RevocationEndpointPath    = "/api/v1/oauth/revoke"
+	IntrospectionEndpointPath = "/api/v1/oauth/introspect"
+	// DeviceAuthorizationEndpointPath is advertised as in RFC 8628 section 4.
+	DeviceAuthorizationEndpointPath = "/api/v1/oauth/device_authorization"
+)
+
+type Config struct {
+	Issuer string
+}
+
+type IService interface {
+	GetConfiguration(ctx context.Context) (Configuration, error)
+	GetUserInfo(ctx context.Context, req tokenservice.VerifyAccessTokenRequest) (UserInfo, error)
+	// GetUserInfoBySubject returns the claims about the subject of an access
+	// token that was verified already.
+	GetUserInfoBySubject(ctx context.Context, subject string) (UserInfo, error)
+}
+
+type Configuration struct {
+	Issuer                            string
+	AuthorizationEndpoint             string
+	TokenEndpoint                     string
+	UserInfoEndpoint                  string
+	JWKSURI                           string
+	RevocationEndpoint                string
+	IntrospectionEndpoint             string
+	DeviceAuthorizationEndpoint       string
+	ScopesSupported                   []string
+	ResponseTypesSupported            []string
+	GrantTypesSupported               []string
+	SubjectTypesSupported             []string
+	IDTokenSigningAlgValuesSupported  []string
+	TokenEndpointAuthMethodsSupported []string
+	ClaimsSupported                   []string
+	CodeChallengeMethodsSupported     []string
+}
+
+type UserInfo struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Role              string
+}
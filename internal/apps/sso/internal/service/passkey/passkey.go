@@ -0,0 +1,395 @@
+package passkey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	ceremonyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	passkeyRepository  passkeyrepo.IRepository
+	ceremonyRepository ceremonyrepo.IRepository
+	userRepository     userrepo.IRepository
+}
+
+func NewService(cfg Config, passkeyRepository passkeyrepo.IRepository, ceremonyRepository ceremonyrepo.IRepository, userRepository userrepo.IRepository) *Service {
+	return &Service{
+		cfg:                cfg,
+		passkeyRepository:  passkeyRepository,
+		ceremonyRepository: ceremonyRepository,
+		userRepository:     userRepository,
+	}
+}
+
+func (s *Service) BeginRegistration(ctx context.Context, userID string) (BeginResponse, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	credentials, err := s.passkeyRepository.ListByUser(ctx, user.ID)
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	relyingParty, err := s.relyingParty()
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	// Authenticators that already hold a passkey of the user refuse to
+	// create another one.
+	excluded := make([]protocol.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		excluded = append(excluded, toWebAuthn(credential).Descriptor())
+	}
+
+	creation, session, err := relyingParty.BeginRegistration(
+		&webAuthnUser{id: user.ID, name: user.Email},
+		webauthn.WithExclusions(excluded),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	return s.begin(ctx, passkeyentity.Ceremony{
+		Type:      passkeyentity.CeremonyTypeRegistration,
+		UserID:    user.ID,
+		Challenge: session.Challenge,
+	}, creation.Response)
+}
+
+func (s *Service) FinishRegistration(ctx context.Context, req FinishRegistrationRequest) (passkeyentity.Credential, error) {
+	ceremony, err := s.consume(ctx, req.Token, passkeyentity.CeremonyTypeRegistration)
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	if ceremony.UserID != req.UserID {
+		return passkeyentity.Credential{}, ErrInvalidCeremony
+	}
+
+	relyingParty, err := s.relyingParty()
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(req.Credential)
+	if err != nil {
+		return passkeyentity.Credential{}, s.rejected(ceremony, err)
+	}
+
+	created, err := relyingParty.CreateCredential(&webAuthnUser{id: ceremony.UserID}, s.session(ceremony), parsed)
+	if err != nil {
+		return passkeyentity.Credential{}, s.rejected(ceremony, err)
+	}
+
+	transports := make([]string, 0, len(created.Transport))
+	for _, transport := range created.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	now := time.Now()
+	credential := passkeyentity.Credential{
+		ID:              uuid.NewString(),
+		UserID:          ceremony.UserID,
+		CredentialID:    created.ID,
+		PublicKey:       created.PublicKey,
+		AttestationType: created.AttestationType,
+		AAGUID:          created.Authenticator.AAGUID,
+		SignCount:       created.Authenticator.SignCount,
+		Transports:      transports,
+		BackupEligible:  created.Flags.BackupEligible,
+		BackupState:     created.Flags.BackupState,
+		CreatedAt:       now,
+		LastUsedAt:      now,
+	}
+
+	if err := s.passkeyRepository.Create(ctx, credential); err != nil {
+		if errors.Is(err, passkeyrepo.ErrCredentialExists) {
+			return passkeyentity.Credential{}, ErrCredentialExists
+		}
+		return passkeyentity.Credential{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventRegistered).
+		Str("user_id", credential.UserID).
+		Str("passkey_id", credential.ID).
+		Msg("passkey registered")
+
+	return credential, nil
+}
+
+func (s *Service) BeginLogin(ctx context.Context, clientID string) (BeginResponse, error) {
+	relyingParty, err := s.relyingParty()
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	assertion, session, err := relyingParty.BeginDiscoverableLogin()
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	return s.begin(ctx, passkeyentity.Ceremony{
+		Type:      passkeyentity.CeremonyTypeLogin,
+		ClientID:  clientID,
+		Challenge: session.Challenge,
+	}, assertion.Response)
+}
+
+func (s *Service) FinishLogin(ctx context.Context, req FinishLoginRequest) (passkeyentity.Credential, error) {
+	ceremony, err := s.consume(ctx, req.Token, passkeyentity.CeremonyTypeLogin)
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	if ceremony.ClientID != req.ClientID {
+		return passkeyentity.Credential{}, ErrInvalidCeremony
+	}
+
+	relyingParty, err := s.relyingParty()
+	if err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(req.Credential)
+	if err != nil {
+		return passkeyentity.Credential{}, s.rejected(ceremony, err)
+	}
+
+	var (
+		credential passkeyentity.Credential
+		lookupErr  error
+	)
+	// The library checks that the user handle of the assertion is the
+	// owner of the credential.
+	lookup := func(rawID, userHandle []byte) (webauthn.User, error) {
+		credential, lookupErr = s.passkeyRepository.GetByCredentialID(ctx, rawID)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+
+		return &webAuthnUser{
+			id:          credential.UserID,
+			credentials: []webauthn.Credential{toWebAuthn(credential)},
+		}, nil
+	}
+
+	_, validated, err := relyingParty.ValidatePasskeyLogin(lookup, s.session(ceremony), parsed)
+	if err != nil {
+		if lookupErr != nil && !errors.Is(lookupErr, passkeyrepo.ErrCredentialNotFound) {
+			return passkeyentity.Credential{}, lookupErr
+		}
+		return passkeyentity.Credential{}, s.rejected(ceremony, err)
+	}
+
+	// A counter that did not move forward means the private key may have
+	// been copied out of the authenticator.
+	if validated.Authenticator.CloneWarning {
+		log.Warn().
+			Str("event", SecurityEventCloneSuspected).
+			Str("user_id", credential.UserID).
+			Str("passkey_id", credential.ID).
+			Uint32("stored_sign_count", credential.SignCount).
+			Msg("passkey sign count did not increase, rejecting sign in")
+
+		return passkeyentity.Credential{}, ErrInvalidCredential
+	}
+
+	now := time.Now()
+	if err := s.passkeyRepository.RecordUse(ctx, credential.ID, validated.Authenticator.SignCount, validated.Flags.BackupState, now); err != nil {
+		return passkeyentity.Credential{}, err
+	}
+
+	credential.SignCount = validated.Authenticator.SignCount
+	credential.BackupState = validated.Flags.BackupState
+	credential.LastUsedAt = now
+
+	return credential, nil
+}
+
+// begin stores a ceremony and hands out its token along with the options
+// for the authenticator.
+func (s *Service) begin(ctx context.Context, ceremony passkeyentity.Ceremony, options any) (BeginResponse, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	token, err := randomToken(CeremonyTokenLength)
+	if err != nil {
+		return BeginResponse{}, err
+	}
+
+	ceremony.TokenHash = tokenentity.Hash(token)
+	ceremony.ExpiresAt = time.Now().Add(s.cfg.CeremonyTTL)
+
+	if err := s.ceremonyRepository.Create(ctx, ceremony); err != nil {
+		return BeginResponse{}, err
+	}
+
+	return BeginResponse{
+		Token:     token,
+		Options:   data,
+		ExpiresAt: ceremony.ExpiresAt,
+	}, nil
+}
+
+// consume takes the ceremony of a token out of the cache, so that its
+// challenge is answered at most once, whether the answer is valid or not.
+func (s *Service) consume(ctx context.Context, token string, ceremonyType passkeyentity.CeremonyType) (passkeyentity.Ceremony, error) {
+	if token == "" {
+		return passkeyentity.Ceremony{}, ErrInvalidCeremony
+	}
+
+	ceremony, err := s.ceremonyRepository.Consume(ctx, tokenentity.Hash(token))
+	if err != nil {
+		if errors.Is(err, ceremonyrepo.ErrCeremonyNotFound) {
+			return passkeyentity.Ceremony{}, ErrInvalidCeremony
+		}
+		return passkeyentity.Ceremony{}, err
+	}
+
+	if ceremony.Type != ceremonyType {
+		return passkeyentity.Ceremony{}, ErrInvalidCeremony
+	}
+
+	return ceremony, nil
+}
+
+// rejected logs why the response of an authenticator failed verification,
+// which is not told to the caller.
+func (s *Service) rejected(ceremony passkeyentity.Ceremony, err error) error {
+	event := log.Info().
+		Err(err).
+		Str("event", SecurityEventRejected).
+		Str("ceremony", string(ceremony.Type)).
+		Str("user_id", ceremony.UserID).
+		Str("client_id", ceremony.ClientID)
+
+	var protocolErr *protocol.Error
+	if errors.As(err, &protocolErr) {
+		event = event.Str("details", protocolErr.DevInfo)
+	}
+
+	event.Msg("passkey response rejected")
+
+	return ErrInvalidCredential
+}
+
+// relyingParty configures the WebAuthn library. It is built for every
+// ceremony, as the library completes its configuration on first use.
+func (s *Service) relyingParty() (*webauthn.WebAuthn, error) {
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    s.cfg.CeremonyTTL,
+		TimeoutUVD: s.cfg.CeremonyTTL,
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:                  s.cfg.RPID,
+		RPDisplayName:         s.cfg.RPName,
+		RPOrigins:             s.cfg.Origins,
+		AttestationPreference: protocol.PreferNoAttestation,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+}
+
+// session restores what the library remembers between the two steps of a
+// ceremony.
+func (s *Service) session(ceremony passkeyentity.Ceremony) webauthn.SessionData {
+	session := webauthn.SessionData{
+		Challenge:        ceremony.Challenge,
+		RelyingPartyID:   s.cfg.RPID,
+		Expires:          ceremony.ExpiresAt,
+		UserVerification: protocol.VerificationRequired,
+	}
+
+	if ceremony.Type == passkeyentity.CeremonyTypeRegistration {
+		session.UserID = []byte(ceremony.UserID)
+		session.CredParams = webauthn.CredentialParametersDefault()
+	}
+
+	return session
+}
+
+// webAuthnUser is a user as the WebAuthn library sees them. The user handle
+// stored in passkeys is the id of the user.
+type webAuthnUser struct {
+	id          string
+	name        string
+	credentials []webauthn.Credential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(u.id)
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.name
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.name
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+func toWebAuthn(credential passkeyentity.Credential) webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(credential.Transports))
+	for _, transport := range credential.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+
+	return webauthn.Credential{
+		ID:              credential.CredentialID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: credential.BackupEligible,
+			BackupState:    credential.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    credential.AAGUID,
+			SignCount: credential.SignCount,
+		},
+	}
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,585 @@
+package passkey
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	passkeyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey"
+	passkeymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkey/mocks"
+	ceremonyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony"
+	ceremonymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passkeyceremony/mocks"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/webauthntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	RPID:        "kgym.test",
+	RPName:      "kgym",
+	Origins:     []string{"https://kgym.test"},
+	CeremonyTTL: 5 * time.Minute,
+}
+
+var testUser = usermodel.User{
+	ID:    "user-123",
+	Email: "user@example.com",
+	Role:  usermodel.RoleUser,
+}
+
+type testDeps struct {
+	passkeyRepo  *passkeymocks.MockIRepository
+	ceremonyRepo *ceremonymocks.MockIRepository
+	userRepo     *usermocks.MockIRepository
+}
+
+func newTestService(ctrl *gomock.Controller) (*Service, testDeps) {
+	deps := testDeps{
+		passkeyRepo:  passkeymocks.NewMockIRepository(ctrl),
+		ceremonyRepo: ceremonymocks.NewMockIRepository(ctrl),
+		userRepo:     usermocks.NewMockIRepository(ctrl),
+	}
+
+	return NewService(testConfig, deps.passkeyRepo, deps.ceremonyRepo, deps.userRepo), deps
+}
+
+func newTestAuthenticator() *webauthntest.Authenticator {
+	return webauthntest.New(webauthntest.Config{Origin: testConfig.Origins[0]})
+}
+
+// expectCeremony stores the next ceremony created in the mock and serves it
+// back at most once to the finish step.
+func expectCeremony(ctx context.Context, deps testDeps) *passkeyentity.Ceremony {
+	var stored passkeyentity.Ceremony
+
+	deps.ceremonyRepo.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, ceremony passkeyentity.Ceremony) error {
+			stored = ceremony
+			deps.ceremonyRepo.EXPECT().Consume(ctx, ceremony.TokenHash).Return(ceremony, nil).MaxTimes(1)
+			return nil
+		})
+
+	return &stored
+}
+
+// register runs a registration for testUser and returns the stored
+// credential.
+func register(t *testing.T, service *Service, deps testDeps, authenticator *webauthntest.Authenticator) passkeyentity.Credential {
+	t.Helper()
+
+	ctx := context.Background()
+
+	deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+	deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return(nil, nil)
+	expectCeremony(ctx, deps)
+
+	begin, err := service.BeginRegistration(ctx, testUser.ID)
+	require.NoError(t, err)
+
+	response, err := authenticator.Register(begin.Options)
+	require.NoError(t, err)
+
+	deps.passkeyRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	credential, err := service.FinishRegistration(ctx, FinishRegistrationRequest{
+		Token:      begin.Token,
+		UserID:     testUser.ID,
+		Credential: response,
+	})
+	require.NoError(t, err)
+
+	return credential
+}
+
+func TestService_BeginRegistration(t *testing.T) {
+	t.Run("should store challenge under token hash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		existing := passkeyentity.Credential{CredentialID: []byte("existing")}
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+		deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return([]passkeyentity.Credential{existing}, nil)
+		stored := expectCeremony(ctx, deps)
+
+		resp, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+		assert.WithinDuration(t, time.Now().Add(testConfig.CeremonyTTL), resp.ExpiresAt, time.Second)
+
+		assert.Equal(t, tokenentity.Hash(resp.Token), stored.TokenHash)
+		assert.Equal(t, passkeyentity.CeremonyTypeRegistration, stored.Type)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.Equal(t, resp.ExpiresAt, stored.ExpiresAt)
+
+		var options struct {
+			Challenge string `json:"challenge"`
+			RP        struct {
+				ID string `json:"id"`
+			} `json:"rp"`
+			User struct {
+				Name string `json:"name"`
+			} `json:"user"`
+			ExcludeCredentials []struct {
+				ID string `json:"id"`
+			} `json:"excludeCredentials"`
+			AuthenticatorSelection struct {
+				ResidentKey      string `json:"residentKey"`
+				UserVerification string `json:"userVerification"`
+			} `json:"authenticatorSelection"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Options, &options))
+		assert.Equal(t, stored.Challenge, options.Challenge)
+		assert.Equal(t, testConfig.RPID, options.RP.ID)
+		assert.Equal(t, testUser.Email, options.User.Name)
+		require.Len(t, options.ExcludeCredentials, 1)
+		assert.Equal(t, "ZXhpc3Rpbmc", options.ExcludeCredentials[0].ID)
+		assert.Equal(t, "required", options.AuthenticatorSelection.ResidentKey)
+		assert.Equal(t, "required", options.AuthenticatorSelection.UserVerification)
+	})
+}
+
+func TestService_FinishRegistration(t *testing.T) {
+	t.Run("should store credential created by authenticator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+		deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return(nil, nil)
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		response, err := newTestAuthenticator().Register(begin.Options)
+		require.NoError(t, err)
+
+		var stored passkeyentity.Credential
+		deps.passkeyRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, credential passkeyentity.Credential) error {
+				stored = credential
+				return nil
+			})
+
+		credential, err := service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:      begin.Token,
+			UserID:     testUser.ID,
+			Credential: response,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stored, credential)
+
+		assert.NotEmpty(t, credential.ID)
+		assert.Equal(t, testUser.ID, credential.UserID)
+		assert.Len(t, credential.CredentialID, 32)
+		assert.NotEmpty(t, credential.PublicKey)
+		assert.Equal(t, "none", credential.AttestationType)
+		assert.Len(t, credential.AAGUID, 16)
+		assert.Zero(t, credential.SignCount)
+		assert.Equal(t, []string{"internal", "hybrid"}, credential.Transports)
+		assert.False(t, credential.BackupEligible)
+	})
+
+	t.Run("should record backup flags of synced passkeys", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		credential := register(t, service, deps, webauthntest.New(webauthntest.Config{
+			Origin: testConfig.Origins[0],
+			Synced: true,
+		}))
+		assert.True(t, credential.BackupEligible)
+		assert.True(t, credential.BackupState)
+	})
+
+	t.Run("should reject unknown and reused ceremonies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.ceremonyRepo.EXPECT().Consume(ctx, tokenentity.Hash("unknown")).Return(passkeyentity.Ceremony{}, ceremonyrepo.ErrCeremonyNotFound)
+
+		_, err := service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:  "unknown",
+			UserID: testUser.ID,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCeremony)
+
+		_, err = service.FinishRegistration(ctx, FinishRegistrationRequest{UserID: testUser.ID})
+		assert.ErrorIs(t, err, ErrInvalidCeremony)
+	})
+
+	t.Run("should reject ceremony of another user or type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.ceremonyRepo.EXPECT().Consume(ctx, tokenentity.Hash("registration")).Return(passkeyentity.Ceremony{
+			Type:   passkeyentity.CeremonyTypeRegistration,
+			UserID: "user-456",
+		}, nil)
+		deps.ceremonyRepo.EXPECT().Consume(ctx, tokenentity.Hash("login")).Return(passkeyentity.Ceremony{
+			Type:     passkeyentity.CeremonyTypeLogin,
+			ClientID: "client-123",
+		}, nil)
+
+		_, err := service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:  "registration",
+			UserID: testUser.ID,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCeremony)
+
+		_, err = service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:  "login",
+			UserID: testUser.ID,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCeremony)
+	})
+
+	t.Run("should reject response to another challenge", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil).Times(2)
+		deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return(nil, nil).Times(2)
+		expectCeremony(ctx, deps)
+
+		first, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		deps.ceremonyRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		second, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		response, err := newTestAuthenticator().Register(second.Options)
+		require.NoError(t, err)
+
+		_, err = service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:      first.Token,
+			UserID:     testUser.ID,
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should reject response from another origin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+		deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return(nil, nil)
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		phishing := webauthntest.New(webauthntest.Config{Origin: "https://kgym.example"})
+
+		response, err := phishing.Register(begin.Options)
+		require.NoError(t, err)
+
+		_, err = service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:      begin.Token,
+			UserID:     testUser.ID,
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should reject malformed credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.ceremonyRepo.EXPECT().Consume(ctx, tokenentity.Hash("token")).Return(passkeyentity.Ceremony{
+			Type:      passkeyentity.CeremonyTypeRegistration,
+			UserID:    testUser.ID,
+			Challenge: "challenge",
+			ExpiresAt: time.Now().Add(time.Minute),
+		}, nil)
+
+		_, err := service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:      "token",
+			UserID:     testUser.ID,
+			Credential: []byte(`{"id":"nope"}`),
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should map registered credential id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.userRepo.EXPECT().GetByID(ctx, testUser.ID).Return(testUser, nil)
+		deps.passkeyRepo.EXPECT().ListByUser(ctx, testUser.ID).Return(nil, nil)
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginRegistration(ctx, testUser.ID)
+		require.NoError(t, err)
+
+		response, err := newTestAuthenticator().Register(begin.Options)
+		require.NoError(t, err)
+
+		deps.passkeyRepo.EXPECT().Create(ctx, gomock.Any()).Return(passkeyrepo.ErrCredentialExists)
+
+		_, err = service.FinishRegistration(ctx, FinishRegistrationRequest{
+			Token:      begin.Token,
+			UserID:     testUser.ID,
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrCredentialExists)
+	})
+}
+
+func TestService_BeginLogin(t *testing.T) {
+	t.Run("should store challenge for client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		stored := expectCeremony(ctx, deps)
+
+		resp, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+
+		assert.Equal(t, tokenentity.Hash(resp.Token), stored.TokenHash)
+		assert.Equal(t, passkeyentity.CeremonyTypeLogin, stored.Type)
+		assert.Equal(t, "client-123", stored.ClientID)
+		assert.Empty(t, stored.UserID)
+
+		var options struct {
+			Challenge        string `json:"challenge"`
+			RPID             string `json:"rpId"`
+			UserVerification string `json:"userVerification"`
+			AllowCredentials []any  `json:"allowCredentials"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Options, &options))
+		assert.Equal(t, stored.Challenge, options.Challenge)
+		assert.Equal(t, testConfig.RPID, options.RPID)
+		assert.Equal(t, "required", options.UserVerification)
+		assert.Empty(t, options.AllowCredentials)
+	})
+}
+
+func TestService_FinishLogin(t *testing.T) {
+	t.Run("should verify assertion and record use", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		authenticator := newTestAuthenticator()
+		registered := register(t, service, deps, authenticator)
+
+		ctx := context.Background()
+
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+
+		response, err := authenticator.Login(begin.Options)
+		require.NoError(t, err)
+
+		deps.passkeyRepo.EXPECT().GetByCredentialID(ctx, registered.CredentialID).Return(registered, nil)
+		deps.passkeyRepo.EXPECT().RecordUse(ctx, registered.ID, uint32(1), false, gomock.Any()).Return(nil)
+
+		credential, err := service.FinishLogin(ctx, FinishLoginRequest{
+			Token:      begin.Token,
+			ClientID:   "client-123",
+			Credential: response,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, registered.ID, credential.ID)
+		assert.Equal(t, testUser.ID, credential.UserID)
+		assert.Equal(t, uint32(1), credential.SignCount)
+	})
+
+	t.Run("should accept synced passkeys that do not count signatures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		authenticator := webauthntest.New(webauthntest.Config{
+			Origin: testConfig.Origins[0],
+			Synced: true,
+		})
+		registered := register(t, service, deps, authenticator)
+
+		ctx := context.Background()
+
+		for range 2 {
+			expectCeremony(ctx, deps)
+
+			begin, err := service.BeginLogin(ctx, "client-123")
+			require.NoError(t, err)
+
+			response, err := authenticator.Login(begin.Options)
+			require.NoError(t, err)
+
+			deps.passkeyRepo.EXPECT().GetByCredentialID(ctx, registered.CredentialID).Return(registered, nil)
+			deps.passkeyRepo.EXPECT().RecordUse(ctx, registered.ID, uint32(0), true, gomock.Any()).Return(nil)
+
+			_, err = service.FinishLogin(ctx, FinishLoginRequest{
+				Token:      begin.Token,
+				ClientID:   "client-123",
+				Credential: response,
+			})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("should reject sign count that did not increase", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		authenticator := newTestAuthenticator()
+		registered := register(t, service, deps, authenticator)
+		registered.SignCount = 7
+
+		ctx := context.Background()
+
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+
+		response, err := authenticator.Login(begin.Options)
+		require.NoError(t, err)
+
+		deps.passkeyRepo.EXPECT().GetByCredentialID(ctx, registered.CredentialID).Return(registered, nil)
+
+		_, err = service.FinishLogin(ctx, FinishLoginRequest{
+			Token:      begin.Token,
+			ClientID:   "client-123",
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should reject unknown credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		authenticator := newTestAuthenticator()
+		registered := register(t, service, deps, authenticator)
+
+		ctx := context.Background()
+
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+
+		response, err := authenticator.Login(begin.Options)
+		require.NoError(t, err)
+
+		deps.passkeyRepo.EXPECT().GetByCredentialID(ctx, registered.CredentialID).Return(passkeyentity.Credential{}, passkeyrepo.ErrCredentialNotFound)
+
+		_, err = service.FinishLogin(ctx, FinishLoginRequest{
+			Token:      begin.Token,
+			ClientID:   "client-123",
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should reject credential of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		authenticator := newTestAuthenticator()
+		registered := register(t, service, deps, authenticator)
+		registered.UserID = "user-456"
+
+		ctx := context.Background()
+
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+
+		response, err := authenticator.Login(begin.Options)
+		require.NoError(t, err)
+
+		deps.passkeyRepo.EXPECT().GetByCredentialID(ctx, registered.CredentialID).Return(registered, nil)
+
+		_, err = service.FinishLogin(ctx, FinishLoginRequest{
+			Token:      begin.Token,
+			ClientID:   "client-123",
+			Credential: response,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCredential)
+	})
+
+	t.Run("should reject ceremony started for another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		expectCeremony(ctx, deps)
+
+		begin, err := service.BeginLogin(ctx, "client-123")
+		require.NoError(t, err)
+
+		_, err = service.FinishLogin(ctx, FinishLoginRequest{
+			Token:    begin.Token,
+			ClientID: "client-456",
+		})
+		assert.ErrorIs(t, err, ErrInvalidCeremony)
+	})
+}
@@ -0,0 +1,81 @@
+package passkey
+
+import (
+	"context"
+	"time"
+
+	passkeyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passkey"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidCeremony is returned for unknown, answered and expired
+	// ceremonies, and for ceremonies started for another user or client.
+	ErrInvalidCeremony = errors.New("invalid passkey ceremony")
+	// ErrInvalidCredential is returned for responses that fail verification
+	// and for credentials that are not registered.
+	ErrInvalidCredential = errors.New("invalid passkey credential")
+	ErrCredentialExists  = errors.New("passkey already registered")
+)
+
+const (
+	CeremonyTokenLength = 32
+
+	SecurityEventRegistered     = "passkey_registered"
+	SecurityEventRejected       = "passkey_rejected"
+	SecurityEventCloneSuspected = "passkey_clone_suspected"
+)
+
+type Config struct {
+	// RPID is the domain passkeys are bound to. They can be used on its
+	// subdomains too.
+	RPID string
+	// RPName is shown to users when they create a passkey.
+	RPName string
+	// Origins are the origins allowed to answer ceremonies: the web origins
+	// of the apps, and the android:apk-key-hash: origins of the Android
+	// apps.
+	Origins []string
+	// CeremonyTTL is how long the authenticator has to answer.
+	CeremonyTTL time.Duration
+}
+
+type IService interface {
+	// BeginRegistration starts registering a passkey for a signed in user
+	// and returns the options to create it with.
+	BeginRegistration(ctx context.Context, userID string) (BeginResponse, error)
+	// FinishRegistration verifies the credential the authenticator created
+	// and stores it.
+	FinishRegistration(ctx context.Context, req FinishRegistrationRequest) (passkeyentity.Credential, error)
+	// BeginLogin starts a sign in with any passkey the authenticator holds
+	// for us, so the user does not have to tell who they are first.
+	BeginLogin(ctx context.Context, clientID string) (BeginResponse, error)
+	// FinishLogin verifies the assertion of the authenticator and returns
+	// the credential it was made with.
+	FinishLogin(ctx context.Context, req FinishLoginRequest) (passkeyentity.Credential, error)
+}
+
+// BeginResponse holds the token of the ceremony to finish and the options
+// to pass to the authenticator, encoded as the JSON of
+// PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions.
+type BeginResponse struct {
+	Token     string
+	Options   []byte
+	ExpiresAt time.Time
+}
+
+// FinishRegistrationRequest carries the JSON encoded PublicKeyCredential
+// the authenticator created.
+type FinishRegistrationRequest struct {
+	Token      string
+	UserID     string
+	Credential []byte
+}
+
+// FinishLoginRequest carries the JSON encoded PublicKeyCredential the
+// authenticator signed the challenge with.
+type FinishLoginRequest struct {
+	Token      string
+	ClientID   string
+	Credential []byte
+}
@@ -0,0 +1,279 @@
+package passwordless
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	passwordlessrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	sendKeyEmail = "email:"
+	sendKeyIP    = "ip:"
+
+	// linkSeparator joins the challenge token and its signature in a magic
+	// link. Neither contains it, as both are base64url encoded.
+	linkSeparator = "."
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	passwordlessRepository passwordlessrepo.IRepository
+	userRepository         userrepo.IRepository
+
+	sender Sender
+}
+
+func NewService(cfg Config, passwordlessRepository passwordlessrepo.IRepository, userRepository userrepo.IRepository, sender Sender) *Service {
+	return &Service{
+		cfg:                    cfg,
+		passwordlessRepository: passwordlessRepository,
+		userRepository:         userRepository,
+		sender:                 sender,
+	}
+}
+
+func (s *Service) Start(ctx context.Context, req StartRequest) (StartResponse, error) {
+	// Sends are counted before the address is looked up, so that the limit
+	// does not tell known addresses apart either.
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if err := s.limitSends(ctx, throttleservice.ScopeAccount, sendKeyEmail+email, s.cfg.MaxSendsPerEmail); err != nil {
+		return StartResponse{}, err
+	}
+	if req.IP != "" {
+		if err := s.limitSends(ctx, throttleservice.ScopeIP, sendKeyIP+req.IP, s.cfg.MaxSendsPerIP); err != nil {
+			return StartResponse{}, err
+		}
+	}
+
+	token, err := randomToken(ChallengeTokenLength)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	resp := StartResponse{
+		Token:     token,
+		ExpiresAt: time.Now().Add(s.cfg.ChallengeTTL),
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, userrepo.ErrUserNotFound) {
+			return resp, nil
+		}
+		return StartResponse{}, err
+	}
+
+	code, err := randomCode(CodeDigits)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	tokenHash := tokenentity.Hash(token)
+
+	err = s.passwordlessRepository.Create(ctx, passwordlessentity.Challenge{
+		TokenHash: tokenHash,
+		UserID:    user.ID,
+		ClientID:  req.ClientID,
+		Scopes:    req.Scopes,
+		CodeHash:  s.codeHash(tokenHash, code),
+		ExpiresAt: resp.ExpiresAt,
+	})
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	link, err := s.linkURL(token)
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	err = s.sender.Send(ctx, Message{
+		To:   user.Email,
+		Code: code,
+		Link: link,
+		TTL:  s.cfg.ChallengeTTL,
+	})
+	if err != nil {
+		return StartResponse{}, err
+	}
+
+	log.Info().
+		Str("event", SecurityEventCodeSent).
+		Str("user_id", user.ID).
+		Str("client_id", req.ClientID).
+		Msg("passwordless code sent")
+
+	return resp, nil
+}
+
+func (s *Service) Complete(ctx context.Context, req CompleteRequest) (passwordlessentity.Challenge, error) {
+	token := req.Token
+	viaLink := req.Link != ""
+	if viaLink {
+		var ok bool
+		if token, ok = s.verifyLink(req.Link); !ok {
+			return passwordlessentity.Challenge{}, ErrInvalidChallenge
+		}
+	}
+
+	if token == "" {
+		return passwordlessentity.Challenge{}, ErrInvalidChallenge
+	}
+
+	challenge, err := s.passwordlessRepository.Get(ctx, tokenentity.Hash(token))
+	if err != nil {
+		if errors.Is(err, passwordlessrepo.ErrChallengeNotFound) {
+			return passwordlessentity.Challenge{}, ErrInvalidChallenge
+		}
+		return passwordlessentity.Challenge{}, err
+	}
+
+	if time.Now().After(challenge.ExpiresAt) || challenge.ClientID != req.ClientID {
+		return passwordlessentity.Challenge{}, ErrInvalidChallenge
+	}
+
+	// A signed link proves access to the mailbox on its own. Codes are short
+	// enough to guess, so the attempts at them are limited.
+	if !viaLink {
+		attempts, err := s.passwordlessRepository.RecordAttempt(ctx, challenge)
+		if err != nil {
+			return passwordlessentity.Challenge{}, err
+		}
+		if attempts > MaxChallengeAttempts {
+			log.Warn().
+				Str("event", SecurityEventTooManyAttempts).
+				Str("user_id", challenge.UserID).
+				Str("client_id", challenge.ClientID).
+				Int64("attempts", attempts).
+				Msg("too many passwordless attempts, dropping challenge")
+
+			if err := s.passwordlessRepository.Consume(ctx, challenge.TokenHash); err != nil && !errors.Is(err, passwordlessrepo.ErrChallengeNotFound) {
+				return passwordlessentity.Challenge{}, err
+			}
+
+			return passwordlessentity.Challenge{}, ErrTooManyAttempts
+		}
+
+		if !hmac.Equal([]byte(s.codeHash(challenge.TokenHash, req.Code)), []byte(challenge.CodeHash)) {
+			return passwordlessentity.Challenge{}, ErrInvalidCode
+		}
+	}
+
+	if err := s.passwordlessRepository.Consume(ctx, challenge.TokenHash); err != nil {
+		if errors.Is(err, passwordlessrepo.ErrChallengeNotFound) {
+			return passwordlessentity.Challenge{}, ErrInvalidChallenge
+		}
+		return passwordlessentity.Challenge{}, err
+	}
+
+	return challenge, nil
+}
+
+// limitSends counts a code sent for key and rejects it if more than limit
+// were sent within the window.
+func (s *Service) limitSends(ctx context.Context, scope, key string, limit int64) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	sends, ttl, err := s.passwordlessRepository.RecordSend(ctx, key, s.cfg.SendWindow)
+	if err != nil {
+		return err
+	}
+
+	if sends > limit {
+		log.Warn().
+			Str("event", SecurityEventRateLimited).
+			Str("scope", scope).
+			Int64("sends", sends).
+			Msg("too many passwordless codes requested")
+
+		return &throttleservice.ThrottledError{
+			Scope:      scope,
+			RetryAfter: ttl,
+		}
+	}
+
+	return nil
+}
+
+// codeHash keys the hash with the secret, as the few possible codes could
+// otherwise be tried against a leaked hash offline.
+func (s *Service) codeHash(tokenHash, code string) string {
+	mac := hmac.New(sha256.New, s.cfg.Secret)
+	mac.Write([]byte("code:" + tokenHash + ":" + code))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) linkSignature(token string) string {
+	mac := hmac.New(sha256.New, s.cfg.Secret)
+	mac.Write([]byte("link:" + token))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyLink returns the challenge token of a magic link if its signature is
+// valid.
+func (s *Service) verifyLink(link string) (string, bool) {
+	token, signature, ok := strings.Cut(link, linkSeparator)
+	if !ok || token == "" {
+		return "", false
+	}
+
+	if !hmac.Equal([]byte(s.linkSignature(token)), []byte(signature)) {
+		return "", false
+	}
+
+	return token, true
+}
+
+func (s *Service) linkURL(token string) (string, error) {
+	u, err := url.Parse(s.cfg.LinkURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("token", token+linkSeparator+s.linkSignature(token))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+func randomCode(digits int) (string, error) {
+	n, err := rand.Int(rand.Reader, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+func randomToken(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,315 @@
+package passwordless
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	passwordlessrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless"
+	passwordlessmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/passwordless/mocks"
+	userrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user"
+	usermocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/mocks"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/user/models"
+	throttleservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/throttle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	Secret:           []byte("0123456789abcdef0123456789abcdef"),
+	ChallengeTTL:     10 * time.Minute,
+	LinkURL:          "https://kgym.test/sign-in",
+	SendWindow:       time.Hour,
+	MaxSendsPerEmail: 3,
+	MaxSendsPerIP:    10,
+}
+
+var testUser = usermodel.User{
+	ID:    "user-123",
+	Email: "user@example.com",
+	Role:  usermodel.RoleUser,
+}
+
+// stubSender keeps the messages it is asked to send.
+type stubSender struct {
+	messages []Message
+}
+
+func (s *stubSender) Send(_ context.Context, msg Message) error {
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+type testDeps struct {
+	passwordlessRepo *passwordlessmocks.MockIRepository
+	userRepo         *usermocks.MockIRepository
+	sender           *stubSender
+}
+
+func newTestService(ctrl *gomock.Controller) (*Service, testDeps) {
+	deps := testDeps{
+		passwordlessRepo: passwordlessmocks.NewMockIRepository(ctrl),
+		userRepo:         usermocks.NewMockIRepository(ctrl),
+		sender:           &stubSender{},
+	}
+
+	return NewService(testConfig, deps.passwordlessRepo, deps.userRepo, deps.sender), deps
+}
+
+func newTestChallenge(service *Service, token, code string) passwordlessentity.Challenge {
+	tokenHash := tokenentity.Hash(token)
+
+	return passwordlessentity.Challenge{
+		TokenHash: tokenHash,
+		UserID:    testUser.ID,
+		ClientID:  "client-123",
+		Scopes:    []string{"openid"},
+		CodeHash:  service.codeHash(tokenHash, code),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+}
+
+func TestService_Start(t *testing.T) {
+	t.Run("should send code and link with keyed hash stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		var stored passwordlessentity.Challenge
+
+		deps.passwordlessRepo.EXPECT().RecordSend(ctx, "email:user@example.com", testConfig.SendWindow).Return(int64(1), time.Hour, nil)
+		deps.passwordlessRepo.EXPECT().RecordSend(ctx, "ip:127.0.0.1", testConfig.SendWindow).Return(int64(1), time.Hour, nil)
+		deps.userRepo.EXPECT().GetByEmail(ctx, " User@Example.com").Return(testUser, nil)
+		deps.passwordlessRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, challenge passwordlessentity.Challenge) error {
+				stored = challenge
+				return nil
+			})
+
+		resp, err := service.Start(ctx, StartRequest{
+			Email:    " User@Example.com",
+			ClientID: "client-123",
+			Scopes:   []string{"openid"},
+			IP:       "127.0.0.1",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Token)
+		assert.WithinDuration(t, time.Now().Add(testConfig.ChallengeTTL), resp.ExpiresAt, time.Second)
+
+		require.Len(t, deps.sender.messages, 1)
+		msg := deps.sender.messages[0]
+		assert.Equal(t, testUser.Email, msg.To)
+		assert.Len(t, msg.Code, CodeDigits)
+
+		assert.Equal(t, tokenentity.Hash(resp.Token), stored.TokenHash)
+		assert.Equal(t, service.codeHash(stored.TokenHash, msg.Code), stored.CodeHash)
+		assert.NotContains(t, stored.CodeHash, msg.Code)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.Equal(t, "client-123", stored.ClientID)
+
+		link, err := url.Parse(msg.Link)
+		require.NoError(t, err)
+		token, ok := service.verifyLink(link.Query().Get("token"))
+		require.True(t, ok)
+		assert.Equal(t, resp.Token, token)
+	})
+
+	t.Run("should not reveal unknown email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.passwordlessRepo.EXPECT().RecordSend(ctx, gomock.Any(), gomock.Any()).Return(int64(1), time.Hour, nil)
+		deps.userRepo.EXPECT().GetByEmail(ctx, "unknown@example.com").Return(usermodel.User{}, userrepo.ErrUserNotFound)
+
+		resp, err := service.Start(ctx, StartRequest{
+			Email:    "unknown@example.com",
+			ClientID: "client-123",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.Token)
+		assert.Empty(t, deps.sender.messages)
+	})
+
+	t.Run("should reject too many codes for the same email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.passwordlessRepo.EXPECT().
+			RecordSend(ctx, "email:user@example.com", testConfig.SendWindow).
+			Return(testConfig.MaxSendsPerEmail+1, 30*time.Minute, nil)
+
+		_, err := service.Start(ctx, StartRequest{
+			Email:    testUser.Email,
+			ClientID: "client-123",
+			IP:       "127.0.0.1",
+		})
+
+		var throttledErr *throttleservice.ThrottledError
+		require.ErrorAs(t, err, &throttledErr)
+		assert.Equal(t, throttleservice.ScopeAccount, throttledErr.Scope)
+		assert.Equal(t, 30*time.Minute, throttledErr.RetryAfter)
+		assert.Empty(t, deps.sender.messages)
+	})
+}
+
+func TestService_Complete(t *testing.T) {
+	const (
+		token = "challenge-token"
+		code  = "123456"
+	)
+
+	t.Run("should consume challenge with valid code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+		challenge := newTestChallenge(service, token, code)
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, challenge.TokenHash).Return(challenge, nil)
+		deps.passwordlessRepo.EXPECT().RecordAttempt(ctx, challenge).Return(int64(1), nil)
+		deps.passwordlessRepo.EXPECT().Consume(ctx, challenge.TokenHash).Return(nil)
+
+		completed, err := service.Complete(ctx, CompleteRequest{
+			Token:    token,
+			Code:     code,
+			ClientID: challenge.ClientID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, challenge, completed)
+	})
+
+	t.Run("should consume challenge with signed link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+		challenge := newTestChallenge(service, token, code)
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, challenge.TokenHash).Return(challenge, nil)
+		deps.passwordlessRepo.EXPECT().Consume(ctx, challenge.TokenHash).Return(nil)
+
+		completed, err := service.Complete(ctx, CompleteRequest{
+			Link:     token + linkSeparator + service.linkSignature(token),
+			ClientID: challenge.ClientID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, challenge.UserID, completed.UserID)
+	})
+
+	t.Run("should reject link with invalid signature", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, _ := newTestService(ctrl)
+
+		_, err := service.Complete(context.Background(), CompleteRequest{
+			Link:     token + linkSeparator + service.linkSignature("other-token"),
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidChallenge)
+
+		_, err = service.Complete(context.Background(), CompleteRequest{
+			Link:     token,
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidChallenge)
+	})
+
+	t.Run("should keep challenge when code is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+		challenge := newTestChallenge(service, token, code)
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, challenge.TokenHash).Return(challenge, nil)
+		deps.passwordlessRepo.EXPECT().RecordAttempt(ctx, challenge).Return(int64(1), nil)
+
+		_, err := service.Complete(ctx, CompleteRequest{
+			Token:    token,
+			Code:     "654321",
+			ClientID: challenge.ClientID,
+		})
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	})
+
+	t.Run("should drop challenge after too many attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+		challenge := newTestChallenge(service, token, code)
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, challenge.TokenHash).Return(challenge, nil)
+		deps.passwordlessRepo.EXPECT().RecordAttempt(ctx, challenge).Return(int64(MaxChallengeAttempts+1), nil)
+		deps.passwordlessRepo.EXPECT().Consume(ctx, challenge.TokenHash).Return(nil)
+
+		_, err := service.Complete(ctx, CompleteRequest{
+			Token:    token,
+			Code:     code,
+			ClientID: challenge.ClientID,
+		})
+		assert.ErrorIs(t, err, ErrTooManyAttempts)
+	})
+
+	t.Run("should reject challenge of another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+		challenge := newTestChallenge(service, token, code)
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, challenge.TokenHash).Return(challenge, nil)
+
+		_, err := service.Complete(ctx, CompleteRequest{
+			Token:    token,
+			Code:     code,
+			ClientID: "client-456",
+		})
+		assert.ErrorIs(t, err, ErrInvalidChallenge)
+	})
+
+	t.Run("should return error when challenge is unknown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		service, deps := newTestService(ctrl)
+
+		ctx := context.Background()
+
+		deps.passwordlessRepo.EXPECT().Get(ctx, tokenentity.Hash(token)).Return(passwordlessentity.Challenge{}, passwordlessrepo.ErrChallengeNotFound)
+
+		_, err := service.Complete(ctx, CompleteRequest{
+			Token:    token,
+			Code:     code,
+			ClientID: "client-123",
+		})
+		assert.ErrorIs(t, err, ErrInvalidChallenge)
+	})
+}
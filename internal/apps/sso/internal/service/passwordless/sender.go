@@ -0,0 +1,32 @@
+package passwordless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/mailer"
+)
+
+var _ Sender = (*MailSender)(nil)
+
+// MailSender delivers codes by email.
+type MailSender struct {
+	mailer mailer.Mailer
+}
+
+func NewMailSender(mailer mailer.Mailer) *MailSender {
+	return &MailSender{
+		mailer: mailer,
+	}
+}
+
+func (s *MailSender) Send(ctx context.Context, msg Message) error {
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      msg.To,
+		Subject: "Your sign in code",
+		Body: fmt.Sprintf(
+			"Your sign in code is %s.\n\nYou can also sign in by opening the link below on this device:\n\n%s\n\nThe code and the link work once within %s. If you did not ask for them, you can ignore this email.\n",
+			msg.Code, msg.Link, msg.TTL,
+		),
+	})
+}
@@ -0,0 +1,94 @@
+package passwordless
+
+import (
+	"context"
+	"time"
+
+	passwordlessentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/passwordless"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidChallenge is returned for unknown, completed and expired
+	// challenges, and for links that were not signed by us.
+	ErrInvalidChallenge = errors.New("invalid passwordless challenge")
+	ErrInvalidCode      = errors.New("invalid passwordless code")
+	ErrTooManyAttempts  = errors.New("too many passwordless attempts")
+)
+
+const (
+	CodeDigits           = 6
+	ChallengeTokenLength = 32
+	MaxChallengeAttempts = 5
+
+	SecurityEventCodeSent        = "passwordless_code_sent"
+	SecurityEventTooManyAttempts = "passwordless_too_many_attempts"
+	SecurityEventRateLimited     = "passwordless_rate_limited"
+)
+
+type Config struct {
+	// Secret keys the hashes of codes and signs magic links.
+	Secret []byte
+	// ChallengeTTL is how long a code and its link can be used.
+	ChallengeTTL time.Duration
+	// LinkURL is the page mailed magic links point to. The signed token is
+	// added as the token query parameter.
+	LinkURL string
+
+	// At most MaxSendsPerEmail and MaxSendsPerIP codes are sent within
+	// SendWindow. Zero disables the limit.
+	SendWindow       time.Duration
+	MaxSendsPerEmail int64
+	MaxSendsPerIP    int64
+}
+
+type IService interface {
+	// Start sends a code and a magic link to the user with the given email
+	// address and returns the token of the challenge to complete. Unknown
+	// addresses get a token too, so that the endpoint cannot be used to find
+	// accounts. Too many codes for the same address or from the same IP are
+	// rejected with a *throttle.ThrottledError.
+	Start(ctx context.Context, req StartRequest) (StartResponse, error)
+	// Complete checks the code presented for a challenge, or the magic link
+	// that stands in for both, and consumes the challenge on success.
+	Complete(ctx context.Context, req CompleteRequest) (passwordlessentity.Challenge, error)
+}
+
+type (
+	StartRequest struct {
+		Email    string
+		ClientID string
+		Scopes   []string
+		// IP is the address of the user agent, used to limit the codes sent.
+		IP string
+	}
+
+	StartResponse struct {
+		Token     string
+		ExpiresAt time.Time
+	}
+)
+
+// CompleteRequest is completed either with Token and Code, or with Link
+// alone.
+type CompleteRequest struct {
+	Token string
+	Code  string
+	Link  string
+
+	ClientID string
+}
+
+// Sender delivers codes to users.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Message is what a Sender delivers. Link signs the user in without typing
+// the code.
+type Message struct {
+	To   string
+	Code string
+	Link string
+	TTL  time.Duration
+}
@@ -0,0 +1,68 @@
+package session
+
+import (
+	"context"
+
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+const (
+	// Limits of the device details stored with a session. Longer values sent
+	// by clients are cut off.
+	MaxDeviceLength     = 255
+	MaxPlatformLength   = 64
+	MaxAppVersionLength = 64
+	MaxIPLength         = 64
+
+	SecurityEventSessionRevoked = "session_revoked"
+)
+
+type IService interface {
+	// Start records a new session. Its id is the id of the refresh token
+	// family issued with it.
+	Start(ctx context.Context, req StartRequest) error
+	// Touch records that a session was used to refresh tokens. Sessions
+	// started before they were recorded are ignored.
+	Touch(ctx context.Context, req TouchRequest) error
+	List(ctx context.Context, userID string) ([]sessionentity.Session, error)
+	// Revoke ends a session and revokes its refresh tokens.
+	Revoke(ctx context.Context, req RevokeRequest) error
+	// RevokeAll ends every session of a user and returns how many were
+	// ended.
+	RevokeAll(ctx context.Context, req RevokeAllRequest) (int, error)
+	// End ends the session of a refresh token family that is revoked other
+	// than through this service, whether the session was recorded or not.
+	End(ctx context.Context, id string) error
+}
+
+type (
+	StartRequest struct {
+		ID       string
+		UserID   string
+		ClientID string
+		Device   sessionentity.Device
+	}
+
+	TouchRequest struct {
+		ID string
+		IP string
+	}
+
+	RevokeRequest struct {
+		ID string
+		// UserID is the user the session has to belong to. Empty lets admins
+		// revoke any session.
+		UserID string
+	}
+
+	RevokeAllRequest struct {
+		UserID string
+		// ExceptID keeps the session the request is made from.
+		ExceptID string
+	}
+)
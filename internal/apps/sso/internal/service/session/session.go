@@ -0,0 +1,135 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	sessionrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	sessionRepository sessionrepo.IRepository
+	tokenRepository   tokenrepo.IRepository
+}
+
+func NewService(sessionRepository sessionrepo.IRepository, tokenRepository tokenrepo.IRepository) *Service {
+	return &Service{
+		sessionRepository: sessionRepository,
+		tokenRepository:   tokenRepository,
+	}
+}
+
+func (s *Service) Start(ctx context.Context, req StartRequest) error {
+	now := time.Now()
+
+	return s.sessionRepository.Create(ctx, sessionentity.Session{
+		ID:       req.ID,
+		UserID:   req.UserID,
+		ClientID: req.ClientID,
+		Device: sessionentity.Device{
+			Name:       truncate(req.Device.Name, MaxDeviceLength),
+			Platform:   truncate(req.Device.Platform, MaxPlatformLength),
+			AppVersion: truncate(req.Device.AppVersion, MaxAppVersionLength),
+			IP:         truncate(req.Device.IP, MaxIPLength),
+		},
+		CreatedAt:  now,
+		LastUsedAt: now,
+	})
+}
+
+func (s *Service) Touch(ctx context.Context, req TouchRequest) error {
+	err := s.sessionRepository.Touch(ctx, req.ID, truncate(req.IP, MaxIPLength), time.Now())
+	if err != nil && !errors.Is(err, sessionrepo.ErrSessionNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, userID string) ([]sessionentity.Session, error) {
+	return s.sessionRepository.ListActive(ctx, userID)
+}
+
+func (s *Service) Revoke(ctx context.Context, req RevokeRequest) error {
+	session, err := s.sessionRepository.GetByID(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, sessionrepo.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+
+	// Sessions of other users are reported as missing, so that their ids
+	// cannot be probed.
+	if session.Revoked() || (req.UserID != "" && session.UserID != req.UserID) {
+		return ErrSessionNotFound
+	}
+
+	return s.revoke(ctx, session)
+}
+
+func (s *Service) RevokeAll(ctx context.Context, req RevokeAllRequest) (int, error) {
+	sessions, err := s.sessionRepository.ListActive(ctx, req.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == req.ExceptID {
+			continue
+		}
+
+		if err := s.revoke(ctx, session); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// End revokes the refresh tokens before the session, so that a failure in
+// between leaves the session listed and the revocation can be retried.
+func (s *Service) End(ctx context.Context, id string) error {
+	if err := s.tokenRepository.RevokeFamily(ctx, id); err != nil {
+		return err
+	}
+
+	err := s.sessionRepository.Revoke(ctx, id)
+	if err != nil && !errors.Is(err, sessionrepo.ErrSessionNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) revoke(ctx context.Context, session sessionentity.Session) error {
+	if err := s.End(ctx, session.ID); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("event", SecurityEventSessionRevoked).
+		Str("session_id", session.ID).
+		Str("user_id", session.UserID).
+		Str("client_id", session.ClientID).
+		Msg("session revoked")
+
+	return nil
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+
+	return string(runes[:length])
+}
@@ -0,0 +1,200 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	sessionentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/session"
+	sessionrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session"
+	sessionmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/mocks"
+	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestSession(id, userID string) sessionentity.Session {
+	return sessionentity.Session{
+		ID:         id,
+		UserID:     userID,
+		ClientID:   "client-123",
+		CreatedAt:  time.Now(),
+		LastUsedAt: time.Now(),
+	}
+}
+
+func TestService_Start(t *testing.T) {
+	t.Run("should record session with truncated device details", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, session sessionentity.Session) error {
+				assert.Equal(t, "family-1", session.ID)
+				assert.Equal(t, "user-1", session.UserID)
+				assert.Len(t, session.Device.Name, MaxDeviceLength)
+				assert.Equal(t, "ios", session.Device.Platform)
+				assert.False(t, session.LastUsedAt.IsZero())
+				return nil
+			})
+
+		err := service.Start(ctx, StartRequest{
+			ID:       "family-1",
+			UserID:   "user-1",
+			ClientID: "client-123",
+			Device: sessionentity.Device{
+				Name:     strings.Repeat("a", 1000),
+				Platform: "ios",
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Touch(t *testing.T) {
+	t.Run("should ignore unknown session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().
+			Touch(ctx, "family-1", "203.0.113.1", gomock.Any()).
+			Return(sessionrepo.ErrSessionNotFound)
+
+		err := service.Touch(ctx, TouchRequest{ID: "family-1", IP: "203.0.113.1"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	t.Run("should revoke session and its refresh tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenRepo)
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().GetByID(ctx, "family-1").Return(newTestSession("family-1", "user-1"), nil)
+		gomock.InOrder(
+			tokenRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil),
+			sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(nil),
+		)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: "family-1", UserID: "user-1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not revoke session of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().GetByID(ctx, "family-1").Return(newTestSession("family-1", "user-2"), nil)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: "family-1", UserID: "user-1"})
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
+	t.Run("should let admin revoke any session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenRepo)
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().GetByID(ctx, "family-1").Return(newTestSession("family-1", "user-2"), nil)
+		tokenRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+		sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(nil)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: "family-1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when session already revoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenmocks.NewMockIRepository(ctrl))
+
+		ctx := context.Background()
+
+		session := newTestSession("family-1", "user-1")
+		session.RevokedAt = time.Now()
+		sessionRepo.EXPECT().GetByID(ctx, "family-1").Return(session, nil)
+
+		err := service.Revoke(ctx, RevokeRequest{ID: "family-1", UserID: "user-1"})
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+}
+
+func TestService_RevokeAll(t *testing.T) {
+	t.Run("should revoke every session except the current one", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenRepo)
+
+		ctx := context.Background()
+
+		sessionRepo.EXPECT().
+			ListActive(ctx, "user-1").
+			Return([]sessionentity.Session{
+				newTestSession("family-1", "user-1"),
+				newTestSession("family-2", "user-1"),
+				newTestSession("family-3", "user-1"),
+			}, nil)
+
+		for _, id := range []string{"family-1", "family-3"} {
+			tokenRepo.EXPECT().RevokeFamily(ctx, id).Return(nil)
+			sessionRepo.EXPECT().Revoke(ctx, id).Return(nil)
+		}
+
+		revoked, err := service.RevokeAll(ctx, RevokeAllRequest{UserID: "user-1", ExceptID: "family-2"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, revoked)
+	})
+}
+
+func TestService_End(t *testing.T) {
+	t.Run("should revoke tokens of family without recorded session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		service := NewService(sessionRepo, tokenRepo)
+
+		ctx := context.Background()
+
+		tokenRepo.EXPECT().RevokeFamily(ctx, "family-1").Return(nil)
+		sessionRepo.EXPECT().Revoke(ctx, "family-1").Return(sessionrepo.ErrSessionNotFound)
+
+		err := service.End(ctx, "family-1")
+		assert.NoError(t, err)
+	})
+}
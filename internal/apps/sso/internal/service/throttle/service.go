@@ -0,0 +1,93 @@
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrThrottled = errors.New("too many failed attempts")
+	ErrLocked    = errors.New("temporarily locked out")
+)
+
+const (
+	ScopeAccount = "account"
+	ScopeIP      = "ip"
+	ScopeClient  = "client"
+
+	SecurityEventLockout = "lockout"
+	SecurityEventUnlock  = "lockout_lifted"
+)
+
+// Limits are the number of failures within the window after which an attempt
+// is throttled. Zero disables the limit.
+type Limits struct {
+	// BackoffAfter failures, every further attempt has to wait exponentially
+	// longer after the latest failure.
+	BackoffAfter int64
+	// LockoutAfter failures, all attempts are rejected for the lockout
+	// duration.
+	LockoutAfter int64
+}
+
+type Config struct {
+	Window          time.Duration
+	BackoffBase     time.Duration
+	BackoffMax      time.Duration
+	LockoutDuration time.Duration
+
+	Account Limits
+	IP      Limits
+	Client  Limits
+}
+
+type IService interface {
+	// Check returns a *ThrottledError if the attempt has to be rejected
+	// without checking the credentials.
+	Check(ctx context.Context, attempt Attempt) error
+	// RecordFailure counts a failed attempt and locks out the scopes that
+	// exceeded their limit.
+	RecordFailure(ctx context.Context, attempt Attempt) error
+	// RecordSuccess forgets the failures of the account.
+	RecordSuccess(ctx context.Context, attempt Attempt) error
+
+	Unlock(ctx context.Context, req UnlockRequest) error
+}
+
+// Attempt identifies where a sign in attempt comes from. Empty fields are not
+// throttled.
+type Attempt struct {
+	Email    string
+	IP       string
+	ClientID string
+}
+
+type UnlockRequest struct {
+	Email string
+	IP    string
+}
+
+// ThrottledError is returned for attempts that are rejected. RetryAfter is
+// how long the caller has to wait before trying again.
+type ThrottledError struct {
+	Scope      string
+	Locked     bool
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("%s: %s", e.Scope, ErrLocked)
+	}
+	return fmt.Sprintf("%s: %s", e.Scope, ErrThrottled)
+}
+
+func (e *ThrottledError) Is(target error) bool {
+	if e.Locked {
+		return target == ErrLocked
+	}
+	return target == ErrThrottled
+}
@@ -0,0 +1,203 @@
+package throttle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	throttlerepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	throttleRepository throttlerepo.IRepository
+}
+
+func NewService(cfg Config, throttleRepository throttlerepo.IRepository) *Service {
+	return &Service{
+		cfg:                cfg,
+		throttleRepository: throttleRepository,
+	}
+}
+
+// subject is a single scope an attempt is counted against.
+type subject struct {
+	scope  string
+	key    string
+	limits Limits
+}
+
+func (s *Service) Check(ctx context.Context, attempt Attempt) error {
+	now := time.Now()
+
+	var throttled *ThrottledError
+	for _, subject := range s.subjects(attempt) {
+		lockedUntil, err := s.throttleRepository.LockedUntil(ctx, subject.key)
+		if err != nil {
+			return err
+		}
+
+		if now.Before(lockedUntil) {
+			throttled = longest(throttled, &ThrottledError{
+				Scope:      subject.scope,
+				Locked:     true,
+				RetryAfter: lockedUntil.Sub(now),
+			})
+			continue
+		}
+
+		if subject.limits.BackoffAfter == 0 {
+			continue
+		}
+
+		failures, latest, err := s.throttleRepository.Failures(ctx, subject.key, now, s.cfg.Window)
+		if err != nil {
+			return err
+		}
+
+		if failures < subject.limits.BackoffAfter {
+			continue
+		}
+
+		retryAt := latest.Add(s.backoff(failures - subject.limits.BackoffAfter))
+		if now.Before(retryAt) {
+			throttled = longest(throttled, &ThrottledError{
+				Scope:      subject.scope,
+				RetryAfter: retryAt.Sub(now),
+			})
+		}
+	}
+
+	if throttled != nil {
+		return throttled
+	}
+
+	return nil
+}
+
+func (s *Service) RecordFailure(ctx context.Context, attempt Attempt) error {
+	now := time.Now()
+
+	for _, subject := range s.subjects(attempt) {
+		failures, err := s.throttleRepository.RecordFailure(ctx, subject.key, now, s.cfg.Window)
+		if err != nil {
+			return err
+		}
+
+		if subject.limits.LockoutAfter == 0 || failures < subject.limits.LockoutAfter {
+			continue
+		}
+
+		if err := s.throttleRepository.Lock(ctx, subject.key, now.Add(s.cfg.LockoutDuration)); err != nil {
+			return err
+		}
+
+		// Failures leading up to the lockout are not held against the
+		// subject once it ends.
+		if err := s.throttleRepository.ResetFailures(ctx, subject.key); err != nil {
+			return err
+		}
+
+		log.Warn().
+			Str("event", SecurityEventLockout).
+			Str("scope", subject.scope).
+			Str("key", subject.key).
+			Str("client_id", attempt.ClientID).
+			Str("ip", attempt.IP).
+			Int64("failures", failures).
+			Dur("duration", s.cfg.LockoutDuration).
+			Msg("too many failed sign in attempts, locking out")
+	}
+
+	return nil
+}
+
+func (s *Service) RecordSuccess(ctx context.Context, attempt Attempt) error {
+	if attempt.Email == "" {
+		return nil
+	}
+
+	return s.throttleRepository.ResetFailures(ctx, accountKey(attempt.Email))
+}
+
+func (s *Service) Unlock(ctx context.Context, req UnlockRequest) error {
+	var keys []string
+	if req.Email != "" {
+		keys = append(keys, accountKey(req.Email))
+	}
+	if req.IP != "" {
+		keys = append(keys, ipKey(req.IP))
+	}
+
+	for _, key := range keys {
+		if err := s.throttleRepository.Unlock(ctx, key); err != nil {
+			return err
+		}
+		if err := s.throttleRepository.ResetFailures(ctx, key); err != nil {
+			return err
+		}
+
+		log.Info().
+			Str("event", SecurityEventUnlock).
+			Str("key", key).
+			Msg("lockout lifted")
+	}
+
+	return nil
+}
+
+func (s *Service) subjects(attempt Attempt) []subject {
+	subjects := make([]subject, 0, 3)
+	if attempt.Email != "" {
+		subjects = append(subjects, subject{scope: ScopeAccount, key: accountKey(attempt.Email), limits: s.cfg.Account})
+	}
+	if attempt.IP != "" {
+		subjects = append(subjects, subject{scope: ScopeIP, key: ipKey(attempt.IP), limits: s.cfg.IP})
+	}
+	if attempt.ClientID != "" {
+		subjects = append(subjects, subject{scope: ScopeClient, key: clientKey(attempt.ClientID), limits: s.cfg.Client})
+	}
+
+	return subjects
+}
+
+// backoff returns the delay after the nth failure past the backoff limit,
+// doubling with every failure up to the maximum.
+func (s *Service) backoff(n int64) time.Duration {
+	delay := s.cfg.BackoffBase
+	for range n {
+		delay *= 2
+		if delay >= s.cfg.BackoffMax {
+			return s.cfg.BackoffMax
+		}
+	}
+
+	return min(delay, s.cfg.BackoffMax)
+}
+
+func longest(a, b *ThrottledError) *ThrottledError {
+	if a == nil || b.RetryAfter > a.RetryAfter {
+		return b
+	}
+	return a
+}
+
+// accountKey hashes the email, so that the cache does not hold a list of
+// addresses. Emails are compared case-insensitively, so that changing the
+// case does not get around the limit.
+func accountKey(email string) string {
+	return ScopeAccount + ":" + tokenentity.Hash(strings.ToLower(strings.TrimSpace(email)))
+}
+
+func ipKey(ip string) string {
+	return ScopeIP + ":" + ip
+}
+
+func clientKey(clientID string) string {
+	return ScopeClient + ":" + clientID
+}
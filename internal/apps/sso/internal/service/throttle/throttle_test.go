@@ -0,0 +1,223 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	throttlemocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/throttle/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	Window:          15 * time.Minute,
+	BackoffBase:     time.Second,
+	BackoffMax:      time.Minute,
+	LockoutDuration: 15 * time.Minute,
+	Account:         Limits{BackoffAfter: 3, LockoutAfter: 10},
+	IP:              Limits{BackoffAfter: 10, LockoutAfter: 100},
+	Client:          Limits{BackoffAfter: 100},
+}
+
+var testAttempt = Attempt{
+	Email:    "user@example.com",
+	IP:       "203.0.113.1",
+	ClientID: "client-1",
+}
+
+func TestService_Check(t *testing.T) {
+	t.Run("should allow attempt below limits", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().LockedUntil(ctx, gomock.Any()).Return(time.Time{}, nil).Times(3)
+		throttleRepo.EXPECT().Failures(ctx, gomock.Any(), gomock.Any(), testConfig.Window).Return(int64(1), time.Now(), nil).Times(3)
+
+		err := service.Check(ctx, testAttempt)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject locked out account", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().LockedUntil(ctx, accountKey(testAttempt.Email)).Return(time.Now().Add(10*time.Minute), nil)
+
+		err := service.Check(ctx, Attempt{Email: testAttempt.Email})
+		require.ErrorIs(t, err, ErrLocked)
+
+		var throttled *ThrottledError
+		require.ErrorAs(t, err, &throttled)
+		assert.Equal(t, ScopeAccount, throttled.Scope)
+		assert.InDelta(t, 10*time.Minute, throttled.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("should back off exponentially after limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().LockedUntil(ctx, gomock.Any()).Return(time.Time{}, nil)
+		throttleRepo.EXPECT().Failures(ctx, accountKey(testAttempt.Email), gomock.Any(), testConfig.Window).Return(int64(5), time.Now(), nil)
+
+		err := service.Check(ctx, Attempt{Email: testAttempt.Email})
+		require.ErrorIs(t, err, ErrThrottled)
+
+		var throttled *ThrottledError
+		require.ErrorAs(t, err, &throttled)
+		assert.False(t, throttled.Locked)
+		assert.InDelta(t, 4*time.Second, throttled.RetryAfter, float64(100*time.Millisecond))
+	})
+
+	t.Run("should allow attempt once backoff elapsed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().LockedUntil(ctx, gomock.Any()).Return(time.Time{}, nil)
+		throttleRepo.EXPECT().Failures(ctx, gomock.Any(), gomock.Any(), testConfig.Window).Return(int64(5), time.Now().Add(-time.Minute), nil)
+
+		err := service.Check(ctx, Attempt{Email: testAttempt.Email})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report the longest wait", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().LockedUntil(ctx, accountKey(testAttempt.Email)).Return(time.Time{}, nil)
+		throttleRepo.EXPECT().Failures(ctx, accountKey(testAttempt.Email), gomock.Any(), testConfig.Window).Return(int64(3), time.Now(), nil)
+		throttleRepo.EXPECT().LockedUntil(ctx, ipKey(testAttempt.IP)).Return(time.Now().Add(5*time.Minute), nil)
+
+		err := service.Check(ctx, Attempt{Email: testAttempt.Email, IP: testAttempt.IP})
+
+		var throttled *ThrottledError
+		require.ErrorAs(t, err, &throttled)
+		assert.Equal(t, ScopeIP, throttled.Scope)
+		assert.True(t, throttled.Locked)
+	})
+
+	t.Run("should hash emails case-insensitively", func(t *testing.T) {
+		assert.Equal(t, accountKey("user@example.com"), accountKey(" User@Example.COM "))
+		assert.NotContains(t, accountKey("user@example.com"), "user@example.com")
+	})
+}
+
+func TestService_RecordFailure(t *testing.T) {
+	t.Run("should count failure for every scope", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().RecordFailure(ctx, accountKey(testAttempt.Email), gomock.Any(), testConfig.Window).Return(int64(1), nil)
+		throttleRepo.EXPECT().RecordFailure(ctx, ipKey(testAttempt.IP), gomock.Any(), testConfig.Window).Return(int64(1), nil)
+		throttleRepo.EXPECT().RecordFailure(ctx, clientKey(testAttempt.ClientID), gomock.Any(), testConfig.Window).Return(int64(1), nil)
+
+		err := service.RecordFailure(ctx, testAttempt)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should lock out account at limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		key := accountKey(testAttempt.Email)
+		throttleRepo.EXPECT().RecordFailure(ctx, key, gomock.Any(), testConfig.Window).Return(testConfig.Account.LockoutAfter, nil)
+		throttleRepo.EXPECT().
+			Lock(ctx, key, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, until time.Time) error {
+				assert.WithinDuration(t, time.Now().Add(testConfig.LockoutDuration), until, time.Second)
+				return nil
+			})
+		throttleRepo.EXPECT().ResetFailures(ctx, key).Return(nil)
+
+		err := service.RecordFailure(ctx, Attempt{Email: testAttempt.Email})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not lock out client without lockout limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().RecordFailure(ctx, clientKey(testAttempt.ClientID), gomock.Any(), testConfig.Window).Return(int64(1000), nil)
+
+		err := service.RecordFailure(ctx, Attempt{ClientID: testAttempt.ClientID})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_RecordSuccess(t *testing.T) {
+	t.Run("should reset account failures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		throttleRepo.EXPECT().ResetFailures(ctx, accountKey(testAttempt.Email)).Return(nil)
+
+		err := service.RecordSuccess(ctx, testAttempt)
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Unlock(t *testing.T) {
+	t.Run("should lift account and ip lockouts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		throttleRepo := throttlemocks.NewMockIRepository(ctrl)
+		service := NewService(testConfig, throttleRepo)
+
+		ctx := context.Background()
+
+		for _, key := range []string{accountKey(testAttempt.Email), ipKey(testAttempt.IP)} {
+			throttleRepo.EXPECT().Unlock(ctx, key).Return(nil)
+			throttleRepo.EXPECT().ResetFailures(ctx, key).Return(nil)
+		}
+
+		err := service.Unlock(ctx, UnlockRequest{Email: testAttempt.Email, IP: testAttempt.IP})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,77 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrClientMismatch = errors.New("token was issued to another client")
+)
+
+const (
+	TypeHintAccessToken  = "access_token"
+	TypeHintRefreshToken = "refresh_token"
+)
+
+type Config struct {
+	Issuer string
+}
+
+type IService interface {
+	Revoke(ctx context.Context, req RevokeRequest) error
+	Introspect(ctx context.Context, req IntrospectRequest) (IntrospectResponse, error)
+	VerifyAccessToken(ctx context.Context, req VerifyAccessTokenRequest) (authservice.AccessTokenClaims, error)
+}
+
+type (
+	RevokeRequest struct {
+		Token         string
+		TokenTypeHint string
+
+		ClientID string
+	}
+)
+
+type (
+	// VerifyAccessTokenRequest is an access token as a call presented it.
+	VerifyAccessTokenRequest struct {
+		Token string
+		// DPoP tells whether the token was sent with the DPoP scheme rather
+		// than as a bearer token.
+		DPoP bool
+		// JKT is the thumbprint of the key that signed the DPoP proof the
+		// token came with, once the proof was verified. It is left empty for
+		// tokens sent as bearer tokens, which are refused if they are bound
+		// even when a proof came along, since they may have been stolen from
+		// the client.
+		JKT string
+	}
+)
+
+type (
+	IntrospectRequest struct {
+		Token         string
+		TokenTypeHint string
+	}
+
+	IntrospectResponse struct {
+		Active bool
+
+		TokenType string
+		ClientID  string
+		Subject   string
+		Issuer    string
+		JTI       string
+		Audience  []string
+		Scopes    []string
+		Role      string
+		Tenant    string
+		ExpiresAt time.Time
+		IssuedAt  time.Time
+	}
+)
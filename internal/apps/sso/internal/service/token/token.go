@@ -0,0 +1,263 @@
+package token
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	denylistrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist"
+	keyrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	"github.com/pkg/errors"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	tokenRepository    tokenrepo.IRepository
+	keyRepository      keyrepo.IRepository
+	denylistRepository denylistrepo.IRepository
+
+	sessionService sessionservice.IService
+}
+
+func NewService(cfg Config, tokenRepository tokenrepo.IRepository, keyRepository keyrepo.IRepository, denylistRepository denylistrepo.IRepository, sessionService sessionservice.IService) *Service {
+	return &Service{
+		cfg:                cfg,
+		tokenRepository:    tokenRepository,
+		keyRepository:      keyRepository,
+		denylistRepository: denylistRepository,
+		sessionService:     sessionService,
+	}
+}
+
+// Revoke follows RFC 7009: unknown or already invalid tokens are not an error.
+func (s *Service) Revoke(ctx context.Context, req RevokeRequest) error {
+	for _, tokenType := range lookupOrder(req.TokenTypeHint) {
+		var (
+			found bool
+			err   error
+		)
+
+		switch tokenType {
+		case TypeHintRefreshToken:
+			found, err = s.revokeRefreshToken(ctx, req.Token, req.ClientID)
+		case TypeHintAccessToken:
+			found, err = s.revokeAccessToken(ctx, req.Token, req.ClientID)
+		}
+		if err != nil {
+			return err
+		}
+		if found {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Introspect(ctx context.Context, req IntrospectRequest) (IntrospectResponse, error) {
+	for _, tokenType := range lookupOrder(req.TokenTypeHint) {
+		var (
+			resp  IntrospectResponse
+			found bool
+			err   error
+		)
+
+		switch tokenType {
+		case TypeHintRefreshToken:
+			resp, found, err = s.introspectRefreshToken(ctx, req.Token)
+		case TypeHintAccessToken:
+			resp, found, err = s.introspectAccessToken(ctx, req.Token)
+		}
+		if err != nil {
+			return IntrospectResponse{}, err
+		}
+		if found {
+			return resp, nil
+		}
+	}
+
+	return IntrospectResponse{Active: false}, nil
+}
+
+// VerifyAccessToken returns the claims of a valid access token, or
+// ErrInvalidToken if it is malformed, expired, revoked or presented without
+// the proof of possession it is bound to.
+func (s *Service) VerifyAccessToken(ctx context.Context, req VerifyAccessTokenRequest) (authservice.AccessTokenClaims, error) {
+	claims, err := s.parseAccessToken(ctx, req.Token)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, err
+	}
+
+	if !boundTo(claims, req) {
+		return authservice.AccessTokenClaims{}, ErrInvalidToken
+	}
+
+	revoked, err := s.denylistRepository.Contains(ctx, claims.ID)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, err
+	}
+	if revoked {
+		return authservice.AccessTokenClaims{}, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+func (s *Service) revokeRefreshToken(ctx context.Context, value, clientID string) (bool, error) {
+	token, err := s.tokenRepository.GetByTokenHash(ctx, tokenentity.Hash(value))
+	if err != nil {
+		if errors.Is(err, tokenrepo.ErrTokenNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if clientID != "" && token.ClientID != clientID {
+		return true, ErrClientMismatch
+	}
+
+	// Revoking a refresh token signs the session out.
+	return true, s.sessionService.End(ctx, token.FamilyID)
+}
+
+func (s *Service) revokeAccessToken(ctx context.Context, value, clientID string) (bool, error) {
+	claims, err := s.parseAccessToken(ctx, value)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if clientID != "" && !slices.Contains(claims.Audience, clientID) {
+		return true, ErrClientMismatch
+	}
+
+	return true, s.denylistRepository.Add(ctx, claims.ID, time.Until(claims.ExpiresAt.Time))
+}
+
+func (s *Service) introspectRefreshToken(ctx context.Context, value string) (IntrospectResponse, bool, error) {
+	token, err := s.tokenRepository.GetByTokenHash(ctx, tokenentity.Hash(value))
+	if err != nil {
+		if errors.Is(err, tokenrepo.ErrTokenNotFound) {
+			return IntrospectResponse{}, false, nil
+		}
+		return IntrospectResponse{}, false, err
+	}
+
+	if token.Revoked || token.UsedAt != nil || token.ExpiresAt.Before(time.Now()) {
+		return IntrospectResponse{Active: false}, true, nil
+	}
+
+	return IntrospectResponse{
+		Active:    true,
+		TokenType: TypeHintRefreshToken,
+		ClientID:  token.ClientID,
+		Subject:   token.Subject,
+		Issuer:    s.cfg.Issuer,
+		Scopes:    token.Scopes,
+		ExpiresAt: token.ExpiresAt,
+		IssuedAt:  token.CreatedAt,
+	}, true, nil
+}
+
+func (s *Service) introspectAccessToken(ctx context.Context, value string) (IntrospectResponse, bool, error) {
+	claims, err := s.parseAccessToken(ctx, value)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			return IntrospectResponse{}, false, nil
+		}
+		return IntrospectResponse{}, false, err
+	}
+
+	revoked, err := s.denylistRepository.Contains(ctx, claims.ID)
+	if err != nil {
+		return IntrospectResponse{}, false, err
+	}
+	if revoked {
+		return IntrospectResponse{Active: false}, true, nil
+	}
+
+	resp := IntrospectResponse{
+		Active:    true,
+		TokenType: TypeHintAccessToken,
+		Subject:   claims.Subject,
+		Issuer:    claims.Issuer,
+		JTI:       claims.ID,
+		Audience:  claims.Audience,
+		Scopes:    claims.Scopes(),
+		Role:      claims.Role,
+		Tenant:    claims.Tenant,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if len(claims.Audience) == 1 {
+		resp.ClientID = claims.Audience[0]
+	}
+	if claims.IssuedAt != nil {
+		resp.IssuedAt = claims.IssuedAt.Time
+	}
+
+	return resp, true, nil
+}
+
+// parseAccessToken verifies an access token against the published signing
+// keys. Any verification failure is reported as ErrInvalidToken.
+func (s *Service) parseAccessToken(ctx context.Context, value string) (authservice.AccessTokenClaims, error) {
+	keys, err := s.keyRepository.GetPublicKeys(ctx)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, err
+	}
+
+	claims := authservice.AccessTokenClaims{}
+
+	_, err = jwt.ParseWithClaims(value, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		for _, key := range keys {
+			if key.ID == kid && key.Algorithm == token.Method.Alg() {
+				return key.Public, nil
+			}
+		}
+
+		return nil, ErrInvalidToken
+	},
+		jwt.WithIssuer(s.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return authservice.AccessTokenClaims{}, ErrInvalidToken
+	}
+
+	if claims.ID == "" {
+		return authservice.AccessTokenClaims{}, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// boundTo reports whether a token is presented as its binding asks, as in
+// RFC 9449 section 7.1: a token bound to a key only along with a verified
+// proof of that key, and the DPoP scheme only with a bound token.
+func boundTo(claims authservice.AccessTokenClaims, req VerifyAccessTokenRequest) bool {
+	if claims.Confirmation == nil || claims.Confirmation.JKT == "" {
+		return !req.DPoP
+	}
+
+	return req.JKT != "" && req.JKT == claims.Confirmation.JKT
+}
+
+func lookupOrder(hint string) []string {
+	if hint == TypeHintAccessToken {
+		return []string{TypeHintAccessToken, TypeHintRefreshToken}
+	}
+
+	return []string{TypeHintRefreshToken, TypeHintAccessToken}
+}
@@ -0,0 +1,545 @@
+package token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	keyentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/key"
+	tokenentity "github.com/kitanoyoru/kgym/internal/apps/sso/internal/entity/token"
+	denylistmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/denylist/mocks"
+	keymocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/key/mocks"
+	sessionmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/session/mocks"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
+	tokenmodel "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/models/token"
+	authservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/auth"
+	sessionservice "github.com/kitanoyoru/kgym/internal/apps/sso/internal/service/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testIssuer = "https://sso.kgym.test"
+
+func newSigningKey(t *testing.T) keyentity.Key {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return keyentity.Key{
+		ID:        "key-123",
+		Private:   privateKey,
+		Public:    privateKey.Public(),
+		Algorithm: "RS256",
+		State:     keyentity.StateActive,
+	}
+}
+
+func signAccessToken(t *testing.T, key keyentity.Key, claims jwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(key.Private)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestService_Revoke(t *testing.T) {
+	t.Run("should revoke refresh token family successfully", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		sessionRepo := sessionmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, sessionservice.NewService(sessionRepo, tokenRepo))
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+
+		token := tokenmodel.Token{
+			ID:        "token-123",
+			FamilyID:  "family-123",
+			ClientID:  "client-123",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash(refreshToken)).
+			Return(token, nil)
+
+		tokenRepo.EXPECT().
+			RevokeFamily(ctx, token.FamilyID).
+			Return(nil)
+
+		sessionRepo.EXPECT().
+			Revoke(ctx, token.FamilyID).
+			Return(nil)
+
+		err := service.Revoke(ctx, RevokeRequest{
+			Token:         refreshToken,
+			TokenTypeHint: TypeHintRefreshToken,
+			ClientID:      "client-123",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when refresh token belongs to another client", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash(refreshToken)).
+			Return(tokenmodel.Token{ID: "token-123", ClientID: "client-123"}, nil)
+
+		err := service.Revoke(ctx, RevokeRequest{
+			Token:    refreshToken,
+			ClientID: "client-456",
+		})
+		assert.ErrorIs(t, err, ErrClientMismatch)
+	})
+
+	t.Run("should deny access token jti for its remaining lifetime", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+		now := time.Now()
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Subject:   "user-123",
+			Audience:  jwt.ClaimStrings{"client-123"},
+			Issuer:    testIssuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(10 * time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		denylistRepo.EXPECT().
+			Add(ctx, "jti-123", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, jti string, ttl time.Duration) error {
+				assert.Greater(t, ttl, 9*time.Minute)
+				assert.LessOrEqual(t, ttl, 10*time.Minute)
+				return nil
+			})
+
+		err := service.Revoke(ctx, RevokeRequest{
+			Token:         accessToken,
+			TokenTypeHint: TypeHintAccessToken,
+			ClientID:      "client-123",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore unknown token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash("unknown-token")).
+			Return(tokenmodel.Token{}, tokenrepo.ErrTokenNotFound)
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{newSigningKey(t)}, nil)
+
+		err := service.Revoke(ctx, RevokeRequest{
+			Token: "unknown-token",
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_Introspect(t *testing.T) {
+	t.Run("should introspect active access token successfully", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+		now := time.Now().Truncate(time.Second)
+
+		accessToken := signAccessToken(t, key, authservice.AccessTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti-123",
+				Subject:   "user-123",
+				Audience:  jwt.ClaimStrings{"client-123"},
+				Issuer:    testIssuer,
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(10 * time.Minute)),
+			},
+			Scope:  "files:read files:write",
+			Role:   "user",
+			Tenant: "kgym",
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		denylistRepo.EXPECT().
+			Contains(ctx, "jti-123").
+			Return(false, nil)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token:         accessToken,
+			TokenTypeHint: TypeHintAccessToken,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Active)
+		assert.Equal(t, TypeHintAccessToken, resp.TokenType)
+		assert.Equal(t, "user-123", resp.Subject)
+		assert.Equal(t, "client-123", resp.ClientID)
+		assert.Equal(t, "jti-123", resp.JTI)
+		assert.Equal(t, now.Add(10*time.Minute).Unix(), resp.ExpiresAt.Unix())
+		assert.Equal(t, []string{"files:read", "files:write"}, resp.Scopes)
+		assert.Equal(t, "user", resp.Role)
+		assert.Equal(t, "kgym", resp.Tenant)
+	})
+
+	t.Run("should introspect access token signed with ES256 key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		key := keyentity.Key{
+			ID:        "key-456",
+			Private:   privateKey,
+			Public:    privateKey.Public(),
+			Algorithm: keyentity.AlgorithmES256,
+			State:     keyentity.StateActive,
+		}
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Subject:   "user-123",
+			Issuer:    testIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{newSigningKey(t), key}, nil)
+
+		denylistRepo.EXPECT().
+			Contains(ctx, "jti-123").
+			Return(false, nil)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token:         accessToken,
+			TokenTypeHint: TypeHintAccessToken,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Active)
+		assert.Equal(t, "user-123", resp.Subject)
+	})
+
+	t.Run("should report revoked access token as inactive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		key := newSigningKey(t)
+
+		accessToken := signAccessToken(t, key, jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Subject:   "user-123",
+			Audience:  jwt.ClaimStrings{"client-123"},
+			Issuer:    testIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{key}, nil)
+
+		denylistRepo.EXPECT().
+			Contains(ctx, "jti-123").
+			Return(true, nil)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token:         accessToken,
+			TokenTypeHint: TypeHintAccessToken,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.Active)
+		assert.Empty(t, resp.Subject)
+	})
+
+	t.Run("should report access token signed by unknown key as inactive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+
+		accessToken := signAccessToken(t, newSigningKey(t), jwt.RegisteredClaims{
+			ID:        "jti-123",
+			Issuer:    testIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(10 * time.Minute)),
+		})
+
+		keyRepo.EXPECT().
+			GetPublicKeys(ctx).
+			Return([]keyentity.Key{newSigningKey(t)}, nil)
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash(accessToken)).
+			Return(tokenmodel.Token{}, tokenrepo.ErrTokenNotFound)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token:         accessToken,
+			TokenTypeHint: TypeHintAccessToken,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.Active)
+	})
+
+	t.Run("should introspect active refresh token successfully", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+
+		token := tokenmodel.Token{
+			ID:        "token-123",
+			Subject:   "user-123",
+			ClientID:  "client-123",
+			Scopes:    []string{"files:read"},
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		}
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash(refreshToken)).
+			Return(token, nil)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token: refreshToken,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Active)
+		assert.Equal(t, TypeHintRefreshToken, resp.TokenType)
+		assert.Equal(t, token.Subject, resp.Subject)
+		assert.Equal(t, token.ClientID, resp.ClientID)
+		assert.Equal(t, token.Scopes, resp.Scopes)
+	})
+
+	t.Run("should report revoked refresh token as inactive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		keyRepo := keymocks.NewMockIRepository(ctrl)
+		denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+		service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+		ctx := context.Background()
+		refreshToken := "refresh-token-123"
+
+		tokenRepo.EXPECT().
+			GetByTokenHash(ctx, tokenentity.Hash(refreshToken)).
+			Return(tokenmodel.Token{
+				ID:        "token-123",
+				Subject:   "user-123",
+				ExpiresAt: time.Now().Add(time.Hour),
+				Revoked:   true,
+			}, nil)
+
+		resp, err := service.Introspect(ctx, IntrospectRequest{
+			Token: refreshToken,
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.Active)
+		assert.Empty(t, resp.Subject)
+	})
+}
+
+func TestService_VerifyAccessToken(t *testing.T) {
+	const jkt = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
+
+	tests := []struct {
+		name    string
+		jkt     string
+		req     VerifyAccessTokenRequest
+		revoked bool
+
+		// denylisted tells whether the token gets as far as the denylist.
+		denylisted bool
+		wantErr    error
+	}{
+		{
+			name:       "should accept unbound token sent as bearer token",
+			denylisted: true,
+		},
+		{
+			name:    "should reject unbound token sent with dpop scheme",
+			req:     VerifyAccessTokenRequest{DPoP: true, JKT: jkt},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:       "should accept bound token with proof of its key",
+			jkt:        jkt,
+			req:        VerifyAccessTokenRequest{DPoP: true, JKT: jkt},
+			denylisted: true,
+		},
+		{
+			name:    "should reject bound token sent as bearer token",
+			jkt:     jkt,
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "should reject bound token sent with dpop scheme but without proof",
+			jkt:     jkt,
+			req:     VerifyAccessTokenRequest{DPoP: true},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "should reject bound token with proof of another key",
+			jkt:     jkt,
+			req:     VerifyAccessTokenRequest{DPoP: true, JKT: "another-key"},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:       "should accept bound subject token with proof of its key",
+			jkt:        jkt,
+			req:        VerifyAccessTokenRequest{JKT: jkt},
+			denylisted: true,
+		},
+		{
+			name:       "should reject revoked token",
+			revoked:    true,
+			denylisted: true,
+			wantErr:    ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+			keyRepo := keymocks.NewMockIRepository(ctrl)
+			denylistRepo := denylistmocks.NewMockIRepository(ctrl)
+
+			service := NewService(Config{Issuer: testIssuer}, tokenRepo, keyRepo, denylistRepo, nil)
+
+			ctx := context.Background()
+			key := newSigningKey(t)
+			now := time.Now()
+
+			claims := authservice.AccessTokenClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					ID:        "jti-123",
+					Subject:   "user-123",
+					Audience:  jwt.ClaimStrings{"client-123"},
+					Issuer:    testIssuer,
+					IssuedAt:  jwt.NewNumericDate(now),
+					ExpiresAt: jwt.NewNumericDate(now.Add(10 * time.Minute)),
+				},
+			}
+			if tt.jkt != "" {
+				claims.Confirmation = &authservice.ConfirmationClaims{JKT: tt.jkt}
+			}
+
+			req := tt.req
+			req.Token = signAccessToken(t, key, claims)
+
+			keyRepo.EXPECT().
+				GetPublicKeys(ctx).
+				Return([]keyentity.Key{key}, nil)
+
+			if tt.denylisted {
+				denylistRepo.EXPECT().
+					Contains(ctx, "jti-123").
+					Return(tt.revoked, nil)
+			}
+
+			got, err := service.VerifyAccessToken(ctx, req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "user-123", got.Subject)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package tokenpurge
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+	resultSkipped = "skipped"
+)
+
+var _ prometheus.Collector = (*Metrics)(nil)
+
+// Metrics describes the purges run by a service. Register it to expose them.
+type Metrics struct {
+	purged      *prometheus.CounterVec
+	runs        *prometheus.CounterVec
+	duration    prometheus.Histogram
+	lastSuccess prometheus.Gauge
+}
+
+func NewMetrics(namespace, subsystem string) *Metrics {
+	return &Metrics{
+		purged: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "tokens_purged_total",
+			Help:      "Number of tokens deleted by the purge, by reason.",
+		}, []string{"reason"}),
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "token_purge_runs_total",
+			Help:      "Number of token purges, by result.",
+		}, []string{"result"}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "token_purge_duration_seconds",
+			Help:      "Duration of token purges that acquired the lock.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 4, 8),
+		}),
+		lastSuccess: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "token_purge_last_success_timestamp_seconds",
+			Help:      "Time the last token purge completed.",
+		}),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.purged.Describe(ch)
+	m.runs.Describe(ch)
+	m.duration.Describe(ch)
+	m.lastSuccess.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.purged.Collect(ch)
+	m.runs.Collect(ch)
+	m.duration.Collect(ch)
+	m.lastSuccess.Collect(ch)
+}
+
+func (m *Metrics) observePurged(reason string, count int64) {
+	m.purged.WithLabelValues(reason).Add(float64(count))
+}
+
+func (m *Metrics) observeRun(startedAt time.Time, err error) {
+	switch {
+	case errors.Is(err, ErrPurgeInProgress):
+		m.runs.WithLabelValues(resultSkipped).Inc()
+		return
+	case err != nil:
+		m.runs.WithLabelValues(resultFailure).Inc()
+	default:
+		m.runs.WithLabelValues(resultSuccess).Inc()
+		m.lastSuccess.SetToCurrentTime()
+	}
+
+	m.duration.Observe(time.Since(startedAt).Seconds())
+}
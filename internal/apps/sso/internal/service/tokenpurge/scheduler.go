@@ -0,0 +1,50 @@
+package tokenpurge
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Scheduler purges tokens periodically. Several replicas may run one; the
+// lock taken by Purge makes sure only one of them purges at a time.
+type Scheduler struct {
+	purgeService IService
+
+	interval time.Duration
+}
+
+func NewScheduler(purgeService IService, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		purgeService: purgeService,
+		interval:     interval,
+	}
+}
+
+// Run blocks until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.tick(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	_, err := s.purgeService.Purge(ctx)
+	if err == nil || errors.Is(err, ErrPurgeInProgress) || ctx.Err() != nil {
+		return
+	}
+
+	log.Error().Err(err).Msg("failed to purge tokens")
+}
@@ -0,0 +1,49 @@
+package tokenpurge
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPurgeInProgress = errors.New("token purge already in progress")
+	ErrLockLost        = errors.New("token purge lock lost")
+)
+
+const (
+	LockName = "tokens:purge"
+
+	ReasonExpired = "expired"
+	ReasonRevoked = "revoked"
+
+	EventTokensPurged = "tokens_purged"
+)
+
+type Config struct {
+	// ExpiredRetention is how long tokens are kept after they expire.
+	ExpiredRetention time.Duration
+	// RevokedRetention is how long revoked and used tokens are kept. Replaying
+	// a used refresh token revokes its whole family, which only works while
+	// the token is still stored.
+	RevokedRetention time.Duration
+	// BatchSize bounds the number of rows deleted by a single statement.
+	BatchSize uint64
+	// LockTTL is how long the lock is held without a batch completing. It
+	// must be longer than a batch takes.
+	LockTTL time.Duration
+}
+
+type IService interface {
+	// Purge deletes expired and revoked tokens past their retention, in
+	// batches, until none are left. Only one purge runs at a time across
+	// replicas; ErrPurgeInProgress is returned while another one holds the
+	// lock.
+	Purge(ctx context.Context) (PurgeResponse, error)
+}
+
+type PurgeResponse struct {
+	Expired int64
+	Revoked int64
+}
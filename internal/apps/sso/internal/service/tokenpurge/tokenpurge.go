@@ -0,0 +1,108 @@
+package tokenpurge
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	lockrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock"
+	tokenrepo "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token"
+	"github.com/rs/zerolog/log"
+)
+
+var _ IService = (*Service)(nil)
+
+type Service struct {
+	cfg Config
+
+	tokenRepository tokenrepo.IRepository
+	lockRepository  lockrepo.IRepository
+	metrics         *Metrics
+
+	owner string
+}
+
+func NewService(cfg Config, tokenRepository tokenrepo.IRepository, lockRepository lockrepo.IRepository, metrics *Metrics) *Service {
+	return &Service{
+		cfg:             cfg,
+		tokenRepository: tokenRepository,
+		lockRepository:  lockRepository,
+		metrics:         metrics,
+		owner:           uuid.NewString(),
+	}
+}
+
+func (s *Service) Purge(ctx context.Context) (resp PurgeResponse, err error) {
+	startedAt := time.Now()
+	defer func() {
+		s.metrics.observeRun(startedAt, err)
+	}()
+
+	acquired, err := s.lockRepository.Acquire(ctx, LockName, s.owner, s.cfg.LockTTL)
+	if err != nil {
+		return PurgeResponse{}, err
+	}
+
+	if !acquired {
+		return PurgeResponse{}, ErrPurgeInProgress
+	}
+
+	defer func() {
+		if err := s.lockRepository.Release(context.WithoutCancel(ctx), LockName, s.owner); err != nil {
+			log.Error().Err(err).Msg("failed to release token purge lock")
+		}
+	}()
+
+	resp.Expired, err = s.purge(ctx, ReasonExpired, startedAt.Add(-s.cfg.ExpiredRetention), s.tokenRepository.DeleteExpired)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Revoked, err = s.purge(ctx, ReasonRevoked, startedAt.Add(-s.cfg.RevokedRetention), s.tokenRepository.DeleteRevoked)
+	if err != nil {
+		return resp, err
+	}
+
+	log.Info().
+		Str("event", EventTokensPurged).
+		Int64("expired", resp.Expired).
+		Int64("revoked", resp.Revoked).
+		Dur("duration", time.Since(startedAt)).
+		Msg("tokens purged")
+
+	return resp, nil
+}
+
+// purge deletes batches until one comes back short. The lock is extended
+// after every batch, so that a long purge keeps it while one that died
+// releases it soon.
+func (s *Service) purge(ctx context.Context, reason string, before time.Time, deleteBatch func(context.Context, time.Time, uint64) (int64, error)) (int64, error) {
+	var total int64
+
+	for {
+		deleted, err := deleteBatch(ctx, before, s.cfg.BatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += deleted
+		s.metrics.observePurged(reason, deleted)
+
+		if uint64(deleted) < s.cfg.BatchSize {
+			return total, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		acquired, err := s.lockRepository.Acquire(ctx, LockName, s.owner, s.cfg.LockTTL)
+		if err != nil {
+			return total, err
+		}
+
+		if !acquired {
+			return total, ErrLockLost
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package tokenpurge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lockmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/lock/mocks"
+	tokenmocks "github.com/kitanoyoru/kgym/internal/apps/sso/internal/repository/token/mocks"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = Config{
+	ExpiredRetention: 24 * time.Hour,
+	RevokedRetention: 30 * 24 * time.Hour,
+	BatchSize:        2,
+	LockTTL:          time.Minute,
+}
+
+func TestService_Purge(t *testing.T) {
+	t.Run("should delete in batches until a batch comes back short", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		lockRepo := lockmocks.NewMockIRepository(ctrl)
+		metrics := NewMetrics("kgym", "sso")
+
+		service := NewService(testConfig, tokenRepo, lockRepo, metrics)
+
+		ctx := context.Background()
+		startedAt := time.Now()
+
+		// One acquisition to start and one to extend the lock after each full
+		// batch.
+		lockRepo.EXPECT().
+			Acquire(ctx, LockName, service.owner, testConfig.LockTTL).
+			Return(true, nil).
+			Times(3)
+
+		lockRepo.EXPECT().
+			Release(gomock.Any(), LockName, service.owner).
+			Return(nil)
+
+		expiredBefore := gomock.Cond(func(before time.Time) bool {
+			return before.Before(startedAt.Add(-testConfig.ExpiredRetention).Add(time.Second)) &&
+				before.After(startedAt.Add(-testConfig.ExpiredRetention).Add(-time.Second))
+		})
+
+		gomock.InOrder(
+			tokenRepo.EXPECT().DeleteExpired(ctx, expiredBefore, testConfig.BatchSize).Return(int64(2), nil),
+			tokenRepo.EXPECT().DeleteExpired(ctx, expiredBefore, testConfig.BatchSize).Return(int64(2), nil),
+			tokenRepo.EXPECT().DeleteExpired(ctx, expiredBefore, testConfig.BatchSize).Return(int64(1), nil),
+		)
+
+		tokenRepo.EXPECT().
+			DeleteRevoked(ctx, gomock.Any(), testConfig.BatchSize).
+			Return(int64(0), nil)
+
+		resp, err := service.Purge(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, PurgeResponse{Expired: 5, Revoked: 0}, resp)
+
+		assert.Equal(t, float64(5), testutil.ToFloat64(metrics.purged.WithLabelValues(ReasonExpired)))
+		assert.Equal(t, float64(0), testutil.ToFloat64(metrics.purged.WithLabelValues(ReasonRevoked)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.runs.WithLabelValues(resultSuccess)))
+	})
+
+	t.Run("should skip while another purge holds the lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		lockRepo := lockmocks.NewMockIRepository(ctrl)
+		metrics := NewMetrics("kgym", "sso")
+
+		service := NewService(testConfig, tokenRepo, lockRepo, metrics)
+
+		ctx := context.Background()
+
+		lockRepo.EXPECT().
+			Acquire(ctx, LockName, service.owner, testConfig.LockTTL).
+			Return(false, nil)
+
+		_, err := service.Purge(ctx)
+		assert.ErrorIs(t, err, ErrPurgeInProgress)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.runs.WithLabelValues(resultSkipped)))
+	})
+
+	t.Run("should stop when the lock is lost", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		lockRepo := lockmocks.NewMockIRepository(ctrl)
+		metrics := NewMetrics("kgym", "sso")
+
+		service := NewService(testConfig, tokenRepo, lockRepo, metrics)
+
+		ctx := context.Background()
+
+		gomock.InOrder(
+			lockRepo.EXPECT().Acquire(ctx, LockName, service.owner, testConfig.LockTTL).Return(true, nil),
+			lockRepo.EXPECT().Acquire(ctx, LockName, service.owner, testConfig.LockTTL).Return(false, nil),
+		)
+
+		lockRepo.EXPECT().
+			Release(gomock.Any(), LockName, service.owner).
+			Return(nil)
+
+		tokenRepo.EXPECT().
+			DeleteExpired(ctx, gomock.Any(), testConfig.BatchSize).
+			Return(int64(2), nil)
+
+		resp, err := service.Purge(ctx)
+		assert.ErrorIs(t, err, ErrLockLost)
+		assert.Equal(t, int64(2), resp.Expired)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.runs.WithLabelValues(resultFailure)))
+	})
+
+	t.Run("should release the lock when a batch fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tokenRepo := tokenmocks.NewMockIRepository(ctrl)
+		lockRepo := lockmocks.NewMockIRepository(ctrl)
+
+		service := NewService(testConfig, tokenRepo, lockRepo, NewMetrics("kgym", "sso"))
+
+		ctx := context.Background()
+		dbErr := errors.New("connection reset")
+
+		lockRepo.EXPECT().
+			Acquire(ctx, LockName, service.owner, testConfig.LockTTL).
+			Return(true, nil)
+
+		lockRepo.EXPECT().
+			Release(gomock.Any(), LockName, service.owner).
+			Return(nil)
+
+		tokenRepo.EXPECT().
+			DeleteExpired(ctx, gomock.Any(), testConfig.BatchSize).
+			Return(int64(0), nil)
+
+		tokenRepo.EXPECT().
+			DeleteRevoked(ctx, gomock.Any(), testConfig.BatchSize).
+			Return(int64(0), dbErr)
+
+		_, err := service.Purge(ctx)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
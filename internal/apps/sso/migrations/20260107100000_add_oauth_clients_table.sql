@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS oauth_clients (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    confidential BOOLEAN NOT NULL DEFAULT FALSE,
+    secret_hash VARCHAR(255) NULL,
+    previous_secret_hash VARCHAR(255) NULL,
+    previous_secret_expires_at TIMESTAMP WITH TIME ZONE NULL,
+    grant_types TEXT[] NOT NULL DEFAULT '{}',
+    redirect_uris TEXT[] NOT NULL DEFAULT '{}',
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    access_token_ttl_seconds BIGINT NOT NULL,
+    refresh_token_ttl_seconds BIGINT NOT NULL,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMP WITH TIME ZONE NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS oauth_clients CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(64) NOT NULL,
+    secret_hash VARCHAR(255) NOT NULL,
+    owner_type VARCHAR(16) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NULL,
+
+    CONSTRAINT api_keys_prefix_unique UNIQUE (prefix)
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_owner_idx ON api_keys (owner_type, owner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS api_keys_owner_idx;
+DROP TABLE IF EXISTS api_keys CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS tokens_expires_at_idx ON tokens (expires_at);
+CREATE INDEX IF NOT EXISTS tokens_revoked_updated_at_idx ON tokens (updated_at) WHERE revoked;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS tokens_revoked_updated_at_idx;
+DROP INDEX IF EXISTS tokens_expires_at_idx;
+-- +goose StatementEnd
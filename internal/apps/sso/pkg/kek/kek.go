@@ -0,0 +1,143 @@
+// Package kek encrypts secrets at rest under a key-encryption key (KEK).
+//
+// Secrets are sealed with a fresh data key, and only the data key is wrapped
+// with the KEK. Rotating the KEK therefore only means re-wrapping data keys,
+// and a provider backed by a KMS never has to see the secrets themselves.
+package kek
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KeySize is the size of KEKs and data keys, i.e. AES-256.
+	KeySize = 32
+)
+
+var (
+	ErrUnknownKey = errors.New("unknown key encryption key")
+	ErrInvalidKey = errors.New("invalid key encryption key")
+	ErrDecrypt    = errors.New("failed to decrypt")
+)
+
+// Provider wraps data keys with a KEK. Wrap always uses the current KEK,
+// Unwrap must also accept previous ones until every envelope has been
+// re-wrapped.
+type Provider interface {
+	CurrentKeyID(ctx context.Context) (string, error)
+	Wrap(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+	Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+type Envelope struct {
+	KeyID      string `json:"kek_id"`
+	WrappedKey []byte `json:"wrapped_key"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Seal encrypts plaintext under a new data key. additionalData is
+// authenticated but not encrypted; it binds the envelope to its owner so
+// envelopes cannot be swapped between records.
+func Seal(ctx context.Context, provider Provider, plaintext, additionalData []byte) (Envelope, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return Envelope{}, err
+	}
+
+	ciphertext, err := encrypt(dataKey, plaintext, additionalData)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	keyID, wrapped, err := provider.Wrap(ctx, dataKey)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		KeyID:      keyID,
+		WrappedKey: wrapped,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func Open(ctx context.Context, provider Provider, envelope Envelope, additionalData []byte) ([]byte, error) {
+	dataKey, err := provider.Unwrap(ctx, envelope.KeyID, envelope.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(dataKey, envelope.Ciphertext, additionalData)
+}
+
+// Rewrap moves envelope to the current KEK. The data key and ciphertext stay
+// the same.
+func Rewrap(ctx context.Context, provider Provider, envelope Envelope) (Envelope, error) {
+	dataKey, err := provider.Unwrap(ctx, envelope.KeyID, envelope.WrappedKey)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	keyID, wrapped, err := provider.Wrap(ctx, dataKey)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	envelope.KeyID = keyID
+	envelope.WrappedKey = wrapped
+
+	return envelope, nil
+}
+
+// encrypt seals plaintext with AES-GCM and prepends the nonce.
+func encrypt(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func decrypt(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,148 @@
+package kek
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T, id string) Key {
+	t.Helper()
+
+	secret := make([]byte, KeySize)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	return Key{ID: id, Secret: secret}
+}
+
+func TestSeal(t *testing.T) {
+	t.Run("should open sealed envelope", func(t *testing.T) {
+		provider, err := NewStatic(newTestKey(t, "kek-1"))
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		envelope, err := Seal(ctx, provider, []byte("secret"), []byte("kid-1"))
+		require.NoError(t, err)
+		assert.Equal(t, "kek-1", envelope.KeyID)
+		assert.NotContains(t, string(envelope.Ciphertext), "secret")
+
+		plaintext, err := Open(ctx, provider, envelope, []byte("kid-1"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), plaintext)
+	})
+
+	t.Run("should reject envelope bound to other data", func(t *testing.T) {
+		provider, err := NewStatic(newTestKey(t, "kek-1"))
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		envelope, err := Seal(ctx, provider, []byte("secret"), []byte("kid-1"))
+		require.NoError(t, err)
+
+		_, err = Open(ctx, provider, envelope, []byte("kid-2"))
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("should reject envelope sealed under unknown kek", func(t *testing.T) {
+		provider, err := NewStatic(newTestKey(t, "kek-1"))
+		require.NoError(t, err)
+
+		other, err := NewStatic(newTestKey(t, "kek-2"))
+		require.NoError(t, err)
+
+		ctx := context.Background()
+
+		envelope, err := Seal(ctx, other, []byte("secret"), nil)
+		require.NoError(t, err)
+
+		_, err = Open(ctx, provider, envelope, nil)
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+}
+
+func TestRewrap(t *testing.T) {
+	t.Run("should move envelope to the current kek", func(t *testing.T) {
+		previous := newTestKey(t, "kek-1")
+		current := newTestKey(t, "kek-2")
+
+		ctx := context.Background()
+
+		oldProvider, err := NewStatic(previous)
+		require.NoError(t, err)
+
+		envelope, err := Seal(ctx, oldProvider, []byte("secret"), nil)
+		require.NoError(t, err)
+
+		provider, err := NewStatic(current, previous)
+		require.NoError(t, err)
+
+		rewrapped, err := Rewrap(ctx, provider, envelope)
+		require.NoError(t, err)
+		assert.Equal(t, "kek-2", rewrapped.KeyID)
+		assert.Equal(t, envelope.Ciphertext, rewrapped.Ciphertext)
+
+		newProvider, err := NewStatic(current)
+		require.NoError(t, err)
+
+		plaintext, err := Open(ctx, newProvider, rewrapped, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), plaintext)
+	})
+}
+
+func TestParseStatic(t *testing.T) {
+	t.Run("should parse keys with the first one current", func(t *testing.T) {
+		first := newTestKey(t, "kek-2")
+		second := newTestKey(t, "kek-1")
+
+		value := first.ID + ":" + base64.StdEncoding.EncodeToString(first.Secret) + "," +
+			second.ID + ":" + base64.StdEncoding.EncodeToString(second.Secret)
+
+		provider, err := ParseStatic(value)
+		require.NoError(t, err)
+
+		current, err := provider.CurrentKeyID(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "kek-2", current)
+		assert.Len(t, provider.keys, 2)
+	})
+
+	t.Run("should read keys from file", func(t *testing.T) {
+		key := newTestKey(t, "kek-1")
+
+		path := filepath.Join(t.TempDir(), "kek")
+		err := os.WriteFile(path, []byte(key.ID+":"+base64.StdEncoding.EncodeToString(key.Secret)+"\n"), 0o600)
+		require.NoError(t, err)
+
+		provider, err := ReadStaticFile(path)
+		require.NoError(t, err)
+
+		current, err := provider.CurrentKeyID(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "kek-1", current)
+	})
+
+	t.Run("should return ErrInvalidKey for malformed values", func(t *testing.T) {
+		cases := map[string]string{
+			"empty":         "",
+			"missing id":    base64.StdEncoding.EncodeToString(make([]byte, KeySize)),
+			"short secret":  "kek-1:" + base64.StdEncoding.EncodeToString(make([]byte, 16)),
+			"not base64":    "kek-1:not base64!",
+			"duplicate ids": "kek-1:" + base64.StdEncoding.EncodeToString(make([]byte, KeySize)) + ",kek-1:" + base64.StdEncoding.EncodeToString(make([]byte, KeySize)),
+		}
+
+		for name, value := range cases {
+			_, err := ParseStatic(value)
+			assert.ErrorIs(t, err, ErrInvalidKey, name)
+		}
+	})
+}
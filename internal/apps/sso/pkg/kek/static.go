@@ -0,0 +1,113 @@
+package kek
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var _ Provider = (*Static)(nil)
+
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// Static holds KEKs in memory, e.g. read from the environment or a mounted
+// secret file. The first key is current, the rest are kept to unwrap
+// envelopes that have not been re-wrapped yet.
+type Static struct {
+	current string
+	keys    map[string][]byte
+}
+
+func NewStatic(keys ...Key) (*Static, error) {
+	if len(keys) == 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "no keys configured")
+	}
+
+	s := &Static{
+		current: keys[0].ID,
+		keys:    make(map[string][]byte, len(keys)),
+	}
+
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.Wrap(ErrInvalidKey, "empty key id")
+		}
+		if len(key.Secret) != KeySize {
+			return nil, errors.Wrapf(ErrInvalidKey, "key %q must be %d bytes", key.ID, KeySize)
+		}
+		if _, ok := s.keys[key.ID]; ok {
+			return nil, errors.Wrapf(ErrInvalidKey, "duplicate key id %q", key.ID)
+		}
+
+		s.keys[key.ID] = key.Secret
+	}
+
+	return s, nil
+}
+
+// ParseStatic reads keys written as "<id>:<base64 secret>", separated by
+// commas or newlines, current key first.
+func ParseStatic(value string) (*Static, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+
+	keys := make([]Key, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(field, ":")
+		if !ok {
+			return nil, errors.Wrap(ErrInvalidKey, "expected <id>:<base64 secret>")
+		}
+
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidKey, "key %q is not base64", id)
+		}
+
+		keys = append(keys, Key{ID: id, Secret: secret})
+	}
+
+	return NewStatic(keys...)
+}
+
+func ReadStaticFile(path string) (*Static, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseStatic(string(data))
+}
+
+func (s *Static) CurrentKeyID(_ context.Context) (string, error) {
+	return s.current, nil
+}
+
+func (s *Static) Wrap(_ context.Context, dataKey []byte) (string, []byte, error) {
+	wrapped, err := encrypt(s.keys[s.current], dataKey, []byte(s.current))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return s.current, wrapped, nil
+}
+
+func (s *Static) Unwrap(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownKey, keyID)
+	}
+
+	return decrypt(key, wrapped, []byte(keyID))
+}
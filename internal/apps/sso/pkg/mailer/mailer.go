@@ -0,0 +1,34 @@
+// Package mailer sends transactional emails such as password reset links.
+package mailer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidHeader = errors.New("invalid mail header")
+)
+
+type Message struct {
+	To      string
+	Subject string
+	// Body is sent as plain text.
+	Body string
+}
+
+// Mailer delivers messages. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// validate rejects header values that could inject further headers.
+func (m Message) validate() error {
+	if m.To == "" || strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return ErrInvalidHeader
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts a single message and returns its envelope and data.
+func fakeSMTPServer(t *testing.T) (string, int, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		_ = text.PrintfLine("220 localhost ESMTP")
+
+		var transcript strings.Builder
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+			case "EHLO", "HELO":
+				_ = text.PrintfLine("250 localhost")
+			case "MAIL", "RCPT":
+				transcript.WriteString(line + "\n")
+				_ = text.PrintfLine("250 OK")
+			case "DATA":
+				_ = text.PrintfLine("354 Go ahead")
+				data, err := text.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				transcript.Write(data)
+				_ = text.PrintfLine("250 OK")
+			case "QUIT":
+				_ = text.PrintfLine("221 Bye")
+				received <- transcript.String()
+				return
+			default:
+				_ = text.PrintfLine("502 Not implemented")
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return host, portNumber, received
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Run("should deliver message to relay", func(t *testing.T) {
+		host, port, received := fakeSMTPServer(t)
+
+		mailer := NewSMTP(SMTPConfig{
+			Host: host,
+			Port: port,
+			From: "no-reply@kgym.test",
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := mailer.Send(ctx, Message{
+			To:      "user@example.com",
+			Subject: "Reset your password",
+			Body:    "Follow the link to reset your password.",
+		})
+		require.NoError(t, err)
+
+		transcript := <-received
+		assert.Contains(t, transcript, "MAIL FROM:<no-reply@kgym.test>")
+		assert.Contains(t, transcript, "RCPT TO:<user@example.com>")
+		assert.Contains(t, transcript, "Subject: Reset your password")
+		assert.Contains(t, transcript, "Follow the link to reset your password.")
+	})
+
+	t.Run("should reject header injection", func(t *testing.T) {
+		mailer := NewSMTP(SMTPConfig{Host: "127.0.0.1", Port: 25})
+
+		err := mailer.Send(context.Background(), Message{
+			To:      "user@example.com\r\nBcc: victim@example.com",
+			Subject: "Hello",
+		})
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	})
+}
+
+func TestSMTP_format(t *testing.T) {
+	t.Run("should encode non-ascii subject", func(t *testing.T) {
+		mailer := NewSMTP(SMTPConfig{From: "no-reply@kgym.test"})
+
+		data := mailer.format(Message{
+			To:      "user@example.com",
+			Subject: "Привет",
+			Body:    "body",
+		}, time.Now())
+
+		header, err := textproto.NewReader(bufio.NewReader(strings.NewReader(string(data)))).ReadMIMEHeader()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(header.Get("Subject"), "=?utf-8?q?"))
+		assert.Equal(t, "text/plain; charset=utf-8", header.Get("Content-Type"))
+	})
+}
+
+func TestMemory(t *testing.T) {
+	t.Run("should keep sent messages", func(t *testing.T) {
+		mailer := NewMemory()
+		ctx := context.Background()
+
+		require.NoError(t, mailer.Send(ctx, Message{To: "a@example.com", Subject: "first"}))
+		require.NoError(t, mailer.Send(ctx, Message{To: "b@example.com", Subject: "second"}))
+		require.NoError(t, mailer.Send(ctx, Message{To: "a@example.com", Subject: "third"}))
+
+		assert.Len(t, mailer.Messages(), 3)
+
+		msg, ok := mailer.Last("a@example.com")
+		require.True(t, ok)
+		assert.Equal(t, "third", msg.Subject)
+
+		_, ok = mailer.Last("c@example.com")
+		assert.False(t, ok)
+
+		mailer.Reset()
+		assert.Empty(t, mailer.Messages())
+	})
+}
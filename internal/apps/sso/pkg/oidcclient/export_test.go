@@ -0,0 +1,9 @@
+package oidcclient
+
+// AllowKeysRefresh lets the next unknown key refetch the key set right away.
+func AllowKeysRefresh(p *Provider) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keysRefreshedAt = p.keysRefreshedAt.Add(-MinKeysRefreshInterval)
+}
@@ -0,0 +1,415 @@
+// Package oidcclient is an OpenID Connect relying party for the authorization
+// code flow with PKCE. It discovers the endpoints of a provider, exchanges
+// codes for tokens and validates the ID tokens it receives.
+package oidcclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+
+	ScopeOpenID = "openid"
+
+	CodeChallengeMethodS256 = "S256"
+
+	// Leeway is the clock skew tolerated when validating ID tokens.
+	Leeway = 1 * time.Minute
+
+	// KeysTTL is how long a fetched key set is used before it is refreshed.
+	KeysTTL = 1 * time.Hour
+	// MinKeysRefreshInterval limits how often the key set is refetched
+	// because a token was signed with an unknown key.
+	MinKeysRefreshInterval = 1 * time.Minute
+
+	maxResponseSize = 1 << 20 // 1MB
+)
+
+var (
+	ErrDiscovery      = errors.New("oidc discovery failed")
+	ErrIssuerMismatch = errors.New("oidc issuer mismatch")
+	ErrExchange       = errors.New("oidc code exchange failed")
+	ErrMissingIDToken = errors.New("oidc token response has no id token")
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrUnknownKey     = errors.New("unknown id token signing key")
+)
+
+// signingMethods are the algorithms ID tokens may be signed with. The none
+// algorithm and HMAC, which would need the client secret, are never accepted.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+type Config struct {
+	// Issuer is the issuer identifier of the provider. The discovery
+	// document is fetched from below it and must name the same issuer.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	// Scopes are requested in addition to openid.
+	Scopes []string
+
+	// HTTPClient is used for every request to the provider. It defaults to
+	// a client with a 10 second timeout.
+	HTTPClient *http.Client
+}
+
+// Metadata is the part of the provider metadata described in OpenID Connect
+// Discovery 1.0 the client relies on.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint,omitempty"`
+}
+
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+}
+
+// Claims are the standard claims of an ID token.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	Nonce             string `json:"nonce,omitempty"`
+	AuthorizedParty   string `json:"azp,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	GivenName         string `json:"given_name,omitempty"`
+	FamilyName        string `json:"family_name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Picture           string `json:"picture,omitempty"`
+}
+
+// Provider is a single upstream OpenID provider. It is safe for concurrent
+// use. Discovery happens on first use, so a provider that is down at start
+// up does not keep the others from working.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu              sync.Mutex
+	metadata        *Metadata
+	keys            map[string]any
+	keysFetchedAt   time.Time
+	keysRefreshedAt time.Time
+}
+
+func New(cfg Config) *Provider {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// Metadata returns the discovered provider metadata.
+func (p *Provider) Metadata(ctx context.Context) (Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	return *metadata, nil
+}
+
+// AuthCodeURL returns the URL to send the user agent to. state and nonce
+// should be unguessable; codeChallenge is the S256 challenge of the code
+// verifier later passed to Exchange.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(ErrDiscovery, err.Error())
+	}
+
+	query := endpoint.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.scopes(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", CodeChallengeMethodS256)
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint.String(), nil
+}
+
+// Exchange redeems an authorization code at the token endpoint. The client
+// authenticates with client_secret_basic if it has a secret.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (Token, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		// RFC 6749 section 2.3.1 wants both parts form-encoded first.
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return Token{}, errors.Wrap(ErrExchange, err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return Token{}, errors.Wrap(ErrExchange, err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &tokenErr) == nil && tokenErr.Error != "" {
+			return Token{}, errors.Wrapf(ErrExchange, "%s: %s", tokenErr.Error, tokenErr.ErrorDescription)
+		}
+		return Token{}, errors.Wrapf(ErrExchange, "unexpected status %d", resp.StatusCode)
+	}
+
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return Token{}, errors.Wrap(ErrExchange, err.Error())
+	}
+
+	if token.IDToken == "" {
+		return Token{}, ErrMissingIDToken
+	}
+
+	return token, nil
+}
+
+// VerifyIDToken validates the signature and the claims of an ID token as
+// described in OpenID Connect Core 1.0 section 3.1.3.7, including that it
+// was issued for the given nonce.
+func (p *Provider) VerifyIDToken(ctx context.Context, raw, nonce string) (Claims, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	var claims Claims
+	_, err = jwt.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(Leeway),
+	)
+	if err != nil {
+		if errors.Is(err, ErrUnknownKey) {
+			return Claims{}, ErrUnknownKey
+		}
+		return Claims{}, errors.Wrap(ErrInvalidIDToken, err.Error())
+	}
+
+	if claims.Subject == "" {
+		return Claims{}, errors.Wrap(ErrInvalidIDToken, "missing subject")
+	}
+
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID {
+		return Claims{}, errors.Wrap(ErrInvalidIDToken, "unexpected authorized party")
+	}
+
+	if nonce == "" || claims.Nonce != nonce {
+		return Claims{}, errors.Wrap(ErrInvalidIDToken, "nonce mismatch")
+	}
+
+	return claims, nil
+}
+
+func (p *Provider) scopes() []string {
+	scopes := []string{ScopeOpenID}
+	for _, scope := range p.cfg.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+// discover fetches the provider metadata unless it already has. The caller
+// must hold p.mu.
+func (p *Provider) discover(ctx context.Context) (*Metadata, error) {
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	var metadata Metadata
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.cfg.Issuer, "/")+DiscoveryPath, &metadata); err != nil {
+		return nil, errors.Wrap(ErrDiscovery, err.Error())
+	}
+
+	if metadata.Issuer != p.cfg.Issuer {
+		return nil, ErrIssuerMismatch
+	}
+
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.Wrap(ErrDiscovery, "missing endpoints")
+	}
+
+	p.metadata = &metadata
+
+	return p.metadata, nil
+}
+
+// key returns the verification key with the given id. The key set is
+// refetched when it is stale or does not have the key, since providers
+// publish new keys before signing with them.
+func (p *Provider) key(ctx context.Context, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	if p.keys == nil || now.Sub(p.keysFetchedAt) > KeysTTL {
+		if err := p.fetchKeys(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+
+	if now.Sub(p.keysRefreshedAt) < MinKeysRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	if err := p.fetchKeys(ctx, now); err != nil {
+		return nil, err
+	}
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+
+	return nil, ErrUnknownKey
+}
+
+// lookupKey finds a key by id. A token without a key id can only be
+// verified if the provider publishes a single key.
+func (p *Provider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+
+	return key, ok
+}
+
+// fetchKeys replaces the cached key set. The caller must hold p.mu.
+func (p *Provider) fetchKeys(ctx context.Context, now time.Time) error {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.keysRefreshedAt = now
+
+	var set JSONWebKeySet
+	if err := p.getJSON(ctx, metadata.JWKSURI, &set); err != nil {
+		return errors.Wrap(ErrDiscovery, err.Error())
+	}
+
+	p.keys = set.PublicKeys()
+	p.keysFetchedAt = now
+
+	return nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
+
+// NewCodeVerifier returns a random PKCE code verifier as described in RFC
+// 7636 section 4.1.
+func NewCodeVerifier() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallengeS256 derives the S256 code challenge of a code verifier.
+func CodeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
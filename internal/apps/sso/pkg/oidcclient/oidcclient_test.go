@@ -0,0 +1,225 @@
+package oidcclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient/oidctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID     = "kgym"
+	clientSecret = "secret"
+	redirectURL  = "https://gateway.kgym.test/authorize/federation/callback"
+)
+
+func newProvider(t *testing.T) (*oidctest.Server, *oidcclient.Provider) {
+	t.Helper()
+
+	server := oidctest.NewServer(oidctest.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	})
+	t.Cleanup(server.Close)
+
+	provider := oidcclient.New(oidcclient.Config{
+		Issuer:       server.Issuer(),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"email", "profile"},
+	})
+
+	return server, provider
+}
+
+// authorize follows the authorization URL and returns the code the provider
+// redirected back with.
+func authorize(t *testing.T, authURL, state string) string {
+	t.Helper()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, state, location.Query().Get("state"))
+
+	return location.Query().Get("code")
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+
+	identity := oidctest.Identity{
+		Subject:       "upstream-user",
+		Email:         "jane@corp.test",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+	}
+
+	t.Run("should complete the authorization code flow", func(t *testing.T) {
+		server, provider := newProvider(t)
+		server.SetIdentity(identity)
+
+		verifier, err := oidcclient.NewCodeVerifier()
+		require.NoError(t, err)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", oidcclient.CodeChallengeS256(verifier))
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, "openid email profile", parsed.Query().Get("scope"))
+		assert.Equal(t, redirectURL, parsed.Query().Get("redirect_uri"))
+
+		code := authorize(t, authURL, "state")
+
+		token, err := provider.Exchange(ctx, code, verifier)
+		require.NoError(t, err)
+
+		claims, err := provider.VerifyIDToken(ctx, token.IDToken, "nonce")
+		require.NoError(t, err)
+		assert.Equal(t, identity.Subject, claims.Subject)
+		assert.Equal(t, identity.Email, claims.Email)
+		assert.True(t, claims.EmailVerified)
+		assert.Equal(t, identity.Name, claims.Name)
+	})
+
+	t.Run("should reject the exchange with the wrong code verifier", func(t *testing.T) {
+		server, provider := newProvider(t)
+		server.SetIdentity(identity)
+
+		verifier, err := oidcclient.NewCodeVerifier()
+		require.NoError(t, err)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", oidcclient.CodeChallengeS256(verifier))
+		require.NoError(t, err)
+
+		code := authorize(t, authURL, "state")
+
+		otherVerifier, err := oidcclient.NewCodeVerifier()
+		require.NoError(t, err)
+
+		_, err = provider.Exchange(ctx, code, otherVerifier)
+		assert.ErrorIs(t, err, oidcclient.ErrExchange)
+	})
+
+	t.Run("should reject an issuer mismatch", func(t *testing.T) {
+		server, _ := newProvider(t)
+
+		provider := oidcclient.New(oidcclient.Config{
+			Issuer:   server.Issuer() + "/",
+			ClientID: clientID,
+		})
+
+		_, err := provider.Metadata(ctx)
+		assert.ErrorIs(t, err, oidcclient.ErrIssuerMismatch)
+	})
+
+	t.Run("should reject invalid id tokens", func(t *testing.T) {
+		server, provider := newProvider(t)
+
+		testCases := []struct {
+			name   string
+			modify func(claims *oidcclient.Claims)
+		}{
+			{
+				name:   "wrong nonce",
+				modify: func(claims *oidcclient.Claims) { claims.Nonce = "other" },
+			},
+			{
+				name:   "wrong issuer",
+				modify: func(claims *oidcclient.Claims) { claims.Issuer = "https://evil.test" },
+			},
+			{
+				name:   "wrong audience",
+				modify: func(claims *oidcclient.Claims) { claims.Audience = jwt.ClaimStrings{"other"} },
+			},
+			{
+				name: "other authorized party",
+				modify: func(claims *oidcclient.Claims) {
+					claims.Audience = jwt.ClaimStrings{clientID, "other"}
+					claims.AuthorizedParty = "other"
+				},
+			},
+			{
+				name: "expired",
+				modify: func(claims *oidcclient.Claims) {
+					claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				},
+			},
+			{
+				name:   "no expiry",
+				modify: func(claims *oidcclient.Claims) { claims.ExpiresAt = nil },
+			},
+			{
+				name:   "no subject",
+				modify: func(claims *oidcclient.Claims) { claims.Subject = "" },
+			},
+		}
+
+		for _, tc := range testCases {
+			claims := server.IDTokenClaims(identity, "nonce")
+			tc.modify(&claims)
+
+			raw, err := server.SignIDToken(claims)
+			require.NoError(t, err)
+
+			_, err = provider.VerifyIDToken(ctx, raw, "nonce")
+			assert.ErrorIs(t, err, oidcclient.ErrInvalidIDToken, tc.name)
+		}
+	})
+
+	t.Run("should reject id tokens signed with another algorithm", func(t *testing.T) {
+		server, provider := newProvider(t)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, server.IDTokenClaims(identity, "nonce"))
+		raw, err := token.SignedString([]byte(clientSecret))
+		require.NoError(t, err)
+
+		_, err = provider.VerifyIDToken(ctx, raw, "nonce")
+		assert.ErrorIs(t, err, oidcclient.ErrInvalidIDToken)
+	})
+
+	t.Run("should refetch the key set when the key is unknown", func(t *testing.T) {
+		server, provider := newProvider(t)
+
+		raw, err := server.SignIDToken(server.IDTokenClaims(identity, "nonce"))
+		require.NoError(t, err)
+
+		_, err = provider.VerifyIDToken(ctx, raw, "nonce")
+		require.NoError(t, err)
+
+		// The key set was just fetched, so a rotation right after is only
+		// picked up once the refresh interval has passed.
+		server.RotateKey()
+
+		raw, err = server.SignIDToken(server.IDTokenClaims(identity, "nonce"))
+		require.NoError(t, err)
+
+		_, err = provider.VerifyIDToken(ctx, raw, "nonce")
+		assert.ErrorIs(t, err, oidcclient.ErrUnknownKey)
+
+		oidcclient.AllowKeysRefresh(provider)
+
+		claims, err := provider.VerifyIDToken(ctx, raw, "nonce")
+		require.NoError(t, err)
+		assert.Equal(t, identity.Subject, claims.Subject)
+	})
+}
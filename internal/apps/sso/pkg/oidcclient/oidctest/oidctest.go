@@ -0,0 +1,274 @@
+// Package oidctest runs an in-process OpenID provider for tests. It serves
+// discovery, a key set, an authorization endpoint that signs in a configured
+// identity without user interaction, and a token endpoint that checks PKCE.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/kitanoyoru/kgym/internal/apps/sso/pkg/oidcclient"
+)
+
+const (
+	AuthorizePath = "/authorize"
+	TokenPath     = "/token"
+	JWKSPath      = "/jwks"
+
+	IDTokenTTL = 5 * time.Minute
+)
+
+// Identity is the user the authorization endpoint signs in.
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	GivenName         string
+	FamilyName        string
+	PreferredUsername string
+}
+
+type Config struct {
+	ClientID     string
+	ClientSecret string
+}
+
+type Server struct {
+	cfg    Config
+	server *httptest.Server
+
+	mu       sync.Mutex
+	identity Identity
+	key      *rsa.PrivateKey
+	keyID    string
+	codes    map[string]authorization
+}
+
+type authorization struct {
+	identity      Identity
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+// NewServer starts a provider. The caller must Close it.
+func NewServer(cfg Config) *Server {
+	s := &Server{
+		cfg:   cfg,
+		codes: make(map[string]authorization),
+	}
+	s.RotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+oidcclient.DiscoveryPath, s.discovery)
+	mux.HandleFunc("GET "+JWKSPath, s.jwks)
+	mux.HandleFunc("GET "+AuthorizePath, s.authorize)
+	mux.HandleFunc("POST "+TokenPath, s.token)
+
+	s.server = httptest.NewServer(mux)
+
+	return s
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Issuer is the issuer identifier, which is also the base URL of the server.
+func (s *Server) Issuer() string {
+	return s.server.URL
+}
+
+// SetIdentity sets the identity signed in by later authorization requests.
+func (s *Server) SetIdentity(identity Identity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.identity = identity
+}
+
+// RotateKey replaces the signing key. The old key is no longer published.
+func (s *Server) RotateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.key = key
+	s.keyID = uuid.NewString()
+}
+
+// SignIDToken signs arbitrary claims with the current key, to test how
+// clients handle tokens the token endpoint would not issue.
+func (s *Server) SignIDToken(claims jwt.Claims) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sign(claims)
+}
+
+// IDTokenClaims returns the claims the token endpoint issues for identity.
+func (s *Server) IDTokenClaims(identity Identity, nonce string) oidcclient.Claims {
+	now := time.Now()
+
+	return oidcclient.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.Issuer(),
+			Subject:   identity.Subject,
+			Audience:  jwt.ClaimStrings{s.cfg.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(IDTokenTTL)),
+		},
+		Nonce:             nonce,
+		Email:             identity.Email,
+		EmailVerified:     identity.EmailVerified,
+		Name:              identity.Name,
+		GivenName:         identity.GivenName,
+		FamilyName:        identity.FamilyName,
+		PreferredUsername: identity.PreferredUsername,
+	}
+}
+
+func (s *Server) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.keyID
+
+	return token.SignedString(s.key)
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, oidcclient.Metadata{
+		Issuer:                s.Issuer(),
+		AuthorizationEndpoint: s.Issuer() + AuthorizePath,
+		TokenEndpoint:         s.Issuer() + TokenPath,
+		JWKSURI:               s.Issuer() + JWKSPath,
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	key := s.key.PublicKey
+	keyID := s.keyID
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, oidcclient.JSONWebKeySet{
+		Keys: []oidcclient.JSONWebKey{{
+			KeyType:   "RSA",
+			KeyID:     keyID,
+			Use:       "sig",
+			Algorithm: "RS256",
+			N:         base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("client_id") != s.cfg.ClientID {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	}
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "invalid redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	if query.Get("response_type") != "code" || query.Get("code_challenge_method") != oidcclient.CodeChallengeMethodS256 || query.Get("code_challenge") == "" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	code := uuid.NewString()
+
+	s.mu.Lock()
+	s.codes[code] = authorization{
+		identity:      s.identity,
+		redirectURI:   redirectURI.String(),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+	}
+	s.mu.Unlock()
+
+	callback := redirectURI.Query()
+	callback.Set("code", code)
+	callback.Set("state", query.Get("state"))
+	redirectURI.RawQuery = callback.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+	}
+	if clientID != s.cfg.ClientID || clientSecret != s.cfg.ClientSecret {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code := r.PostForm.Get("code")
+	auth, ok := s.codes[code]
+	delete(s.codes, code)
+	if !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") || oidcclient.CodeChallengeS256(r.PostForm.Get("code_verifier")) != auth.codeChallenge {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	idToken, err := s.sign(s.IDTokenClaims(auth.identity, auth.nonce))
+	if err != nil {
+		tokenError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, oidcclient.Token{
+		AccessToken: uuid.NewString(),
+		TokenType:   "Bearer",
+		IDToken:     idToken,
+		ExpiresIn:   int64(IDTokenTTL.Seconds()),
+	})
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
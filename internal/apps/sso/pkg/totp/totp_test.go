@@ -0,0 +1,72 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed from the test vectors in RFC 6238 appendix B.
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode(t *testing.T) {
+	t.Run("should match RFC 6238 test vectors", func(t *testing.T) {
+		vectors := map[int64]string{
+			59:          "287082",
+			1111111109:  "081804",
+			1111111111:  "050471",
+			1234567890:  "005924",
+			2000000000:  "279037",
+			20000000000: "353130",
+		}
+
+		for unix, code := range vectors {
+			assert.Equal(t, code, Code(rfcSecret, Step(time.Unix(unix, 0))), unix)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should accept codes within the skew", func(t *testing.T) {
+		now := time.Unix(1111111109, 0)
+
+		for _, offset := range []time.Duration{-Period, 0, Period} {
+			code := Code(rfcSecret, Step(now.Add(offset)))
+
+			step, ok := Validate(rfcSecret, code, now)
+			require.True(t, ok, offset)
+			assert.Equal(t, Step(now.Add(offset)), step)
+		}
+	})
+
+	t.Run("should reject codes outside the skew", func(t *testing.T) {
+		now := time.Unix(1111111109, 0)
+		code := Code(rfcSecret, Step(now.Add(-2*Period)))
+
+		_, ok := Validate(rfcSecret, code, now)
+		assert.False(t, ok)
+	})
+
+	t.Run("should reject malformed codes", func(t *testing.T) {
+		_, ok := Validate(rfcSecret, "12345", time.Now())
+		assert.False(t, ok)
+	})
+}
+
+func TestProvisioningURI(t *testing.T) {
+	t.Run("should build otpauth uri", func(t *testing.T) {
+		uri := ProvisioningURI("kgym", "admin@kgym.test", rfcSecret)
+
+		parsed, err := url.Parse(uri)
+		require.NoError(t, err)
+		assert.Equal(t, "otpauth", parsed.Scheme)
+		assert.Equal(t, "totp", parsed.Host)
+		assert.Equal(t, "/kgym:admin@kgym.test", parsed.Path)
+		assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", parsed.Query().Get("secret"))
+		assert.Equal(t, "kgym", parsed.Query().Get("issuer"))
+		assert.Equal(t, "6", parsed.Query().Get("digits"))
+	})
+}
@@ -0,0 +1,274 @@
+// Package webauthntest is a software authenticator for tests. It creates
+// discoverable ES256 credentials with "none" attestation and answers
+// ceremonies the way a browser or a mobile platform would, always reporting
+// the user as present and verified.
+package webauthntest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrExcluded is returned when the relying party excludes a credential
+	// the authenticator already holds.
+	ErrExcluded = errors.New("authenticator already holds an excluded credential")
+	// ErrNoCredential is returned when the authenticator holds no
+	// credential the relying party accepts.
+	ErrNoCredential = errors.New("authenticator holds no matching credential")
+)
+
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagBackupState    = 0x10
+	flagAttestedData   = 0x40
+)
+
+type Config struct {
+	// Origin is the origin of the app the ceremonies are answered from.
+	Origin string
+	// Synced makes credentials backed up, like passkeys synced by a
+	// platform, whose signature counter always stays at zero.
+	Synced bool
+}
+
+type Authenticator struct {
+	cfg    Config
+	aaguid []byte
+
+	mu          sync.Mutex
+	credentials []*credential
+}
+
+type credential struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	counter    uint32
+}
+
+func New(cfg Config) *Authenticator {
+	aaguid := make([]byte, 16)
+	if _, err := rand.Read(aaguid); err != nil {
+		panic(err)
+	}
+
+	return &Authenticator{
+		cfg:    cfg,
+		aaguid: aaguid,
+	}
+}
+
+// Register creates a credential from the JSON encoded
+// PublicKeyCredentialCreationOptions and returns the JSON encoded
+// PublicKeyCredential to send back.
+func (a *Authenticator) Register(options []byte) ([]byte, error) {
+	var opts protocol.PublicKeyCredentialCreationOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, err
+	}
+
+	var userHandle protocol.URLEncodedBase64
+	if err := remarshal(opts.User.ID, &userHandle); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, excluded := range opts.CredentialExcludeList {
+		if a.find(opts.RelyingParty.ID, excluded.CredentialID) != nil {
+			return nil, ErrExcluded
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &credential{
+		id:         make([]byte, 32),
+		rpID:       opts.RelyingParty.ID,
+		userHandle: userHandle,
+		key:        key,
+	}
+	if _, err := rand.Read(c.id); err != nil {
+		return nil, err
+	}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: key.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord: key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authData := a.authData(c, flagAttestedData)
+	authData = append(authData, a.aaguid...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(c.id)))
+	authData = append(authData, c.id...)
+	authData = append(authData, publicKey...)
+
+	attestationObject, err := webauthncbor.Marshal(struct {
+		Format       string         `cbor:"fmt"`
+		AttStatement map[string]any `cbor:"attStmt"`
+		AuthData     []byte         `cbor:"authData"`
+	}{
+		Format:       "none",
+		AttStatement: map[string]any{},
+		AuthData:     authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clientData, err := a.clientData(protocol.CreateCeremony, opts.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.credentials = append(a.credentials, c)
+
+	return json.Marshal(map[string]any{
+		"id":                      protocol.URLEncodedBase64(c.id).String(),
+		"rawId":                   protocol.URLEncodedBase64(c.id),
+		"type":                    "public-key",
+		"authenticatorAttachment": "platform",
+		"response": map[string]any{
+			"clientDataJSON":    protocol.URLEncodedBase64(clientData),
+			"attestationObject": protocol.URLEncodedBase64(attestationObject),
+			"transports":        []string{"internal", "hybrid"},
+		},
+	})
+}
+
+// Login signs the challenge of the JSON encoded
+// PublicKeyCredentialRequestOptions with the latest credential the relying
+// party accepts and returns the JSON encoded PublicKeyCredential to send
+// back.
+func (a *Authenticator) Login(options []byte) ([]byte, error) {
+	var opts protocol.PublicKeyCredentialRequestOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var c *credential
+	for i := len(a.credentials) - 1; i >= 0 && c == nil; i-- {
+		candidate := a.credentials[i]
+		if candidate.rpID != opts.RelyingPartyID {
+			continue
+		}
+		if len(opts.AllowedCredentials) == 0 {
+			c = candidate
+		}
+		for _, allowed := range opts.AllowedCredentials {
+			if bytes.Equal(allowed.CredentialID, candidate.id) {
+				c = candidate
+			}
+		}
+	}
+	if c == nil {
+		return nil, ErrNoCredential
+	}
+
+	if !a.cfg.Synced {
+		c.counter++
+	}
+
+	authData := a.authData(c, 0)
+
+	clientData, err := a.clientData(protocol.AssertCeremony, opts.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, c.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":                      protocol.URLEncodedBase64(c.id).String(),
+		"rawId":                   protocol.URLEncodedBase64(c.id),
+		"type":                    "public-key",
+		"authenticatorAttachment": "platform",
+		"response": map[string]any{
+			"clientDataJSON":    protocol.URLEncodedBase64(clientData),
+			"authenticatorData": protocol.URLEncodedBase64(authData),
+			"signature":         protocol.URLEncodedBase64(signature),
+			"userHandle":        protocol.URLEncodedBase64(c.userHandle),
+		},
+	})
+}
+
+func (a *Authenticator) find(rpID string, id []byte) *credential {
+	for _, c := range a.credentials {
+		if c.rpID == rpID && bytes.Equal(c.id, id) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// authData encodes the authenticator data up to the signature counter.
+func (a *Authenticator) authData(c *credential, flags byte) []byte {
+	flags |= flagUserPresent | flagUserVerified
+	if a.cfg.Synced {
+		flags |= flagBackupEligible | flagBackupState
+	}
+
+	rpIDHash := sha256.Sum256([]byte(c.rpID))
+
+	authData := append([]byte{}, rpIDHash[:]...)
+	authData = append(authData, flags)
+	authData = binary.BigEndian.AppendUint32(authData, c.counter)
+
+	return authData
+}
+
+func (a *Authenticator) clientData(ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) ([]byte, error) {
+	return json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: challenge.String(),
+		Origin:    a.cfg.Origin,
+	})
+}
+
+// remarshal decodes a value the JSON decoder left generic, such as the user
+// handle, into dst.
+func remarshal(src any, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -12,6 +12,7 @@ require (
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.3
 	github.com/jackc/pgx/v5 v5.8.0
 	github.com/kitanoyoru/kgym/contracts/protobuf v0.0.0-20260103131015-fe35aa05ab64
+	github.com/kitanoyoru/kgym/pkg/auth v0.0.0-00010101000000-000000000000
 	github.com/kitanoyoru/kgym/pkg/database v0.0.0-20260103131015-fe35aa05ab64
 	github.com/kitanoyoru/kgym/pkg/logging v0.0.0-20260103131623-9c57395a1221
 	github.com/kitanoyoru/kgym/pkg/metrics v0.0.0-20260103131015-fe35aa05ab64
@@ -29,6 +30,7 @@ require (
 	go.uber.org/goleak v1.3.0
 	go.uber.org/mock v0.6.0
 	go.uber.org/multierr v1.11.0
+	golang.org/x/crypto v0.46.0
 	google.golang.org/grpc v1.78.0
 	google.golang.org/protobuf v1.36.11
 )
@@ -63,6 +65,7 @@ require (
 	github.com/go-ole/go-ole v1.2.6 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.4 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
@@ -108,7 +111,6 @@ require (
 	go.opentelemetry.io/otel/metric v1.39.0 // indirect
 	go.opentelemetry.io/otel/sdk v1.39.0 // indirect
 	go.yaml.in/yaml/v2 v2.4.3 // indirect
-	golang.org/x/crypto v0.46.0 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
@@ -119,6 +121,7 @@ require (
 )
 
 replace (
+	github.com/kitanoyoru/kgym/pkg/auth => ../../../pkg/auth
 	github.com/kitanoyoru/kgym/pkg/database => ../../../pkg/database
 	github.com/kitanoyoru/kgym/pkg/logging => ../../../pkg/logging
 	github.com/kitanoyoru/kgym/pkg/metrics => ../../../pkg/metrics
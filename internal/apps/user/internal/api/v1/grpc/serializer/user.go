@@ -19,7 +19,6 @@ func PbUserToEntity(pbUser *pb.User) (*userentity.User, error) {
 		Email:     pbUser.Email,
 		Role:      role,
 		Username:  pbUser.Username,
-		Password:  pbUser.Password,
 		AvatarURL: pbUser.AvatarUrl,
 		Mobile:    pbUser.Mobile,
 		FirstName: pbUser.FirstName,
@@ -35,16 +34,16 @@ func EntityToPbUser(entityUser userentity.User) (pb.User, error) {
 	}
 
 	return pb.User{
-		Id:        entityUser.ID,
-		Email:     entityUser.Email,
-		Role:      role,
-		Username:  entityUser.Username,
-		Password:  entityUser.Password,
-		AvatarUrl: entityUser.AvatarURL,
-		Mobile:    entityUser.Mobile,
-		FirstName: entityUser.FirstName,
-		LastName:  entityUser.LastName,
-		BirthDate: timestamppb.New(entityUser.BirthDate),
+		Id:            entityUser.ID,
+		Email:         entityUser.Email,
+		EmailVerified: entityUser.EmailVerified,
+		Role:          role,
+		Username:      entityUser.Username,
+		AvatarUrl:     entityUser.AvatarURL,
+		Mobile:        entityUser.Mobile,
+		FirstName:     entityUser.FirstName,
+		LastName:      entityUser.LastName,
+		BirthDate:     timestamppb.New(entityUser.BirthDate),
 	}, nil
 }
 
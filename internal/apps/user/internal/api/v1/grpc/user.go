@@ -3,6 +3,9 @@ package grpc
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
 	pb "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/user/v1"
 	"github.com/kitanoyoru/kgym/internal/apps/user/internal/api/v1/grpc/serializer"
 	userentity "github.com/kitanoyoru/kgym/internal/apps/user/internal/entity/user"
@@ -71,6 +74,9 @@ func (s *UserServiceServer) GetUser(ctx context.Context, req *pb.GetUser_Request
 	}
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 
@@ -94,3 +100,54 @@ func (s *UserServiceServer) DeleteUser(ctx context.Context, req *pb.DeleteUser_R
 
 	return &pb.DeleteUser_Response{}, nil
 }
+
+func (s *UserServiceServer) UpdatePassword(ctx context.Context, req *pb.UpdatePassword_Request) (*pb.UpdatePassword_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdatePassword")
+	defer span.End()
+
+	err := s.userService.UpdatePassword(ctx, userservice.UpdatePasswordRequest{
+		ID:       req.Id,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update password: %v", err)
+	}
+
+	return &pb.UpdatePassword_Response{}, nil
+}
+
+func (s *UserServiceServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmail_Request) (*pb.VerifyEmail_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	if err := s.userService.VerifyEmail(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify email: %v", err)
+	}
+
+	return &pb.VerifyEmail_Response{}, nil
+}
+
+func (s *UserServiceServer) VerifyPassword(ctx context.Context, req *pb.VerifyPassword_Request) (*pb.VerifyPassword_Response, error) {
+	ctx, span := s.tracer.Start(ctx, "VerifyPassword")
+	defer span.End()
+
+	userEntity, err := s.userService.VerifyPassword(ctx, userservice.VerifyPasswordRequest{
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		if errors.Is(err, userservice.ErrInvalidCredentials) {
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify password: %v", err)
+	}
+
+	pbUser, err := serializer.EntityToPbUser(userEntity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert entity to protobuf: %v", err)
+	}
+
+	return &pb.VerifyPassword_Response{
+		User: &pbUser,
+	}, nil
+}
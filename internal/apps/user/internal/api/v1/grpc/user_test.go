@@ -14,6 +14,7 @@ import (
 	"github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user/postgres"
 	userservice "github.com/kitanoyoru/kgym/internal/apps/user/internal/service/user"
 	"github.com/kitanoyoru/kgym/internal/apps/user/migrations"
+	"github.com/kitanoyoru/kgym/internal/apps/user/pkg/password"
 	postgresdb "github.com/kitanoyoru/kgym/pkg/database/postgres"
 	"github.com/kitanoyoru/kgym/pkg/testing/integration/cockroachdb"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +22,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -52,7 +55,10 @@ func (s *UserServiceTestSuite) SetupSuite() {
 	require.NoError(s.T(), err, "failed to run migrations")
 
 	repository := postgres.New(s.db)
-	userService := userservice.New(repository)
+	hasher, err := password.NewHasher(password.DefaultParams)
+	require.NoError(s.T(), err, "failed to create password hasher")
+
+	userService := userservice.New(repository, hasher)
 	grpcServer, err := NewUserService(userService)
 	require.NoError(s.T(), err, "failed to create gRPC server")
 
@@ -384,7 +390,7 @@ func (s *UserServiceTestSuite) TestGetUser() {
 		}
 
 		resp, err := s.client.GetUser(ctx, req)
-		assert.Error(s.T(), err)
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
 		assert.Nil(s.T(), resp)
 	})
 
@@ -398,11 +404,119 @@ func (s *UserServiceTestSuite) TestGetUser() {
 		}
 
 		resp, err := s.client.GetUser(ctx, req)
-		assert.Error(s.T(), err)
+		assert.Equal(s.T(), codes.NotFound, status.Code(err))
 		assert.Nil(s.T(), resp)
 	})
 }
 
+func (s *UserServiceTestSuite) TestVerifyPassword() {
+	ctx := context.Background()
+	_, _ = s.db.Exec(ctx, "DELETE FROM users")
+
+	s.Run("should verify password successfully", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, _ = s.db.Exec(ctx, "DELETE FROM users")
+
+		email := "verify@example.com"
+		userID := s.createTestUser(ctx, email, pb.Role_USER, "verifyuser", "password123")
+
+		var storedHash string
+		err := s.db.QueryRow(ctx, "SELECT password_hash FROM users WHERE id = $1", userID).Scan(&storedHash)
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), "password123", storedHash)
+
+		resp, err := s.client.VerifyPassword(ctx, &pb.VerifyPassword_Request{
+			Email:    email,
+			Password: "password123",
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), userID, resp.User.Id)
+	})
+
+	s.Run("should return unauthenticated when password is wrong", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, _ = s.db.Exec(ctx, "DELETE FROM users")
+
+		email := "wrongpassword@example.com"
+		s.createTestUser(ctx, email, pb.Role_USER, "wrongpassworduser", "password123")
+
+		resp, err := s.client.VerifyPassword(ctx, &pb.VerifyPassword_Request{
+			Email:    email,
+			Password: "password456",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+		assert.Nil(s.T(), resp)
+	})
+
+	s.Run("should return unauthenticated when user not found", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := s.client.VerifyPassword(ctx, &pb.VerifyPassword_Request{
+			Email:    "nonexistent@example.com",
+			Password: "password123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+		assert.Nil(s.T(), resp)
+	})
+}
+
+func (s *UserServiceTestSuite) TestUpdatePassword() {
+	s.Run("should replace password", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, _ = s.db.Exec(ctx, "DELETE FROM users")
+
+		email := "update-password@example.com"
+		userID := s.createTestUser(ctx, email, pb.Role_USER, "updatepassworduser", "password123")
+
+		_, err := s.client.UpdatePassword(ctx, &pb.UpdatePassword_Request{
+			Id:       userID,
+			Password: "new-password123",
+		})
+		require.NoError(s.T(), err)
+
+		_, err = s.client.VerifyPassword(ctx, &pb.VerifyPassword_Request{
+			Email:    email,
+			Password: "password123",
+		})
+		assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+
+		_, err = s.client.VerifyPassword(ctx, &pb.VerifyPassword_Request{
+			Email:    email,
+			Password: "new-password123",
+		})
+		assert.NoError(s.T(), err)
+	})
+}
+
+func (s *UserServiceTestSuite) TestVerifyEmail() {
+	s.Run("should mark email as verified", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, _ = s.db.Exec(ctx, "DELETE FROM users")
+
+		userID := s.createTestUser(ctx, "verify-email@example.com", pb.Role_USER, "verifyemailuser", "password123")
+
+		resp, err := s.client.GetUser(ctx, &pb.GetUser_Request{Id: &userID})
+		require.NoError(s.T(), err)
+		assert.False(s.T(), resp.User.EmailVerified)
+
+		_, err = s.client.VerifyEmail(ctx, &pb.VerifyEmail_Request{Id: userID})
+		require.NoError(s.T(), err)
+
+		resp, err = s.client.GetUser(ctx, &pb.GetUser_Request{Id: &userID})
+		require.NoError(s.T(), err)
+		assert.True(s.T(), resp.User.EmailVerified)
+	})
+}
+
 func (s *UserServiceTestSuite) TestDeleteUser() {
 	ctx := context.Background()
 	_, _ = s.db.Exec(ctx, "DELETE FROM users")
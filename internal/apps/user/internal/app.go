@@ -12,6 +12,8 @@ import (
 	userrepository "github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user"
 	userpostgres "github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user/postgres"
 	userservice "github.com/kitanoyoru/kgym/internal/apps/user/internal/service/user"
+	"github.com/kitanoyoru/kgym/internal/apps/user/pkg/password"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
 	pkgpostgres "github.com/kitanoyoru/kgym/pkg/database/postgres"
 	pkgredis "github.com/kitanoyoru/kgym/pkg/database/redis"
 	pkglogging "github.com/kitanoyoru/kgym/pkg/logging"
@@ -22,6 +24,8 @@ import (
 	"google.golang.org/grpc"
 	pbhealth "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	pbreflection "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	pbreflectionalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
 const (
@@ -35,6 +39,7 @@ type App struct {
 	dbPool     *pgxpool.Pool
 	rdb        *redis.ClusterClient
 	grpcServer *grpc.Server
+	verifier   *pkgauth.Verifier
 
 	userRepository userrepository.IRepository
 
@@ -80,6 +85,8 @@ func (app *App) Run(ctx context.Context) error {
 		return err
 	}
 
+	go app.verifier.Run(ctx)
+
 	return app.grpcServer.Serve(listener)
 }
 
@@ -95,7 +102,18 @@ func (app *App) initRepositories(_ context.Context) error {
 }
 
 func (app *App) initServices(_ context.Context) error {
-	app.userService = userservice.New(app.userRepository)
+	hasher, err := password.NewHasher(password.Params{
+		Memory:      app.cfg.Password.Memory,
+		Iterations:  app.cfg.Password.Iterations,
+		Parallelism: app.cfg.Password.Parallelism,
+		SaltLength:  app.cfg.Password.SaltLength,
+		KeyLength:   app.cfg.Password.KeyLength,
+	})
+	if err != nil {
+		return err
+	}
+
+	app.userService = userservice.New(app.userRepository, hasher)
 
 	return nil
 }
@@ -113,6 +131,32 @@ func (app *App) initGRPCServer(_ context.Context) error {
 		grpcprometheus.WithContextLabels(pkgmetrics.AllMetadataFields...),
 	)
 
+	app.verifier = pkgauth.NewVerifier(pkgauth.Config{
+		Issuer:    app.cfg.Auth.Issuer,
+		Audiences: app.cfg.Auth.Audiences,
+		JWKSURL:   app.cfg.Auth.JWKSURL,
+		// Proofs of possession are remembered in Redis, so that a replayed
+		// proof is refused by every replica.
+		ReplayCache: pkgauth.NewRedisReplayCache(app.rdb),
+	})
+
+	policies := pkgauth.Policies{
+		// Users are created by SSO, which decides their role. Anyone else
+		// could make themselves an admin.
+		pbuser.UserService_CreateUser_FullMethodName: pkgauth.RequireScope(pkgauth.ScopeService),
+		pbuser.UserService_GetUser_FullMethodName:    pkgauth.Authenticated(),
+		pbuser.UserService_DeleteUser_FullMethodName: pkgauth.RequireRole(pkgauth.RoleAdmin),
+		// The credentials of users are only checked and changed by SSO, which
+		// throttles the attempts.
+		pbuser.UserService_VerifyPassword_FullMethodName: pkgauth.RequireScope(pkgauth.ScopeService),
+		pbuser.UserService_UpdatePassword_FullMethodName: pkgauth.RequireScope(pkgauth.ScopeService),
+		pbuser.UserService_VerifyEmail_FullMethodName:    pkgauth.RequireScope(pkgauth.ScopeService),
+
+		"/" + pbhealth.Health_ServiceDesc.ServiceName:                    pkgauth.Public(),
+		"/" + pbreflection.ServerReflection_ServiceDesc.ServiceName:      pkgauth.Public(),
+		"/" + pbreflectionalpha.ServerReflection_ServiceDesc.ServiceName: pkgauth.Public(),
+	}
+
 	server := grpc.NewServer(
 		grpc.MaxRecvMsgSize(app.cfg.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(app.cfg.MaxSendMsgSize),
@@ -125,6 +169,7 @@ func (app *App) initGRPCServer(_ context.Context) error {
 			logging.UnaryServerInterceptor(
 				pkglogging.NewInterceptorLogger(Namespace, ServiceName),
 			),
+			pkgauth.UnaryServerInterceptor(app.verifier, policies),
 		),
 		grpc.ChainStreamInterceptor(
 			srvMetrics.StreamServerInterceptor(
@@ -133,6 +178,7 @@ func (app *App) initGRPCServer(_ context.Context) error {
 			logging.StreamServerInterceptor(
 				pkglogging.NewInterceptorLogger(Namespace, ServiceName),
 			),
+			pkgauth.StreamServerInterceptor(app.verifier, policies),
 		),
 		grpc.StatsHandler(
 			otelgrpc.NewServerHandler(),
@@ -6,8 +6,10 @@ import (
 
 type Config struct {
 	GRPC
+	Auth
 	Cache
 	Database
+	Password
 
 	ShutdownTimeout time.Duration `env:"KGYM_USER_SHUTDOWN_TIMEOUT" envDefault:"10s"`
 }
@@ -21,6 +23,13 @@ type GRPC struct {
 	MaxConcurrentStreams uint32        `env:"KGYM_USER_GRPC_MAX_CONCURRENT_STREAMS" envDefault:"1000"`
 }
 
+// Auth configures the verification of access tokens issued by SSO.
+type Auth struct {
+	Issuer    string   `env:"KGYM_USER_AUTH_ISSUER" validate:"required,url"`
+	Audiences []string `env:"KGYM_USER_AUTH_AUDIENCES" validate:"required,min=1"`
+	JWKSURL   string   `env:"KGYM_USER_AUTH_JWKS_URL" validate:"required,url"`
+}
+
 type Cache struct {
 	Address string `env:"KGYM_USER_CACHE_ADDRESS" validate:"required"`
 }
@@ -28,3 +37,11 @@ type Cache struct {
 type Database struct {
 	ConnectionString string `env:"KGYM_USER_DATABASE_CONNECTION_STRING" validate:"required"`
 }
+
+type Password struct {
+	Memory      uint32 `env:"KGYM_USER_PASSWORD_MEMORY" envDefault:"65536"`
+	Iterations  uint32 `env:"KGYM_USER_PASSWORD_ITERATIONS" envDefault:"3"`
+	Parallelism uint8  `env:"KGYM_USER_PASSWORD_PARALLELISM" envDefault:"2"`
+	SaltLength  uint32 `env:"KGYM_USER_PASSWORD_SALT_LENGTH" envDefault:"16"`
+	KeyLength   uint32 `env:"KGYM_USER_PASSWORD_KEY_LENGTH" envDefault:"32"`
+}
@@ -8,16 +8,18 @@ import (
 )
 
 type User struct {
-	ID        string `validate:"required,uuid"`
-	Email     string `validate:"required,email"`
-	Role      Role
-	Username  string `validate:"required,min=3,max=32"`
-	Password  string `validate:"required,min=8,max=64"`
-	AvatarURL string `validate:"required,url"`
-	Mobile    string `validate:"required,e164"`
-	FirstName string `validate:"required,min=1,max=32"`
-	LastName  string `validate:"required,min=1,max=32"`
-	BirthDate time.Time
+	ID    string `validate:"required,uuid"`
+	Email string `validate:"required,email"`
+	// EmailVerified is set once the user proves ownership of Email.
+	EmailVerified bool
+	Role          Role
+	Username      string `validate:"required,min=3,max=32"`
+	PasswordHash  string `validate:"required"`
+	AvatarURL     string `validate:"required,url"`
+	Mobile        string `validate:"required,e164"`
+	FirstName     string `validate:"required,min=1,max=32"`
+	LastName      string `validate:"required,min=1,max=32"`
+	BirthDate     time.Time
 }
 
 func (u User) Validate(ctx context.Context) error {
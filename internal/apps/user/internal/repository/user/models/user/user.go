@@ -14,9 +14,10 @@ const (
 var Columns = []string{
 	"id",
 	"email",
+	"email_verified",
 	"role",
 	"username",
-	"password",
+	"password_hash",
 	"avatar_url",
 	"mobile",
 	"first_name",
@@ -36,39 +37,42 @@ func FromEntity(entity userentity.User) (User, error) {
 	now := carbon.Now().StdTime()
 
 	return User{
-		ID:        entity.ID,
-		Email:     entity.Email,
-		Role:      role,
-		Username:  entity.Username,
-		Password:  entity.Password,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:            entity.ID,
+		Email:         entity.Email,
+		EmailVerified: entity.EmailVerified,
+		Role:          role,
+		Username:      entity.Username,
+		PasswordHash:  entity.PasswordHash,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}, nil
 }
 
 type User struct {
-	ID        string     `db:"id"`
-	Email     string     `db:"email"`
-	Role      Role       `db:"role"`
-	Username  string     `db:"username"`
-	Password  string     `db:"password"`
-	AvatarURL string     `db:"avatar_url"`
-	Mobile    string     `db:"mobile"`
-	FirstName string     `db:"first_name"`
-	LastName  string     `db:"last_name"`
-	BirthDate time.Time  `db:"birth_date"`
-	CreatedAt time.Time  `db:"created_at"`
-	UpdatedAt time.Time  `db:"updated_at"`
-	DeletedAt *time.Time `db:"deleted_at"`
+	ID            string     `db:"id"`
+	Email         string     `db:"email"`
+	EmailVerified bool       `db:"email_verified"`
+	Role          Role       `db:"role"`
+	Username      string     `db:"username"`
+	PasswordHash  string     `db:"password_hash"`
+	AvatarURL     string     `db:"avatar_url"`
+	Mobile        string     `db:"mobile"`
+	FirstName     string     `db:"first_name"`
+	LastName      string     `db:"last_name"`
+	BirthDate     time.Time  `db:"birth_date"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+	DeletedAt     *time.Time `db:"deleted_at"`
 }
 
 func (u User) Values() []any {
 	return []any{
 		u.ID,
 		u.Email,
+		u.EmailVerified,
 		u.Role,
 		u.Username,
-		u.Password,
+		u.PasswordHash,
 		u.AvatarURL,
 		u.Mobile,
 		u.FirstName,
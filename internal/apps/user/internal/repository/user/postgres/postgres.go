@@ -117,3 +117,43 @@ func (r *Repository) DeleteByID(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (r *Repository) MarkEmailVerified(ctx context.Context, id string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(usermodel.Table).
+		Set("email_verified", true).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdatePasswordHash(ctx context.Context, id string, passwordHash string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(usermodel.Table).
+		Set("password_hash", passwordHash).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
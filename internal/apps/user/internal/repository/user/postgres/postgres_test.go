@@ -70,16 +70,16 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 	s.Run("should create a user successfully", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "test@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "testuser",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "test@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "testuser",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -91,38 +91,38 @@ func (s *RepositoryTestSuite) TestCreate() {
 		assert.Equal(s.T(), user.Email, retrievedUser.Email)
 		assert.Equal(s.T(), usermodel.RoleUser, retrievedUser.Role)
 		assert.Equal(s.T(), user.Username, retrievedUser.Username)
-		assert.Equal(s.T(), user.Password, retrievedUser.Password)
+		assert.Equal(s.T(), user.PasswordHash, retrievedUser.PasswordHash)
 	})
 
 	s.Run("should not create a user because of duplicate email", func() {
 		email := "duplicate@example.com"
 		user1 := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     email,
-			Role:      userentity.RoleUser,
-			Username:  "user1",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar1.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        email,
+			Role:         userentity.RoleUser,
+			Username:     "user1",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar1.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user1)
 		require.NoError(s.T(), err)
 
 		user2 := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     email,
-			Role:      userentity.RoleAdmin,
-			Username:  "user2",
-			Password:  "password456",
-			AvatarURL: "https://example.com/avatar2.jpg",
-			Mobile:    "+0987654321",
-			FirstName: "Jane",
-			LastName:  "Smith",
-			BirthDate: carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        email,
+			Role:         userentity.RoleAdmin,
+			Username:     "user2",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password456",
+			AvatarURL:    "https://example.com/avatar2.jpg",
+			Mobile:       "+0987654321",
+			FirstName:    "Jane",
+			LastName:     "Smith",
+			BirthDate:    carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err = repository.Create(ctx, user2)
@@ -132,32 +132,32 @@ func (s *RepositoryTestSuite) TestCreate() {
 	s.Run("should not create a user because of duplicate username", func() {
 		username := "duplicateuser"
 		user1 := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "user1@example.com",
-			Role:      userentity.RoleUser,
-			Username:  username,
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar1.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "user1@example.com",
+			Role:         userentity.RoleUser,
+			Username:     username,
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar1.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user1)
 		require.NoError(s.T(), err)
 
 		user2 := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "user2@example.com",
-			Role:      userentity.RoleAdmin,
-			Username:  username,
-			Password:  "password456",
-			AvatarURL: "https://example.com/avatar2.jpg",
-			Mobile:    "+0987654321",
-			FirstName: "Jane",
-			LastName:  "Smith",
-			BirthDate: carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "user2@example.com",
+			Role:         userentity.RoleAdmin,
+			Username:     username,
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password456",
+			AvatarURL:    "https://example.com/avatar2.jpg",
+			Mobile:       "+0987654321",
+			FirstName:    "Jane",
+			LastName:     "Smith",
+			BirthDate:    carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err = repository.Create(ctx, user2)
@@ -171,16 +171,16 @@ func (s *RepositoryTestSuite) TestGetByID() {
 
 	s.Run("should get user by id successfully", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "getbyid@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "getbyiduser",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "getbyid@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "getbyiduser",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -192,7 +192,7 @@ func (s *RepositoryTestSuite) TestGetByID() {
 		assert.Equal(s.T(), user.Email, retrievedUser.Email)
 		assert.Equal(s.T(), usermodel.RoleUser, retrievedUser.Role)
 		assert.Equal(s.T(), user.Username, retrievedUser.Username)
-		assert.Equal(s.T(), user.Password, retrievedUser.Password)
+		assert.Equal(s.T(), user.PasswordHash, retrievedUser.PasswordHash)
 	})
 
 	s.Run("should return error when user not found", func() {
@@ -203,16 +203,16 @@ func (s *RepositoryTestSuite) TestGetByID() {
 
 	s.Run("should not return deleted user", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "tobedeleted@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "tobedeleted",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "tobedeleted@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "tobedeleted",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -232,16 +232,16 @@ func (s *RepositoryTestSuite) TestGetByEmail() {
 
 	s.Run("should get user by email successfully", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "getbyemail@example.com",
-			Role:      userentity.RoleAdmin,
-			Username:  "getbyemailuser",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "Jane",
-			LastName:  "Smith",
-			BirthDate: carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "getbyemail@example.com",
+			Role:         userentity.RoleAdmin,
+			Username:     "getbyemailuser",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "Jane",
+			LastName:     "Smith",
+			BirthDate:    carbon.CreateFromDateTime(1991, 2, 2, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -253,7 +253,7 @@ func (s *RepositoryTestSuite) TestGetByEmail() {
 		assert.Equal(s.T(), user.Email, retrievedUser.Email)
 		assert.Equal(s.T(), usermodel.RoleAdmin, retrievedUser.Role)
 		assert.Equal(s.T(), user.Username, retrievedUser.Username)
-		assert.Equal(s.T(), user.Password, retrievedUser.Password)
+		assert.Equal(s.T(), user.PasswordHash, retrievedUser.PasswordHash)
 	})
 
 	s.Run("should return error when user not found by email", func() {
@@ -264,16 +264,16 @@ func (s *RepositoryTestSuite) TestGetByEmail() {
 
 	s.Run("should not return deleted user by email", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "tobedeletedbyemail@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "tobedeletedbyemail",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "tobedeletedbyemail@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "tobedeletedbyemail",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -293,16 +293,16 @@ func (s *RepositoryTestSuite) TestDeleteByID() {
 
 	s.Run("should delete a user successfully", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "todelete@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "todelete",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "todelete@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "todelete",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -327,16 +327,16 @@ func (s *RepositoryTestSuite) TestDeleteByID() {
 
 	s.Run("should allow deleting already deleted user", func() {
 		user := userentity.User{
-			ID:        uuid.New().String(),
-			Email:     "doubledelete@example.com",
-			Role:      userentity.RoleUser,
-			Username:  "doubledelete",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
-			BirthDate: carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+			ID:           uuid.New().String(),
+			Email:        "doubledelete@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "doubledelete",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
 		}
 
 		err := repository.Create(ctx, user)
@@ -350,6 +350,71 @@ func (s *RepositoryTestSuite) TestDeleteByID() {
 	})
 }
 
+func (s *RepositoryTestSuite) TestUpdatePasswordHash() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should update password hash successfully", func() {
+		user := userentity.User{
+			ID:           uuid.New().String(),
+			Email:        "rehash@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "rehash",
+			PasswordHash: "$2a$10$abcdefghijklmnopqrstuuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+		}
+
+		err := repository.Create(ctx, user)
+		require.NoError(s.T(), err)
+
+		newHash := "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$bmV3"
+		err = repository.UpdatePasswordHash(ctx, user.ID, newHash)
+		require.NoError(s.T(), err)
+
+		retrievedUser, err := repository.GetByID(ctx, user.ID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), newHash, retrievedUser.PasswordHash)
+	})
+}
+
+func (s *RepositoryTestSuite) TestMarkEmailVerified() {
+	ctx := context.Background()
+	repository := New(s.db)
+
+	s.Run("should mark email as verified", func() {
+		user := userentity.User{
+			ID:           uuid.New().String(),
+			Email:        "verify@example.com",
+			Role:         userentity.RoleUser,
+			Username:     "verify",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$password123",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
+			BirthDate:    carbon.CreateFromDateTime(1990, 1, 1, 0, 0, 0).SetTimezone(carbon.UTC).StdTime(),
+		}
+
+		err := repository.Create(ctx, user)
+		require.NoError(s.T(), err)
+
+		retrievedUser, err := repository.GetByID(ctx, user.ID)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrievedUser.EmailVerified)
+
+		err = repository.MarkEmailVerified(ctx, user.ID)
+		require.NoError(s.T(), err)
+
+		retrievedUser, err = repository.GetByID(ctx, user.ID)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedUser.EmailVerified)
+	})
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
@@ -12,4 +12,6 @@ type IRepository interface {
 	GetByEmail(ctx context.Context, email string) (usermodel.User, error)
 	Create(ctx context.Context, user userentity.User) error
 	DeleteByID(ctx context.Context, id string) error
+	UpdatePasswordHash(ctx context.Context, id string, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id string) error
 }
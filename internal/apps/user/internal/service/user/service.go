@@ -5,6 +5,11 @@ import (
 	"time"
 
 	userentity "github.com/kitanoyoru/kgym/internal/apps/user/internal/entity/user"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
 )
 
 type IService interface {
@@ -12,6 +17,9 @@ type IService interface {
 	GetByID(ctx context.Context, id string) (userentity.User, error)
 	GetByEmail(ctx context.Context, email string) (userentity.User, error)
 	DeleteByID(ctx context.Context, id string) error
+	VerifyPassword(ctx context.Context, req VerifyPasswordRequest) (userentity.User, error)
+	UpdatePassword(ctx context.Context, req UpdatePasswordRequest) error
+	VerifyEmail(ctx context.Context, id string) error
 }
 
 type (
@@ -31,3 +39,17 @@ type (
 		ID string
 	}
 )
+
+type (
+	VerifyPasswordRequest struct {
+		Email    string
+		Password string
+	}
+)
+
+type (
+	UpdatePasswordRequest struct {
+		ID       string
+		Password string
+	}
+)
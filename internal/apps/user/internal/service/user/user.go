@@ -4,29 +4,43 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	userentity "github.com/kitanoyoru/kgym/internal/apps/user/internal/entity/user"
 	userrepo "github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user"
+	usermodel "github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user/models/user"
+	"github.com/kitanoyoru/kgym/internal/apps/user/pkg/password"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 )
 
 type Service struct {
-	repo userrepo.IRepository
+	repo   userrepo.IRepository
+	hasher *password.Hasher
 }
 
-func New(repo userrepo.IRepository) *Service {
-	return &Service{repo: repo}
+func New(repo userrepo.IRepository, hasher *password.Hasher) *Service {
+	return &Service{
+		repo:   repo,
+		hasher: hasher,
+	}
 }
 
 func (s *Service) Create(ctx context.Context, req CreateRequest) (CreateResponse, error) {
+	passwordHash, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		return CreateResponse{}, err
+	}
+
 	user := userentity.User{
-		Email:     req.Email,
-		Role:      req.Role,
-		Username:  req.Username,
-		Password:  req.Password,
-		AvatarURL: req.AvatarURL,
-		Mobile:    req.Mobile,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		BirthDate: req.BirthDate,
+		Email:        req.Email,
+		Role:         req.Role,
+		Username:     req.Username,
+		PasswordHash: passwordHash,
+		AvatarURL:    req.AvatarURL,
+		Mobile:       req.Mobile,
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		BirthDate:    req.BirthDate,
 	}
 
 	user.ID = uuid.NewString()
@@ -46,48 +60,87 @@ func (s *Service) GetByID(ctx context.Context, id string) (userentity.User, erro
 		return userentity.User{}, err
 	}
 
-	role, err := model.Role.ToEntity()
+	return modelToEntity(model)
+}
+
+func (s *Service) GetByEmail(ctx context.Context, email string) (userentity.User, error) {
+	model, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
 		return userentity.User{}, err
 	}
 
-	return userentity.User{
-		ID:        model.ID,
-		Email:     model.Email,
-		Role:      role,
-		Username:  model.Username,
-		Password:  model.Password,
-		AvatarURL: model.AvatarURL,
-		Mobile:    model.Mobile,
-		FirstName: model.FirstName,
-		LastName:  model.LastName,
-	}, nil
+	return modelToEntity(model)
 }
 
-func (s *Service) GetByEmail(ctx context.Context, email string) (userentity.User, error) {
-	model, err := s.repo.GetByEmail(ctx, email)
+func (s *Service) DeleteByID(ctx context.Context, id string) error {
+	return s.repo.DeleteByID(ctx, id)
+}
+
+func (s *Service) VerifyPassword(ctx context.Context, req VerifyPasswordRequest) (userentity.User, error) {
+	model, err := s.repo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.hasher.VerifyDummy(req.Password)
+			return userentity.User{}, ErrInvalidCredentials
+		}
+
+		return userentity.User{}, err
+	}
+
+	match, needsRehash, err := s.hasher.Verify(req.Password, model.PasswordHash)
 	if err != nil {
 		return userentity.User{}, err
 	}
+	if !match {
+		return userentity.User{}, ErrInvalidCredentials
+	}
+
+	if needsRehash {
+		s.rehash(ctx, model.ID, req.Password)
+	}
+
+	return modelToEntity(model)
+}
+
+func (s *Service) UpdatePassword(ctx context.Context, req UpdatePasswordRequest) error {
+	passwordHash, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdatePasswordHash(ctx, req.ID, passwordHash)
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, id string) error {
+	return s.repo.MarkEmailVerified(ctx, id)
+}
+
+func (s *Service) rehash(ctx context.Context, id, plaintext string) {
+	passwordHash, err := s.hasher.Hash(plaintext)
+	if err == nil {
+		err = s.repo.UpdatePasswordHash(ctx, id, passwordHash)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("user_id", id).Msg("failed to rehash password")
+	}
+}
 
+func modelToEntity(model usermodel.User) (userentity.User, error) {
 	role, err := model.Role.ToEntity()
 	if err != nil {
 		return userentity.User{}, err
 	}
 
 	return userentity.User{
-		ID:        model.ID,
-		Email:     model.Email,
-		Role:      role,
-		Username:  model.Username,
-		Password:  model.Password,
-		AvatarURL: model.AvatarURL,
-		Mobile:    model.Mobile,
-		FirstName: model.FirstName,
-		LastName:  model.LastName,
+		ID:            model.ID,
+		Email:         model.Email,
+		EmailVerified: model.EmailVerified,
+		Role:          role,
+		Username:      model.Username,
+		PasswordHash:  model.PasswordHash,
+		AvatarURL:     model.AvatarURL,
+		Mobile:        model.Mobile,
+		FirstName:     model.FirstName,
+		LastName:      model.LastName,
 	}, nil
 }
-
-func (s *Service) DeleteByID(ctx context.Context, id string) error {
-	return s.repo.DeleteByID(ctx, id)
-}
@@ -7,14 +7,17 @@ import (
 
 	"github.com/dromara/carbon/v2"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	userentity "github.com/kitanoyoru/kgym/internal/apps/user/internal/entity/user"
 	"github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user/mocks"
 	usermodel "github.com/kitanoyoru/kgym/internal/apps/user/internal/repository/user/models/user"
+	"github.com/kitanoyoru/kgym/internal/apps/user/pkg/password"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type ServiceTestSuite struct {
@@ -22,6 +25,7 @@ type ServiceTestSuite struct {
 
 	ctrl     *gomock.Controller
 	mockRepo *mocks.MockIRepository
+	hasher   *password.Hasher
 	service  *Service
 	ctx      context.Context
 }
@@ -29,7 +33,18 @@ type ServiceTestSuite struct {
 func (s *ServiceTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.mockRepo = mocks.NewMockIRepository(s.ctrl)
-	s.service = New(s.mockRepo)
+
+	hasher, err := password.NewHasher(password.Params{
+		Memory:      1024,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	s.Require().NoError(err)
+	s.hasher = hasher
+
+	s.service = New(s.mockRepo, s.hasher)
 	s.ctx = context.Background()
 }
 
@@ -55,7 +70,6 @@ func (s *ServiceTestSuite) TestCreate() {
 			Email:     req.Email,
 			Role:      req.Role,
 			Username:  req.Username,
-			Password:  req.Password,
 			AvatarURL: req.AvatarURL,
 			Mobile:    req.Mobile,
 			FirstName: req.FirstName,
@@ -71,7 +85,10 @@ func (s *ServiceTestSuite) TestCreate() {
 				assert.Equal(s.T(), expectedUser.Email, user.Email)
 				assert.Equal(s.T(), expectedUser.Role, user.Role)
 				assert.Equal(s.T(), expectedUser.Username, user.Username)
-				assert.Equal(s.T(), expectedUser.Password, user.Password)
+				assert.NotEqual(s.T(), req.Password, user.PasswordHash)
+				match, _, err := s.hasher.Verify(req.Password, user.PasswordHash)
+				assert.NoError(s.T(), err)
+				assert.True(s.T(), match)
 				assert.Equal(s.T(), expectedUser.AvatarURL, user.AvatarURL)
 				assert.Equal(s.T(), expectedUser.Mobile, user.Mobile)
 				assert.Equal(s.T(), expectedUser.FirstName, user.FirstName)
@@ -114,15 +131,15 @@ func (s *ServiceTestSuite) TestGetByID() {
 	s.Run("should get user by id successfully", func() {
 		userID := uuid.New().String()
 		model := usermodel.User{
-			ID:        userID,
-			Email:     "test@example.com",
-			Role:      usermodel.RoleUser,
-			Username:  "testuser",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "John",
-			LastName:  "Doe",
+			ID:           userID,
+			Email:        "test@example.com",
+			Role:         usermodel.RoleUser,
+			Username:     "testuser",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "John",
+			LastName:     "Doe",
 		}
 
 		s.mockRepo.EXPECT().
@@ -135,7 +152,7 @@ func (s *ServiceTestSuite) TestGetByID() {
 		assert.Equal(s.T(), model.Email, user.Email)
 		assert.Equal(s.T(), userentity.RoleUser, user.Role)
 		assert.Equal(s.T(), model.Username, user.Username)
-		assert.Equal(s.T(), model.Password, user.Password)
+		assert.Equal(s.T(), model.PasswordHash, user.PasswordHash)
 		assert.Equal(s.T(), model.AvatarURL, user.AvatarURL)
 		assert.Equal(s.T(), model.Mobile, user.Mobile)
 		assert.Equal(s.T(), model.FirstName, user.FirstName)
@@ -163,7 +180,6 @@ func (s *ServiceTestSuite) TestGetByID() {
 			Email:    "test@example.com",
 			Role:     usermodel.Role("invalid"),
 			Username: "testuser",
-			Password: "password123",
 		}
 
 		s.mockRepo.EXPECT().
@@ -180,15 +196,15 @@ func (s *ServiceTestSuite) TestGetByEmail() {
 	s.Run("should get user by email successfully", func() {
 		email := "test@example.com"
 		model := usermodel.User{
-			ID:        uuid.New().String(),
-			Email:     email,
-			Role:      usermodel.RoleAdmin,
-			Username:  "testuser",
-			Password:  "password123",
-			AvatarURL: "https://example.com/avatar.jpg",
-			Mobile:    "+1234567890",
-			FirstName: "Jane",
-			LastName:  "Smith",
+			ID:           uuid.New().String(),
+			Email:        email,
+			Role:         usermodel.RoleAdmin,
+			Username:     "testuser",
+			PasswordHash: "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+			AvatarURL:    "https://example.com/avatar.jpg",
+			Mobile:       "+1234567890",
+			FirstName:    "Jane",
+			LastName:     "Smith",
 		}
 
 		s.mockRepo.EXPECT().
@@ -201,7 +217,7 @@ func (s *ServiceTestSuite) TestGetByEmail() {
 		assert.Equal(s.T(), email, user.Email)
 		assert.Equal(s.T(), userentity.RoleAdmin, user.Role)
 		assert.Equal(s.T(), model.Username, user.Username)
-		assert.Equal(s.T(), model.Password, user.Password)
+		assert.Equal(s.T(), model.PasswordHash, user.PasswordHash)
 		assert.Equal(s.T(), model.AvatarURL, user.AvatarURL)
 		assert.Equal(s.T(), model.Mobile, user.Mobile)
 		assert.Equal(s.T(), model.FirstName, user.FirstName)
@@ -229,7 +245,6 @@ func (s *ServiceTestSuite) TestGetByEmail() {
 			Email:    email,
 			Role:     usermodel.Role("invalid"),
 			Username: "testuser",
-			Password: "password123",
 		}
 
 		s.mockRepo.EXPECT().
@@ -268,6 +283,177 @@ func (s *ServiceTestSuite) TestDeleteByID() {
 	})
 }
 
+func (s *ServiceTestSuite) TestVerifyPassword() {
+	s.Run("should verify password successfully", func() {
+		hash, err := s.hasher.Hash("password123")
+		s.Require().NoError(err)
+
+		model := usermodel.User{
+			ID:           uuid.New().String(),
+			Email:        "test@example.com",
+			Role:         usermodel.RoleUser,
+			Username:     "testuser",
+			PasswordHash: hash,
+		}
+
+		s.mockRepo.EXPECT().
+			GetByEmail(s.ctx, model.Email).
+			Return(model, nil)
+
+		user, err := s.service.VerifyPassword(s.ctx, VerifyPasswordRequest{
+			Email:    model.Email,
+			Password: "password123",
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), model.ID, user.ID)
+	})
+
+	s.Run("should return invalid credentials when password does not match", func() {
+		hash, err := s.hasher.Hash("password123")
+		s.Require().NoError(err)
+
+		model := usermodel.User{
+			ID:           uuid.New().String(),
+			Email:        "test@example.com",
+			Role:         usermodel.RoleUser,
+			PasswordHash: hash,
+		}
+
+		s.mockRepo.EXPECT().
+			GetByEmail(s.ctx, model.Email).
+			Return(model, nil)
+
+		_, err = s.service.VerifyPassword(s.ctx, VerifyPasswordRequest{
+			Email:    model.Email,
+			Password: "wrong-password",
+		})
+		assert.ErrorIs(s.T(), err, ErrInvalidCredentials)
+	})
+
+	s.Run("should return invalid credentials when user does not exist", func() {
+		s.mockRepo.EXPECT().
+			GetByEmail(s.ctx, "missing@example.com").
+			Return(usermodel.User{}, pgx.ErrNoRows)
+
+		_, err := s.service.VerifyPassword(s.ctx, VerifyPasswordRequest{
+			Email:    "missing@example.com",
+			Password: "password123",
+		})
+		assert.ErrorIs(s.T(), err, ErrInvalidCredentials)
+	})
+
+	s.Run("should rehash password when parameters changed", func() {
+		legacyHasher, err := password.NewHasher(password.Params{
+			Memory:      2048,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		})
+		s.Require().NoError(err)
+
+		legacyHash, err := legacyHasher.Hash("password123")
+		s.Require().NoError(err)
+
+		model := usermodel.User{
+			ID:           uuid.New().String(),
+			Email:        "test@example.com",
+			Role:         usermodel.RoleUser,
+			PasswordHash: legacyHash,
+		}
+
+		s.mockRepo.EXPECT().
+			GetByEmail(s.ctx, model.Email).
+			Return(model, nil)
+		s.mockRepo.EXPECT().
+			UpdatePasswordHash(s.ctx, model.ID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, passwordHash string) error {
+				match, needsRehash, err := s.hasher.Verify("password123", passwordHash)
+				assert.NoError(s.T(), err)
+				assert.True(s.T(), match)
+				assert.False(s.T(), needsRehash)
+				return nil
+			})
+
+		_, err = s.service.VerifyPassword(s.ctx, VerifyPasswordRequest{
+			Email:    model.Email,
+			Password: "password123",
+		})
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should rehash legacy bcrypt password", func() {
+		legacyHash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		s.Require().NoError(err)
+
+		model := usermodel.User{
+			ID:           uuid.New().String(),
+			Email:        "test@example.com",
+			Role:         usermodel.RoleUser,
+			PasswordHash: string(legacyHash),
+		}
+
+		s.mockRepo.EXPECT().
+			GetByEmail(s.ctx, model.Email).
+			Return(model, nil)
+		s.mockRepo.EXPECT().
+			UpdatePasswordHash(s.ctx, model.ID, gomock.Any()).
+			Return(nil)
+
+		_, err = s.service.VerifyPassword(s.ctx, VerifyPasswordRequest{
+			Email:    model.Email,
+			Password: "password123",
+		})
+		require.NoError(s.T(), err)
+	})
+}
+
+func (s *ServiceTestSuite) TestUpdatePassword() {
+	s.Run("should store hash of new password", func() {
+		userID := uuid.New().String()
+
+		s.mockRepo.EXPECT().
+			UpdatePasswordHash(s.ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, passwordHash string) error {
+				match, _, err := s.hasher.Verify("new-password", passwordHash)
+				s.Require().NoError(err)
+				assert.True(s.T(), match)
+				return nil
+			})
+
+		err := s.service.UpdatePassword(s.ctx, UpdatePasswordRequest{
+			ID:       userID,
+			Password: "new-password",
+		})
+		require.NoError(s.T(), err)
+	})
+}
+
+func (s *ServiceTestSuite) TestVerifyEmail() {
+	s.Run("should mark email as verified", func() {
+		userID := uuid.New().String()
+
+		s.mockRepo.EXPECT().
+			MarkEmailVerified(s.ctx, userID).
+			Return(nil)
+
+		err := s.service.VerifyEmail(s.ctx, userID)
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should return error when repository fails", func() {
+		userID := uuid.New().String()
+		expectedErr := errors.New("database error")
+
+		s.mockRepo.EXPECT().
+			MarkEmailVerified(s.ctx, userID).
+			Return(expectedErr)
+
+		err := s.service.VerifyEmail(s.ctx, userID)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kitanoyoru/kgym/internal/apps/user/pkg/password"
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upHashUserPasswords, downHashUserPasswords)
+}
+
+// upHashUserPasswords replaces plaintext passwords left over from before
+// password_hash was introduced. Rows that already hold an argon2id or bcrypt
+// hash are left untouched.
+func upHashUserPasswords(ctx context.Context, tx *sql.Tx) error {
+	hasher, err := password.NewHasher(password.DefaultParams)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, password_hash FROM users")
+	if err != nil {
+		return err
+	}
+
+	plaintexts := make(map[string]string)
+	for rows.Next() {
+		var id, value string
+		if err := rows.Scan(&id, &value); err != nil {
+			rows.Close()
+			return err
+		}
+		if !password.IsHashed(value) {
+			plaintexts[id] = value
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for id, plaintext := range plaintexts {
+		hash, err := hasher.Hash(plaintext)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE users SET password_hash = $1 WHERE id = $2", hash, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// downHashUserPasswords is a no-op: hashing is not reversible.
+func downHashUserPasswords(_ context.Context, _ *sql.Tx) error {
+	return nil
+}
@@ -0,0 +1,165 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidHash          = errors.New("invalid password hash")
+	ErrIncompatibleVersion  = errors.New("incompatible argon2 version")
+	ErrUnsupportedAlgorithm = errors.New("unsupported password hash algorithm")
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+)
+
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+var DefaultParams = Params{
+	Memory:      64 * 1024, // 64 MiB
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type Hasher struct {
+	params Params
+
+	// dummyHash is verified against when the user is unknown, so that the
+	// response time does not reveal whether an account exists.
+	dummyHash string
+}
+
+func NewHasher(params Params) (*Hasher, error) {
+	h := &Hasher{
+		params: params,
+	}
+
+	dummyHash, err := h.Hash("kgym-dummy-password")
+	if err != nil {
+		return nil, err
+	}
+	h.dummyHash = dummyHash
+
+	return h, nil
+}
+
+// Hash returns the Argon2id hash of the password in PHC string format:
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		AlgorithmArgon2id,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify checks the password against an Argon2id PHC string or a legacy
+// bcrypt hash. needsRehash is true when the password matches but the hash
+// was not produced with the hasher's current algorithm and parameters.
+func (h *Hasher) Verify(password, encodedHash string) (match bool, needsRehash bool, err error) {
+	switch {
+	case strings.HasPrefix(encodedHash, "$"+AlgorithmArgon2id+"$"):
+		params, salt, key, err := decodeArgon2id(encodedHash)
+		if err != nil {
+			return false, false, err
+		}
+
+		otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+			return false, false, nil
+		}
+
+		return true, params != h.params, nil
+	case isBcrypt(encodedHash):
+		err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		if err != nil {
+			return false, false, err
+		}
+
+		return true, true, nil
+	default:
+		return false, false, ErrUnsupportedAlgorithm
+	}
+}
+
+// VerifyDummy performs the same amount of work as Verify for a password
+// that belongs to no user. The result is always discarded.
+func (h *Hasher) VerifyDummy(password string) {
+	_, _, _ = h.Verify(password, h.dummyHash)
+}
+
+func IsHashed(value string) bool {
+	return strings.HasPrefix(value, "$"+AlgorithmArgon2id+"$") || isBcrypt(value)
+}
+
+func isBcrypt(value string) bool {
+	return strings.HasPrefix(value, "$2a$") ||
+		strings.HasPrefix(value, "$2b$") ||
+		strings.HasPrefix(value, "$2y$")
+}
+
+func decodeArgon2id(encodedHash string) (Params, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+	if version != argon2.Version {
+		return Params{}, nil, nil, ErrIncompatibleVersion
+	}
+
+	var params Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.Strict().DecodeString(parts[4])
+	if err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+	params.SaltLength = uint32(len(salt))
+
+	key, err := base64.RawStdEncoding.Strict().DecodeString(parts[5])
+	if err != nil {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
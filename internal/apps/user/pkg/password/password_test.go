@@ -0,0 +1,115 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testParams = Params{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHasher_Hash(t *testing.T) {
+	t.Run("should encode hash in PHC format", func(t *testing.T) {
+		hasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		hash, err := hasher.Hash("password123")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+		assert.Len(t, strings.Split(hash, "$"), 6)
+		assert.True(t, IsHashed(hash))
+	})
+
+	t.Run("should use a random salt", func(t *testing.T) {
+		hasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		first, err := hasher.Hash("password123")
+		require.NoError(t, err)
+		second, err := hasher.Hash("password123")
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestHasher_Verify(t *testing.T) {
+	t.Run("should verify argon2id hash", func(t *testing.T) {
+		hasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		hash, err := hasher.Hash("password123")
+		require.NoError(t, err)
+
+		match, needsRehash, err := hasher.Verify("password123", hash)
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.False(t, needsRehash)
+
+		match, needsRehash, err = hasher.Verify("wrong-password", hash)
+		require.NoError(t, err)
+		assert.False(t, match)
+		assert.False(t, needsRehash)
+	})
+
+	t.Run("should request rehash when parameters change", func(t *testing.T) {
+		oldHasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		hash, err := oldHasher.Hash("password123")
+		require.NoError(t, err)
+
+		newParams := testParams
+		newParams.Iterations = 2
+
+		newHasher, err := NewHasher(newParams)
+		require.NoError(t, err)
+
+		match, needsRehash, err := newHasher.Verify("password123", hash)
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, needsRehash)
+	})
+
+	t.Run("should verify legacy bcrypt hash and request rehash", func(t *testing.T) {
+		hasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		legacy, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		require.NoError(t, err)
+		assert.True(t, IsHashed(string(legacy)))
+
+		match, needsRehash, err := hasher.Verify("password123", string(legacy))
+		require.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, needsRehash)
+
+		match, _, err = hasher.Verify("wrong-password", string(legacy))
+		require.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("should reject unsupported and malformed hashes", func(t *testing.T) {
+		hasher, err := NewHasher(testParams)
+		require.NoError(t, err)
+
+		_, _, err = hasher.Verify("password123", "password123")
+		assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+		assert.False(t, IsHashed("password123"))
+
+		_, _, err = hasher.Verify("password123", "$argon2id$v=19$m=1024,t=1,p=1$salt")
+		assert.ErrorIs(t, err, ErrInvalidHash)
+
+		_, _, err = hasher.Verify("password123", "$argon2id$v=16$m=1024,t=1,p=1$c2FsdHNhbHQ$a2V5")
+		assert.ErrorIs(t, err, ErrIncompatibleVersion)
+	})
+}
@@ -0,0 +1,176 @@
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/clientip"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Scheme is the authorization scheme integrations present their keys
+	// with, as in "Authorization: ApiKey <key>".
+	Scheme = "ApiKey"
+
+	// refreshMargin is how long before it expires a cached token is
+	// exchanged again, so that it does not expire on its way upstream.
+	refreshMargin = 30 * time.Second
+)
+
+var ErrMalformedToken = errors.New("malformed access token")
+
+// Exchanger trades API keys for the short-lived access tokens the services
+// behind the gateway accept. Tokens are cached per key until shortly before
+// they expire, so that an integration does not cost an exchange per request.
+type Exchanger struct {
+	grpcSSOServiceClient pbSSO.SSOServiceClient
+	clientIPResolver     clientip.Resolver
+
+	mu     sync.Mutex
+	tokens map[string]token
+}
+
+type Config struct {
+	GRPCEndpoint     string
+	GRPCDialOptions  []grpc.DialOption
+	ClientIPResolver clientip.Resolver
+}
+
+type token struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+func New(ctx context.Context, cfg Config) (*Exchanger, error) {
+	conn, err := grpc.NewClient(cfg.GRPCEndpoint, cfg.GRPCDialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Errorf("Failed to close conn to %s: %v", cfg.GRPCEndpoint, cerr)
+		}
+	}()
+
+	return &Exchanger{
+		grpcSSOServiceClient: pbSSO.NewSSOServiceClient(conn),
+		clientIPResolver:     cfg.ClientIPResolver,
+		tokens:               make(map[string]token),
+	}, nil
+}
+
+// Exchange returns an access token for the API key the request was made
+// with.
+func (e *Exchanger) Exchange(r *http.Request, apiKey string) (string, error) {
+	// Keys are only held hashed, so that a dump of the gateway does not leak
+	// them.
+	sum := sha256.Sum256([]byte(apiKey))
+	cacheKey := hex.EncodeToString(sum[:])
+
+	now := time.Now()
+
+	e.mu.Lock()
+	cached, ok := e.tokens[cacheKey]
+	e.mu.Unlock()
+
+	if ok && now.Before(cached.expiresAt.Add(-refreshMargin)) {
+		return cached.accessToken, nil
+	}
+
+	resp, err := e.grpcSSOServiceClient.GetToken(e.outgoingContext(r), &pbSSO.GetToken_Request{
+		Grant: &pbSSO.GetToken_Request_ApiKeyGrant{
+			ApiKeyGrant: &pbSSO.APIKeyGrant{
+				ApiKey: apiKey,
+			},
+		},
+	})
+	if err != nil {
+		e.mu.Lock()
+		delete(e.tokens, cacheKey)
+		e.mu.Unlock()
+
+		return "", err
+	}
+
+	expiresAt, err := expiry(resp.Token.AccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	e.mu.Lock()
+	e.evictExpired(now)
+	e.tokens[cacheKey] = token{
+		accessToken: resp.Token.AccessToken,
+		expiresAt:   expiresAt,
+	}
+	e.mu.Unlock()
+
+	return resp.Token.AccessToken, nil
+}
+
+// evictExpired drops the tokens that can no longer be used, so that keys
+// which stopped calling do not pile up. The caller must hold e.mu.
+func (e *Exchanger) evictExpired(now time.Time) {
+	for cacheKey, cached := range e.tokens {
+		if !now.Before(cached.expiresAt) {
+			delete(e.tokens, cacheKey)
+		}
+	}
+}
+
+func (e *Exchanger) outgoingContext(r *http.Request) context.Context {
+	md := map[string]string{
+		"x-request-id":  r.Header.Get("X-Request-ID"),
+		"x-platform":    r.Header.Get("X-Platform"),
+		"x-app-version": r.Header.Get("X-App-Version"),
+		"x-client-ip":   e.clientIPResolver.ClientIP(r),
+		"x-device":      r.Header.Get("X-Device"),
+	}
+	if md["x-device"] == "" {
+		md["x-device"] = r.UserAgent()
+	}
+
+	return metadata.NewOutgoingContext(r.Context(), metadata.New(md))
+}
+
+// expiry reads when an access token expires. The token was just issued by
+// the SSO service over a trusted connection and is verified by the service
+// it is forwarded to, so its signature is not checked here.
+func expiry(accessToken string) (time.Time, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, ErrMalformedToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, errors.Wrap(ErrMalformedToken, err.Error())
+	}
+
+	var claims struct {
+		ExpiresAt int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.Wrap(ErrMalformedToken, err.Error())
+	}
+
+	if claims.ExpiresAt == 0 {
+		return time.Time{}, errors.Wrap(ErrMalformedToken, "missing exp claim")
+	}
+
+	return time.Unix(claims.ExpiresAt, 0), nil
+}
@@ -0,0 +1,206 @@
+package apikey
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/clientip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stubSSOClient answers the API key grant with getToken and counts the
+// exchanges.
+type stubSSOClient struct {
+	pbSSO.SSOServiceClient
+
+	getToken  func(ctx context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error)
+	exchanges atomic.Int32
+}
+
+func (c *stubSSOClient) GetToken(ctx context.Context, req *pbSSO.GetToken_Request, _ ...grpc.CallOption) (*pbSSO.GetToken_Response, error) {
+	c.exchanges.Add(1)
+
+	return c.getToken(ctx, req)
+}
+
+func newTestExchanger(client *stubSSOClient) *Exchanger {
+	return &Exchanger{
+		grpcSSOServiceClient: client,
+		clientIPResolver:     clientip.Resolver{TrustedProxies: 1},
+		tokens:               make(map[string]token),
+	}
+}
+
+// unsignedToken builds an access token that expires at expiresAt. The
+// exchanger does not check signatures.
+func unsignedToken(expiresAt time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"at+jwt"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())))
+
+	return header + "." + payload + ".signature"
+}
+
+func tokenResponse(accessToken string) *pbSSO.GetToken_Response {
+	return &pbSSO.GetToken_Response{
+		Token: &pbSSO.Token{AccessToken: accessToken},
+	}
+}
+
+func TestExchanger_Exchange(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt time.Duration
+		exchanges int32
+	}{
+		{
+			name:      "should reuse token until shortly before it expires",
+			expiresAt: 5 * time.Minute,
+			exchanges: 1,
+		},
+		{
+			name:      "should exchange again once token is about to expire",
+			expiresAt: refreshMargin / 2,
+			exchanges: 2,
+		},
+		{
+			name:      "should exchange again once token expired",
+			expiresAt: -time.Minute,
+			exchanges: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessToken := unsignedToken(time.Now().Add(tt.expiresAt))
+
+			client := &stubSSOClient{
+				getToken: func(_ context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+					assert.Equal(t, "kgym_key", req.GetApiKeyGrant().GetApiKey())
+					return tokenResponse(accessToken), nil
+				},
+			}
+			exchanger := newTestExchanger(client)
+
+			for range 2 {
+				got, err := exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), "kgym_key")
+				require.NoError(t, err)
+				assert.Equal(t, accessToken, got)
+			}
+
+			assert.Equal(t, tt.exchanges, client.exchanges.Load())
+		})
+	}
+
+	t.Run("should cache tokens per key", func(t *testing.T) {
+		client := &stubSSOClient{
+			getToken: func(_ context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+				return tokenResponse(unsignedToken(time.Now().Add(time.Hour))), nil
+			},
+		}
+		exchanger := newTestExchanger(client)
+
+		for _, apiKey := range []string{"kgym_first", "kgym_second", "kgym_first"} {
+			_, err := exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), apiKey)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(2), client.exchanges.Load())
+		assert.Len(t, exchanger.tokens, 2)
+		assert.NotContains(t, exchanger.tokens, "kgym_first", "keys must only be held hashed")
+	})
+
+	t.Run("should drop cached token when exchange fails", func(t *testing.T) {
+		var revoked atomic.Bool
+
+		client := &stubSSOClient{
+			getToken: func(_ context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+				if revoked.Load() {
+					return nil, status.Error(codes.Unauthenticated, "invalid api key")
+				}
+				return tokenResponse(unsignedToken(time.Now().Add(refreshMargin / 2))), nil
+			},
+		}
+		exchanger := newTestExchanger(client)
+
+		_, err := exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), "kgym_key")
+		require.NoError(t, err)
+		require.Len(t, exchanger.tokens, 1)
+
+		revoked.Store(true)
+
+		_, err = exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), "kgym_key")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Empty(t, exchanger.tokens)
+	})
+
+	t.Run("should evict expired tokens of other keys", func(t *testing.T) {
+		client := &stubSSOClient{
+			getToken: func(_ context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+				return tokenResponse(unsignedToken(time.Now().Add(time.Hour))), nil
+			},
+		}
+		exchanger := newTestExchanger(client)
+		exchanger.tokens["stale"] = token{accessToken: "stale", expiresAt: time.Now().Add(-time.Second)}
+
+		_, err := exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), "kgym_key")
+		require.NoError(t, err)
+
+		assert.NotContains(t, exchanger.tokens, "stale")
+		assert.Len(t, exchanger.tokens, 1)
+	})
+
+	t.Run("should reject malformed tokens", func(t *testing.T) {
+		for _, accessToken := range []string{
+			"not-a-jwt",
+			"header.%%%.signature",
+			"header." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + ".signature",
+		} {
+			client := &stubSSOClient{
+				getToken: func(_ context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+					return tokenResponse(accessToken), nil
+				},
+			}
+			exchanger := newTestExchanger(client)
+
+			_, err := exchanger.Exchange(httptest.NewRequest(http.MethodGet, "/", nil), "kgym_key")
+			assert.ErrorIs(t, err, ErrMalformedToken, accessToken)
+			assert.Empty(t, exchanger.tokens)
+		}
+	})
+
+	t.Run("should forward the client of the request", func(t *testing.T) {
+		client := &stubSSOClient{
+			getToken: func(ctx context.Context, req *pbSSO.GetToken_Request) (*pbSSO.GetToken_Response, error) {
+				md, ok := metadata.FromOutgoingContext(ctx)
+				require.True(t, ok)
+				assert.Equal(t, []string{"203.0.113.7"}, md.Get("x-client-ip"))
+				assert.Equal(t, []string{"front-desk/1.0"}, md.Get("x-device"))
+				assert.Equal(t, []string{"request-123"}, md.Get("x-request-id"))
+
+				return tokenResponse(unsignedToken(time.Now().Add(time.Hour))), nil
+			},
+		}
+		exchanger := newTestExchanger(client)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "10.0.0.2:443"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+		req.Header.Set("X-Request-ID", "request-123")
+		req.Header.Set("User-Agent", "front-desk/1.0")
+
+		_, err := exchanger.Exchange(req, "kgym_key")
+		require.NoError(t, err)
+	})
+}
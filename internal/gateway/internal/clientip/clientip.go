@@ -0,0 +1,45 @@
+package clientip
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Resolver finds the address of the user agent behind a known number of
+// reverse proxies. Each proxy appends the address it received the request
+// from to X-Forwarded-For, so only the entries added by trusted proxies can be
+// relied on; anything before them is set by the client.
+type Resolver struct {
+	TrustedProxies int
+}
+
+func (r Resolver) ClientIP(req *http.Request) string {
+	remoteAddr := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+
+	if r.TrustedProxies <= 0 {
+		return remoteAddr
+	}
+
+	var hops []string
+	for _, header := range req.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(header, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	hops = append(hops, remoteAddr)
+
+	// The last hop is the proxy that connected to us; walking back over the
+	// trusted proxies gives the address the outermost one saw.
+	i := len(hops) - 1 - r.TrustedProxies
+	if i < 0 {
+		i = 0
+	}
+
+	return hops[i]
+}
@@ -0,0 +1,84 @@
+package clientip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolver_ClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies int
+		remoteAddr     string
+		forwardedFor   []string
+		expected       string
+	}{
+		{
+			name:       "should use the peer address without trusted proxies",
+			remoteAddr: "203.0.113.7:51234",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:         "should ignore forwarded addresses without trusted proxies",
+			remoteAddr:   "203.0.113.7:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			expected:     "203.0.113.7",
+		},
+		{
+			name:           "should use the address the trusted proxy saw",
+			trustedProxies: 1,
+			remoteAddr:     "10.0.0.2:443",
+			forwardedFor:   []string{"203.0.113.7"},
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should ignore addresses the client prepended",
+			trustedProxies: 1,
+			remoteAddr:     "10.0.0.2:443",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.7"},
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should walk back over every trusted proxy",
+			trustedProxies: 2,
+			remoteAddr:     "10.0.0.3:443",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.7", "10.0.0.2"},
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should use the first hop when there are fewer than the trusted proxies",
+			trustedProxies: 3,
+			remoteAddr:     "10.0.0.2:443",
+			forwardedFor:   []string{"203.0.113.7"},
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "should skip empty forwarded entries",
+			trustedProxies: 1,
+			remoteAddr:     "10.0.0.2:443",
+			forwardedFor:   []string{"203.0.113.7, ,"},
+			expected:       "203.0.113.7",
+		},
+		{
+			name:       "should keep a peer address without a port",
+			remoteAddr: "203.0.113.7",
+			expected:   "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			resolver := Resolver{TrustedProxies: tt.trustedProxies}
+			assert.Equal(t, tt.expected, resolver.ClientIP(req))
+		})
+	}
+}
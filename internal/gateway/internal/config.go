@@ -13,6 +13,17 @@ type Config struct {
 	EnableCors     bool   `env:"KGYM_GATEWAY_ENABLE_CORS" validate:"required"`
 	BodyLimit      int    `env:"KGYM_GATEWAY_BODY_LIMIT" validate:"required"`
 	MaxGRPCMsgSize int    `env:"KGYM_GATEWAY_MAX_GRPC_MSG_SIZE" validate:"required"`
+	// TrustedProxies is the number of reverse proxies in front of the gateway
+	// whose X-Forwarded-For entries are trusted.
+	TrustedProxies int `env:"KGYM_GATEWAY_TRUSTED_PROXIES" envDefault:"0" validate:"gte=0"`
+	// PublicURL is the origin clients reach the gateway at, such as
+	// https://api.kgym.app. DPoP proofs are checked against it.
+	PublicURL string `env:"KGYM_GATEWAY_PUBLIC_URL" validate:"omitempty,url"`
+	// AuthIssuer, AuthAudiences and AuthJWKSURL configure the verification
+	// of access tokens issued by SSO, as in the services behind the gateway.
+	AuthIssuer    string   `env:"KGYM_GATEWAY_AUTH_ISSUER" validate:"required,url"`
+	AuthAudiences []string `env:"KGYM_GATEWAY_AUTH_AUDIENCES" validate:"required,min=1"`
+	AuthJWKSURL   string   `env:"KGYM_GATEWAY_AUTH_JWKS_URL" validate:"required,url"`
 }
 
 func ParseAndValidate(ctx context.Context, cfg *Config) error {
@@ -0,0 +1,61 @@
+package dpop
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	Header = "DPoP"
+
+	// MetadataProof, MetadataHTTPMethod and MetadataHTTPURL carry a proof to
+	// the services along with the method and URL of the request it has to
+	// match.
+	MetadataProof      = "dpop"
+	MetadataHTTPMethod = "x-http-method"
+	MetadataHTTPURL    = "x-http-url"
+)
+
+// Forwarder passes the DPoP proofs of requests on to the services, which
+// check them against the request the client made. The gateway is the last
+// place that knows that request.
+type Forwarder struct {
+	// PublicURL is the origin clients reach the gateway at. Proofs name the
+	// URL the client saw, so it has to be set when a proxy in front of the
+	// gateway terminates TLS or rewrites the host. Without it the origin is
+	// taken from the request.
+	PublicURL string
+}
+
+// Metadata returns the metadata to forward for r, which is empty if r carries
+// no proof. Every proof is forwarded, so that the services can refuse
+// requests with more than one.
+func (f Forwarder) Metadata(r *http.Request) metadata.MD {
+	proofs := r.Header.Values(Header)
+	if len(proofs) == 0 {
+		return metadata.MD{}
+	}
+
+	md := metadata.MD{
+		MetadataHTTPMethod: []string{r.Method},
+		MetadataHTTPURL:    []string{f.requestURL(r)},
+	}
+	md.Append(MetadataProof, proofs...)
+
+	return md
+}
+
+func (f Forwarder) requestURL(r *http.Request) string {
+	origin := f.PublicURL
+	if origin == "" {
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		origin = scheme + "://" + r.Host
+	}
+
+	return strings.TrimSuffix(origin, "/") + r.URL.EscapedPath()
+}
@@ -2,7 +2,9 @@ package gateway
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,16 +12,23 @@ import (
 	pbFile "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/file/v1"
 	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
 	pbUser "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/user/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/apikey"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/clientip"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/dpop"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/handlers/authorize"
 	"github.com/kitanoyoru/kgym/internal/gateway/internal/handlers/file"
 	"github.com/kitanoyoru/kgym/internal/gateway/internal/middlewares"
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
 	"github.com/rs/cors"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/multierr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func New(ctx context.Context, cfg Config) (*Gateway, error) {
@@ -40,12 +49,20 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 	}
 	healthClient := grpc_health_v1.NewHealthClient(healthConn)
 
+	clientIPResolver := clientip.Resolver{TrustedProxies: cfg.TrustedProxies}
+	dpopForwarder := dpop.Forwarder{PublicURL: cfg.PublicURL}
+
 	mux := runtime.NewServeMux(
 		runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
 			md := map[string]string{
 				"x-request-id":  r.Header.Get("X-Request-ID"),
 				"x-platform":    r.Header.Get("X-Platform"),
 				"x-app-version": r.Header.Get("X-App-Version"),
+				"x-client-ip":   clientIPResolver.ClientIP(r),
+				"x-device":      r.Header.Get("X-Device"),
+			}
+			if md["x-device"] == "" {
+				md["x-device"] = r.UserAgent()
 			}
 
 			authorization := r.Header.Get("Authorization")
@@ -56,7 +73,19 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 			}
 			md["authorization"] = authorization
 
-			return metadata.New(md)
+			return metadata.Join(metadata.New(md), dpopForwarder.Metadata(r))
+		}),
+		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			switch key {
+			case "cache-control", "etag":
+				return key, true
+			default:
+				return runtime.MetadataHeaderPrefix + key, true
+			}
+		}),
+		runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			setRetryAfter(w, err)
+			runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
 		}),
 		runtime.WithHealthzEndpoint(healthClient),
 	)
@@ -65,6 +94,14 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 		pbUser.RegisterUserServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
 		pbFile.RegisterFileServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
 		pbSSO.RegisterSSOServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterClientServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterMFAServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterSessionServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterAccountServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterFederationServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterPasskeyServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
+		pbSSO.RegisterAPIKeyServiceHandlerFromEndpoint(ctx, mux, cfg.GRPCEndpoint, opts),
 	)
 	if err != nil {
 		return nil, err
@@ -73,6 +110,7 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 	fileHandler, err := file.New(ctx, mux, file.Config{
 		GRPCEndpoint:    cfg.GRPCEndpoint,
 		GRPCDialOptions: opts,
+		DPoPForwarder:   dpopForwarder,
 		BodyLimit:       cfg.BodyLimit,
 		ChunkSize:       5 * 1024 * 1024, // 5MB
 	})
@@ -80,13 +118,43 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 		return nil, err
 	}
 
+	authorizeHandler, err := authorize.New(ctx, authorize.Config{
+		GRPCEndpoint:     cfg.GRPCEndpoint,
+		GRPCDialOptions:  opts,
+		ClientIPResolver: clientIPResolver,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyExchanger, err := apikey.New(ctx, apikey.Config{
+		GRPCEndpoint:     cfg.GRPCEndpoint,
+		GRPCDialOptions:  opts,
+		ClientIPResolver: clientIPResolver,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = multierr.Combine(
 		mux.HandlePath(http.MethodPost, "/api/v1/files/user-avatar", fileHandler.UploadUserAvatar()),
+		mux.HandlePath(http.MethodGet, authorize.Path, authorizeHandler.LoginPage()),
+		mux.HandlePath(http.MethodPost, authorize.Path, authorizeHandler.Login()),
+		mux.HandlePath(http.MethodGet, authorize.FederationPath, authorizeHandler.StartFederation()),
+		mux.HandlePath(http.MethodGet, authorize.FederationCallbackPath, authorizeHandler.FederationCallback()),
+		mux.HandlePath(http.MethodGet, authorize.DevicePath, authorizeHandler.DevicePage()),
+		mux.HandlePath(http.MethodPost, authorize.DevicePath, authorizeHandler.AuthorizeDevice()),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	verifier := pkgauth.NewVerifier(pkgauth.Config{
+		Issuer:    cfg.AuthIssuer,
+		Audiences: cfg.AuthAudiences,
+		JWKSURL:   cfg.AuthJWKSURL,
+	})
+
 	handler := otelhttp.NewHandler(
 		middlewares.Logging(
 			cors.New(cors.Options{
@@ -99,7 +167,7 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 					http.MethodDelete,
 					http.MethodOptions,
 				},
-			}).Handler(mux),
+			}).Handler(middlewares.APIKey(apiKeyExchanger)(middlewares.Auth(verifier)(mux))),
 		),
 		"gateway",
 		otelhttp.WithFilter(func(r *http.Request) bool {
@@ -108,6 +176,7 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 	)
 
 	return &Gateway{
+		verifier: verifier,
 		server: &http.Server{
 			Addr:         ":" + cfg.HTTPPort,
 			ReadTimeout:  30 * time.Second,
@@ -118,11 +187,26 @@ func New(ctx context.Context, cfg Config) (*Gateway, error) {
 	}, nil
 }
 
+// setRetryAfter tells the client when to try again if the upstream service
+// sent a retry delay along with the error.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := int64(math.Ceil(info.GetRetryDelay().AsDuration().Seconds()))
+			w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			return
+		}
+	}
+}
+
 type Gateway struct {
-	server *http.Server
+	verifier *pkgauth.Verifier
+	server   *http.Server
 }
 
 func (g *Gateway) Run(ctx context.Context) error {
+	go g.verifier.Run(ctx)
+
 	return g.server.ListenAndServe()
 }
 
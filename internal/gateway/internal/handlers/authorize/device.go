@@ -0,0 +1,160 @@
+package authorize
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DevicePath = "/device"
+)
+
+//go:embed templates/device.html
+var devicePageTemplateSource string
+
+var devicePageTemplate = template.Must(template.New("device").Parse(devicePageTemplateSource))
+
+type devicePage struct {
+	Action string
+
+	UserCode string
+
+	// ClientName and Scopes are only set once the code was looked up.
+	ClientName string
+	Scope      string
+	Scopes     []string
+
+	Username string
+
+	Message string
+	Error   string
+	Done    bool
+}
+
+// DevicePage asks for the code shown on a device and, once it is known,
+// which application is asking for access.
+func (h *Handler) DevicePage() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		p := devicePage{
+			Action:   DevicePath,
+			UserCode: r.URL.Query().Get("user_code"),
+		}
+
+		if p.UserCode == "" {
+			renderDevice(w, http.StatusOK, p)
+			return
+		}
+
+		resp, err := h.grpcSSOServiceClient.GetDeviceAuthorization(h.outgoingContext(r), &pbSSO.GetDeviceAuthorization_Request{
+			UserCode: p.UserCode,
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound, codes.InvalidArgument:
+				p.Error = "The code is invalid or has expired. Check the code shown on your device."
+				renderDevice(w, http.StatusNotFound, p)
+			default:
+				grpclog.Errorf("Failed to get device authorization: %v", err)
+				p.Error = "Something went wrong, please try again later."
+				renderDevice(w, http.StatusInternalServerError, p)
+			}
+			return
+		}
+
+		p.ClientName = resp.ClientName
+		p.Scope = resp.Scope
+		p.Scopes = strings.Fields(resp.Scope)
+
+		renderDevice(w, http.StatusOK, p)
+	}
+}
+
+// AuthorizeDevice authenticates the user and allows or denies the device
+// the code belongs to.
+func (h *Handler) AuthorizeDevice() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if err := r.ParseForm(); err != nil {
+			renderDevice(w, http.StatusBadRequest, devicePage{Action: DevicePath, Error: "Malformed request."})
+			return
+		}
+
+		p := devicePage{
+			Action:     DevicePath,
+			UserCode:   r.PostForm.Get("user_code"),
+			ClientName: r.PostForm.Get("client_name"),
+			Scope:      r.PostForm.Get("scope"),
+			Scopes:     strings.Fields(r.PostForm.Get("scope")),
+			Username:   r.PostForm.Get("username"),
+		}
+		approve := r.PostForm.Get("decision") == "allow"
+
+		resp, err := h.grpcSSOServiceClient.AuthorizeDevice(h.outgoingContext(r), &pbSSO.AuthorizeDevice_Request{
+			UserCode: p.UserCode,
+			Approve:  approve,
+			Username: p.Username,
+			Password: r.PostForm.Get("password"),
+			Otp:      r.PostForm.Get("otp"),
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound, codes.InvalidArgument:
+				p.ClientName = ""
+				p.Error = "The code is invalid or has expired. Check the code shown on your device."
+				renderDevice(w, http.StatusNotFound, p)
+			case codes.Unauthenticated:
+				p.Error = "Invalid email, password or one-time code."
+				renderDevice(w, http.StatusUnauthorized, p)
+			case codes.ResourceExhausted:
+				p.Error = "Too many failed attempts, please try again later."
+				if delay := retryDelay(err); delay > 0 {
+					w.Header().Set("Retry-After", strconv.Itoa(int(delay.Seconds())))
+					p.Error = "Too many failed attempts, please try again in " + delay.String() + "."
+				}
+				renderDevice(w, http.StatusTooManyRequests, p)
+			case codes.PermissionDenied:
+				p.Error = "Enter the one-time code from your authenticator app."
+				renderDevice(w, http.StatusUnauthorized, p)
+			case codes.FailedPrecondition:
+				p.Error = "Your account requires two-factor authentication. Set up an authenticator app before signing in."
+				p.Done = true
+				renderDevice(w, http.StatusForbidden, p)
+			default:
+				grpclog.Errorf("Failed to authorize device: %v", err)
+				p.Error = "Something went wrong, please try again later."
+				renderDevice(w, http.StatusInternalServerError, p)
+			}
+			return
+		}
+
+		p.ClientName = resp.ClientName
+		p.Done = true
+		if approve {
+			p.Message = "Your device is signed in. You can return to it now."
+		} else {
+			p.Message = "Access was denied. Your device will not be signed in."
+		}
+
+		renderDevice(w, http.StatusOK, p)
+	}
+}
+
+func renderDevice(w http.ResponseWriter, code int, p devicePage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(code)
+
+	if err := devicePageTemplate.Execute(w, p); err != nil {
+		grpclog.Errorf("Failed to render device page: %v", err)
+	}
+}
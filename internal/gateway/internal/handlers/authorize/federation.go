@@ -0,0 +1,142 @@
+package authorize
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FederationPath         = Path + "/federation"
+	FederationCallbackPath = FederationPath + "/callback"
+)
+
+// authorizationParams are the parameters of the authorization request that
+// are carried over to the federated sign in.
+var authorizationParams = []string{
+	"client_id",
+	"redirect_uri",
+	"response_type",
+	"scope",
+	"state",
+	"nonce",
+	"code_challenge",
+	"code_challenge_method",
+}
+
+// StartFederation sends the user agent to the upstream identity provider
+// chosen on the login page.
+func (h *Handler) StartFederation() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		query := r.URL.Query()
+		p := pageFromValues(query)
+
+		resp, err := h.grpcFederationServiceClient.StartFederation(h.outgoingContext(r), &pbSSO.StartFederation_Request{
+			Provider:            query.Get("provider"),
+			ClientId:            p.ClientID,
+			RedirectUri:         p.RedirectURI,
+			ResponseType:        p.ResponseType,
+			Scope:               p.Scope,
+			State:               p.State,
+			Nonce:               p.Nonce,
+			CodeChallenge:       p.CodeChallenge,
+			CodeChallengeMethod: p.CodeChallengeMethod,
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				render(w, http.StatusBadRequest, page{Error: "Unknown identity provider.", Fatal: true})
+			case codes.InvalidArgument:
+				render(w, http.StatusBadRequest, page{Error: "The application sent an invalid authorization request: " + status.Convert(err).Message() + ".", Fatal: true})
+			case codes.Unavailable:
+				render(w, http.StatusBadGateway, page{Error: "The identity provider is unavailable, please try again later.", Fatal: true})
+			default:
+				grpclog.Errorf("Failed to start federated sign in: %v", err)
+				render(w, http.StatusInternalServerError, page{Error: "Something went wrong, please try again later.", Fatal: true})
+			}
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		http.Redirect(w, r, resp.AuthorizationUrl, http.StatusFound)
+	}
+}
+
+// FederationCallback completes a federated sign in when the upstream
+// identity provider sends the user agent back, and on success sends it on to
+// the client with an authorization code.
+func (h *Handler) FederationCallback() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		query := r.URL.Query()
+
+		if errorCode := query.Get("error"); errorCode != "" {
+			msg := "The identity provider did not sign you in: " + errorCode + "."
+			if description := query.Get("error_description"); description != "" {
+				msg = "The identity provider did not sign you in: " + description + "."
+			}
+			render(w, http.StatusUnauthorized, page{Error: msg, Fatal: true})
+			return
+		}
+
+		resp, err := h.grpcFederationServiceClient.CompleteFederation(h.outgoingContext(r), &pbSSO.CompleteFederation_Request{
+			State: query.Get("state"),
+			Code:  query.Get("code"),
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				render(w, http.StatusBadRequest, page{Error: "The sign in request expired or was already used. Please start over.", Fatal: true})
+			case codes.Unauthenticated:
+				render(w, http.StatusUnauthorized, page{Error: "The identity provider could not sign you in.", Fatal: true})
+			case codes.PermissionDenied:
+				render(w, http.StatusForbidden, page{Error: "Your account requires a one-time code. Sign in with your password instead.", Fatal: true})
+			case codes.FailedPrecondition:
+				render(w, http.StatusForbidden, page{Error: "This account cannot be signed in with the identity provider. Sign in with your password instead.", Fatal: true})
+			case codes.Unavailable:
+				render(w, http.StatusBadGateway, page{Error: "The identity provider is unavailable, please try again later.", Fatal: true})
+			default:
+				grpclog.Errorf("Failed to complete federated sign in: %v", err)
+				render(w, http.StatusInternalServerError, page{Error: "Something went wrong, please try again later.", Fatal: true})
+			}
+			return
+		}
+
+		redirectWithCode(w, r, resp.RedirectUri, resp.Code, resp.State)
+	}
+}
+
+// providers returns the upstream identity providers to offer on the login
+// page, each linking to the federated sign in for the same authorization
+// request. The login page still works with a password if they cannot be
+// listed.
+func (h *Handler) providers(ctx context.Context, values url.Values) []provider {
+	resp, err := h.grpcFederationServiceClient.ListIdentityProviders(ctx, &pbSSO.ListIdentityProviders_Request{})
+	if err != nil {
+		grpclog.Errorf("Failed to list identity providers: %v", err)
+		return nil
+	}
+
+	providers := make([]provider, 0, len(resp.Providers))
+	for _, idp := range resp.Providers {
+		query := url.Values{}
+		for _, param := range authorizationParams {
+			if value := values.Get(param); value != "" {
+				query.Set(param, value)
+			}
+		}
+		query.Set("provider", idp.Id)
+
+		providers = append(providers, provider{
+			Name: idp.Name,
+			URL:  FederationPath + "?" + query.Encode(),
+		})
+	}
+
+	return providers
+}
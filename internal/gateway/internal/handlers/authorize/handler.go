@@ -0,0 +1,236 @@
+package authorize
+
+import (
+	"context"
+	_ "embed"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/clientip"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	Path = "/authorize"
+)
+
+//go:embed templates/authorize.html
+var pageTemplateSource string
+
+var pageTemplate = template.Must(template.New("authorize").Parse(pageTemplateSource))
+
+type Handler struct {
+	grpcSSOServiceClient        pbSSO.SSOServiceClient
+	grpcFederationServiceClient pbSSO.FederationServiceClient
+	clientIPResolver            clientip.Resolver
+}
+
+type Config struct {
+	GRPCEndpoint     string
+	GRPCDialOptions  []grpc.DialOption
+	ClientIPResolver clientip.Resolver
+}
+
+type page struct {
+	Action string
+
+	ClientID            string
+	RedirectURI         string
+	ResponseType        string
+	Scope               string
+	Scopes              []string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+
+	Username string
+
+	Providers []provider
+
+	Error string
+	Fatal bool
+}
+
+// provider is an upstream identity provider offered on the login page.
+type provider struct {
+	Name string
+	URL  string
+}
+
+func New(ctx context.Context, cfg Config) (*Handler, error) {
+	conn, err := grpc.NewClient(cfg.GRPCEndpoint, cfg.GRPCDialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Errorf("Failed to close conn to %s: %v", cfg.GRPCEndpoint, cerr)
+		}
+	}()
+
+	return &Handler{
+		grpcSSOServiceClient:        pbSSO.NewSSOServiceClient(conn),
+		grpcFederationServiceClient: pbSSO.NewFederationServiceClient(conn),
+		clientIPResolver:            cfg.ClientIPResolver,
+	}, nil
+}
+
+// LoginPage renders the login and consent form for an authorization request.
+// The request itself is only validated once the form is submitted.
+func (h *Handler) LoginPage() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		p := pageFromValues(r.URL.Query())
+		p.Providers = h.providers(r.Context(), r.URL.Query())
+
+		render(w, http.StatusOK, p)
+	}
+}
+
+// Login authenticates the resource owner and, on success, sends the user
+// agent back to the client with an authorization code.
+func (h *Handler) Login() runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if err := r.ParseForm(); err != nil {
+			render(w, http.StatusBadRequest, page{Error: "Malformed request.", Fatal: true})
+			return
+		}
+
+		p := pageFromValues(r.PostForm)
+		p.Username = r.PostForm.Get("username")
+		p.Providers = h.providers(r.Context(), r.PostForm)
+
+		ctx := h.outgoingContext(r)
+
+		resp, err := h.grpcSSOServiceClient.Authorize(ctx, &pbSSO.Authorize_Request{
+			ClientId:            p.ClientID,
+			RedirectUri:         p.RedirectURI,
+			ResponseType:        p.ResponseType,
+			Scope:               p.Scope,
+			State:               p.State,
+			Nonce:               p.Nonce,
+			CodeChallenge:       p.CodeChallenge,
+			CodeChallengeMethod: p.CodeChallengeMethod,
+			Username:            p.Username,
+			Password:            r.PostForm.Get("password"),
+			Otp:                 r.PostForm.Get("otp"),
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.Unauthenticated:
+				p.Error = "Invalid email, password or one-time code."
+				render(w, http.StatusUnauthorized, p)
+			case codes.ResourceExhausted:
+				p.Error = "Too many failed attempts, please try again later."
+				if delay := retryDelay(err); delay > 0 {
+					w.Header().Set("Retry-After", strconv.Itoa(int(delay.Seconds())))
+					p.Error = "Too many failed attempts, please try again in " + delay.String() + "."
+				}
+				render(w, http.StatusTooManyRequests, p)
+			case codes.PermissionDenied:
+				p.Error = "Enter the one-time code from your authenticator app."
+				render(w, http.StatusUnauthorized, p)
+			case codes.FailedPrecondition:
+				p.Error = "Your account requires two-factor authentication. Set up an authenticator app before signing in."
+				p.Fatal = true
+				render(w, http.StatusForbidden, p)
+			case codes.InvalidArgument:
+				p.Error = "The application sent an invalid authorization request: " + status.Convert(err).Message() + "."
+				p.Fatal = true
+				render(w, http.StatusBadRequest, p)
+			default:
+				grpclog.Errorf("Failed to authorize: %v", err)
+				p.Error = "Something went wrong, please try again later."
+				p.Fatal = true
+				render(w, http.StatusInternalServerError, p)
+			}
+			return
+		}
+
+		redirectWithCode(w, r, resp.RedirectUri, resp.Code, resp.State)
+	}
+}
+
+func (h *Handler) outgoingContext(r *http.Request) context.Context {
+	return metadata.NewOutgoingContext(r.Context(), metadata.New(map[string]string{
+		"x-request-id":  r.Header.Get("X-Request-ID"),
+		"x-platform":    r.Header.Get("X-Platform"),
+		"x-app-version": r.Header.Get("X-App-Version"),
+		"x-client-ip":   h.clientIPResolver.ClientIP(r),
+		// The pages are only used by browsers, whose user agent names the
+		// device.
+		"x-device": r.UserAgent(),
+	}))
+}
+
+// redirectWithCode sends the user agent back to the client with an
+// authorization code.
+func redirectWithCode(w http.ResponseWriter, r *http.Request, rawRedirectURI, code, state string) {
+	redirectURI, err := url.Parse(rawRedirectURI)
+	if err != nil {
+		render(w, http.StatusBadRequest, page{Error: "The application sent an invalid redirect uri.", Fatal: true})
+		return
+	}
+
+	query := redirectURI.Query()
+	query.Set("code", code)
+	if state != "" {
+		query.Set("state", state)
+	}
+	redirectURI.RawQuery = query.Encode()
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func pageFromValues(values url.Values) page {
+	return page{
+		Action:              Path,
+		ClientID:            values.Get("client_id"),
+		RedirectURI:         values.Get("redirect_uri"),
+		ResponseType:        values.Get("response_type"),
+		Scope:               values.Get("scope"),
+		Scopes:              strings.Fields(values.Get("scope")),
+		State:               values.Get("state"),
+		Nonce:               values.Get("nonce"),
+		CodeChallenge:       values.Get("code_challenge"),
+		CodeChallengeMethod: values.Get("code_challenge_method"),
+	}
+}
+
+// retryDelay returns how long the user has to wait before trying again, as
+// sent along with the error, rounded up to whole seconds.
+func retryDelay(err error) time.Duration {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return (info.GetRetryDelay().AsDuration() + time.Second - 1).Truncate(time.Second)
+		}
+	}
+
+	return 0
+}
+
+func render(w http.ResponseWriter, code int, p page) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(code)
+
+	if err := pageTemplate.Execute(w, p); err != nil {
+		grpclog.Errorf("Failed to render authorize page: %v", err)
+	}
+}
@@ -0,0 +1,286 @@
+package authorize
+
+import (
+	"context"
+	"errors"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	pbSSO "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/sso/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/clientip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// stubSSOClient answers Authorize with authorize and keeps the last request.
+type stubSSOClient struct {
+	pbSSO.SSOServiceClient
+
+	authorize func(ctx context.Context, req *pbSSO.Authorize_Request) (*pbSSO.Authorize_Response, error)
+	request   *pbSSO.Authorize_Request
+}
+
+func (c *stubSSOClient) Authorize(ctx context.Context, req *pbSSO.Authorize_Request, _ ...grpc.CallOption) (*pbSSO.Authorize_Response, error) {
+	c.request = req
+
+	return c.authorize(ctx, req)
+}
+
+// stubFederationClient lists providers, or fails with err.
+type stubFederationClient struct {
+	pbSSO.FederationServiceClient
+
+	providers []*pbSSO.IdentityProvider
+	err       error
+}
+
+func (c *stubFederationClient) ListIdentityProviders(context.Context, *pbSSO.ListIdentityProviders_Request, ...grpc.CallOption) (*pbSSO.ListIdentityProviders_Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &pbSSO.ListIdentityProviders_Response{Providers: c.providers}, nil
+}
+
+func newTestHandler(ssoClient *stubSSOClient, federationClient *stubFederationClient) *Handler {
+	return &Handler{
+		grpcSSOServiceClient:        ssoClient,
+		grpcFederationServiceClient: federationClient,
+		clientIPResolver:            clientip.Resolver{TrustedProxies: 1},
+	}
+}
+
+func authorizationQuery() url.Values {
+	return url.Values{
+		"client_id":             {"client-123"},
+		"redirect_uri":          {"https://app.kgym.test/callback"},
+		"response_type":         {"code"},
+		"scope":                 {"openid profile"},
+		"state":                 {"state-123"},
+		"code_challenge":        {"challenge"},
+		"code_challenge_method": {"S256"},
+	}
+}
+
+func retryStatus(t *testing.T, delay time.Duration) error {
+	t.Helper()
+
+	st, err := status.New(codes.ResourceExhausted, "too many attempts").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(delay),
+	})
+	require.NoError(t, err)
+
+	return st.Err()
+}
+
+func TestHandler_LoginPage(t *testing.T) {
+	t.Run("should render the request with links to the providers", func(t *testing.T) {
+		handler := newTestHandler(&stubSSOClient{}, &stubFederationClient{
+			providers: []*pbSSO.IdentityProvider{{Id: "google", Name: "Google"}},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, Path+"?"+authorizationQuery().Encode(), nil)
+		w := httptest.NewRecorder()
+
+		handler.LoginPage()(w, req, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+
+		body := html.UnescapeString(w.Body.String())
+		assert.Contains(t, body, `name="client_id" value="client-123"`)
+		assert.Contains(t, body, `name="code_challenge" value="challenge"`)
+		assert.Contains(t, body, "<li>openid</li>")
+		assert.Contains(t, body, FederationPath+"?")
+		assert.Contains(t, body, "provider=google")
+		assert.Contains(t, body, "state=state-123")
+	})
+
+	t.Run("should render the password form when providers cannot be listed", func(t *testing.T) {
+		handler := newTestHandler(&stubSSOClient{}, &stubFederationClient{
+			err: status.Error(codes.Unavailable, "unavailable"),
+		})
+
+		req := httptest.NewRequest(http.MethodGet, Path+"?"+authorizationQuery().Encode(), nil)
+		w := httptest.NewRecorder()
+
+		handler.LoginPage()(w, req, nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `name="password"`)
+		assert.NotContains(t, w.Body.String(), FederationPath)
+	})
+}
+
+func TestHandler_Login(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *pbSSO.Authorize_Response
+		err      error
+
+		code       int
+		location   string
+		retryAfter string
+		body       string
+	}{
+		{
+			name: "should redirect to the client with the code",
+			response: &pbSSO.Authorize_Response{
+				RedirectUri: "https://app.kgym.test/callback?tab=home",
+				Code:        "code-123",
+				State:       "state-123",
+			},
+			code:     http.StatusFound,
+			location: "https://app.kgym.test/callback?code=code-123&state=state-123&tab=home",
+		},
+		{
+			name: "should leave out empty state",
+			response: &pbSSO.Authorize_Response{
+				RedirectUri: "https://app.kgym.test/callback",
+				Code:        "code-123",
+			},
+			code:     http.StatusFound,
+			location: "https://app.kgym.test/callback?code=code-123",
+		},
+		{
+			name: "should refuse a malformed redirect uri",
+			response: &pbSSO.Authorize_Response{
+				RedirectUri: "https://app.kgym.test/%zz",
+				Code:        "code-123",
+			},
+			code: http.StatusBadRequest,
+			body: "invalid redirect uri",
+		},
+		{
+			name: "should ask again for invalid credentials",
+			err:  status.Error(codes.Unauthenticated, "invalid credentials"),
+			code: http.StatusUnauthorized,
+			body: "Invalid email, password or one-time code.",
+		},
+		{
+			name:       "should tell throttled users when to try again",
+			err:        retryStatus(t, 1500*time.Millisecond),
+			code:       http.StatusTooManyRequests,
+			retryAfter: "2",
+			body:       "please try again in 2s",
+		},
+		{
+			name: "should ask for the one-time code",
+			err:  status.Error(codes.PermissionDenied, "mfa required"),
+			code: http.StatusUnauthorized,
+			body: "Enter the one-time code",
+		},
+		{
+			name: "should ask to enroll a second factor",
+			err:  status.Error(codes.FailedPrecondition, "mfa enrollment required"),
+			code: http.StatusForbidden,
+			body: "requires two-factor authentication",
+		},
+		{
+			name: "should explain invalid authorization requests",
+			err:  status.Error(codes.InvalidArgument, "invalid redirect uri"),
+			code: http.StatusBadRequest,
+			body: "invalid authorization request: invalid redirect uri.",
+		},
+		{
+			name: "should hide unexpected errors",
+			err:  errors.New("connection reset"),
+			code: http.StatusInternalServerError,
+			body: "Something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssoClient := &stubSSOClient{
+				authorize: func(context.Context, *pbSSO.Authorize_Request) (*pbSSO.Authorize_Response, error) {
+					return tt.response, tt.err
+				},
+			}
+			handler := newTestHandler(ssoClient, &stubFederationClient{})
+
+			form := authorizationQuery()
+			form.Set("username", "member@example.com")
+			form.Set("password", "password123")
+
+			req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+
+			handler.Login()(w, req, nil)
+
+			assert.Equal(t, tt.code, w.Code)
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			assert.Equal(t, tt.location, w.Header().Get("Location"))
+			assert.Equal(t, tt.retryAfter, w.Header().Get("Retry-After"))
+			assert.Contains(t, html.UnescapeString(w.Body.String()), tt.body)
+		})
+	}
+
+	t.Run("should pass the request and the client on to sso", func(t *testing.T) {
+		ssoClient := &stubSSOClient{
+			authorize: func(ctx context.Context, req *pbSSO.Authorize_Request) (*pbSSO.Authorize_Response, error) {
+				md, ok := metadata.FromOutgoingContext(ctx)
+				require.True(t, ok)
+				assert.Equal(t, []string{"203.0.113.7"}, md.Get("x-client-ip"))
+				assert.Equal(t, []string{"Mozilla/5.0"}, md.Get("x-device"))
+
+				return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+			},
+		}
+		handler := newTestHandler(ssoClient, &stubFederationClient{})
+
+		form := authorizationQuery()
+		form.Set("username", "member@example.com")
+		form.Set("password", "password123")
+		form.Set("otp", "123456")
+
+		req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("User-Agent", "Mozilla/5.0")
+		req.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+		w := httptest.NewRecorder()
+
+		handler.Login()(w, req, nil)
+
+		require.NotNil(t, ssoClient.request)
+		assert.Equal(t, "client-123", ssoClient.request.ClientId)
+		assert.Equal(t, "https://app.kgym.test/callback", ssoClient.request.RedirectUri)
+		assert.Equal(t, "code", ssoClient.request.ResponseType)
+		assert.Equal(t, "openid profile", ssoClient.request.Scope)
+		assert.Equal(t, "state-123", ssoClient.request.State)
+		assert.Equal(t, "challenge", ssoClient.request.CodeChallenge)
+		assert.Equal(t, "S256", ssoClient.request.CodeChallengeMethod)
+		assert.Equal(t, "member@example.com", ssoClient.request.Username)
+		assert.Equal(t, "password123", ssoClient.request.Password)
+		assert.Equal(t, "123456", ssoClient.request.Otp)
+
+		body := html.UnescapeString(w.Body.String())
+		assert.Contains(t, body, `name="username" value="member@example.com"`)
+		assert.NotContains(t, body, "password123", "the password must not be rendered back")
+	})
+
+	t.Run("should refuse a malformed form", func(t *testing.T) {
+		handler := newTestHandler(&stubSSOClient{}, &stubFederationClient{})
+
+		req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader("%zz"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+
+		handler.Login()(w, req, nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	pbFile "github.com/kitanoyoru/kgym/contracts/protobuf/gen/go/file/v1"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/dpop"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,7 @@ type Handler struct {
 	mux *runtime.ServeMux
 
 	grpcFileServiceClient pbFile.FileServiceClient
+	dpopForwarder         dpop.Forwarder
 
 	bodyLimit, chunkSize int
 }
@@ -26,6 +28,7 @@ type Handler struct {
 type Config struct {
 	GRPCEndpoint    string
 	GRPCDialOptions []grpc.DialOption
+	DPoPForwarder   dpop.Forwarder
 
 	BodyLimit, ChunkSize int
 }
@@ -46,6 +49,7 @@ func New(ctx context.Context, mux *runtime.ServeMux, cfg Config) (*Handler, erro
 	return &Handler{
 		mux:                   mux,
 		grpcFileServiceClient: pbFile.NewFileServiceClient(conn),
+		dpopForwarder:         cfg.DPoPForwarder,
 		bodyLimit:             cfg.BodyLimit,
 		chunkSize:             cfg.ChunkSize,
 	}, nil
@@ -68,7 +72,7 @@ func (h *Handler) UploadUserAvatar() runtime.HandlerFunc {
 		}
 		md["authorization"] = authorization
 
-		ctx := metadata.NewOutgoingContext(r.Context(), metadata.New(md))
+		ctx := metadata.NewOutgoingContext(r.Context(), metadata.Join(metadata.New(md), h.dpopForwarder.Metadata(r)))
 
 		err := r.ParseMultipartForm(int64(h.bodyLimit))
 		if err != nil {
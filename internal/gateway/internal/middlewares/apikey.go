@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/kitanoyoru/kgym/internal/gateway/internal/apikey"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// APIKeyExchanger trades the API key a request was made with for an access
+// token. It is implemented by *apikey.Exchanger.
+type APIKeyExchanger interface {
+	Exchange(r *http.Request, apiKey string) (string, error)
+}
+
+// APIKey replaces the API key of requests made by integrations with an access
+// token, so that the services behind the gateway only ever see bearer tokens.
+func APIKey(exchanger APIKeyExchanger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme, apiKey, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, apikey.Scheme) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			accessToken, err := exchanger.Exchange(r, strings.TrimSpace(apiKey))
+			if err != nil {
+				writeStatus(w, err, apikey.Scheme)
+				return
+			}
+
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer "+accessToken)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeStatus answers with the error the same way the gateway answers with
+// errors of the services behind it. Unauthenticated requests are challenged
+// to authenticate with scheme.
+func writeStatus(w http.ResponseWriter, err error, scheme string) {
+	st, ok := status.FromError(err)
+	if !ok {
+		log.Error().Err(err).Msg("failed to exchange api key")
+		st = status.New(codes.Internal, "failed to exchange api key")
+	}
+
+	body, merr := protojson.Marshal(st.Proto())
+	if merr != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", scheme)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,124 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubExchanger trades every API key for the same access token, or fails with
+// err.
+type stubExchanger struct {
+	accessToken string
+	err         error
+
+	apiKeys []string
+}
+
+func (e *stubExchanger) Exchange(_ *http.Request, apiKey string) (string, error) {
+	e.apiKeys = append(e.apiKeys, apiKey)
+
+	return e.accessToken, e.err
+}
+
+// recorder is the handler behind the middleware under test. It records the
+// authorization the request reached it with.
+type recorder struct {
+	called        bool
+	authorization string
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.called = true
+	rec.authorization = r.Header.Get("Authorization")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestAPIKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		exchanger     *stubExchanger
+
+		code          int
+		apiKeys       []string
+		forwarded     string
+		authenticate  string
+		passedThrough bool
+	}{
+		{
+			name:          "should pass requests without authorization on",
+			exchanger:     &stubExchanger{},
+			code:          http.StatusNoContent,
+			passedThrough: true,
+		},
+		{
+			name:          "should pass bearer tokens on untouched",
+			authorization: "Bearer access-token",
+			exchanger:     &stubExchanger{},
+			code:          http.StatusNoContent,
+			forwarded:     "Bearer access-token",
+			passedThrough: true,
+		},
+		{
+			name:          "should replace api key with access token",
+			authorization: "ApiKey kgym_key",
+			exchanger:     &stubExchanger{accessToken: "access-token"},
+			code:          http.StatusNoContent,
+			apiKeys:       []string{"kgym_key"},
+			forwarded:     "Bearer access-token",
+			passedThrough: true,
+		},
+		{
+			name:          "should accept the scheme in any case",
+			authorization: "apikey  kgym_key ",
+			exchanger:     &stubExchanger{accessToken: "access-token"},
+			code:          http.StatusNoContent,
+			apiKeys:       []string{"kgym_key"},
+			forwarded:     "Bearer access-token",
+			passedThrough: true,
+		},
+		{
+			name:          "should challenge requests with an invalid api key",
+			authorization: "ApiKey kgym_revoked",
+			exchanger:     &stubExchanger{err: status.Error(codes.Unauthenticated, "invalid api key")},
+			code:          http.StatusUnauthorized,
+			apiKeys:       []string{"kgym_revoked"},
+			authenticate:  "ApiKey",
+		},
+		{
+			name:          "should hide errors that are not statuses",
+			authorization: "ApiKey kgym_key",
+			exchanger:     &stubExchanger{err: errors.New("connection reset")},
+			code:          http.StatusInternalServerError,
+			apiKeys:       []string{"kgym_key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &recorder{}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			APIKey(tt.exchanger)(next).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.code, w.Code)
+			assert.Equal(t, tt.apiKeys, tt.exchanger.apiKeys)
+			assert.Equal(t, tt.passedThrough, next.called)
+			assert.Equal(t, tt.forwarded, next.authorization)
+			assert.Equal(t, tt.authenticate, w.Header().Get("WWW-Authenticate"))
+			assert.Equal(t, tt.authorization, req.Header.Get("Authorization"), "the request of the caller must not be modified")
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	pkgauth "github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ssoPathPrefixes are the OAuth and OIDC endpoints of SSO. Clients of any
+// audience call them, e.g. third-party clients fetch their user info, so the
+// tokens sent to them are left to SSO to verify.
+var ssoPathPrefixes = []string{
+	"/api/v1/oauth/",
+	"/.well-known/",
+}
+
+// Auth rejects requests with an access token that SSO did not issue or that
+// expired before they reach the services behind the gateway. Requests
+// without an access token are passed on, since the services decide which of
+// their methods are public. Whether a bound token is presented with a valid
+// DPoP proof is left to the services, which consume the proof.
+func Auth(verifier *pkgauth.Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The path is cleaned so that dot segments cannot smuggle another
+			// route past the check.
+			urlPath := path.Clean(r.URL.Path)
+			if slices.ContainsFunc(ssoPathPrefixes, func(prefix string) bool {
+				return strings.HasPrefix(urlPath, prefix)
+			}) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scheme, accessToken, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") && !strings.EqualFold(scheme, "DPoP") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if _, err := verifier.Verify(r.Context(), strings.TrimSpace(accessToken)); err != nil {
+				if errors.Is(err, pkgauth.ErrKeySetUnavailable) {
+					writeStatus(w, status.Error(codes.Unavailable, "failed to verify access token"), scheme)
+					return
+				}
+
+				writeStatus(w, status.Error(codes.Unauthenticated, "invalid access token"), scheme)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Package auth verifies the access tokens issued by SSO in the services that
+// accept them. It caches the key set SSO publishes, validates tokens against
+// it and provides gRPC server interceptors that enforce a policy per method
+// and pass the claims of the caller on in the context.
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AccessTokenType is the typ header of access tokens as in RFC 9068. ID
+	// tokens are signed with the same keys, so it is what tells them apart.
+	AccessTokenType = "at+jwt"
+
+	DefaultLeeway             = 1 * time.Minute
+	DefaultRefreshInterval    = 15 * time.Minute
+	DefaultMinRefreshInterval = 30 * time.Second
+)
+
+var (
+	ErrInvalidToken      = errors.New("invalid access token")
+	ErrKeySetUnavailable = errors.New("key set unavailable")
+
+	errUnexpectedTokenType = errors.New("unexpected token type")
+	errUnknownKey          = errors.New("unknown signing key")
+)
+
+// signingMethods are the algorithms access tokens may be signed with. The
+// none algorithm and HMAC are never accepted.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+type Config struct {
+	// Issuer is the issuer identifier of SSO. Tokens of any other issuer
+	// are rejected.
+	Issuer string
+	// Audiences are the clients whose tokens are accepted. A token must be
+	// issued for at least one of them, so with none every token is
+	// rejected.
+	Audiences []string
+	// JWKSURL is where SSO publishes its key set.
+	JWKSURL string
+
+	// Leeway is the clock skew tolerated when validating the expiry and not
+	// before times. It defaults to DefaultLeeway.
+	Leeway time.Duration
+	// RefreshInterval is how often Run refetches the key set. It defaults
+	// to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+	// MinRefreshInterval limits how often the key set is refetched because
+	// a token was signed with an unknown key. It defaults to
+	// DefaultMinRefreshInterval.
+	MinRefreshInterval time.Duration
+
+	// HTTPClient is used to fetch the key set. It defaults to a client with
+	// a 10 second timeout.
+	HTTPClient *http.Client
+
+	// ReplayCache remembers the DPoP proofs presented with tokens bound to
+	// a key. Without it such tokens are rejected.
+	ReplayCache ReplayCache
+	// ProofMaxAge is how long after it was issued a DPoP proof is accepted.
+	// It defaults to DefaultProofMaxAge.
+	ProofMaxAge time.Duration
+}
+
+// Verifier validates access tokens. It is safe for concurrent use.
+type Verifier struct {
+	cfg    Config
+	keySet *keySet
+	proofs *ProofVerifier
+}
+
+func NewVerifier(cfg Config) *Verifier {
+	if cfg.Leeway == 0 {
+		cfg.Leeway = DefaultLeeway
+	}
+	if cfg.RefreshInterval == 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	if cfg.MinRefreshInterval == 0 {
+		cfg.MinRefreshInterval = DefaultMinRefreshInterval
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	var proofs *ProofVerifier
+	if cfg.ReplayCache != nil {
+		proofs = NewProofVerifier(ProofConfig{
+			ReplayCache: cfg.ReplayCache,
+			MaxAge:      cfg.ProofMaxAge,
+			Leeway:      cfg.Leeway,
+		})
+	}
+
+	return &Verifier{
+		cfg:    cfg,
+		keySet: newKeySet(cfg.JWKSURL, client, cfg.MinRefreshInterval),
+		proofs: proofs,
+	}
+}
+
+// Run refreshes the key set in the background, so keys SSO publishes ahead
+// of a rotation are known before the first token is signed with them. It
+// blocks until ctx is done.
+func (v *Verifier) Run(ctx context.Context) {
+	v.keySet.run(ctx, v.cfg.RefreshInterval)
+}
+
+// Verify returns the claims of a valid access token. It fails with
+// ErrInvalidToken if the token is malformed, not signed by SSO, not issued
+// for one of the audiences or outside of its validity period, and with
+// ErrKeySetUnavailable if the key set could not be fetched.
+func (v *Verifier) Verify(ctx context.Context, raw string) (Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+		typ, _ := token.Header["typ"].(string)
+		if !isAccessTokenType(typ) {
+			return nil, errUnexpectedTokenType
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return v.keySet.key(ctx, kid)
+	},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(v.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(v.cfg.Leeway),
+	)
+	if err != nil {
+		if errors.Is(err, ErrKeySetUnavailable) {
+			return Claims{}, ErrKeySetUnavailable
+		}
+		return Claims{}, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	if claims.Subject == "" {
+		return Claims{}, errors.Wrap(ErrInvalidToken, "missing subject")
+	}
+
+	if !slices.ContainsFunc(claims.Audience, func(audience string) bool {
+		return slices.Contains(v.cfg.Audiences, audience)
+	}) {
+		return Claims{}, errors.Wrap(ErrInvalidToken, "unexpected audience")
+	}
+
+	return claims, nil
+}
+
+// VerifyBinding checks that a token bound to a key is presented with a valid
+// DPoP proof signed by that key, and that the DPoP scheme is only used with
+// bound tokens. dpop tells whether the token was sent with the DPoP scheme,
+// and req must hold the token since the proof carries its hash. It fails with
+// ErrInvalidToken or ErrInvalidProof if the token may not be used.
+func (v *Verifier) VerifyBinding(ctx context.Context, claims Claims, dpop bool, proof string, req ProofRequest) error {
+	jkt := claims.BoundKey()
+	if jkt == "" {
+		if dpop {
+			return errors.Wrap(ErrInvalidToken, "token is not bound to a key")
+		}
+		return nil
+	}
+
+	// A bound token sent as a bearer token may have been stolen from the
+	// client, so it is refused even with a proof.
+	if !dpop {
+		return errors.Wrap(ErrInvalidToken, "bound token sent as bearer token")
+	}
+
+	if v.proofs == nil {
+		return errors.Wrap(ErrInvalidProof, "dpop is not supported")
+	}
+
+	if proof == "" {
+		return errors.Wrap(ErrInvalidProof, "missing proof")
+	}
+
+	p, err := v.proofs.Verify(ctx, proof, req)
+	if err != nil {
+		return err
+	}
+
+	if p.JKT != jkt {
+		return errors.Wrap(ErrInvalidProof, "proof signed by another key")
+	}
+
+	return nil
+}
+
+// isAccessTokenType reports whether typ names an access token. RFC 9068
+// allows the media type with and without the application/ prefix.
+func isAccessTokenType(typ string) bool {
+	typ = strings.ToLower(typ)
+
+	return typ == AccessTokenType || typ == "application/"+AccessTokenType
+}
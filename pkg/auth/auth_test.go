@@ -0,0 +1,365 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuerURL = "https://sso.kgym.test"
+	audience  = "kgym"
+)
+
+// issuer publishes a key set and signs tokens like SSO does.
+type issuer struct {
+	server *httptest.Server
+
+	mu    sync.Mutex
+	key   *ecdsa.PrivateKey
+	keyID string
+
+	fetches     atomic.Int32
+	unavailable atomic.Bool
+}
+
+func newIssuer(t *testing.T) *issuer {
+	t.Helper()
+
+	i := &issuer{}
+	i.rotateKey()
+
+	i.server = httptest.NewServer(http.HandlerFunc(i.jwks))
+	t.Cleanup(i.server.Close)
+
+	return i
+}
+
+func (i *issuer) verifier(cfg auth.Config) *auth.Verifier {
+	cfg.Issuer = issuerURL
+	if cfg.Audiences == nil {
+		cfg.Audiences = []string{audience}
+	}
+	cfg.JWKSURL = i.server.URL
+
+	return auth.NewVerifier(cfg)
+}
+
+// rotateKey replaces the signing key. The old key is no longer published.
+func (i *issuer) rotateKey() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.key = key
+	i.keyID = uuid.NewString()
+}
+
+func (i *issuer) claims() auth.Claims {
+	now := time.Now()
+
+	return auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    issuerURL,
+			Subject:   uuid.NewString(),
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+		},
+		Scope:     "openid profile",
+		Role:      "user",
+		SessionID: uuid.NewString(),
+	}
+}
+
+func (i *issuer) sign(t *testing.T, claims jwt.Claims) string {
+	t.Helper()
+
+	return i.signWithType(t, claims, auth.AccessTokenType)
+}
+
+func (i *issuer) signWithType(t *testing.T, claims jwt.Claims, typ string) string {
+	t.Helper()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = typ
+	token.Header["kid"] = i.keyID
+
+	raw, err := token.SignedString(i.key)
+	require.NoError(t, err)
+
+	return raw
+}
+
+func (i *issuer) jwks(w http.ResponseWriter, r *http.Request) {
+	i.fetches.Add(1)
+
+	if i.unavailable.Load() {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	i.mu.Lock()
+	key := i.key.PublicKey
+	keyID := i.keyID
+	i.mu.Unlock()
+
+	point, err := key.Bytes()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(auth.JSONWebKeySet{
+		Keys: []auth.JSONWebKey{{
+			KeyType:   "EC",
+			KeyID:     keyID,
+			Use:       "sig",
+			Algorithm: "ES256",
+			Curve:     "P-256",
+			X:         base64.RawURLEncoding.EncodeToString(point[1:33]),
+			Y:         base64.RawURLEncoding.EncodeToString(point[33:]),
+		}},
+	})
+}
+
+func TestVerifier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should verify a valid access token", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		expected := issuer.claims()
+		expected.Actor = &auth.ActorClaims{Subject: uuid.NewString()}
+
+		claims, err := verifier.Verify(ctx, issuer.sign(t, expected))
+		require.NoError(t, err)
+		assert.Equal(t, expected.Subject, claims.Subject)
+		assert.Equal(t, expected.ID, claims.ID)
+		assert.Equal(t, expected.Role, claims.Role)
+		assert.Equal(t, expected.SessionID, claims.SessionID)
+		assert.Equal(t, expected.Actor, claims.Actor)
+		assert.Equal(t, []string{"openid", "profile"}, claims.Scopes())
+		assert.True(t, claims.HasScope("profile"))
+		assert.False(t, claims.HasScope("email"))
+	})
+
+	t.Run("should accept tokens issued for any of the audiences", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{
+			Audiences: []string{"web", audience},
+		})
+
+		claims := issuer.claims()
+		claims.Audience = jwt.ClaimStrings{"other", audience}
+
+		_, err := verifier.Verify(ctx, issuer.sign(t, claims))
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject invalid access tokens", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		testCases := []struct {
+			name   string
+			modify func(claims *auth.Claims)
+		}{
+			{
+				name:   "wrong issuer",
+				modify: func(claims *auth.Claims) { claims.Issuer = "https://evil.test" },
+			},
+			{
+				name:   "wrong audience",
+				modify: func(claims *auth.Claims) { claims.Audience = jwt.ClaimStrings{"other"} },
+			},
+			{
+				name:   "no audience",
+				modify: func(claims *auth.Claims) { claims.Audience = nil },
+			},
+			{
+				name: "expired",
+				modify: func(claims *auth.Claims) {
+					claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				},
+			},
+			{
+				name: "not yet valid",
+				modify: func(claims *auth.Claims) {
+					claims.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+				},
+			},
+			{
+				name:   "no expiry",
+				modify: func(claims *auth.Claims) { claims.ExpiresAt = nil },
+			},
+			{
+				name:   "no subject",
+				modify: func(claims *auth.Claims) { claims.Subject = "" },
+			},
+		}
+
+		for _, tc := range testCases {
+			claims := issuer.claims()
+			tc.modify(&claims)
+
+			_, err := verifier.Verify(ctx, issuer.sign(t, claims))
+			assert.ErrorIs(t, err, auth.ErrInvalidToken, tc.name)
+		}
+	})
+
+	t.Run("should tolerate clock skew within the leeway", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		claims := issuer.claims()
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-auth.DefaultLeeway / 2))
+
+		_, err := verifier.Verify(ctx, issuer.sign(t, claims))
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject id tokens", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		// ID tokens are signed with the same keys, but have the default
+		// type.
+		_, err := verifier.Verify(ctx, issuer.signWithType(t, issuer.claims(), "JWT"))
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+		_, err = verifier.Verify(ctx, issuer.signWithType(t, issuer.claims(), "application/at+jwt"))
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject tokens signed with another algorithm", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, issuer.claims())
+		token.Header["typ"] = auth.AccessTokenType
+		token.Header["kid"] = issuer.keyID
+		raw, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, raw)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	})
+
+	t.Run("should refetch the key set when the key is unknown", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		_, err := verifier.Verify(ctx, issuer.sign(t, issuer.claims()))
+		require.NoError(t, err)
+
+		// The key set was just fetched, so a rotation right after is only
+		// picked up once the refresh interval has passed.
+		issuer.rotateKey()
+		raw := issuer.sign(t, issuer.claims())
+
+		_, err = verifier.Verify(ctx, raw)
+		assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+		auth.AllowKeysRefresh(verifier)
+
+		_, err = verifier.Verify(ctx, raw)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, issuer.fetches.Load())
+	})
+
+	t.Run("should fetch the key set once for concurrent tokens", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{})
+
+		raw := issuer.sign(t, issuer.claims())
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := verifier.Verify(ctx, raw)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.EqualValues(t, 1, issuer.fetches.Load())
+	})
+
+	t.Run("should fail when the key set is unavailable", func(t *testing.T) {
+		issuer := newIssuer(t)
+		issuer.unavailable.Store(true)
+		verifier := issuer.verifier(auth.Config{})
+
+		raw := issuer.sign(t, issuer.claims())
+
+		_, err := verifier.Verify(ctx, raw)
+		assert.ErrorIs(t, err, auth.ErrKeySetUnavailable)
+
+		// Without a key set every token fails the same way until the set
+		// can be fetched again.
+		_, err = verifier.Verify(ctx, raw)
+		assert.ErrorIs(t, err, auth.ErrKeySetUnavailable)
+		assert.EqualValues(t, 1, issuer.fetches.Load())
+
+		issuer.unavailable.Store(false)
+		auth.AllowKeysRefresh(verifier)
+
+		_, err = verifier.Verify(ctx, raw)
+		require.NoError(t, err)
+	})
+
+	t.Run("should refresh the key set in the background", func(t *testing.T) {
+		issuer := newIssuer(t)
+		verifier := issuer.verifier(auth.Config{
+			RefreshInterval:    10 * time.Millisecond,
+			MinRefreshInterval: time.Hour,
+		})
+
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			verifier.Run(ctx)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			<-done
+		})
+
+		issuer.rotateKey()
+		raw := issuer.sign(t, issuer.claims())
+
+		assert.Eventually(t, func() bool {
+			_, err := verifier.Verify(ctx, raw)
+			return err == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// RoleAdmin is the role SSO puts into the access tokens of admins.
+	RoleAdmin = "admin"
+	// ScopeService is only granted to the tokens SSO issues itself to call
+	// the internal methods of other services. No grant hands it out to users
+	// or clients.
+	ScopeService = "service"
+)
+
+// Claims are the claims of an access token. Scope holds the granted scopes,
+// space-delimited as in RFC 9068.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	Scope  string `json:"scope,omitempty"`
+	Role   string `json:"role,omitempty"`
+	Tenant string `json:"tenant,omitempty"`
+	// SessionID is the session the token was issued for. Tokens issued to
+	// clients on their own behalf have none.
+	SessionID string `json:"sid,omitempty"`
+	// Actor is set on tokens issued by the token exchange grant and names
+	// the admin acting as the subject.
+	Actor *ActorClaims `json:"act,omitempty"`
+	// Confirmation is set on tokens bound to a key with DPoP. They are only
+	// accepted along with a proof signed by that key.
+	Confirmation *ConfirmationClaims `json:"cnf,omitempty"`
+	// APIKeyID is set on tokens issued by the API key grant and names the
+	// key they were exchanged for.
+	APIKeyID string `json:"api_key_id,omitempty"`
+}
+
+// ActorClaims identify the party acting on behalf of the subject of a token,
+// as in the act claim of RFC 8693.
+type ActorClaims struct {
+	Subject string `json:"sub"`
+}
+
+// ConfirmationClaims hold the thumbprint of the key a token is bound to, as in
+// the cnf claim of RFC 9449.
+type ConfirmationClaims struct {
+	JKT string `json:"jkt"`
+}
+
+// BoundKey returns the thumbprint of the key the token is bound to, if any.
+func (c Claims) BoundKey() string {
+	if c.Confirmation == nil {
+		return ""
+	}
+
+	return c.Confirmation.JKT
+}
+
+func (c Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+func (c Claims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes(), scope)
+}
+
+type claimsContextKey struct{}
+
+// ContextWithClaims returns a copy of ctx that carries the claims of the
+// caller.
+func ContextWithClaims(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims the interceptors verified for the
+// call. There are none for calls to public methods.
+func ClaimsFromContext(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(Claims)
+
+	return claims, ok
+}
@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProofType is the typ header of DPoP proofs as in RFC 9449.
+	ProofType = "dpop+jwt"
+
+	DefaultProofMaxAge = 5 * time.Minute
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid dpop proof")
+)
+
+// ReplayCache remembers the proofs that were used, so that each is accepted
+// once.
+type ReplayCache interface {
+	// Remember records id until expiresAt. It reports false if id is
+	// already recorded.
+	Remember(ctx context.Context, id string, expiresAt time.Time) (bool, error)
+}
+
+type ProofConfig struct {
+	// ReplayCache remembers the proofs that were used until they are too
+	// old to be accepted anyway.
+	ReplayCache ReplayCache
+
+	// MaxAge is how long after it was issued a proof is accepted. It
+	// defaults to DefaultProofMaxAge.
+	MaxAge time.Duration
+	// Leeway is the clock skew tolerated when validating the issue time. It
+	// defaults to DefaultLeeway.
+	Leeway time.Duration
+}
+
+// ProofVerifier validates DPoP proofs as described in RFC 9449 section 4.3.
+// It is safe for concurrent use.
+type ProofVerifier struct {
+	cfg ProofConfig
+}
+
+func NewProofVerifier(cfg ProofConfig) *ProofVerifier {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = DefaultProofMaxAge
+	}
+	if cfg.Leeway == 0 {
+		cfg.Leeway = DefaultLeeway
+	}
+
+	return &ProofVerifier{
+		cfg: cfg,
+	}
+}
+
+// ProofRequest is the HTTP request a proof was sent with.
+type ProofRequest struct {
+	Method string
+	URL    string
+	// AccessToken is the token the proof was presented with. Proofs sent
+	// to resource servers must carry its hash, proofs sent to the token
+	// endpoint come without a token.
+	AccessToken string
+}
+
+// Proof describes a valid proof.
+type Proof struct {
+	// JKT is the JWK SHA-256 thumbprint of the key that signed the proof,
+	// as in RFC 7638. Tokens are bound to the key through it.
+	JKT      string
+	ID       string
+	IssuedAt time.Time
+}
+
+type proofClaims struct {
+	jwt.RegisteredClaims
+
+	HTTPMethod      string `json:"htm"`
+	HTTPURI         string `json:"htu"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+// Verify returns the proof if raw is a valid proof for req that was not used
+// before. It fails with ErrInvalidProof if the proof is malformed, not signed
+// by the key in its header, made for another request, too old or replayed.
+func (v *ProofVerifier) Verify(ctx context.Context, raw string, req ProofRequest) (Proof, error) {
+	var (
+		claims proofClaims
+		jwk    JSONWebKey
+	)
+	_, err := jwt.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+		typ, _ := token.Header["typ"].(string)
+		if !strings.EqualFold(typ, ProofType) {
+			return nil, errUnexpectedTokenType
+		}
+
+		header, ok := token.Header["jwk"].(map[string]any)
+		if !ok {
+			return nil, errors.New("missing jwk")
+		}
+		if _, ok := header["d"]; ok {
+			return nil, errors.New("jwk holds a private key")
+		}
+
+		buf, err := json.Marshal(header)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(buf, &jwk); err != nil {
+			return nil, err
+		}
+
+		return jwk.PublicKey()
+	},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(v.cfg.Leeway),
+	)
+	if err != nil {
+		return Proof{}, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	if claims.ID == "" {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "missing jti")
+	}
+
+	if claims.IssuedAt == nil {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "missing iat")
+	}
+
+	issuedAt := claims.IssuedAt.Time
+	expiresAt := issuedAt.Add(v.cfg.MaxAge + v.cfg.Leeway)
+	if time.Now().After(expiresAt) {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "proof is too old")
+	}
+
+	if claims.HTTPMethod != req.Method {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "unexpected htm")
+	}
+
+	if !sameURI(claims.HTTPURI, req.URL) {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "unexpected htu")
+	}
+
+	if req.AccessToken != "" && claims.AccessTokenHash != AccessTokenHash(req.AccessToken) {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "unexpected ath")
+	}
+
+	jkt, err := jwk.Thumbprint()
+	if err != nil {
+		return Proof{}, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	// A jti only has to be unique for the key, so two clients may pick the
+	// same one.
+	fresh, err := v.cfg.ReplayCache.Remember(ctx, jkt+":"+claims.ID, expiresAt)
+	if err != nil {
+		return Proof{}, err
+	}
+
+	if !fresh {
+		return Proof{}, errors.Wrap(ErrInvalidProof, "proof was already used")
+	}
+
+	return Proof{
+		JKT:      jkt,
+		ID:       claims.ID,
+		IssuedAt: issuedAt,
+	}, nil
+}
+
+// AccessTokenHash is the ath claim of proofs presented with accessToken.
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Thumbprint returns the JWK SHA-256 thumbprint of the key as described in
+// RFC 7638. Only the required members are hashed, in lexicographic order.
+func (k JSONWebKey) Thumbprint() (string, error) {
+	var members any
+	switch k.KeyType {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{k.E, k.KeyType, k.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{k.Curve, k.KeyType, k.X, k.Y}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{k.Curve, k.KeyType, k.X}
+	default:
+		return "", ErrUnsupportedKey
+	}
+
+	buf, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// sameURI compares the htu of a proof to the URL of the request, without the
+// query and fragment as RFC 9449 asks. The scheme and host are compared case
+// insensitively.
+func sameURI(htu, requestURL string) bool {
+	a, err := url.Parse(htu)
+	if err != nil || !a.IsAbs() {
+		return false
+	}
+
+	b, err := url.Parse(requestURL)
+	if err != nil || !b.IsAbs() {
+		return false
+	}
+
+	return strings.EqualFold(a.Scheme, b.Scheme) &&
+		strings.EqualFold(a.Host, b.Host) &&
+		a.EscapedPath() == b.EscapedPath()
+}
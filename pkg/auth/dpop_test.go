@@ -0,0 +1,291 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	resourceMethod = "POST"
+	resourceURL    = "https://api.kgym.test/api/v1/files"
+)
+
+// replayCache is an in-memory auth.ReplayCache.
+type replayCache struct {
+	mu  sync.Mutex
+	ids map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{ids: make(map[string]time.Time)}
+}
+
+func (c *replayCache) Remember(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.ids[id]; ok {
+		return false, nil
+	}
+
+	c.ids[id] = expiresAt
+
+	return true, nil
+}
+
+// holder holds the key a client binds its tokens to and signs proofs with it.
+type holder struct {
+	key *ecdsa.PrivateKey
+	jwk auth.JSONWebKey
+}
+
+func newHolder(t *testing.T) *holder {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	point, err := key.PublicKey.Bytes()
+	require.NoError(t, err)
+
+	return &holder{
+		key: key,
+		jwk: auth.JSONWebKey{
+			KeyType: "EC",
+			Curve:   "P-256",
+			X:       base64.RawURLEncoding.EncodeToString(point[1:33]),
+			Y:       base64.RawURLEncoding.EncodeToString(point[33:]),
+		},
+	}
+}
+
+func (h *holder) jkt(t *testing.T) string {
+	t.Helper()
+
+	jkt, err := h.jwk.Thumbprint()
+	require.NoError(t, err)
+
+	return jkt
+}
+
+func (h *holder) claims(accessToken string) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"jti": uuid.NewString(),
+		"htm": resourceMethod,
+		"htu": resourceURL,
+		"iat": time.Now().Unix(),
+	}
+	if accessToken != "" {
+		claims["ath"] = auth.AccessTokenHash(accessToken)
+	}
+
+	return claims
+}
+
+func (h *holder) proof(t *testing.T, accessToken string) string {
+	t.Helper()
+
+	return h.sign(t, h.claims(accessToken))
+}
+
+func (h *holder) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = auth.ProofType
+	token.Header["jwk"] = h.jwk
+
+	raw, err := token.SignedString(h.key)
+	require.NoError(t, err)
+
+	return raw
+}
+
+func TestProofVerifier(t *testing.T) {
+	ctx := context.Background()
+
+	request := auth.ProofRequest{
+		Method: resourceMethod,
+		URL:    resourceURL,
+	}
+
+	t.Run("should verify a valid proof", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		proof, err := verifier.Verify(ctx, holder.proof(t, ""), request)
+		require.NoError(t, err)
+		assert.Equal(t, holder.jkt(t), proof.JKT)
+		assert.NotEmpty(t, proof.ID)
+	})
+
+	t.Run("should ignore the query and fragment of the url", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		claims := holder.claims("")
+		claims["htu"] = "HTTPS://API.kgym.test/api/v1/files?page=2#top"
+
+		_, err := verifier.Verify(ctx, holder.sign(t, claims), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a replayed proof", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		proof := holder.proof(t, "")
+
+		_, err := verifier.Verify(ctx, proof, request)
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, proof, request)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+	})
+
+	t.Run("should check the hash of the access token", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		req := request
+		req.AccessToken = "access-token"
+
+		_, err := verifier.Verify(ctx, holder.proof(t, "other-token"), req)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+
+		_, err = verifier.Verify(ctx, holder.proof(t, ""), req)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+
+		_, err = verifier.Verify(ctx, holder.proof(t, req.AccessToken), req)
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject invalid proofs", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		testCases := []struct {
+			name   string
+			modify func(claims jwt.MapClaims)
+		}{
+			{
+				name:   "wrong method",
+				modify: func(claims jwt.MapClaims) { claims["htm"] = "GET" },
+			},
+			{
+				name:   "wrong url",
+				modify: func(claims jwt.MapClaims) { claims["htu"] = "https://api.kgym.test/api/v1/users" },
+			},
+			{
+				name:   "relative url",
+				modify: func(claims jwt.MapClaims) { claims["htu"] = "/api/v1/files" },
+			},
+			{
+				name:   "no jti",
+				modify: func(claims jwt.MapClaims) { delete(claims, "jti") },
+			},
+			{
+				name:   "no iat",
+				modify: func(claims jwt.MapClaims) { delete(claims, "iat") },
+			},
+			{
+				name: "too old",
+				modify: func(claims jwt.MapClaims) {
+					claims["iat"] = time.Now().Add(-auth.DefaultProofMaxAge - auth.DefaultLeeway - time.Minute).Unix()
+				},
+			},
+			{
+				name: "issued in the future",
+				modify: func(claims jwt.MapClaims) {
+					claims["iat"] = time.Now().Add(auth.DefaultLeeway + time.Minute).Unix()
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			claims := holder.claims("")
+			tc.modify(claims)
+
+			_, err := verifier.Verify(ctx, holder.sign(t, claims), request)
+			assert.ErrorIs(t, err, auth.ErrInvalidProof, tc.name)
+		}
+	})
+
+	t.Run("should reject proofs not signed by the key in their header", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder, other := newHolder(t), newHolder(t)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, holder.claims(""))
+		token.Header["typ"] = auth.ProofType
+		token.Header["jwk"] = other.jwk
+		raw, err := token.SignedString(holder.key)
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, raw, request)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+	})
+
+	t.Run("should reject proofs of another type", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, holder.claims(""))
+		token.Header["typ"] = auth.AccessTokenType
+		token.Header["jwk"] = holder.jwk
+		raw, err := token.SignedString(holder.key)
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, raw, request)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+	})
+
+	t.Run("should reject a private key in the header", func(t *testing.T) {
+		verifier := auth.NewProofVerifier(auth.ProofConfig{ReplayCache: newReplayCache()})
+		holder := newHolder(t)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, holder.claims(""))
+		token.Header["typ"] = auth.ProofType
+		token.Header["jwk"] = map[string]string{
+			"kty": holder.jwk.KeyType,
+			"crv": holder.jwk.Curve,
+			"x":   holder.jwk.X,
+			"y":   holder.jwk.Y,
+			"d":   base64.RawURLEncoding.EncodeToString(holder.key.D.Bytes()),
+		}
+		raw, err := token.SignedString(holder.key)
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(ctx, raw, request)
+		assert.ErrorIs(t, err, auth.ErrInvalidProof)
+	})
+}
+
+func TestJSONWebKey_Thumbprint(t *testing.T) {
+	// The example of RFC 7638 section 3.1.
+	jwk := auth.JSONWebKey{
+		KeyType:   "RSA",
+		KeyID:     "2011-04-29",
+		Algorithm: "RS256",
+		N:         "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:         "AQAB",
+	}
+
+	jkt, err := jwk.Thumbprint()
+	require.NoError(t, err)
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", jkt)
+
+	_, err = auth.JSONWebKey{KeyType: "oct"}.Thumbprint()
+	assert.ErrorIs(t, err, auth.ErrUnsupportedKey)
+}
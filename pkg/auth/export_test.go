@@ -0,0 +1,9 @@
+package auth
+
+// AllowKeysRefresh lets the next unknown key refetch the key set right away.
+func AllowKeysRefresh(v *Verifier) {
+	v.keySet.fetchMu.Lock()
+	defer v.keySet.fetchMu.Unlock()
+
+	v.keySet.fetchedAt = v.keySet.fetchedAt.Add(-v.cfg.MinRefreshInterval)
+}
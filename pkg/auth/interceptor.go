@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	HeaderAuthorization = "authorization"
+	BearerPrefix        = "Bearer "
+	DPoPPrefix          = "DPoP "
+
+	HeaderDPoP = "dpop"
+	// HeaderHTTPMethod and HeaderHTTPURL hold the method and URL of the HTTP
+	// request the gateway made the call for. DPoP proofs are checked
+	// against them.
+	HeaderHTTPMethod = "x-http-method"
+	HeaderHTTPURL    = "x-http-url"
+)
+
+// UnaryServerInterceptor enforces the policy of each unary method and passes
+// the claims of the caller on in the context.
+func UnaryServerInterceptor(verifier *Verifier, policies Policies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, verifier, policies.lookup(info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor enforces the policy of each streaming method and
+// passes the claims of the caller on in the context of the stream.
+func StreamServerInterceptor(verifier *Verifier, policies Policies) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), verifier, policies.lookup(info.FullMethod))
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authorize(ctx context.Context, verifier *Verifier, policy Policy) (context.Context, error) {
+	if policy.public {
+		return ctx, nil
+	}
+
+	accessToken, dpop, ok := accessTokenFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	claims, err := verifier.Verify(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, ErrKeySetUnavailable) {
+			return nil, status.Error(codes.Unavailable, "failed to verify access token")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	proof, req, _ := ProofFromContext(ctx)
+	req.AccessToken = accessToken
+
+	if err := verifier.VerifyBinding(ctx, claims, dpop, proof, req); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidProof):
+			return nil, status.Error(codes.Unauthenticated, "invalid dpop proof")
+		case errors.Is(err, ErrInvalidToken):
+			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+		default:
+			return nil, status.Error(codes.Unavailable, "failed to verify dpop proof")
+		}
+	}
+
+	if !policy.allows(claims) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return ContextWithClaims(ctx, claims), nil
+}
+
+// accessTokenFromContext returns the access token of the call and whether it
+// was sent with the DPoP scheme rather than as a bearer token.
+func accessTokenFromContext(ctx context.Context) (string, bool, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false, false
+	}
+
+	for _, value := range md.Get(HeaderAuthorization) {
+		if len(value) > len(BearerPrefix) && strings.EqualFold(value[:len(BearerPrefix)], BearerPrefix) {
+			return value[len(BearerPrefix):], false, true
+		}
+		if len(value) > len(DPoPPrefix) && strings.EqualFold(value[:len(DPoPPrefix)], DPoPPrefix) {
+			return value[len(DPoPPrefix):], true, true
+		}
+	}
+
+	return "", false, false
+}
+
+// ProofFromContext returns the DPoP proof of the call and the request it has
+// to match, and whether the call came with a proof at all. A call with
+// several proofs has an empty one, which fails verification as RFC 9449
+// asks.
+func ProofFromContext(ctx context.Context) (string, ProofRequest, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ProofRequest{}, false
+	}
+
+	values := md.Get(HeaderDPoP)
+	if len(values) == 0 {
+		return "", ProofRequest{}, false
+	}
+
+	var proof string
+	if len(values) == 1 {
+		proof = values[0]
+	}
+
+	return proof, ProofRequest{
+		Method: lastValue(md, HeaderHTTPMethod),
+		URL:    lastValue(md, HeaderHTTPURL),
+	}, true
+}
+
+// lastValue returns the last value of key. The gateway appends its own values
+// after the ones a client may have sent as Grpc-Metadata headers.
+func lastValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[len(values)-1]
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,212 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kitanoyoru/kgym/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	publicMethod        = "/file.v1.FileService/GetFileURL"
+	authenticatedMethod = "/file.v1.FileService/UploadUserAvatar"
+	adminMethod         = "/file.v1.FileService/DeleteFile"
+	scopedMethod        = "/file.v1.FileService/ListFiles"
+	unlistedMethod      = "/file.v1.FileService/Unlisted"
+	healthMethod        = "/grpc.health.v1.Health/Check"
+)
+
+var policies = auth.Policies{
+	publicMethod:             auth.Public(),
+	authenticatedMethod:      auth.Authenticated(),
+	adminMethod:              auth.RequireRole("admin"),
+	scopedMethod:             auth.RequireScope("files:read", "profile"),
+	"/grpc.health.v1.Health": auth.Public(),
+}
+
+func withBearerToken(ctx context.Context, token string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(auth.HeaderAuthorization, auth.BearerPrefix+token))
+}
+
+// withDPoPToken adds a bound token, a proof and the request the gateway
+// forwards with them.
+func withDPoPToken(ctx context.Context, token, proof string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(
+		auth.HeaderAuthorization, auth.DPoPPrefix+token,
+		auth.HeaderDPoP, proof,
+		auth.HeaderHTTPMethod, resourceMethod,
+		auth.HeaderHTTPURL, resourceURL,
+	))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	issuer := newIssuer(t)
+	interceptor := auth.UnaryServerInterceptor(issuer.verifier(auth.Config{}), policies)
+
+	// call returns the claims the handler saw.
+	call := func(ctx context.Context, method string) (auth.Claims, bool, error) {
+		var (
+			claims auth.Claims
+			ok     bool
+		)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			claims, ok = auth.ClaimsFromContext(ctx)
+			return nil, nil
+		})
+
+		return claims, ok, err
+	}
+
+	t.Run("should let anyone call public methods", func(t *testing.T) {
+		_, ok, err := call(ctx, publicMethod)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		_, _, err = call(ctx, healthMethod)
+		require.NoError(t, err)
+	})
+
+	t.Run("should pass the claims of the caller to the handler", func(t *testing.T) {
+		expected := issuer.claims()
+
+		claims, ok, err := call(withBearerToken(ctx, issuer.sign(t, expected)), authenticatedMethod)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expected.Subject, claims.Subject)
+	})
+
+	t.Run("should reject calls without a valid access token", func(t *testing.T) {
+		for _, method := range []string{authenticatedMethod, adminMethod, unlistedMethod} {
+			_, _, err := call(ctx, method)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), method)
+
+			_, _, err = call(withBearerToken(ctx, "invalid"), method)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), method)
+		}
+	})
+
+	t.Run("should require the role of the policy", func(t *testing.T) {
+		claims := issuer.claims()
+
+		_, _, err := call(withBearerToken(ctx, issuer.sign(t, claims)), adminMethod)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		claims.Role = "admin"
+
+		_, _, err = call(withBearerToken(ctx, issuer.sign(t, claims)), adminMethod)
+		require.NoError(t, err)
+	})
+
+	t.Run("should require all scopes of the policy", func(t *testing.T) {
+		claims := issuer.claims()
+		claims.Scope = "files:read"
+
+		_, _, err := call(withBearerToken(ctx, issuer.sign(t, claims)), scopedMethod)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		claims.Scope = "profile files:read"
+
+		_, _, err = call(withBearerToken(ctx, issuer.sign(t, claims)), scopedMethod)
+		require.NoError(t, err)
+	})
+
+	t.Run("should require a proof of possession for bound tokens", func(t *testing.T) {
+		interceptor := auth.UnaryServerInterceptor(issuer.verifier(auth.Config{ReplayCache: newReplayCache()}), policies)
+		holder := newHolder(t)
+
+		claims := issuer.claims()
+		claims.Confirmation = &auth.ConfirmationClaims{JKT: holder.jkt(t)}
+		token := issuer.sign(t, claims)
+
+		call := func(ctx context.Context) error {
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: authenticatedMethod}, func(ctx context.Context, req any) (any, error) {
+				return nil, nil
+			})
+			return err
+		}
+
+		proof := holder.proof(t, token)
+		require.NoError(t, call(withDPoPToken(ctx, token, proof)))
+
+		// Replayed proofs, proofs of other keys and bound tokens sent as
+		// bearer tokens are all refused.
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(withDPoPToken(ctx, token, proof))))
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(withDPoPToken(ctx, token, newHolder(t).proof(t, token)))))
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(withDPoPToken(ctx, token, ""))))
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(withBearerToken(ctx, token))))
+
+		// The DPoP scheme is only for bound tokens.
+		unbound := issuer.sign(t, issuer.claims())
+		assert.Equal(t, codes.Unauthenticated, status.Code(call(withDPoPToken(ctx, unbound, holder.proof(t, unbound)))))
+	})
+
+	t.Run("should reject bound tokens without a replay cache", func(t *testing.T) {
+		holder := newHolder(t)
+
+		claims := issuer.claims()
+		claims.Confirmation = &auth.ConfirmationClaims{JKT: holder.jkt(t)}
+		token := issuer.sign(t, claims)
+
+		_, _, err := call(withDPoPToken(ctx, token, holder.proof(t, token)), authenticatedMethod)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("should fail as unavailable when the key set cannot be fetched", func(t *testing.T) {
+		issuer := newIssuer(t)
+		issuer.unavailable.Store(true)
+		interceptor := auth.UnaryServerInterceptor(issuer.verifier(auth.Config{}), policies)
+
+		_, err := interceptor(withBearerToken(ctx, issuer.sign(t, issuer.claims())), nil, &grpc.UnaryServerInfo{FullMethod: authenticatedMethod}, func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	issuer := newIssuer(t)
+	interceptor := auth.StreamServerInterceptor(issuer.verifier(auth.Config{}), policies)
+
+	t.Run("should pass the claims of the caller to the handler", func(t *testing.T) {
+		expected := issuer.claims()
+		stream := &serverStream{ctx: withBearerToken(ctx, issuer.sign(t, expected))}
+
+		err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: authenticatedMethod}, func(srv any, stream grpc.ServerStream) error {
+			claims, ok := auth.ClaimsFromContext(stream.Context())
+			require.True(t, ok)
+			assert.Equal(t, expected.Subject, claims.Subject)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject calls without an access token", func(t *testing.T) {
+		stream := &serverStream{ctx: ctx}
+
+		err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: authenticatedMethod}, func(srv any, stream grpc.ServerStream) error {
+			t.Fatal("handler called")
+			return nil
+		})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,280 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const maxKeySetSize = 1 << 20 // 1MB
+
+var (
+	ErrUnsupportedKey = errors.New("unsupported json web key")
+)
+
+// JSONWebKeySet is a key set as described in RFC 7517 section 5.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JSONWebKey holds the public parameters of RSA, EC and OKP keys.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid,omitempty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC and OKP
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// PublicKeys returns the signature verification keys of the set by key id.
+// Encryption keys and keys that cannot be parsed are skipped, so a single
+// key of an unknown type does not break verification.
+func (s JSONWebKeySet) PublicKeys() map[string]any {
+	keys := make(map[string]any, len(s.Keys))
+	for _, jwk := range s.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.KeyID] = key
+	}
+
+	return keys
+}
+
+func (k JSONWebKey) PublicKey() (any, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, ErrUnsupportedKey
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrUnsupportedKey
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, ErrUnsupportedKey
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, ErrUnsupportedKey
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(buf), nil
+}
+
+// keySet caches the key set published at a URL. Lookups only take a read
+// lock; fetches are serialized so a burst of tokens signed with a new key
+// fetches the set once.
+type keySet struct {
+	url                string
+	client             *http.Client
+	minRefreshInterval time.Duration
+
+	mu   sync.RWMutex
+	keys map[string]any
+
+	fetchMu   sync.Mutex
+	fetchedAt time.Time
+}
+
+func newKeySet(url string, client *http.Client, minRefreshInterval time.Duration) *keySet {
+	return &keySet{
+		url:                url,
+		client:             client,
+		minRefreshInterval: minRefreshInterval,
+	}
+}
+
+func (s *keySet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.fetchMu.Lock()
+		err := s.fetch(ctx)
+		s.fetchMu.Unlock()
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Str("url", s.url).Msg("failed to refresh key set")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// key returns the verification key with the given id. The key set is
+// refetched when it does not have the key, since SSO publishes new keys
+// before signing with them, but at most once per minRefreshInterval.
+func (s *keySet) key(ctx context.Context, kid string) (any, error) {
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	// Another caller may have fetched the set while this one waited.
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	if time.Since(s.fetchedAt) < s.minRefreshInterval {
+		if !s.loaded() {
+			return nil, ErrKeySetUnavailable
+		}
+		return nil, errUnknownKey
+	}
+
+	if err := s.fetch(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.lookup(kid); ok {
+		return key, nil
+	}
+
+	return nil, errUnknownKey
+}
+
+// lookup finds a key by id. A token without a key id can only be verified
+// if the set has a single key.
+func (s *keySet) lookup(kid string) (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+
+	key, ok := s.keys[kid]
+
+	return key, ok
+}
+
+func (s *keySet) loaded() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.keys != nil
+}
+
+// fetch replaces the cached key set. A failed fetch keeps the previous set.
+// The caller must hold s.fetchMu.
+func (s *keySet) fetch(ctx context.Context) error {
+	s.fetchedAt = time.Now()
+
+	var set JSONWebKeySet
+	if err := s.getJSON(ctx, &set); err != nil {
+		return errors.Wrap(ErrKeySetUnavailable, err.Error())
+	}
+
+	keys := set.PublicKeys()
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *keySet) getJSON(ctx context.Context, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, s.url)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxKeySetSize)).Decode(v)
+}
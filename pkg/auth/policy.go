@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+)
+
+// Policy decides who may call a method.
+type Policy struct {
+	public bool
+	roles  []string
+	scopes []string
+}
+
+// Public lets anyone call the method. Tokens sent along are not verified.
+func Public() Policy {
+	return Policy{public: true}
+}
+
+// Authenticated requires a valid access token.
+func Authenticated() Policy {
+	return Policy{}
+}
+
+// RequireRole requires a valid access token of a user with one of the roles.
+func RequireRole(roles ...string) Policy {
+	return Policy{roles: roles}
+}
+
+// RequireScope requires a valid access token that was granted all of the
+// scopes.
+func RequireScope(scopes ...string) Policy {
+	return Policy{scopes: scopes}
+}
+
+func (p Policy) allows(claims Claims) bool {
+	if len(p.roles) > 0 && !slices.Contains(p.roles, claims.Role) {
+		return false
+	}
+
+	granted := claims.Scopes()
+	for _, scope := range p.scopes {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Policies are the policies of the methods of a server by full method name,
+// as in /file.v1.FileService/DeleteFile. A service name, as in
+// /grpc.health.v1.Health, sets the policy of all methods of the service that
+// have none of their own. Methods without a policy require a valid access
+// token, so a method added to a server is never public by accident.
+type Policies map[string]Policy
+
+func (p Policies) lookup(fullMethod string) Policy {
+	if policy, ok := p[fullMethod]; ok {
+		return policy
+	}
+
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if policy, ok := p[fullMethod[:i]]; ok {
+			return policy
+		}
+	}
+
+	return Authenticated()
+}
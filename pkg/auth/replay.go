@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	ReplayKeyPrefix = "dpop:jti:"
+)
+
+var _ ReplayCache = (*RedisReplayCache)(nil)
+
+// RedisReplayCache remembers proofs in Redis, so that a proof is accepted
+// once across all replicas of a service.
+type RedisReplayCache struct {
+	rdb redis.Cmdable
+}
+
+func NewRedisReplayCache(rdb redis.Cmdable) *RedisReplayCache {
+	return &RedisReplayCache{
+		rdb: rdb,
+	}
+}
+
+func (c *RedisReplayCache) Remember(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	// Redis rounds expirations below a millisecond to none at all.
+	ttl := max(time.Until(expiresAt), time.Millisecond)
+
+	return c.rdb.SetNX(ctx, ReplayKeyPrefix+id, 1, ttl).Result()
+}
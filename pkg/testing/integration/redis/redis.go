@@ -3,8 +3,12 @@ package redis
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/testcontainers/testcontainers-go"
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -15,9 +19,48 @@ type RedisContainer struct {
 }
 
 func SetupTestContainer(ctx context.Context) (*RedisContainer, error) {
+	return setupTestContainer(ctx)
+}
+
+// SetupTestClusterContainer starts a Redis Cluster of a single node that
+// serves every hash slot, so commands across slots fail as they do in
+// production. The node announces its address inside the container network;
+// clients have to route every slot to URI themselves.
+func SetupTestClusterContainer(ctx context.Context) (*RedisContainer, error) {
+	container, err := setupTestContainer(ctx, "redis-server", "--cluster-enabled", "yes")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := run(ctx, container, "redis-cli", "cluster", "addslotsrange", "0", "16383"); err != nil {
+		_ = container.Terminate(ctx)
+		return nil, err
+	}
+
+	for range 50 {
+		info, err := run(ctx, container, "redis-cli", "cluster", "info")
+		if err != nil {
+			_ = container.Terminate(ctx)
+			return nil, err
+		}
+
+		if strings.Contains(info, "cluster_state:ok") {
+			return container, nil
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	_ = container.Terminate(ctx)
+
+	return nil, fmt.Errorf("redis cluster did not become ready")
+}
+
+func setupTestContainer(ctx context.Context, cmd ...string) (*RedisContainer, error) {
 	req := testcontainers.ContainerRequest{
 		Image:        "redis:8.4.0-alpine",
 		ExposedPorts: []string{"6379/tcp"},
+		Cmd:          cmd,
 		WaitingFor:   wait.ForLog("Ready to accept connections"),
 	}
 
@@ -48,3 +91,21 @@ func SetupTestContainer(ctx context.Context) (*RedisContainer, error) {
 		URI:       uri,
 	}, nil
 }
+
+func run(ctx context.Context, container testcontainers.Container, cmd ...string) (string, error) {
+	code, reader, err := container.Exec(ctx, cmd, tcexec.Multiplexed())
+	if err != nil {
+		return "", err
+	}
+
+	output, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	if code != 0 {
+		return "", fmt.Errorf("%s exited with %d: %s", strings.Join(cmd, " "), code, output)
+	}
+
+	return string(output), nil
+}